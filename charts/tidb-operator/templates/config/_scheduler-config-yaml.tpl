@@ -15,6 +15,7 @@ extenders:
   - urlPrefix: http://127.0.0.1:10262/scheduler
     filterVerb: filter
     preemptVerb: preempt
+    prioritizeVerb: prioritize
     weight: 1
     enableHTTPS: false
     httpTimeout: 30s
@@ -37,6 +38,7 @@ extenders:
   - urlPrefix: http://127.0.0.1:10262/scheduler
     filterVerb: filter
     preemptVerb: preempt
+    prioritizeVerb: prioritize
     weight: 1
     enableHTTPS: false
     httpTimeout: 30s
@@ -15,22 +15,40 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/openshift/generic-admission-server/pkg/cmd"
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/version"
+	"github.com/pingcap/tidb-operator/pkg/webhook/conversion"
 	"github.com/pingcap/tidb-operator/pkg/webhook/statefulset"
 	"github.com/pingcap/tidb-operator/pkg/webhook/strategy"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/client-go/rest"
 	"k8s.io/component-base/logs"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	// conversionServiceName is the name of the Service in front of the webhook, it's created by the chart
+	conversionServiceName = "tidb-admission-webhook"
 )
 
 var (
 	printVersion         bool
 	extraServiceAccounts string
 	minResyncDuration    time.Duration
+
+	conversionPort        int
+	conversionTLSCertFile string
+	conversionTLSKeyFile  string
+	conversionCAFile      string
 )
 
 func init() {
@@ -42,6 +60,10 @@ func init() {
 	flag.BoolVar(&printVersion, "version", false, "Show version and quit")
 	flag.StringVar(&extraServiceAccounts, "extraServiceAccounts", "", "comma-separated, extra Service Accounts the Webhook should control. The full pattern for each common service account is system:serviceaccount:<namespace>:<serviceaccount-name>")
 	flag.DurationVar(&minResyncDuration, "min-resync-duration", 12*time.Hour, "The resync period in reflectors will be random between MinResyncPeriod and 2*MinResyncPeriod.")
+	flag.IntVar(&conversionPort, "conversion-port", 0, "The port on which to serve the conversion webhook of the CRDs. If 0, the conversion webhook is disabled.")
+	flag.StringVar(&conversionTLSCertFile, "conversion-tls-cert-file", "", "File containing the x509 certificate to serve the conversion webhook.")
+	flag.StringVar(&conversionTLSKeyFile, "conversion-tls-private-key-file", "", "File containing the x509 private key matching --conversion-tls-cert-file.")
+	flag.StringVar(&conversionCAFile, "conversion-ca-file", "", "File containing the CA bundle the kube-apiserver uses to verify the certificate of the conversion webhook.")
 	features.DefaultFeatureGate.AddFlag(flag.CommandLine)
 }
 
@@ -72,5 +94,45 @@ func main() {
 	statefulSetAdmissionHook := statefulset.NewStatefulSetAdmissionControl()
 	strategyAdmissionHook := strategy.NewStrategyAdmissionHook(&strategy.Registry)
 
+	if conversionPort > 0 {
+		if err := startConversionWebhook(ns); err != nil {
+			klog.Fatalf("failed to start the conversion webhook: %v", err)
+		}
+	}
+
 	cmd.RunAdmissionServer(statefulSetAdmissionHook, strategyAdmissionHook)
 }
+
+// startConversionWebhook serves the conversion webhook in background and registers it to the CRDs
+func startConversionWebhook(ns string) error {
+	caBundle, err := ioutil.ReadFile(conversionCAFile)
+	if err != nil {
+		return fmt.Errorf("failed to read CA file %s: %v", conversionCAFile, err)
+	}
+	cfg, err := rest.InClusterConfig()
+	if err != nil {
+		return err
+	}
+	extCli, err := apiextensionsclientset.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(conversion.Path, conversion.NewWebhook())
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", conversionPort),
+		Handler: mux,
+	}
+	go func() {
+		klog.Infof("conversion webhook is serving on %s", server.Addr)
+		klog.Fatal(server.ListenAndServeTLS(conversionTLSCertFile, conversionTLSKeyFile))
+	}()
+
+	return conversion.Register(extCli, &apiextensionsv1.ServiceReference{
+		Namespace: ns,
+		Name:      conversionServiceName,
+		Path:      pointer.StringPtr(conversion.Path),
+		Port:      pointer.Int32Ptr(int32(conversionPort)),
+	}, caBundle)
+}
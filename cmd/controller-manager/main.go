@@ -34,17 +34,22 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller/restore"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbcluster"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbdashboard"
+	"github.com/pingcap/tidb-operator/pkg/controller/tidbgrant"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbinitializer"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbmonitor"
 	"github.com/pingcap/tidb-operator/pkg/controller/tidbngmonitoring"
+	"github.com/pingcap/tidb-operator/pkg/controller/tidbuser"
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/metrics"
 	"github.com/pingcap/tidb-operator/pkg/scheme"
+	"github.com/pingcap/tidb-operator/pkg/tracing"
 	"github.com/pingcap/tidb-operator/pkg/upgrader"
 	"github.com/pingcap/tidb-operator/pkg/version"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -78,6 +83,15 @@ func main() {
 
 	metrics.RegisterMetrics()
 
+	shutdownTracing, err := tracing.Setup(tracing.Config{
+		Endpoint:    cliCfg.TracingEndpoint,
+		SampleRatio: cliCfg.TracingSampleRatio,
+		ServiceName: "tidb-controller-manager",
+	})
+	if err != nil {
+		klog.Fatalf("failed to setup tracing: %v", err)
+	}
+
 	hostName, err := os.Hostname()
 	if err != nil {
 		klog.Fatalf("failed to get hostname: %v", err)
@@ -120,6 +134,14 @@ func main() {
 	if err != nil {
 		klog.Fatalf("failed to get advanced-statefulset Clientset: %v", err)
 	}
+	extCli, err := apiextensionsclientset.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("failed to get apiextensions Clientset: %v", err)
+	}
+	dynCli, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		klog.Fatalf("failed to get dynamic client: %v", err)
+	}
 	// TODO: optimize the read of genericCli with the shared cache
 	genericCli, err := client.New(cfg, client.Options{Scheme: scheme.Scheme})
 	if err != nil {
@@ -129,9 +151,9 @@ func main() {
 	// note that kubeCli here must not be the hijacked one
 	var operatorUpgrader upgrader.Interface
 	if cliCfg.ClusterScoped {
-		operatorUpgrader = upgrader.NewUpgrader(kubeCli, cli, asCli, metav1.NamespaceAll)
+		operatorUpgrader = upgrader.NewUpgrader(kubeCli, cli, asCli, extCli, dynCli, metav1.NamespaceAll)
 	} else {
-		operatorUpgrader = upgrader.NewUpgrader(kubeCli, cli, asCli, ns)
+		operatorUpgrader = upgrader.NewUpgrader(kubeCli, cli, asCli, extCli, dynCli, ns)
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.AdvancedStatefulSet) {
@@ -178,6 +200,8 @@ func main() {
 			tidbmonitor.NewController(deps),
 			tidbngmonitoring.NewController(deps),
 			tidbdashboard.NewController(deps),
+			tidbuser.NewController(deps),
+			tidbgrant.NewController(deps),
 		}
 		if features.DefaultFeatureGate.Enabled(features.AutoScaling) {
 			controllers = append(controllers, autoscaler.NewController(deps))
@@ -250,6 +274,9 @@ func main() {
 	go func() {
 		sig := <-sc
 		klog.Infof("got signal %s to exit", sig)
+		if err2 := shutdownTracing(context.Background()); err2 != nil {
+			klog.Errorf("fail to flush the traces: %v", err2)
+		}
 		if err2 := srv.Shutdown(context.Background()); err2 != nil {
 			klog.Fatal("fail to shutdown the HTTP server", err2)
 		}
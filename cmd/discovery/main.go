@@ -26,23 +26,35 @@ import (
 	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/discovery"
 	"github.com/pingcap/tidb-operator/pkg/discovery/server"
 	"github.com/pingcap/tidb-operator/pkg/dmapi"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"github.com/pingcap/tidb-operator/pkg/version"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/component-base/logs"
 	"k8s.io/klog/v2"
 )
 
 var (
-	printVersion bool
-	port         int
-	proxyPort    int
+	printVersion   bool
+	port           int
+	proxyPort      int
+	stateConfigMap string
+	leaderElect    bool
+	leaseName      string
+	leaseDuration  = 15 * time.Second
+	renewDeadline  = 10 * time.Second
+	retryPeriod    = 2 * time.Second
+	waitDuration   = 5 * time.Second
 )
 
 func init() {
@@ -50,6 +62,9 @@ func init() {
 	flag.BoolVar(&printVersion, "version", false, "Show version and quit")
 	flag.IntVar(&port, "port", 10261, "The port that the tidb discovery's http service runs on (default 10261)")
 	flag.IntVar(&proxyPort, "proxy-port", 10262, "The port that the tidb discovery's proxy service runs on (default 10262)")
+	flag.StringVar(&stateConfigMap, "state-configmap", "", "The ConfigMap to persist the peer knowledge in, it is kept in memory if empty")
+	flag.BoolVar(&leaderElect, "leader-elect", false, "Elect a leader to serve the discovery requests when running multiple replicas")
+	flag.StringVar(&leaseName, "lease-name", "", "The name of the Lease used for leader election")
 	flag.Parse()
 }
 
@@ -84,6 +99,14 @@ func main() {
 	if len(tcName) < 1 {
 		klog.Fatal("ENV TC_NAME is not set")
 	}
+	ns := os.Getenv("MY_POD_NAMESPACE")
+	if leaderElect && leaseName == "" {
+		klog.Fatal("--lease-name is required when --leader-elect is set")
+	}
+	hostName, err := os.Hostname()
+	if err != nil {
+		klog.Fatalf("failed to get hostname: %v", err)
+	}
 	tcTls := false
 	tlsEnabled := os.Getenv("TC_TLS_ENABLED")
 	if tlsEnabled == strconv.FormatBool(true) {
@@ -91,7 +114,7 @@ func main() {
 	}
 	// informers
 	options := []kubeinformers.SharedInformerOption{
-		kubeinformers.WithNamespace(os.Getenv("MY_POD_NAMESPACE")),
+		kubeinformers.WithNamespace(ns),
 	}
 
 	kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeCli, 30*time.Minute, options...)
@@ -104,19 +127,71 @@ func main() {
 	// waiting for the shared informer's store has synced.
 	cache.WaitForCacheSync(ctx.Done(), secretInformer.HasSynced)
 
+	serverOptions := server.Options{Identity: hostName}
+	if stateConfigMap != "" {
+		serverOptions.StateStore = discovery.NewConfigMapStateStore(kubeCli, ns, stateConfigMap)
+	}
+	lister := kubeInformerFactory.Core().V1().Secrets().Lister()
+	discoveryServer := server.NewServerWithOptions(pdapi.NewDefaultPDControl(lister), dmapi.NewDefaultMasterControl(lister), cli, kubeCli, serverOptions)
 	go wait.Forever(func() {
 		addr := fmt.Sprintf("0.0.0.0:%d", port)
 		klog.Infof("starting TiDB Discovery server, listening on %s", addr)
-		lister := kubeInformerFactory.Core().V1().Secrets().Lister()
-		discoveryServer := server.NewServer(pdapi.NewDefaultPDControl(lister), dmapi.NewDefaultMasterControl(lister), cli, kubeCli)
 		discoveryServer.ListenAndServe(addr)
-	}, 5*time.Second)
+	}, waitDuration)
 	go wait.Forever(func() {
 		addr := fmt.Sprintf("0.0.0.0:%d", proxyPort)
 		klog.Infof("starting TiDB Proxy server, listening on %s", addr)
 		proxyServer := server.NewProxyServer(tcName, tcTls)
 		proxyServer.ListenAndServe(addr)
-	}, 5*time.Second)
+	}, waitDuration)
+
+	startLeading := func(context.Context) {
+		// the discovery requests are rejected until the persisted peer knowledge is loaded
+		err := wait.PollImmediateInfinite(waitDuration, func() (bool, error) {
+			if err := discoveryServer.StartLeading(); err != nil {
+				klog.Errorf("failed to start serving the discovery requests: %v", err)
+				return false, nil
+			}
+			return true, nil
+		})
+		if err != nil {
+			klog.Fatalf("failed to start serving the discovery requests: %v", err)
+		}
+	}
+	if leaderElect {
+		// leader election for multiple tidb-discovery instances, only the leader serves the discovery requests
+		go wait.Forever(func() {
+			leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+				Lock: &resourcelock.LeaseLock{
+					LeaseMeta: metav1.ObjectMeta{
+						Namespace: ns,
+						Name:      leaseName,
+					},
+					Client: kubeCli.CoordinationV1(),
+					LockConfig: resourcelock.ResourceLockConfig{
+						Identity:      hostName,
+						EventRecorder: &record.FakeRecorder{},
+					},
+				},
+				LeaseDuration:   leaseDuration,
+				RenewDeadline:   renewDeadline,
+				RetryPeriod:     retryPeriod,
+				ReleaseOnCancel: true,
+				Callbacks: leaderelection.LeaderCallbacks{
+					OnStartedLeading: startLeading,
+					OnStoppedLeading: func() {
+						if ctx.Err() != nil {
+							klog.Infof("leader election is stopped")
+							return
+						}
+						klog.Fatal("leader election lost")
+					},
+				},
+			})
+		}, waitDuration)
+	} else {
+		go startLeading(ctx)
+	}
 
 	srv := http.Server{Addr: ":6060"}
 	sc := make(chan os.Signal, 1)
@@ -130,6 +205,8 @@ func main() {
 	go func() {
 		sig := <-sc
 		klog.Infof("got signal %s to exit", sig)
+		// release the lease so that another discovery pod takes over at once
+		cancel()
 		if err2 := srv.Shutdown(context.Background()); err2 != nil {
 			klog.Fatal("fail to shutdown the HTTP server", err2)
 		}
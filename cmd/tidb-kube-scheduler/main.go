@@ -0,0 +1,39 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// tidb-kube-scheduler is kube-scheduler with the TiDBScheduling plugin built in,
+// it replaces the kube-scheduler and tidb-scheduler extender pair.
+package main
+
+import (
+	"os"
+
+	"github.com/pingcap/tidb-operator/pkg/scheduler/plugins"
+	"github.com/pingcap/tidb-operator/pkg/version"
+	"k8s.io/component-base/logs"
+	"k8s.io/kubernetes/cmd/kube-scheduler/app"
+)
+
+func main() {
+	command := app.NewSchedulerCommand(
+		app.WithPlugin(plugins.Name, plugins.New),
+	)
+
+	logs.InitLogs()
+	defer logs.FlushLogs()
+	version.LogVersionInfo()
+
+	if err := command.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+var _ = func() bool {
+	testing.Init()
+	return true
+}()
+
+// TestRunMain runs the scheduler in the E2E tests, the test binary is built with `make E2E=y` to collect
+// the coverage and run with the E2E argument. It's skipped by `go test` as it requires a Kubernetes cluster.
+func TestRunMain(t *testing.T) {
+	e2e := false
+	var args []string
+	for _, arg := range os.Args {
+		switch {
+		case arg == "E2E":
+			e2e = true
+		case strings.HasPrefix(arg, "-test."):
+		default:
+			args = append(args, arg)
+		}
+	}
+
+	if !e2e {
+		t.Skip("only run in the E2E tests")
+	}
+
+	os.Args = args
+	main()
+}
@@ -291,7 +291,7 @@ function hack::ensure_goimports() {
 
 function hack::ensure_codegen() {
     echo "Installing codegen..."
-    GOBIN=$OUTPUT_BIN go install k8s.io/code-generator/cmd/{defaulter-gen,client-gen,lister-gen,informer-gen,deepcopy-gen}@v$K8S_VERSION
+    GOBIN=$OUTPUT_BIN go install k8s.io/code-generator/cmd/{defaulter-gen,client-gen,lister-gen,informer-gen,deepcopy-gen,conversion-gen}@v$K8S_VERSION
 }
 
 function hack::ensure_openapi() {
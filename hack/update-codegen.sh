@@ -31,5 +31,13 @@ GOBIN=$OUTPUT_BIN bash $ROOT/hack/generate-groups.sh "deepcopy,client,informer,l
     --output-base $ROOT \
     --go-header-file ./hack/boilerplate/boilerplate.generatego.txt
 
+# v1beta1 is only served by the conversion webhook, the operator and the clients work with v1alpha1
+GOBIN=$OUTPUT_BIN bash $ROOT/hack/generate-groups.sh "deepcopy,conversion" \
+    github.com/pingcap/tidb-operator/pkg/client \
+    github.com/pingcap/tidb-operator/pkg/apis \
+    pingcap:v1beta1 \
+    --output-base $ROOT \
+    --go-header-file ./hack/boilerplate/boilerplate.generatego.txt
+
 # then we merge generated code with our code base and clean up
 cp -r github.com/pingcap/tidb-operator/pkg $ROOT && rm -rf github.com
@@ -62,6 +62,12 @@ const (
 	// BackupProtectionFinalizer is the name of finalizer on backups
 	BackupProtectionFinalizer string = "tidb.pingcap.com/backup-protection"
 
+	// TiDBUserProtectionFinalizer is the name of finalizer on TiDBUsers, the user is dropped from TiDB before the finalizer is removed
+	TiDBUserProtectionFinalizer string = "tidb.pingcap.com/tidb-user-protection"
+
+	// TiDBGrantProtectionFinalizer is the name of finalizer on TiDBGrants, the privileges are revoked from TiDB before the finalizer is removed
+	TiDBGrantProtectionFinalizer string = "tidb.pingcap.com/tidb-grant-protection"
+
 	// AutoScalingGroupLabelKey describes the autoscaling group of the TiDB
 	AutoScalingGroupLabelKey = "tidb.pingcap.com/autoscaling-group"
 	// AutoInstanceLabelKey is label key used in autoscaling, it represents the autoscaler name
@@ -70,9 +76,15 @@ const (
 	AutoComponentLabelKey string = "tidb.pingcap.com/auto-component"
 	// BaseTCLabelKey is label key used for heterogeneous clusters to refer to its base TidbCluster
 	BaseTCLabelKey string = "tidb.pingcap.com/base-tc"
+	// TiKVGroupLabelKey is label key used to mark the TiKV group that a resource belongs to
+	TiKVGroupLabelKey string = "tidb.pingcap.com/tikv-group"
+	// TiFlashRoleLabelKey is label key used to mark the role of TiFlash in the disaggregated architecture
+	TiFlashRoleLabelKey string = "tidb.pingcap.com/tiflash-role"
 
 	// AnnHATopologyKey defines the High availability topology key
 	AnnHATopologyKey = "pingcap.com/ha-topology-key"
+	// DefaultHATopologyKey is the High availability topology key used if AnnHATopologyKey is not set
+	DefaultHATopologyKey = "kubernetes.io/hostname"
 
 	// AnnFailTiDBScheduler is for injecting a failure into the TiDB custom scheduler
 	// A pod with this annotation will produce an error when scheduled.
@@ -137,6 +149,10 @@ const (
 	// when TiDB cluster is restored from volume snapshot based backup.
 	AnnTiKVVolumesReadyKey = "tidb.pingcap.com/tikv-volumes-ready"
 
+	// AnnV1alpha1Fields is the annotation key of v1beta1 objects to preserve the values of the deprecated
+	// v1alpha1 fields which are removed in v1beta1, so that the conversion between the two versions is lossless.
+	AnnV1alpha1Fields = "tidb.pingcap.com/v1alpha1-fields"
+
 	// PDLabelVal is PD label value
 	PDLabelVal string = "pd"
 	// TiDBLabelVal is TiDB label value
@@ -145,10 +161,16 @@ const (
 	TiKVLabelVal string = "tikv"
 	// TiFlashLabelVal is TiFlash label value
 	TiFlashLabelVal string = "tiflash"
+	// TiFlashComputeRoleVal is the role label value of TiFlash compute nodes
+	TiFlashComputeRoleVal string = "compute"
 	// TiCDCLabelVal is TiCDC label value
 	TiCDCLabelVal string = "ticdc"
 	// TiProxyLabelVal is TiProxy label value
 	TiProxyLabelVal string = "tiproxy"
+	// PDMSTSOLabelVal is the label value of the TSO microservice of PD
+	PDMSTSOLabelVal string = "tso"
+	// PDMSSchedulingLabelVal is the label value of the scheduling microservice of PD
+	PDMSSchedulingLabelVal string = "scheduling"
 	// PumpLabelVal is Pump label value
 	PumpLabelVal string = "pump"
 	// DiscoveryLabelVal is Discovery label value
@@ -372,6 +394,16 @@ func (l Label) IsPD() bool {
 	return l[ComponentLabelKey] == PDLabelVal
 }
 
+// PDMS assigns the name of the PD microservice to component key in label
+func (l Label) PDMS(name string) Label {
+	return l.Component(name)
+}
+
+// IsPDMS returns whether label is a PD microservice component
+func (l Label) IsPDMS() bool {
+	return l[ComponentLabelKey] == PDMSTSOLabelVal || l[ComponentLabelKey] == PDMSSchedulingLabelVal
+}
+
 // TiProxy assigns tiproxy to component key in label
 func (l Label) TiProxy() Label {
 	return l.Component(TiProxyLabelVal)
@@ -472,11 +504,23 @@ func (l Label) IsTiKV() bool {
 	return l[ComponentLabelKey] == TiKVLabelVal
 }
 
+// TiKVGroup assigns specific value to tikv group key in label
+func (l Label) TiKVGroup(name string) Label {
+	l[TiKVGroupLabelKey] = name
+	return l
+}
+
 // TiFlash assigns tiflash to component key in label
 func (l Label) TiFlash() Label {
 	return l.Component(TiFlashLabelVal)
 }
 
+// TiFlashCompute assigns compute to tiflash role key in label
+func (l Label) TiFlashCompute() Label {
+	l[TiFlashRoleLabelKey] = TiFlashComputeRoleVal
+	return l
+}
+
 // IsTiFlash returns whether label is a TiFlash component
 func (l Label) IsTiFlash() bool {
 	return l[ComponentLabelKey] == TiFlashLabelVal
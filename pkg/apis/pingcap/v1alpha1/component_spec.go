@@ -85,6 +85,9 @@ func (tc *TidbCluster) AllComponentSpec() []ComponentAccessor {
 	if tc.Spec.PD != nil {
 		components = append(components, tc.BasePDSpec())
 	}
+	for i := range tc.Spec.PDMS {
+		components = append(components, tc.BasePDMSSpec(&tc.Spec.PDMS[i]))
+	}
 	if tc.Spec.TiDB != nil {
 		components = append(components, tc.BaseTiDBSpec())
 	}
@@ -654,6 +657,11 @@ func (tc *TidbCluster) BaseTiProxySpec() ComponentAccessor {
 	return buildTidbClusterComponentAccessor(TiProxyMemberType, tc, spec)
 }
 
+// BasePDMSSpec returns the base spec of the members of a PD microservice
+func (tc *TidbCluster) BasePDMSSpec(spec *PDMSSpec) ComponentAccessor {
+	return buildTidbClusterComponentAccessor(MemberType(spec.Name), tc, &spec.ComponentSpec)
+}
+
 // BaseTiCDCSpec returns the base spec of TiCDC servers
 func (tc *TidbCluster) BaseTiCDCSpec() ComponentAccessor {
 	var spec *ComponentSpec
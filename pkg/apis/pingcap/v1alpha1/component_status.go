@@ -22,6 +22,7 @@ import (
 var (
 	// supported components
 	_ ComponentStatus = &PDStatus{}
+	_ ComponentStatus = &PDMSStatus{}
 	_ ComponentStatus = &TiKVStatus{}
 	_ ComponentStatus = &TiDBStatus{}
 	_ ComponentStatus = &PumpStatus{}
@@ -76,6 +77,9 @@ func (tc *TidbCluster) AllComponentStatus() []ComponentStatus {
 	if tc.Spec.PD != nil {
 		components = append(components, &tc.Status.PD)
 	}
+	for _, spec := range tc.Spec.PDMS {
+		components = append(components, tc.GetPDMSStatus(spec.Name))
+	}
 	if tc.Spec.TiDB != nil {
 		components = append(components, &tc.Status.TiDB)
 	}
@@ -173,6 +177,52 @@ func (s *PDStatus) SetVolumes(vols map[StorageVolumeName]*StorageVolumeStatus) {
 	s.Volumes = vols
 }
 
+func (s *PDMSStatus) MemberType() MemberType {
+	return MemberType(s.Name)
+}
+func (s *PDMSStatus) GetSynced() bool {
+	return s.Synced
+}
+func (s *PDMSStatus) GetPhase() MemberPhase {
+	return s.Phase
+}
+func (s *PDMSStatus) GetVolumes() map[StorageVolumeName]*StorageVolumeStatus {
+	return s.Volumes
+}
+func (s *PDMSStatus) GetConditions() []metav1.Condition {
+	return s.Conditions
+}
+func (s *PDMSStatus) GetStatefulSet() *appsv1.StatefulSetStatus {
+	return s.StatefulSet
+}
+func (s *PDMSStatus) SetSynced(synced bool) {
+	s.Synced = synced
+}
+func (s *PDMSStatus) SetCondition(newCondition metav1.Condition) {
+	if s.Conditions == nil {
+		s.Conditions = []metav1.Condition{}
+	}
+	conditions := s.Conditions
+	meta.SetStatusCondition(&conditions, newCondition)
+	s.Conditions = conditions
+}
+func (s *PDMSStatus) RemoveCondition(conditionType string) {
+	if s.Conditions == nil {
+		return
+	}
+	conditions := s.Conditions
+	meta.RemoveStatusCondition(&conditions, conditionType)
+	s.Conditions = conditions
+}
+func (s *PDMSStatus) SetPhase(phase MemberPhase) {
+	s.Phase = phase
+}
+func (s *PDMSStatus) SetStatefulSet(sts *appsv1.StatefulSetStatus) {
+	s.StatefulSet = sts
+}
+func (s *PDMSStatus) SetVolumes(vols map[StorageVolumeName]*StorageVolumeStatus) {
+	s.Volumes = vols
+}
 func (s *TiKVStatus) MemberType() MemberType {
 	return TiKVMemberType
 }
@@ -22,4 +22,10 @@ const (
 
 	// DefaultTidbUser is the default tidb user for login tidb cluster
 	DefaultTidbUser = "root"
+
+	// DMUpstreamTLSMountPath is the directory where the client certificates of the upstream databases are mounted
+	DMUpstreamTLSMountPath = "/var/lib/upstream-tls"
+
+	// DMDownstreamTLSMountPath is the directory where the client certificates of the downstream databases are mounted
+	DMDownstreamTLSMountPath = "/var/lib/downstream-tls"
 )
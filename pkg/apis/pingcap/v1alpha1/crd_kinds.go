@@ -61,6 +61,14 @@ const (
 	TiDBDashboardKind    = "TidbDashboard"
 	TiDBDashboardKindKey = "tidbdashboard"
 
+	TiDBUserName    = "tidbusers"
+	TiDBUserKind    = "TiDBUser"
+	TiDBUserKindKey = "tidbuser"
+
+	TiDBGrantName    = "tidbgrants"
+	TiDBGrantKind    = "TiDBGrant"
+	TiDBGrantKindKey = "tidbgrant"
+
 	SpecPath = "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1."
 )
 
@@ -38,6 +38,9 @@ func SetTidbClusterDefault(tc *v1alpha1.TidbCluster) {
 	if tc.Spec.PD != nil {
 		setPdSpecDefault(tc)
 	}
+	if len(tc.Spec.PDMS) > 0 {
+		setPDMSSpecDefault(tc)
+	}
 	if tc.Spec.TiKV != nil {
 		setTikvSpecDefault(tc)
 	}
@@ -112,6 +115,17 @@ func setTikvSpecDefault(tc *v1alpha1.TidbCluster) {
 	if tc.Spec.TiKV.MaxFailoverCount == nil {
 		tc.Spec.TiKV.MaxFailoverCount = pointer.Int32Ptr(3)
 	}
+	for i := range tc.Spec.TiKVGroups {
+		group := &tc.Spec.TiKVGroups[i]
+		if len(tc.Spec.Version) > 0 || group.Version != nil {
+			if group.BaseImage == "" {
+				group.BaseImage = defaultTiKVImage
+			}
+		}
+		if group.MaxFailoverCount == nil {
+			group.MaxFailoverCount = pointer.Int32Ptr(3)
+		}
+	}
 }
 
 func setPdSpecDefault(tc *v1alpha1.TidbCluster) {
@@ -125,6 +139,17 @@ func setPdSpecDefault(tc *v1alpha1.TidbCluster) {
 	}
 }
 
+func setPDMSSpecDefault(tc *v1alpha1.TidbCluster) {
+	for i := range tc.Spec.PDMS {
+		ms := &tc.Spec.PDMS[i]
+		if len(tc.Spec.Version) > 0 || ms.Version != nil {
+			if ms.BaseImage == "" {
+				ms.BaseImage = defaultPDImage
+			}
+		}
+	}
+}
+
 func setPumpSpecDefault(tc *v1alpha1.TidbCluster) {
 	if len(tc.Spec.Version) > 0 || tc.Spec.Pump.Version != nil {
 		if tc.Spec.Pump.BaseImage == "" {
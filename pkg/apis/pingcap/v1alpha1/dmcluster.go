@@ -16,9 +16,11 @@ package v1alpha1
 import (
 	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
@@ -136,6 +138,55 @@ func (dc *DMCluster) WorkerStsDesiredOrdinals(excludeFailover bool) sets.Int32 {
 	return GetPodOrdinalsFromReplicasAndDeleteSlots(replicas, dc.getDeleteSlots(label.DMWorkerLabelVal))
 }
 
+// HasSources returns whether the bindings and relay of the upstream sources are managed by the operator
+func (dc *DMCluster) HasSources() bool {
+	return dc.Spec.Worker != nil && len(dc.Spec.Worker.Sources) > 0
+}
+
+// OpenAPIEnabled returns whether the OpenAPI of dm-master is enabled,
+// which is required to transfer the sources and enable the relay log.
+func (dc *DMCluster) OpenAPIEnabled() bool {
+	if dc.HasSources() {
+		// enabled by the operator
+		return true
+	}
+	if dc.Spec.Master.Config == nil {
+		return false
+	}
+	for _, key := range []string{"openapi", "experimental.openapi"} {
+		if enabled, ok := dc.Spec.Master.Config.Get(key).Interface().(bool); ok && enabled {
+			return true
+		}
+	}
+	return false
+}
+
+// TLSUpstreamPaths returns the paths of the client certificates of the upstream databases
+func (dc *DMCluster) TLSUpstreamPaths() map[string]DMTLSClientPaths {
+	return dmTLSClientPaths(DMUpstreamTLSMountPath, dc.Spec.TLSUpstreams)
+}
+
+// TLSDownstreamPaths returns the paths of the client certificates of the downstream databases
+func (dc *DMCluster) TLSDownstreamPaths() map[string]DMTLSClientPaths {
+	return dmTLSClientPaths(DMDownstreamTLSMountPath, dc.Spec.TLSDownstreams)
+}
+
+func dmTLSClientPaths(mountPath string, secrets []DMTLSClientSecret) map[string]DMTLSClientPaths {
+	if len(secrets) == 0 {
+		return nil
+	}
+	paths := make(map[string]DMTLSClientPaths, len(secrets))
+	for _, secret := range secrets {
+		dir := path.Join(mountPath, secret.Name)
+		paths[secret.Name] = DMTLSClientPaths{
+			SSLCA:   path.Join(dir, corev1.ServiceAccountRootCAKey),
+			SSLCert: path.Join(dir, corev1.TLSCertKey),
+			SSLKey:  path.Join(dir, corev1.TLSPrivateKeyKey),
+		}
+	}
+	return paths
+}
+
 func (dc *DMCluster) GetWorkerRecoverByUID() types.UID {
 	if dc.Spec.Worker == nil || dc.Spec.Worker.Failover == nil {
 		return ""
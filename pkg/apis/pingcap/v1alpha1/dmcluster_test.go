@@ -302,6 +302,31 @@ func TestMasterVersion(t *testing.T) {
 	}
 }
 
+func TestDMTLSClientPaths(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dc := newDMCluster()
+	g.Expect(dc.TLSUpstreamPaths()).To(BeNil())
+	g.Expect(dc.TLSDownstreamPaths()).To(BeNil())
+
+	dc.Spec.TLSUpstreams = []DMTLSClientSecret{{Name: "mysql1", SecretName: "mysql1-client"}}
+	dc.Spec.TLSDownstreams = []DMTLSClientSecret{{Name: "tidb", SecretName: "tidb-client"}}
+	g.Expect(dc.TLSUpstreamPaths()).To(Equal(map[string]DMTLSClientPaths{
+		"mysql1": {
+			SSLCA:   "/var/lib/upstream-tls/mysql1/ca.crt",
+			SSLCert: "/var/lib/upstream-tls/mysql1/tls.crt",
+			SSLKey:  "/var/lib/upstream-tls/mysql1/tls.key",
+		},
+	}))
+	g.Expect(dc.TLSDownstreamPaths()).To(Equal(map[string]DMTLSClientPaths{
+		"tidb": {
+			SSLCA:   "/var/lib/downstream-tls/tidb/ca.crt",
+			SSLCert: "/var/lib/downstream-tls/tidb/tls.crt",
+			SSLKey:  "/var/lib/downstream-tls/tidb/tls.key",
+		},
+	}))
+}
+
 func newDMCluster() *DMCluster {
 	return &DMCluster{
 		TypeMeta: metav1.TypeMeta{
@@ -0,0 +1,118 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// Reasons of the events emitted for the decisions made by the operator.
+// The catalogue is stable so that tools can rely on it, existing reasons must not be renamed.
+const (
+	// EventReasonScaleOut is emitted when some members of a component begin to scale out.
+	EventReasonScaleOut = "ScaleOut"
+	// EventReasonScaleIn is emitted when some members of a component begin to scale in.
+	EventReasonScaleIn = "ScaleIn"
+	// EventReasonFailedScaleIn is emitted when the scale-in of a component is blocked,
+	// e.g. the remaining TiKV stores in Up state are not enough for the max replicas of PD.
+	EventReasonFailedScaleIn = "FailedScaleIn"
+
+	// EventReasonUpgradePod is emitted when a pod of a component begins to be upgraded.
+	EventReasonUpgradePod = "UpgradePod"
+	// EventReasonUpgradeBlocked is emitted when the upgrade of a component is held,
+	// e.g. TiKV is not upgraded while PD or TiFlash is upgrading or scaling.
+	EventReasonUpgradeBlocked = "UpgradeBlocked"
+
+	// EventReasonUnhealthy is emitted when a member of a component is found unhealthy.
+	EventReasonUnhealthy = "Unhealthy"
+	// EventReasonRecovery is emitted when a failure member is recovered.
+	EventReasonRecovery = "Recovery"
+	// EventReasonFailoverLimitReached is emitted when a failure member is not failed over
+	// because the number of failure members reaches `maxFailoverCount`.
+	EventReasonFailoverLimitReached = "FailoverLimitReached"
+
+	// EventReasonSuspend is emitted when a component begins to be suspended.
+	EventReasonSuspend = "Suspend"
+	// EventReasonResume is emitted when a suspended component begins to be resumed.
+	EventReasonResume = "Resume"
+	// EventReasonSuspendBlocked is emitted when a component can't be suspended now.
+	EventReasonSuspendBlocked = "SuspendBlocked"
+
+	// EventReasonModifyVolume is emitted when the volumes of a pod begin to be modified.
+	EventReasonModifyVolume = "ModifyVolume"
+	// EventReasonReplaceVolume is emitted when the volumes of a pod begin to be replaced.
+	EventReasonReplaceVolume = "ReplaceVolume"
+	// EventReasonModifyVolumeBlocked is emitted when the volumes of a component can't be modified.
+	EventReasonModifyVolumeBlocked = "ModifyVolumeBlocked"
+
+	// EventReasonTransferSource is emitted when an upstream source of DM begins to be
+	// transferred to another dm-worker.
+	EventReasonTransferSource = "TransferSource"
+	// EventReasonSyncRelay is emitted when the relay log of an upstream source of DM
+	// begins to be enabled or disabled.
+	EventReasonSyncRelay = "SyncRelay"
+
+	// EventReasonFailedSyncResourceGroup is emitted when the resource groups in spec.resourceGroups
+	// can't be synced to TiDB, e.g. TiDB doesn't support resource control.
+	EventReasonFailedSyncResourceGroup = "FailedSyncResourceGroup"
+)
+
+// Types of the component conditions mirroring the blocking decisions.
+// The condition is True with the reason of the corresponding event while the operation is blocked,
+// and turns False with reason ConditionReasonUnblocked once the operation can go on.
+const (
+	ComponentConditionScaleBlocked        = "ScaleBlocked"
+	ComponentConditionUpgradeBlocked      = "UpgradeBlocked"
+	ComponentConditionFailoverBlocked     = "FailoverBlocked"
+	ComponentConditionSuspendBlocked      = "SuspendBlocked"
+	ComponentConditionVolumeModifyBlocked = "VolumeModifyBlocked"
+
+	ConditionReasonUnblocked = "Unblocked"
+)
+
+// Types of the component conditions computed from the health of the members.
+const (
+	// ComponentConditionAvailable is True when enough members are healthy to serve,
+	// i.e. the majority for PD and TiKV, or at least one member for the others.
+	ComponentConditionAvailable = "Available"
+	// ComponentConditionProgressing is True while the component is upgrading, scaling
+	// or its statefulset is rolling out.
+	ComponentConditionProgressing = "Progressing"
+	// ComponentConditionDegraded is True when some members are unhealthy or failed over.
+	ComponentConditionDegraded = "Degraded"
+	// ComponentConditionQuorumAtRisk is True when the raft quorum of PD or TiKV can't tolerate
+	// another member failure. It's False with reason ConditionReasonNoRedundancy if no failure
+	// can be tolerated by design. Each TiKV group is evaluated on its own.
+	ComponentConditionQuorumAtRisk = "QuorumAtRisk"
+)
+
+// Reasons of the component conditions computed from the health of the members.
+const (
+	ConditionReasonMinimumMembersAvailable   = "MinimumMembersAvailable"
+	ConditionReasonMinimumMembersUnavailable = "MinimumMembersUnavailable"
+	ConditionReasonNoReplicas                = "NoReplicas"
+	ConditionReasonSuspended                 = "Suspended"
+
+	ConditionReasonUpgrading              = "Upgrading"
+	ConditionReasonScaling                = "Scaling"
+	ConditionReasonStatefulSetNotUpToDate = "StatefulSetNotUpToDate"
+	ConditionReasonUpToDate               = "UpToDate"
+
+	ConditionReasonMembersUnhealthy  = "MembersUnhealthy"
+	ConditionReasonFailureMembers    = "FailureMembers"
+	ConditionReasonAllMembersHealthy = "AllMembersHealthy"
+
+	ConditionReasonQuorumLost       = "QuorumLost"
+	ConditionReasonNoFaultTolerance = "NoFaultTolerance"
+	ConditionReasonQuorumHealthy    = "QuorumHealthy"
+	// ConditionReasonNoRedundancy is the reason of a False QuorumAtRisk condition when all the members
+	// are healthy but the quorum can't tolerate any failure by design, e.g. there are less than 3 PD members.
+	ConditionReasonNoRedundancy = "NoRedundancy"
+)
@@ -72,6 +72,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TidbNGMonitoringList{},
 		&TidbDashboard{},
 		&TidbDashboardList{},
+		&TiDBUser{},
+		&TiDBUserList{},
+		&TiDBGrant{},
+		&TiDBGrantList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -43,6 +43,12 @@ const (
 	// defaultTiCDCGracefulShutdownTimeout is the timeout limit of graceful
 	// shutdown a TiCDC pod.
 	defaultTiCDCGracefulShutdownTimeout = 10 * time.Minute
+	// defaultTLSCertDuration is the default validity of the certificates issued by the operator
+	defaultTLSCertDuration = 90 * 24 * time.Hour
+	// defaultTLSCertRenewBefore is how long before the expiry the certificates are renewed by default
+	defaultTLSCertRenewBefore = 30 * 24 * time.Hour
+	// MaxInternalTLSCertDuration is the max validity of the certificates signed by the internal CA
+	MaxInternalTLSCertDuration = 365 * 24 * time.Hour
 
 	// the latest version
 	versionLatest = "latest"
@@ -249,6 +255,57 @@ func (tc *TidbCluster) TiProxyImage() string {
 	return image
 }
 
+// GetPDMSSpec returns the spec of the PD microservice by name.
+//
+// If the microservice isn't specified, return nil.
+func (tc *TidbCluster) GetPDMSSpec(name string) *PDMSSpec {
+	for i := range tc.Spec.PDMS {
+		if tc.Spec.PDMS[i].Name == name {
+			return &tc.Spec.PDMS[i]
+		}
+	}
+	return nil
+}
+
+// GetPDMSStatus returns the status of the PD microservice by name, the status is initialized if it doesn't exist.
+func (tc *TidbCluster) GetPDMSStatus(name string) *PDMSStatus {
+	if tc.Status.PDMS == nil {
+		tc.Status.PDMS = map[string]*PDMSStatus{}
+	}
+	status, ok := tc.Status.PDMS[name]
+	if !ok || status == nil {
+		status = &PDMSStatus{Name: name}
+		tc.Status.PDMS[name] = status
+	}
+	return status
+}
+
+// PDMSImage return the image used by the PD microservice.
+//
+// If the microservice isn't specified, return empty string.
+func (tc *TidbCluster) PDMSImage(name string) string {
+	spec := tc.GetPDMSSpec(name)
+	if spec == nil {
+		return ""
+	}
+
+	image := spec.Image
+	baseImage := spec.BaseImage
+	// base image takes higher priority
+	if baseImage != "" {
+		version := spec.Version
+		if version == nil {
+			version = &tc.Spec.Version
+		}
+		if *version == "" {
+			image = baseImage
+		} else {
+			image = fmt.Sprintf("%s:%s", baseImage, *version)
+		}
+	}
+	return image
+}
+
 // TiCDCVersion returns the image version used by TiCDC.
 //
 // If TiCDC isn't specified, return empty string.
@@ -389,6 +446,26 @@ func (tc *TidbCluster) PDScaling() bool {
 	return tc.Status.PD.Phase == ScalePhase
 }
 
+// PDMSUpgrading returns whether any of the PD microservices is upgrading
+func (tc *TidbCluster) PDMSUpgrading() bool {
+	for _, spec := range tc.Spec.PDMS {
+		if status, ok := tc.Status.PDMS[spec.Name]; ok && status != nil && status.Phase == UpgradePhase {
+			return true
+		}
+	}
+	return false
+}
+
+// PDMSScaling returns whether any of the PD microservices is scaling
+func (tc *TidbCluster) PDMSScaling() bool {
+	for _, spec := range tc.Spec.PDMS {
+		if status, ok := tc.Status.PDMS[spec.Name]; ok && status != nil && status.Phase == ScalePhase {
+			return true
+		}
+	}
+	return false
+}
+
 func (tc *TidbCluster) TiKVUpgrading() bool {
 	return tc.Status.TiKV.Phase == UpgradePhase
 }
@@ -417,6 +494,13 @@ func (tc *TidbCluster) TiFlashScaling() bool {
 	return tc.Status.TiFlash.Phase == ScalePhase
 }
 
+// TiFlashComputeUpgradingOrScaling returns whether TiFlash compute nodes are upgrading or scaling.
+// If tc is returned by TiFlashComputeView, write nodes are checked instead, so that write nodes
+// and compute nodes are upgraded one at a time.
+func (tc *TidbCluster) TiFlashComputeUpgradingOrScaling() bool {
+	return tc.Status.TiFlashCompute.Phase == UpgradePhase || tc.Status.TiFlashCompute.Phase == ScalePhase
+}
+
 func (tc *TidbCluster) TiProxyUpgrading() bool {
 	return tc.Status.TiProxy.Phase == UpgradePhase
 }
@@ -614,7 +698,38 @@ func (tc *TidbCluster) TiKVStsDesiredReplicas() int32 {
 	if tc.Spec.TiKV == nil {
 		return 0
 	}
-	return tc.Spec.TiKV.Replicas + int32(len(tc.Status.TiKV.FailureStores))
+	return tc.Spec.TiKV.Replicas + tc.tikvFailoverReplicas() + tc.tikvVolumeReplaceReplicas()
+}
+
+// tikvVolumeReplaceReplicas returns the count of spare replicas added while volumes are being replaced.
+func (tc *TidbCluster) tikvVolumeReplaceReplicas() int32 {
+	if tc.Spec.TiKV.VolumeReplace == nil || !tc.TiKVVolumeReplacing() {
+		return 0
+	}
+	return tc.Spec.TiKV.GetVolumeReplaceSpareReplicas()
+}
+
+// TiKVVolumeReplacing returns whether any volume of TiKV is being replaced.
+func (tc *TidbCluster) TiKVVolumeReplacing() bool {
+	for _, v := range tc.Status.TiKV.Volumes {
+		if v != nil && v.Replacing {
+			return true
+		}
+	}
+	return false
+}
+
+// tikvFailoverReplicas returns the count of replicas added for the failure stores.
+// A failure store rebuilt in place only needs a spare replica while it is being
+// deleted, so that PD can move its regions away and make it Tombstone.
+func (tc *TidbCluster) tikvFailoverReplicas() int32 {
+	var replicas int32
+	for _, fs := range tc.Status.TiKV.FailureStores {
+		if fs.Mode != FailoverModeRebuildLocalVolume || (fs.HostDown && !fs.StoreDeleted) {
+			replicas++
+		}
+	}
+	return replicas
 }
 
 func (tc *TidbCluster) TiKVStsActualReplicas() int32 {
@@ -636,6 +751,87 @@ func (tc *TidbCluster) TiKVStsDesiredOrdinals(excludeFailover bool) sets.Int32 {
 	return GetPodOrdinalsFromReplicasAndDeleteSlots(replicas, tc.getDeleteSlots(label.TiKVLabelVal))
 }
 
+// TiKVGroupView returns a shallow copy of tc in which `spec.tikv` and `status.tikv` are replaced
+// by the spec and status of the TiKV group, so the group can be managed in the same way as `spec.tikv`.
+// The view is labeled with the group name, see TiKVGroupName. In `status.tikvGroups` of the view, the
+// status of `spec.tikv` takes the place of the group, see TiKVGroupsUpgradingOrScaling.
+//
+// Return nil if the group is not found.
+func (tc *TidbCluster) TiKVGroupView(name string) *TidbCluster {
+	var group *TiKVGroupSpec
+	for i := range tc.Spec.TiKVGroups {
+		if tc.Spec.TiKVGroups[i].Name == name {
+			group = &tc.Spec.TiKVGroups[i]
+			break
+		}
+	}
+	if group == nil {
+		return nil
+	}
+
+	view := &TidbCluster{
+		TypeMeta:   tc.TypeMeta,
+		ObjectMeta: *tc.ObjectMeta.DeepCopy(),
+		Spec:       tc.Spec,
+		Status:     tc.Status,
+	}
+	if view.Labels == nil {
+		view.Labels = map[string]string{}
+	}
+	view.Labels[label.TiKVGroupLabelKey] = name
+	// delete slots are only supported by `spec.tikv`
+	delete(view.Annotations, label.AnnTiKVDeleteSlots)
+	view.Spec.TiKV = &group.TiKVSpec
+	view.Status.TiKV = tc.Status.TiKVGroups[name]
+	view.Status.TiKVGroups = make(map[string]TiKVStatus, len(tc.Status.TiKVGroups))
+	for group, status := range tc.Status.TiKVGroups {
+		view.Status.TiKVGroups[group] = status
+	}
+	view.Status.TiKVGroups[name] = tc.Status.TiKV
+	return view
+}
+
+// TiKVGroupName returns the name of the TiKV group if tc is returned by TiKVGroupView,
+// otherwise return empty string.
+func (tc *TidbCluster) TiKVGroupName() string {
+	return tc.Labels[label.TiKVGroupLabelKey]
+}
+
+// TiKVGroupsAllStoresReady returns whether all stores of all TiKV groups are ready
+func (tc *TidbCluster) TiKVGroupsAllStoresReady() bool {
+	for _, group := range tc.Spec.TiKVGroups {
+		if !tc.TiKVGroupView(group.Name).TiKVAllStoresReady() {
+			return false
+		}
+	}
+	return true
+}
+
+// TiKVGroupsUpgradingOrScaling returns whether any TiKV group is upgrading or scaling.
+// If tc is returned by TiKVGroupView, `spec.tikv` is checked instead of the group of the view,
+// so that `spec.tikv` and the TiKV groups are upgraded one at a time.
+func (tc *TidbCluster) TiKVGroupsUpgradingOrScaling() bool {
+	for _, group := range tc.Spec.TiKVGroups {
+		phase := tc.Status.TiKVGroups[group.Name].Phase
+		if phase == UpgradePhase || phase == ScalePhase {
+			return true
+		}
+	}
+	return false
+}
+
+// SetTiKVGroupStatus saves the TiKV status of a view returned by TiKVGroupView.
+func (tc *TidbCluster) SetTiKVGroupStatus(view *TidbCluster) {
+	name := view.TiKVGroupName()
+	if name == "" {
+		return
+	}
+	if tc.Status.TiKVGroups == nil {
+		tc.Status.TiKVGroups = map[string]TiKVStatus{}
+	}
+	tc.Status.TiKVGroups[name] = view.Status.TiKV
+}
+
 // TiFlashAllPodsStarted return whether all pods of TiFlash are started.
 //
 // If TiFlash isn't specified, return false.
@@ -693,6 +889,75 @@ func (tc *TidbCluster) TiFlashStsDesiredOrdinals(excludeFailover bool) sets.Int3
 	return GetPodOrdinalsFromReplicasAndDeleteSlots(replicas, tc.getDeleteSlots(label.TiFlashLabelVal))
 }
 
+// TiFlashDisaggregated returns whether TiFlash is in the disaggregated storage and compute architecture
+func (tc *TidbCluster) TiFlashDisaggregated() bool {
+	return tc.Spec.TiFlash != nil && tc.Spec.TiFlash.Disaggregated != nil
+}
+
+// TiFlashComputeView returns a shallow copy of tc in which `spec.tiflash` and `status.tiflash` are replaced
+// by the spec and status of TiFlash compute nodes, so the compute nodes can be managed in the same way as
+// `spec.tiflash`. The view is labeled with the compute role, see IsTiFlashCompute. In the view,
+// `status.tiflashCompute` is replaced by the status of write nodes, see TiFlashComputeUpgradingOrScaling.
+//
+// Return nil if TiFlash is not in the disaggregated architecture.
+func (tc *TidbCluster) TiFlashComputeView() *TidbCluster {
+	if !tc.TiFlashDisaggregated() {
+		return nil
+	}
+	write := tc.Spec.TiFlash
+	compute := write.Disaggregated.Compute.DeepCopy()
+	if compute.BaseImage == "" {
+		compute.BaseImage = write.BaseImage
+	}
+	if compute.Version == nil {
+		compute.Version = write.Version
+	}
+
+	view := &TidbCluster{
+		TypeMeta:   tc.TypeMeta,
+		ObjectMeta: *tc.ObjectMeta.DeepCopy(),
+		Spec:       tc.Spec,
+		Status:     tc.Status,
+	}
+	if view.Labels == nil {
+		view.Labels = map[string]string{}
+	}
+	view.Labels[label.TiFlashRoleLabelKey] = label.TiFlashComputeRoleVal
+	// delete slots are only supported by write nodes
+	delete(view.Annotations, label.AnnTiFlashDeleteSlots)
+	view.Spec.TiFlash = &TiFlashSpec{
+		ComponentSpec:        compute.ComponentSpec,
+		ResourceRequirements: compute.ResourceRequirements,
+		ServiceAccount:       write.ServiceAccount,
+		Replicas:             compute.Replicas,
+		BaseImage:            compute.BaseImage,
+		Privileged:           write.Privileged,
+		StorageClaims:        compute.StorageClaims,
+		Config:               compute.Config,
+		Initializer:          write.Initializer,
+		LogTailer:            write.LogTailer,
+		Disaggregated:        write.Disaggregated,
+	}
+	view.Status.TiFlash = tc.Status.TiFlashCompute
+	view.Status.TiFlashCompute = tc.Status.TiFlash
+	return view
+}
+
+// TiFlashComputeAllStoresReady returns whether all stores of TiFlash compute nodes are up.
+//
+// If TiFlash is not in the disaggregated architecture, return true.
+func (tc *TidbCluster) TiFlashComputeAllStoresReady() bool {
+	if !tc.TiFlashDisaggregated() {
+		return true
+	}
+	return tc.TiFlashComputeView().TiFlashAllStoresReady()
+}
+
+// IsTiFlashCompute returns whether tc is returned by TiFlashComputeView
+func (tc *TidbCluster) IsTiFlashCompute() bool {
+	return tc.Labels[label.TiFlashRoleLabelKey] == label.TiFlashComputeRoleVal
+}
+
 // TiCDCAllCapturesReady return whether all captures of TiCDC are ready.
 //
 // If TiCDC isn't specified, return false.
@@ -748,6 +1013,15 @@ func (tc *TidbCluster) TiProxyStsActualReplicas() int32 {
 	return stsStatus.Replicas
 }
 
+func (tc *TidbCluster) PDMSStsDesiredReplicas(name string) int32 {
+	spec := tc.GetPDMSSpec(name)
+	if spec == nil {
+		return 0
+	}
+
+	return spec.Replicas
+}
+
 func (tc *TidbCluster) TiCDCDeployDesiredReplicas() int32 {
 	if tc.Spec.TiCDC == nil {
 		return 0
@@ -935,6 +1209,39 @@ func (tc *TidbCluster) IsTLSClusterEnabled() bool {
 	return tc.Spec.TLSCluster != nil && tc.Spec.TLSCluster.Enabled
 }
 
+// TLSIssuer returns the issuer of the cluster certificates, it's nil if the certificates are provided by users
+func (tc *TidbCluster) TLSIssuer() *TLSIssuer {
+	if !tc.IsTLSClusterEnabled() {
+		return nil
+	}
+	return tc.Spec.TLSCluster.Issuer
+}
+
+// TLSCertHash returns the hash of the certificate stored in the Secret if it's issued by the operator,
+// it's empty if the certificates are provided by users
+func (tc *TidbCluster) TLSCertHash(secretName string) string {
+	if tc.TLSIssuer() == nil {
+		return ""
+	}
+	return tc.Status.TLSCertificates[secretName].Hash
+}
+
+// GetDuration returns the validity of the certificates
+func (i *TLSIssuer) GetDuration() time.Duration {
+	if i.Duration == nil {
+		return defaultTLSCertDuration
+	}
+	return i.Duration.Duration
+}
+
+// GetRenewBefore returns how long before the expiry the certificates are renewed
+func (i *TLSIssuer) GetRenewBefore() time.Duration {
+	if i.RenewBefore == nil {
+		return defaultTLSCertRenewBefore
+	}
+	return i.RenewBefore.Duration
+}
+
 func (tc *TidbCluster) IsRecoveryMode() bool {
 	return tc.Spec.RecoveryMode
 }
@@ -958,6 +1265,14 @@ func (tc *TidbCluster) Timezone() string {
 	return tz
 }
 
+// DiscoveryReplicas returns the number of discovery pods
+func (tc *TidbCluster) DiscoveryReplicas() int32 {
+	if tc.Spec.Discovery.Replicas == nil || *tc.Spec.Discovery.Replicas < 1 {
+		return 1
+	}
+	return *tc.Spec.Discovery.Replicas
+}
+
 func (tc *TidbCluster) IsPVReclaimEnabled() bool {
 	enabled := tc.Spec.EnablePVReclaim
 	if enabled == nil {
@@ -1045,6 +1360,20 @@ func (tikv *TiKVSpec) GetRecoverByUID() types.UID {
 	return tikv.Failover.RecoverByUID
 }
 
+func (tikv *TiKVSpec) GetFailoverMode() FailoverMode {
+	if tikv.Failover == nil || tikv.Failover.Mode == "" {
+		return FailoverModeAddReplica
+	}
+	return tikv.Failover.Mode
+}
+
+func (tikv *TiKVSpec) GetVolumeReplaceSpareReplicas() int32 {
+	if tikv.VolumeReplace == nil || tikv.VolumeReplace.SpareReplicas == nil {
+		return 1
+	}
+	return *tikv.VolumeReplace.SpareReplicas
+}
+
 func (tikv *TiKVSpec) GetScaleInParallelism() int {
 	if tikv.ScalePolicy.ScaleInParallelism == nil {
 		return 1
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	apps "k8s.io/api/apps/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -1026,3 +1027,84 @@ func setPhaseForAllComponent(tc *TidbCluster, phase MemberPhase) {
 	tc.Status.TiFlash.Phase = phase
 	tc.Status.TiCDC.Phase = phase
 }
+
+func TestTiKVGroupView(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbCluster()
+	tc.Annotations = map[string]string{label.AnnTiKVDeleteSlots: "[1]"}
+	tc.Spec.TiKV = &TiKVSpec{Replicas: 3}
+	tc.Spec.TiKVGroups = []TiKVGroupSpec{{Name: "g1", TiKVSpec: TiKVSpec{Replicas: 2}}}
+	tc.Status.TiKVGroups = map[string]TiKVStatus{"g1": {Phase: UpgradePhase}}
+
+	g.Expect(tc.TiKVGroupView("g2")).To(BeNil())
+	g.Expect(tc.TiKVGroupName()).To(BeEmpty())
+
+	view := tc.TiKVGroupView("g1")
+	g.Expect(view.TiKVGroupName()).To(Equal("g1"))
+	g.Expect(view.Spec.TiKV.Replicas).To(Equal(int32(2)))
+	g.Expect(view.Status.TiKV.Phase).To(Equal(UpgradePhase))
+	g.Expect(view.TiKVStsDesiredOrdinals(false).List()).To(Equal([]int32{0, 1}))
+	g.Expect(tc.Labels).NotTo(HaveKey(label.TiKVGroupLabelKey))
+	g.Expect(tc.Annotations).To(HaveKey(label.AnnTiKVDeleteSlots))
+	// the status of spec.tikv takes the place of the group in the view
+	g.Expect(view.Status.TiKVGroups["g1"].Phase).To(BeEmpty())
+	g.Expect(tc.Status.TiKVGroups["g1"].Phase).To(Equal(UpgradePhase))
+
+	view.Status.TiKV.Phase = NormalPhase
+	tc.SetTiKVGroupStatus(view)
+	g.Expect(tc.Status.TiKVGroups["g1"].Phase).To(Equal(NormalPhase))
+	g.Expect(tc.Status.TiKV.Phase).To(BeEmpty())
+}
+
+func TestTiKVGroupsUpgradingOrScaling(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbCluster()
+	tc.Spec.TiKV = &TiKVSpec{Replicas: 3}
+	tc.Spec.TiKVGroups = []TiKVGroupSpec{{Name: "g1"}, {Name: "g2"}}
+	tc.Status.TiKVGroups = map[string]TiKVStatus{"g1": {Phase: UpgradePhase}}
+	g.Expect(tc.TiKVGroupsUpgradingOrScaling()).To(BeTrue())
+	g.Expect(tc.TiKVGroupView("g1").TiKVGroupsUpgradingOrScaling()).To(BeFalse())
+	g.Expect(tc.TiKVGroupView("g2").TiKVGroupsUpgradingOrScaling()).To(BeTrue())
+
+	tc.Status.TiKVGroups["g1"] = TiKVStatus{Phase: NormalPhase}
+	tc.Status.TiKV.Phase = ScalePhase
+	g.Expect(tc.TiKVGroupsUpgradingOrScaling()).To(BeFalse())
+	g.Expect(tc.TiKVGroupView("g1").TiKVGroupsUpgradingOrScaling()).To(BeTrue())
+	g.Expect(tc.TiKVGroupView("g2").TiKVGroupsUpgradingOrScaling()).To(BeTrue())
+}
+
+func TestTiFlashComputeView(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbCluster()
+	tc.Annotations = map[string]string{label.AnnTiFlashDeleteSlots: "[1]"}
+	tc.Spec.TiFlash = &TiFlashSpec{Replicas: 3, BaseImage: "pingcap/tiflash"}
+	tc.Status.TiFlashCompute = TiFlashStatus{Phase: UpgradePhase}
+
+	g.Expect(tc.TiFlashComputeView()).To(BeNil())
+	g.Expect(tc.TiFlashComputeAllStoresReady()).To(BeTrue())
+
+	tc.Spec.TiFlash.Disaggregated = &TiFlashDisaggregatedSpec{Compute: TiFlashComputeSpec{Replicas: 2}}
+	view := tc.TiFlashComputeView()
+	g.Expect(view.IsTiFlashCompute()).To(BeTrue())
+	g.Expect(tc.IsTiFlashCompute()).To(BeFalse())
+	g.Expect(view.Spec.TiFlash.Replicas).To(Equal(int32(2)))
+	g.Expect(view.Spec.TiFlash.BaseImage).To(Equal("pingcap/tiflash"))
+	g.Expect(view.Status.TiFlash.Phase).To(Equal(UpgradePhase))
+	g.Expect(view.TiFlashStsDesiredOrdinals(false).List()).To(Equal([]int32{0, 1}))
+	g.Expect(tc.Labels).NotTo(HaveKey(label.TiFlashRoleLabelKey))
+	g.Expect(tc.Annotations).To(HaveKey(label.AnnTiFlashDeleteSlots))
+	g.Expect(tc.Spec.TiFlash.Replicas).To(Equal(int32(3)))
+	g.Expect(tc.TiFlashComputeAllStoresReady()).To(BeFalse())
+
+	// write nodes and compute nodes see the phase of each other
+	tc.Status.TiFlash.Phase = NormalPhase
+	g.Expect(tc.TiFlashComputeUpgradingOrScaling()).To(BeTrue())
+	g.Expect(tc.TiFlashComputeView().TiFlashComputeUpgradingOrScaling()).To(BeFalse())
+	tc.Status.TiFlash.Phase = ScalePhase
+	tc.Status.TiFlashCompute.Phase = NormalPhase
+	g.Expect(tc.TiFlashComputeUpgradingOrScaling()).To(BeFalse())
+	g.Expect(tc.TiFlashComputeView().TiFlashComputeUpgradingOrScaling()).To(BeTrue())
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+const (
+	defaultTiDBUserHost = "%"
+	allObjects          = "*"
+)
+
+// GetUserName returns the name of the user in TiDB
+func (u *TiDBUser) GetUserName() string {
+	if u.Spec.UserName != "" {
+		return u.Spec.UserName
+	}
+	return u.Name
+}
+
+// GetHost returns the host of the user in TiDB
+func (u *TiDBUser) GetHost() string {
+	if u.Spec.Host != "" {
+		return u.Spec.Host
+	}
+	return defaultTiDBUserHost
+}
+
+// GetClusterNamespace returns the namespace of the TidbCluster where the user is created
+func (u *TiDBUser) GetClusterNamespace() string {
+	if u.Spec.Cluster.Namespace != "" {
+		return u.Spec.Cluster.Namespace
+	}
+	return u.Namespace
+}
+
+// GetDatabase returns the database on which the privileges are granted
+func (g *TiDBGrant) GetDatabase() string {
+	if g.Spec.Database != "" {
+		return g.Spec.Database
+	}
+	return allObjects
+}
+
+// GetTable returns the table on which the privileges are granted
+func (g *TiDBGrant) GetTable() string {
+	if g.Spec.Table != "" {
+		return g.Spec.Table
+	}
+	return allObjects
+}
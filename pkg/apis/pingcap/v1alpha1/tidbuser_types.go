@@ -0,0 +1,228 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// TiDBAccountSynced means the account or the privileges in TiDB match the spec
+	TiDBAccountSynced = "Synced"
+	// TiDBAccountDrifted means the account or the privileges in TiDB were changed out-of-band,
+	// which is detected and reverted in the last sync
+	TiDBAccountDrifted = "Drifted"
+)
+
+// TiDBUser is a user or a role in TiDB managed by the operator.
+// The operator connects to TiDB with the account in `spec.sqlAccess` of the TidbCluster.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="tu"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="User",type=string,JSONPath=`.status.userName`,description="The name of the user in TiDB"
+// +kubebuilder:printcolumn:name="Synced",type=string,JSONPath=`.status.conditions[?(@.type=="Synced")].status`,description="Whether the user in TiDB matches the spec"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TiDBUser struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec defines the desired state of TiDBUser
+	Spec TiDBUserSpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	// Most recently observed status of the TiDBUser
+	Status TiDBUserStatus `json:"status,omitempty"`
+}
+
+// TiDBUserList is TiDBUser list
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TiDBUserList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TiDBUser `json:"items"`
+}
+
+// TiDBUserSpec describes a user or a role in TiDB
+//
+// +k8s:openapi-gen=true
+type TiDBUserSpec struct {
+	// Cluster is the TidbCluster where the user is created
+	Cluster TidbClusterRef `json:"cluster"`
+
+	// UserName is the name of the user in TiDB, defaults to the name of the TiDBUser.
+	// It can not be changed after the user is created.
+	// +kubebuilder:validation:MaxLength=32
+	// +optional
+	UserName string `json:"userName,omitempty"`
+
+	// Host is the host from which the user can connect, defaults to `%`.
+	// It can not be changed after the user is created.
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// Role indicates the account is a role, which has no password and can not login
+	// +optional
+	Role bool `json:"role,omitempty"`
+
+	// PasswordSecret refers to the key of a Secret which stores the password, it's required if role is false.
+	// The password in TiDB is updated when the Secret is changed.
+	// +optional
+	PasswordSecret *corev1.SecretKeySelector `json:"passwordSecret,omitempty"`
+
+	// Roles are the names of roles granted to the user, the host of the roles is `%`.
+	// All the granted roles are activated by default when the user logs in.
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+}
+
+// TiDBUserStatus is the status of TiDBUser
+//
+// +k8s:openapi-gen=true
+type TiDBUserStatus struct {
+	// ObservedGeneration is the generation of the spec which is synced to TiDB
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// UserName is the name of the user created in TiDB
+	// +optional
+	UserName string `json:"userName,omitempty"`
+
+	// Host is the host of the user created in TiDB
+	// +optional
+	Host string `json:"host,omitempty"`
+
+	// PasswordSecretVersion is the resource version of the password Secret applied to TiDB
+	// +optional
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+
+	// Roles are the roles granted to the user by the operator
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// Conditions of the user, e.g. Synced and Drifted
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// TiDBGrant grants privileges to a TiDBUser.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName="tg"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="User",type=string,JSONPath=`.spec.user`,description="The TiDBUser which the privileges are granted to"
+// +kubebuilder:printcolumn:name="Synced",type=string,JSONPath=`.status.conditions[?(@.type=="Synced")].status`,description="Whether the privileges in TiDB match the spec"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TiDBGrant struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec defines the desired state of TiDBGrant
+	Spec TiDBGrantSpec `json:"spec"`
+
+	// +k8s:openapi-gen=false
+	// Most recently observed status of the TiDBGrant
+	Status TiDBGrantStatus `json:"status,omitempty"`
+}
+
+// TiDBGrantList is TiDBGrant list
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TiDBGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +k8s:openapi-gen=false
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TiDBGrant `json:"items"`
+}
+
+// TiDBGrantSpec describes the privileges granted to a TiDBUser
+//
+// +k8s:openapi-gen=true
+type TiDBGrantSpec struct {
+	// User is the name of the TiDBUser in the same namespace, it can be a user or a role
+	User string `json:"user"`
+
+	// Privileges are the privileges to grant, e.g. SELECT, INSERT or ALL PRIVILEGES
+	// +kubebuilder:validation:MinItems=1
+	Privileges []string `json:"privileges"`
+
+	// Database is the database on which the privileges are granted, defaults to `*` which means all databases
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// Table is the table on which the privileges are granted, defaults to `*` which means all tables
+	// +optional
+	Table string `json:"table,omitempty"`
+
+	// WithGrantOption allows the user to grant the privileges to others
+	// +optional
+	WithGrantOption bool `json:"withGrantOption,omitempty"`
+}
+
+// TiDBGrantStatus is the status of TiDBGrant
+//
+// +k8s:openapi-gen=true
+type TiDBGrantStatus struct {
+	// ObservedGeneration is the generation of the spec which is synced to TiDB
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Applied is the privileges granted in TiDB by the operator
+	// +optional
+	Applied *TiDBGrantApplied `json:"applied,omitempty"`
+
+	// Conditions of the grant, e.g. Synced and Drifted
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// TiDBGrantApplied is the privileges granted in TiDB
+//
+// +k8s:openapi-gen=true
+type TiDBGrantApplied struct {
+	// Cluster is the TidbCluster where the privileges are granted
+	Cluster TidbClusterRef `json:"cluster"`
+	// UserName is the name of the user in TiDB
+	UserName string `json:"userName"`
+	// Host is the host of the user in TiDB
+	Host string `json:"host"`
+	// Database is the database on which the privileges are granted
+	Database string `json:"database"`
+	// Table is the table on which the privileges are granted
+	Table string `json:"table"`
+	// Privileges are the granted privileges
+	Privileges []string `json:"privileges"`
+	// WithGrantOption is whether the privileges are granted with grant option
+	// +optional
+	WithGrantOption bool `json:"withGrantOption,omitempty"`
+}
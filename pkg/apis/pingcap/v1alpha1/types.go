@@ -77,6 +77,10 @@ const (
 	TiCDCMemberType MemberType = "ticdc"
 	// TiProxyMemberType is ticdc member type
 	TiProxyMemberType MemberType = "tiproxy"
+	// PDMSTSOMemberType is the member type of the TSO microservice of PD
+	PDMSTSOMemberType MemberType = "tso"
+	// PDMSSchedulingMemberType is the member type of the scheduling microservice of PD
+	PDMSSchedulingMemberType MemberType = "scheduling"
 	// PumpMemberType is pump member type
 	PumpMemberType MemberType = "pump"
 
@@ -139,6 +143,7 @@ const (
 //
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:shortName="tc"
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
 // +kubebuilder:printcolumn:name="PD",type=string,JSONPath=`.status.pd.image`,description="The image for PD cluster"
 // +kubebuilder:printcolumn:name="Storage",type=string,JSONPath=`.spec.pd.requests.storage`,description="The storage size specified for PD node"
@@ -192,6 +197,13 @@ type TidbClusterSpec struct {
 	// +optional
 	PD *PDSpec `json:"pd,omitempty"`
 
+	// PDMS are the microservices split from PD, each of them is managed by its own StatefulSet.
+	// It requires `spec.pd.mode` to be `ms`.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	PDMS []PDMSSpec `json:"pdms,omitempty"`
+
 	// TiDB cluster spec
 	// +optional
 	TiDB *TiDBSpec `json:"tidb,omitempty"`
@@ -200,6 +212,13 @@ type TidbClusterSpec struct {
 	// +optional
 	TiKV *TiKVSpec `json:"tikv,omitempty"`
 
+	// TiKVGroups are additional groups of TiKV stores, each group is managed by its own StatefulSet
+	// so that it can have different storage, resources and store labels from `spec.tikv`
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	TiKVGroups []TiKVGroupSpec `json:"tikvGroups,omitempty"`
+
 	// TiFlash cluster spec
 	// +optional
 	TiFlash *TiFlashSpec `json:"tiflash,omitempty"`
@@ -374,19 +393,47 @@ type TidbClusterSpec struct {
 
 	// PreferIPv6 indicates whether to prefer IPv6 addresses for all components.
 	PreferIPv6 bool `json:"preferIPv6,omitempty"`
+
+	// ResourceGroups are the resource groups of TiDB managed by the operator, requires TiDB v7.1.0+.
+	// The operator executes `CREATE/ALTER/DROP RESOURCE GROUP` to make the resource groups match the list,
+	// resource groups not created by the operator are not touched.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ResourceGroups []ResourceGroupSpec `json:"resourceGroups,omitempty"`
+
+	// SQLAccess is the account used by the operator to execute SQL in TiDB,
+	// it's required if resourceGroups is not empty.
+	// +optional
+	SQLAccess *TiDBSQLAccessConfig `json:"sqlAccess,omitempty"`
 }
 
 // TidbClusterStatus represents the current status of a tidb cluster.
 type TidbClusterStatus struct {
 	ClusterID  string                    `json:"clusterID,omitempty"`
 	PD         PDStatus                  `json:"pd,omitempty"`
+	PDMS       map[string]*PDMSStatus    `json:"pdms,omitempty"` // key: microservice name
 	TiKV       TiKVStatus                `json:"tikv,omitempty"`
+	TiKVGroups map[string]TiKVStatus     `json:"tikvGroups,omitempty"` // key: group name
 	TiDB       TiDBStatus                `json:"tidb,omitempty"`
 	Pump       PumpStatus                `json:"pump,omitempty"`
 	TiFlash    TiFlashStatus             `json:"tiflash,omitempty"`
 	TiProxy    TiProxyStatus             `json:"tiproxy,omitempty"`
 	TiCDC      TiCDCStatus               `json:"ticdc,omitempty"`
 	AutoScaler *TidbClusterAutoScalerRef `json:"auto-scaler,omitempty"`
+
+	// TiFlashCompute is the status of TiFlash compute nodes in the disaggregated architecture
+	// +optional
+	TiFlashCompute TiFlashStatus `json:"tiflashCompute,omitempty"`
+
+	// ResourceGroups is the status of resource groups managed by the operator
+	// +optional
+	ResourceGroups map[string]ResourceGroupStatus `json:"resourceGroups,omitempty"` // key: group name
+
+	// TLSCertificates is the status of the certificates issued by `spec.tlsCluster.issuer`
+	// +optional
+	TLSCertificates map[string]TLSCertificateStatus `json:"tlsCertificates,omitempty"` // key: secret name
+
 	// Represents the latest available observations of a tidb cluster's state.
 	// +optional
 	// +nullable
@@ -439,6 +486,13 @@ const (
 type DiscoverySpec struct {
 	*ComponentSpec              `json:",inline"`
 	corev1.ResourceRequirements `json:",inline"`
+
+	// Replicas is the number of discovery pods. If it is larger than 1, the pods
+	// elect a leader to serve the requests and the others stand by.
+	// Optional: Defaults to 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -514,6 +568,50 @@ type PDSpec struct {
 	// +optional
 	// +kubebuilder:validation:Enum:="";"v1"
 	StartUpScriptVersion string `json:"startUpScriptVersion,omitempty"`
+
+	// Mode is the mode of PD cluster, the valid value is one of:
+	//
+	// - "": PD serves all the services.
+	// - "ms": PD only serves the API service and the others are served by the microservices in `spec.pdms`.
+	//
+	// Optional: Defaults to ""
+	// +optional
+	// +kubebuilder:validation:Enum:="";"ms"
+	Mode string `json:"mode,omitempty"`
+}
+
+const (
+	// PDModeMS is the mode of PD cluster in which TSO and scheduling are served by the microservices
+	PDModeMS = "ms"
+)
+
+// PDMSSpec contains details of the members of a PD microservice
+// +k8s:openapi-gen=true
+type PDMSSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Name of the microservice
+	// +kubebuilder:validation:Enum:="tso";"scheduling"
+	Name string `json:"name"`
+
+	// Specify a Service Account for the microservice
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// +kubebuilder:default=pingcap/pd
+	// +optional
+	BaseImage string `json:"baseImage"`
+
+	// Config is the Configuration of the microservice
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *PDConfigWraper `json:"config,omitempty"`
 }
 
 // TiKVSpec contains details of TiKV members
@@ -629,6 +727,35 @@ type TiKVSpec struct {
 	// ScalePolicy is the scale configuration for TiKV
 	// +optional
 	ScalePolicy ScalePolicy `json:"scalePolicy,omitempty"`
+
+	// VolumeReplace enables migrating volumes by replacing stores one by one if the change of
+	// `storageClassName`, `requests.storage` or `storageVolumes` can't be applied in place,
+	// e.g. shrinking the size or changing to a storage class of another provisioner.
+	// +optional
+	VolumeReplace *VolumeReplaceSpec `json:"volumeReplace,omitempty"`
+}
+
+// VolumeReplaceSpec is the configuration of replacing stores to migrate volumes.
+// +k8s:openapi-gen=true
+type VolumeReplaceSpec struct {
+	// SpareReplicas is the count of stores added before replacing to keep enough replicas of regions,
+	// they are removed after all volumes are replaced.
+	// Defaults to 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	SpareReplicas *int32 `json:"spareReplicas,omitempty"`
+}
+
+// TiKVGroupSpec contains details of a group of TiKV members
+// +k8s:openapi-gen=true
+type TiKVGroupSpec struct {
+	// Name of the group, the StatefulSet of the group is named `${cluster}-tikv-${name}`.
+	// If TLS is enabled, the certificate of TiKV should also be valid for the peer service of the group,
+	// that is `*.${cluster}-tikv-${name}-peer`.
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	TiKVSpec `json:",inline"`
 }
 
 // TiFlashSpec contains details of TiFlash members
@@ -689,6 +816,111 @@ type TiFlashSpec struct {
 	// ScalePolicy is the scale configuration for TiFlash
 	// +optional
 	ScalePolicy ScalePolicy `json:"scalePolicy,omitempty"`
+
+	// Disaggregated enables the disaggregated storage and compute architecture of TiFlash, which requires v7.0.0+.
+	// If it's set, the TiFlash nodes above work as write nodes and upload data to the shared storage,
+	// and the compute nodes are managed by the StatefulSet `${cluster}-tiflash-compute`.
+	// +optional
+	Disaggregated *TiFlashDisaggregatedSpec `json:"disaggregated,omitempty"`
+}
+
+// TiFlashDisaggregatedSpec contains details of TiFlash in the disaggregated storage and compute architecture
+// +k8s:openapi-gen=true
+type TiFlashDisaggregatedSpec struct {
+	// StorageProvider is the shared storage of write nodes and compute nodes, only S3 is supported now.
+	// If `secretName` is set, the secret should contain `access_key` and `secret_key`.
+	StorageProvider `json:",inline"`
+
+	// Compute is the spec of TiFlash compute nodes, which are stateless and read data from the shared storage
+	Compute TiFlashComputeSpec `json:"compute"`
+}
+
+// TiFlashComputeSpec contains details of TiFlash compute nodes
+// +k8s:openapi-gen=true
+type TiFlashComputeSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// Optional: Defaults to `spec.tiflash.baseImage`
+	// +optional
+	BaseImage string `json:"baseImage,omitempty"`
+
+	// The persistent volume claims of the local cache of data in the shared storage.
+	// Only the first claim is used, and an emptyDir volume without cache is used if it's empty.
+	// +optional
+	StorageClaims []StorageClaim `json:"storageClaims,omitempty"`
+
+	// Config is the Configuration of TiFlash compute nodes
+	// +optional
+	Config *TiFlashConfigWraper `json:"config,omitempty"`
+}
+
+// ResourceGroupPriority is the priority of a resource group
+type ResourceGroupPriority string
+
+const (
+	ResourceGroupPriorityLow    ResourceGroupPriority = "LOW"
+	ResourceGroupPriorityMedium ResourceGroupPriority = "MEDIUM"
+	ResourceGroupPriorityHigh   ResourceGroupPriority = "HIGH"
+)
+
+// ResourceGroupSpec describes a resource group of TiDB
+// +k8s:openapi-gen=true
+type ResourceGroupSpec struct {
+	// Name of the resource group, `default` is the builtin resource group of TiDB.
+	// TiDB stores the name in lowercase, so only lowercase names are allowed.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9_]+$`
+	// +kubebuilder:validation:MaxLength=32
+	Name string `json:"name"`
+
+	// RUPerSec is the quota of Request Units per second of the resource group
+	// +kubebuilder:validation:Minimum=1
+	RUPerSec int64 `json:"ruPerSec"`
+
+	// Priority is the priority of the resource group, defaults to MEDIUM
+	// +kubebuilder:validation:Enum="";LOW;MEDIUM;HIGH
+	// +optional
+	Priority ResourceGroupPriority `json:"priority,omitempty"`
+
+	// Burstable allows the resource group to use the idle resources when the quota is exceeded
+	// +optional
+	Burstable bool `json:"burstable,omitempty"`
+}
+
+// TiDBSQLAccessConfig defines the account used by the operator to access TiDB via SQL
+// +k8s:openapi-gen=true
+type TiDBSQLAccessConfig struct {
+	// User is the user for login TiDB, defaults to root
+	// +optional
+	User string `json:"user,omitempty"`
+	// SecretName is the name of secret which stores the password of the user in the key `password`
+	SecretName string `json:"secretName"`
+	// TLSClientSecretName is the name of secret which stores the TiDB client certificate,
+	// it's used if the TLS between TiDB and MySQL clients is enabled.
+	// Optional: Defaults to `${cluster}-tidb-client-secret`
+	// +optional
+	TLSClientSecretName *string `json:"tlsClientSecretName,omitempty"`
+}
+
+// ResourceGroupStatus is the status of a resource group managed by the operator
+type ResourceGroupStatus struct {
+	// RUPerSec is the quota of Request Units per second applied to TiDB
+	RUPerSec int64 `json:"ruPerSec"`
+	// Priority is the priority applied to TiDB
+	Priority ResourceGroupPriority `json:"priority,omitempty"`
+	// Burstable is the burstable option applied to TiDB
+	Burstable bool `json:"burstable,omitempty"`
+	// ConsumedReadRU is the total read Request Units consumed by the resource group, reported by PD
+	// +optional
+	ConsumedReadRU int64 `json:"consumedReadRU,omitempty"`
+	// ConsumedWriteRU is the total write Request Units consumed by the resource group, reported by PD
+	// +optional
+	ConsumedWriteRU int64 `json:"consumedWriteRU,omitempty"`
 }
 
 // TiCDCSpec contains details of TiCDC members
@@ -1295,6 +1527,23 @@ type PDStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// PDMSStatus is the status of a PD microservice
+type PDMSStatus struct {
+	Name        string                  `json:"name,omitempty"`
+	Synced      bool                    `json:"synced,omitempty"`
+	Phase       MemberPhase             `json:"phase,omitempty"`
+	StatefulSet *apps.StatefulSetStatus `json:"statefulSet,omitempty"`
+	// Members contains the addresses of the healthy members registered in PD
+	Members []string `json:"members,omitempty"`
+	Image   string   `json:"image,omitempty"`
+	// Volumes contains the status of all volumes.
+	Volumes map[StorageVolumeName]*StorageVolumeStatus `json:"volumes,omitempty"`
+	// Represents the latest available observations of a component's state.
+	// +optional
+	// +nullable
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
 // PDMember is PD member
 type PDMember struct {
 	Name string `json:"name"`
@@ -1531,6 +1780,42 @@ type TiKVFailureStore struct {
 	HostDown     bool                      `json:"hostDown,omitempty"`
 	// +nullable
 	CreatedAt metav1.Time `json:"createdAt,omitempty"`
+	// Mode is the failover mode the failure store is handled with
+	// +optional
+	Mode FailoverMode `json:"mode,omitempty"`
+	// NodeName is the node the failure store ran on when it was marked as failure
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+	// PVNames are the PVs deleted with the PVCs of the failure store
+	// +optional
+	PVNames []string `json:"pvNames,omitempty"`
+	// History records the steps taken to handle the failure store
+	// +optional
+	History []FailoverRecord `json:"history,omitempty"`
+}
+
+// FailoverStep is a step to handle a failure store
+type FailoverStep string
+
+const (
+	// FailoverStepMarkedFailure means the store has been down for longer than the failover period
+	FailoverStepMarkedFailure FailoverStep = "MarkedFailure"
+	// FailoverStepHostDown means the node of the failure store is detected down
+	FailoverStepHostDown FailoverStep = "HostDown"
+	// FailoverStepStoreDeleted means the failure store has been deleted from PD
+	FailoverStepStoreDeleted FailoverStep = "StoreDeleted"
+	// FailoverStepVolumesDeleted means the pod, PVCs and PVs of the failure store have been deleted
+	FailoverStepVolumesDeleted FailoverStep = "VolumesDeleted"
+	// FailoverStepRebuilt means a new store is up for the pod of the failure store
+	FailoverStepRebuilt FailoverStep = "Rebuilt"
+)
+
+// FailoverRecord is a record in the history of a failure store
+type FailoverRecord struct {
+	Step FailoverStep `json:"step"`
+	// +nullable
+	Time    metav1.Time `json:"time,omitempty"`
+	Message string      `json:"message,omitempty"`
 }
 
 // PumpNodeStatus represents the status saved in etcd.
@@ -1611,6 +1896,73 @@ type TLSCluster struct {
 	//        Same for other components.
 	// +optional
 	Enabled bool `json:"enabled,omitempty"`
+
+	// Issuer makes the operator issue and rotate the certificates of all the components and the client,
+	// the Secrets described above are managed by the operator instead of users.
+	// The components are rolling restarted in the upgrade order after their certificates are renewed.
+	// It's only supported by TidbCluster.
+	// +optional
+	Issuer *TLSIssuer `json:"issuer,omitempty"`
+}
+
+// TLSIssuerType is the type of the issuer which signs the certificates
+type TLSIssuerType string
+
+const (
+	// TLSIssuerTypeInternal means the certificates are signed by a CA managed by the operator,
+	// the CA is stored in the Secret <clusterName>-ca-secret.
+	TLSIssuerTypeInternal TLSIssuerType = "Internal"
+	// TLSIssuerTypeCertManager means the certificates are issued by cert-manager,
+	// the operator creates one cert-manager Certificate for each Secret.
+	TLSIssuerTypeCertManager TLSIssuerType = "CertManager"
+)
+
+// TLSIssuer describes how the certificates are issued and rotated
+// +k8s:openapi-gen=true
+type TLSIssuer struct {
+	// Type is the type of the issuer, Internal or CertManager
+	// +kubebuilder:validation:Enum=Internal;CertManager
+	Type TLSIssuerType `json:"type"`
+
+	// IssuerRef refers to the cert-manager Issuer or ClusterIssuer, it's required if type is CertManager
+	// +optional
+	IssuerRef *CertManagerIssuerRef `json:"issuerRef,omitempty"`
+
+	// Duration is the validity of the certificates, defaults to 90 days.
+	// It can not be longer than 1 year if type is Internal.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBefore is how long before the expiry the certificates are renewed, defaults to 30 days
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// CertManagerIssuerRef refers to an Issuer or ClusterIssuer of cert-manager
+// +k8s:openapi-gen=true
+type CertManagerIssuerRef struct {
+	// Name of the issuer
+	Name string `json:"name"`
+	// Kind of the issuer, Issuer or ClusterIssuer, defaults to Issuer
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Group of the issuer, defaults to cert-manager.io
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
+// TLSCertificateStatus is the status of a certificate issued for the cluster
+// +k8s:openapi-gen=true
+type TLSCertificateStatus struct {
+	// Component which uses the certificate, `ca` for the internal CA and `client` for the client certificate
+	Component string `json:"component"`
+	// NotBefore is the time from which the certificate is valid
+	NotBefore metav1.Time `json:"notBefore"`
+	// NotAfter is the expiry of the certificate
+	NotAfter metav1.Time `json:"notAfter"`
+	// Hash of the certificate and the trusted CAs, the component is rolling restarted when it's changed
+	// +optional
+	Hash string `json:"hash,omitempty"`
 }
 
 // +genclient
@@ -1620,6 +1972,7 @@ type TLSCluster struct {
 //
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:shortName="bk"
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.backupType`,description="the type of backup, such as full, db, table. Only used when Mode = snapshot."
 // +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.backupMode`,description="the mode of backup, such as snapshot, log."
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`,description="The current status of the backup"
@@ -2157,6 +2510,7 @@ type BackupStatus struct {
 //
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:shortName="bks"
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`,description="The cron format string used for backup scheduling"
 // +kubebuilder:printcolumn:name="MaxBackups",type=integer,JSONPath=`.spec.maxBackups`,description="The max number of backups we want to keep"
 // +kubebuilder:printcolumn:name="LastBackup",type=string,JSONPath=`.status.lastBackup`,description="The last backup CR name",priority=1
@@ -2231,6 +2585,7 @@ type BackupScheduleStatus struct {
 //
 // +k8s:openapi-gen=true
 // +kubebuilder:resource:shortName="rt"
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`,description="The current status of the restore"
 // +kubebuilder:printcolumn:name="Started",type=date,JSONPath=`.status.timeStarted`,description="The time at which the restore was started",priority=1
 // +kubebuilder:printcolumn:name="Completed",type=date,JSONPath=`.status.timeCompleted`,description="The time at which the restore was completed",priority=1
@@ -2535,9 +2890,24 @@ type DMClusterSpec struct {
 
 	// TLSClientSecretNames are the names of secrets which stores mysql/tidb server client certificates
 	// that used by dm-master and dm-worker.
+	// Each secret is mounted at /var/lib/source-tls/<secretName> in a volume named after the secret,
+	// so the paths in the source and task configs change with the secret names. Use TLSUpstreams and
+	// TLSDownstreams instead to mount the certificates at paths named after the databases.
 	// +optional
 	TLSClientSecretNames []string `json:"tlsClientSecretNames,omitempty"`
 
+	// TLSUpstreams are the secrets which store the client certificates to connect the upstream databases.
+	// The secret of each upstream is mounted at /var/lib/upstream-tls/<name> of dm-master and dm-worker,
+	// the paths of the certificates are shown in the status and can be referred to by the source config.
+	// +optional
+	TLSUpstreams []DMTLSClientSecret `json:"tlsUpstreams,omitempty"`
+
+	// TLSDownstreams are the secrets which store the client certificates to connect the downstream databases.
+	// The secret of each downstream is mounted at /var/lib/downstream-tls/<name> of dm-master and dm-worker,
+	// the paths of the certificates are shown in the status and can be referred to by the task config.
+	// +optional
+	TLSDownstreams []DMTLSClientSecret `json:"tlsDownstreams,omitempty"`
+
 	// Whether Hostnetwork is enabled for DM cluster Pods
 	// Optional: Defaults to false
 	// +optional
@@ -2609,6 +2979,23 @@ type DMClusterSpec struct {
 	SuspendAction *SuspendAction `json:"suspendAction,omitempty"`
 }
 
+// DMTLSClientSecret is the secret which stores the client certificates to connect an upstream or downstream database.
+// The secret must contain the keys ca.crt, tls.crt and tls.key.
+// +k8s:openapi-gen=true
+type DMTLSClientSecret struct {
+	// Name of the upstream or downstream database, it's used as the directory name of the mount path
+	Name string `json:"name"`
+	// SecretName is the name of the secret
+	SecretName string `json:"secretName"`
+}
+
+// DMTLSClientPaths are the paths of the client certificates mounted in dm-master and dm-worker
+type DMTLSClientPaths struct {
+	SSLCA   string `json:"sslCA"`
+	SSLCert string `json:"sslCert"`
+	SSLKey  string `json:"sslKey"`
+}
+
 // DMClusterStatus represents the current status of a dm cluster.
 type DMClusterStatus struct {
 	Master MasterStatus `json:"master,omitempty"`
@@ -2618,6 +3005,13 @@ type DMClusterStatus struct {
 	// +optional
 	// +nullable
 	Conditions []DMClusterCondition `json:"conditions,omitempty"`
+
+	// TLSUpstreams are the paths of the client certificates of the upstream databases, keyed by the upstream name
+	// +optional
+	TLSUpstreams map[string]DMTLSClientPaths `json:"tlsUpstreams,omitempty"`
+	// TLSDownstreams are the paths of the client certificates of the downstream databases, keyed by the downstream name
+	// +optional
+	TLSDownstreams map[string]DMTLSClientPaths `json:"tlsDownstreams,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -2742,6 +3136,45 @@ type WorkerSpec struct {
 	// Failover is the configurations of failover
 	// +optional
 	Failover *Failover `json:"failover,omitempty"`
+
+	// Sources are the binding preferences and relay settings of the upstream sources.
+	// The sources must be created in dm-master, they are not created by the operator.
+	// Managing the sources requires the OpenAPI of dm-master, which is enabled
+	// automatically if sources are configured.
+	// +optional
+	Sources []DMSourceSpec `json:"sources,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// DMSourceSpec is the binding preference and relay setting of an upstream source
+type DMSourceSpec struct {
+	// Name of the source in dm-master
+	Name string `json:"name"`
+
+	// PreferredWorkers are the names of the dm-worker pods the source prefers to bind to,
+	// in order of preference. The source is transferred to the first free preferred worker
+	// if it is not bound to any of them.
+	// +optional
+	PreferredWorkers []string `json:"preferredWorkers,omitempty"`
+
+	// Relay is the relay log setting of the source, the relay log is left as it is if not set
+	// +optional
+	Relay *DMRelaySpec `json:"relay,omitempty"`
+}
+
+// +k8s:openapi-gen=true
+// DMRelaySpec is the relay log setting of an upstream source
+type DMRelaySpec struct {
+	// Enabled indicates whether the relay log is enabled
+	Enabled bool `json:"enabled"`
+
+	// BinlogName is the binlog file the relay log starts from when it is enabled
+	// +optional
+	BinlogName string `json:"binlogName,omitempty"`
+
+	// BinlogGTID is the GTID set the relay log starts from when it is enabled
+	// +optional
+	BinlogGTID string `json:"binlogGTID,omitempty"`
 }
 
 // DMClusterCondition is dm cluster condition
@@ -2833,6 +3266,23 @@ type WorkerStatus struct {
 	// +optional
 	// +nullable
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Sources are the bindings of the upstream sources, keyed by the source name
+	// +optional
+	Sources map[string]DMSourceStatus `json:"sources,omitempty"`
+}
+
+// DMSourceStatus is the binding status of an upstream source
+type DMSourceStatus struct {
+	Name string `json:"name"`
+	// Worker is the dm-worker the source is bound to, empty if the source is not bound
+	Worker string `json:"worker,omitempty"`
+	// RelayEnabled indicates whether the relay log of the source is enabled
+	RelayEnabled bool `json:"relayEnabled,omitempty"`
+	// RelayStage is the stage of the relay log
+	RelayStage string `json:"relayStage,omitempty"`
+	// Last time the source is bound to another worker.
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // WorkerMember is dm-worker member status
@@ -2905,6 +3355,16 @@ type StorageVolumeStatus struct {
 	ObservedStorageVolumeStatus `json:",inline"`
 	// Name is the volume name which is same as `volumes.name` in Pod spec.
 	Name StorageVolumeName `json:"name"`
+
+	// Replacing is true if the volumes can't be modified in place and are being migrated by replacing stores.
+	// +optional
+	Replacing bool `json:"replacing,omitempty"`
+	// ReplacingPod is the pod whose store is being replaced.
+	// +optional
+	ReplacingPod string `json:"replacingPod,omitempty"`
+	// ReplacedCount is the count of pods whose volumes have been replaced in the current migration.
+	// +optional
+	ReplacedCount int `json:"replacedCount,omitempty"`
 }
 
 // TopologySpreadConstraint specifies how to spread matching pods among the given topology.
@@ -2929,8 +3389,30 @@ type Failover struct {
 	// it takes effect only when set `spec.recoverFailover=false`
 	// +optional
 	RecoverByUID types.UID `json:"recoverByUID,omitempty"`
+
+	// Mode is the way to handle a failure store, it's only supported by TiKV.
+	// - AddReplica: add a new replica for the failure store and keep the failure one until it's recovered.
+	// - RebuildLocalVolume: after the node of the failure store is detected down, delete the store from PD
+	//   and the PVCs and PVs pinned to the node, then recreate the pod with new volumes on a healthy node.
+	//   It's designed for local PVs, which are lost with the node.
+	// In both modes, the failure stores are kept in the status with their history and count against
+	// maxFailoverCount until they are recovered by recoverFailover or recoverByUID.
+	// Optional: Defaults to AddReplica
+	// +kubebuilder:validation:Enum:="";"AddReplica";"RebuildLocalVolume"
+	// +optional
+	Mode FailoverMode `json:"mode,omitempty"`
 }
 
+// FailoverMode is the way to handle a failure store
+type FailoverMode string
+
+const (
+	// FailoverModeAddReplica adds a new replica for a failure store
+	FailoverModeAddReplica FailoverMode = "AddReplica"
+	// FailoverModeRebuildLocalVolume rebuilds a failure store on a healthy node with new volumes
+	FailoverModeRebuildLocalVolume FailoverMode = "RebuildLocalVolume"
+)
+
 type ScalePolicy struct {
 	// ScaleInParallelism configures max scale in replicas for TiKV stores.
 	// +kubebuilder:default=1
@@ -21,6 +21,7 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
@@ -44,6 +45,13 @@ func ValidateTidbCluster(tc *v1alpha1.TidbCluster) field.ErrorList {
 	fldPath := field.NewPath("metadata")
 	// validate metadata/annotations
 	allErrs = append(allErrs, validateAnnotations(tc.ObjectMeta.Annotations, fldPath.Child("annotations"))...)
+	// validate metadata/labels
+	if _, ok := tc.Labels[label.TiKVGroupLabelKey]; ok {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("labels").Key(label.TiKVGroupLabelKey), "label is reserved for TiKV groups"))
+	}
+	if _, ok := tc.Labels[label.TiFlashRoleLabelKey]; ok {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("labels").Key(label.TiFlashRoleLabelKey), "label is reserved for TiFlash compute nodes"))
+	}
 	// validate spec
 	allErrs = append(allErrs, validateTiDBClusterSpec(&tc.Spec, field.NewPath("spec"))...)
 	return allErrs
@@ -88,6 +96,82 @@ func ValidateTiDBDashboard(td *v1alpha1.TidbDashboard) field.ErrorList {
 	return allErrs
 }
 
+var privilegeRegexp = regexp.MustCompile(`^[A-Za-z]+( [A-Za-z]+)*$`)
+
+// ValidateTiDBUser validates a TiDBUser
+func ValidateTiDBUser(user *v1alpha1.TiDBUser) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if user.Spec.Cluster.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("cluster", "name"), "cluster name must not be empty"))
+	}
+	if name := user.GetUserName(); len(name) > 32 {
+		allErrs = append(allErrs, field.TooLong(specPath.Child("userName"), name, 32))
+	}
+	if host := user.GetHost(); len(host) > 255 {
+		allErrs = append(allErrs, field.TooLong(specPath.Child("host"), host, 255))
+	}
+
+	secretPath := specPath.Child("passwordSecret")
+	if user.Spec.Role {
+		if user.Spec.PasswordSecret != nil {
+			allErrs = append(allErrs, field.Forbidden(secretPath, "a role has no password"))
+		}
+	} else if user.Spec.PasswordSecret == nil {
+		allErrs = append(allErrs, field.Required(secretPath, "passwordSecret must be specified for a user"))
+	} else {
+		if user.Spec.PasswordSecret.Name == "" {
+			allErrs = append(allErrs, field.Required(secretPath.Child("name"), "secret name must not be empty"))
+		}
+		if user.Spec.PasswordSecret.Key == "" {
+			allErrs = append(allErrs, field.Required(secretPath.Child("key"), "secret key must not be empty"))
+		}
+	}
+
+	roles := map[string]struct{}{}
+	for i, role := range user.Spec.Roles {
+		idxPath := specPath.Child("roles").Index(i)
+		if role == "" {
+			allErrs = append(allErrs, field.Required(idxPath, "role must not be empty"))
+		}
+		if _, ok := roles[role]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath, role))
+		}
+		roles[role] = struct{}{}
+	}
+	return allErrs
+}
+
+// ValidateTiDBGrant validates a TiDBGrant
+func ValidateTiDBGrant(grant *v1alpha1.TiDBGrant) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if grant.Spec.User == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("user"), "user must not be empty"))
+	}
+	if len(grant.Spec.Privileges) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("privileges"), "privileges must not be empty"))
+	}
+	privileges := map[string]struct{}{}
+	for i, privilege := range grant.Spec.Privileges {
+		idxPath := specPath.Child("privileges").Index(i)
+		if !privilegeRegexp.MatchString(privilege) {
+			allErrs = append(allErrs, field.Invalid(idxPath, privilege, "privilege must consist of words separated by a single space, e.g. SELECT or CREATE VIEW"))
+		}
+		key := strings.ToUpper(privilege)
+		if _, ok := privileges[key]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath, privilege))
+		}
+		privileges[key] = struct{}{}
+	}
+	if grant.GetDatabase() == "*" && grant.GetTable() != "*" {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("table"), grant.Spec.Table, "database must be specified if table is specified"))
+	}
+	return allErrs
+}
+
 func ValidateTidbMonitor(monitor *v1alpha1.TidbMonitor) field.ErrorList {
 	allErrs := field.ErrorList{}
 	// validate monitor service
@@ -130,9 +214,18 @@ func validateTiDBClusterSpec(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path
 	if spec.PD != nil {
 		allErrs = append(allErrs, validatePDSpec(spec.PD, fldPath.Child("pd"))...)
 	}
+	if spec.PD != nil && spec.PD.Mode == v1alpha1.PDModeMS && spec.StartScriptVersion != v1alpha1.StartScriptV2 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("pd", "mode"), spec.PD.Mode, "startScriptVersion must be v2 if PD runs in ms mode"))
+	}
+	if len(spec.PDMS) > 0 {
+		allErrs = append(allErrs, validatePDMS(spec, fldPath)...)
+	}
 	if spec.TiKV != nil {
 		allErrs = append(allErrs, validateTiKVSpec(spec.TiKV, fldPath.Child("tikv"))...)
 	}
+	if len(spec.TiKVGroups) > 0 {
+		allErrs = append(allErrs, validateTiKVGroups(spec, fldPath.Child("tikvGroups"))...)
+	}
 	if spec.TiDB != nil {
 		allErrs = append(allErrs, validateTiDBSpec(spec.TiDB, fldPath.Child("tidb"))...)
 	}
@@ -148,6 +241,12 @@ func validateTiDBClusterSpec(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path
 	if spec.PDAddresses != nil {
 		allErrs = append(allErrs, validatePDAddresses(spec.PDAddresses, fldPath.Child("pdAddresses"))...)
 	}
+	if len(spec.ResourceGroups) > 0 {
+		allErrs = append(allErrs, validateResourceGroups(spec, fldPath.Child("resourceGroups"))...)
+	}
+	if spec.TLSCluster != nil && spec.TLSCluster.Issuer != nil {
+		allErrs = append(allErrs, validateTLSIssuer(spec.TLSCluster.Issuer, fldPath.Child("tlsCluster", "issuer"))...)
+	}
 	return allErrs
 }
 
@@ -172,6 +271,33 @@ func validatePDSpec(spec *v1alpha1.PDSpec, fldPath *field.Path) field.ErrorList
 	return allErrs
 }
 
+// validatePDMS validates spec.pdms, fldPath is the path of the spec
+func validatePDMS(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.PD == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("pd"), "pd must be specified if pdms is not empty"))
+	} else if spec.PD.Mode != v1alpha1.PDModeMS {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("pd", "mode"), spec.PD.Mode, "pd must run in ms mode if pdms is not empty"))
+	}
+	names := map[string]struct{}{}
+	for i := range spec.PDMS {
+		ms := &spec.PDMS[i]
+		idxPath := fldPath.Child("pdms").Index(i)
+		switch v1alpha1.MemberType(ms.Name) {
+		case v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType:
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), ms.Name,
+				[]string{v1alpha1.PDMSTSOMemberType.String(), v1alpha1.PDMSSchedulingMemberType.String()}))
+		}
+		if _, ok := names[ms.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), ms.Name))
+		}
+		names[ms.Name] = struct{}{}
+		allErrs = append(allErrs, validateComponentSpec(&ms.ComponentSpec, idxPath)...)
+	}
+	return allErrs
+}
+
 func validatePDAddresses(arrayOfAddresses []string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, address := range arrayOfAddresses {
@@ -187,6 +313,111 @@ func validatePDAddresses(arrayOfAddresses []string, fldPath *field.Path) field.E
 	return allErrs
 }
 
+func validateTiKVGroups(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.TiKV == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "tikv"), "tikv must be specified if tikvGroups is not empty"))
+	}
+	names := map[string]struct{}{}
+	for i := range spec.TiKVGroups {
+		group := &spec.TiKVGroups[i]
+		idxPath := fldPath.Index(i)
+		for _, msg := range validation.IsDNS1123Label(group.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), group.Name, msg))
+		}
+		// `${cluster}-tikv-peer` is the peer service of `spec.tikv`
+		if group.Name == "peer" {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), group.Name, "name is reserved"))
+		}
+		if _, ok := names[group.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), group.Name))
+		}
+		names[group.Name] = struct{}{}
+		allErrs = append(allErrs, validateTiKVSpec(&group.TiKVSpec, idxPath)...)
+	}
+	return allErrs
+}
+
+var resourceGroupNameRegexp = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+func validateResourceGroups(spec *v1alpha1.TidbClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.TiDB == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "tidb"), "tidb must be specified if resourceGroups is not empty"))
+	}
+	if spec.SQLAccess == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "sqlAccess"), "sqlAccess must be specified if resourceGroups is not empty"))
+	} else if spec.SQLAccess.SecretName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "sqlAccess", "secretName"), "secretName must not be empty"))
+	}
+	names := map[string]struct{}{}
+	for i := range spec.ResourceGroups {
+		group := &spec.ResourceGroups[i]
+		idxPath := fldPath.Index(i)
+		if !resourceGroupNameRegexp.MatchString(group.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), group.Name, "name must consist of at most 32 lowercase letters, digits or '_'"))
+		}
+		if _, ok := names[group.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), group.Name))
+		}
+		names[group.Name] = struct{}{}
+		if group.RUPerSec <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("ruPerSec"), group.RUPerSec, "ruPerSec must be greater than 0"))
+		}
+		switch group.Priority {
+		case "", v1alpha1.ResourceGroupPriorityLow, v1alpha1.ResourceGroupPriorityMedium, v1alpha1.ResourceGroupPriorityHigh:
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("priority"), group.Priority,
+				[]string{string(v1alpha1.ResourceGroupPriorityLow), string(v1alpha1.ResourceGroupPriorityMedium), string(v1alpha1.ResourceGroupPriorityHigh)}))
+		}
+	}
+	return allErrs
+}
+
+func validateTLSIssuer(issuer *v1alpha1.TLSIssuer, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch issuer.Type {
+	case v1alpha1.TLSIssuerTypeInternal:
+		if issuer.GetDuration() > v1alpha1.MaxInternalTLSCertDuration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), issuer.GetDuration().String(),
+				fmt.Sprintf("duration must not be longer than %s for the internal issuer", v1alpha1.MaxInternalTLSCertDuration)))
+		}
+	case v1alpha1.TLSIssuerTypeCertManager:
+		if issuer.IssuerRef == nil || issuer.IssuerRef.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("issuerRef", "name"), "issuerRef must be specified for cert-manager"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), issuer.Type,
+			[]string{string(v1alpha1.TLSIssuerTypeInternal), string(v1alpha1.TLSIssuerTypeCertManager)}))
+	}
+	if issuer.GetRenewBefore() <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("renewBefore"), issuer.GetRenewBefore().String(), "renewBefore must be positive"))
+	}
+	if issuer.GetDuration() <= issuer.GetRenewBefore() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), issuer.GetDuration().String(), "duration must be longer than renewBefore"))
+	}
+	return allErrs
+}
+
+// validateUpdateTiKVGroups checks that a TiKV group is scaled in to 0 before it's removed,
+// otherwise the stores of the group would be orphaned
+func validateUpdateTiKVGroups(old, tc *v1alpha1.TidbCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := map[string]struct{}{}
+	for _, group := range tc.Spec.TiKVGroups {
+		names[group.Name] = struct{}{}
+	}
+	for _, group := range old.Spec.TiKVGroups {
+		if _, ok := names[group.Name]; ok {
+			continue
+		}
+		if group.Replicas != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("TiKV group %q must be scaled in to 0 before it is removed", group.Name)))
+		}
+	}
+	return allErrs
+}
+
 func validateTiKVSpec(spec *v1alpha1.TiKVSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateComponentSpec(&spec.ComponentSpec, fldPath)...)
@@ -217,6 +448,47 @@ func validateTiFlashSpec(spec *v1alpha1.TiFlashSpec, fldPath *field.Path) field.
 			spec.StorageClaims, "storageClaims should be configured at least one item."))
 	}
 	allErrs = append(allErrs, validateScalePolicy(&spec.ScalePolicy, fldPath.Child("scalePolicy"))...)
+	if spec.Disaggregated != nil {
+		allErrs = append(allErrs, validateTiFlashDisaggregated(spec.Disaggregated, fldPath.Child("disaggregated"))...)
+	}
+	if spec.Failover != nil && spec.Failover.Mode == v1alpha1.FailoverModeRebuildLocalVolume {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("failover", "mode"), spec.Failover.Mode,
+			[]string{string(v1alpha1.FailoverModeAddReplica)}))
+	}
+	return allErrs
+}
+
+// validateTiFlashDisaggregated validates the shared storage and compute nodes of the disaggregated architecture
+func validateTiFlashDisaggregated(spec *v1alpha1.TiFlashDisaggregatedSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.S3 == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("s3"), "s3 must be specified as the shared storage"))
+	} else if spec.S3.Bucket == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("s3", "bucket"), "bucket must not be empty"))
+	}
+	if spec.Gcs != nil || spec.Azblob != nil || spec.Local != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only s3 is supported as the shared storage"))
+	}
+
+	computePath := fldPath.Child("compute")
+	allErrs = append(allErrs, validateComponentSpec(&spec.Compute.ComponentSpec, computePath)...)
+	allErrs = append(allErrs, validateTiFlashConfig(spec.Compute.Config, computePath)...)
+	for i, claim := range spec.Compute.StorageClaims {
+		allErrs = append(allErrs, validateRequestsStorage(claim.Resources.Requests, computePath.Child("storageClaims").Index(i).Child("resources"))...)
+	}
+	return allErrs
+}
+
+// validateUpdateTiFlashDisaggregated forbids switching the architecture of an existing TiFlash,
+// because the data of write nodes are stored in different formats
+func validateUpdateTiFlashDisaggregated(old, tc *v1alpha1.TidbCluster, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if old.Spec.TiFlash == nil || tc.Spec.TiFlash == nil {
+		return allErrs
+	}
+	if old.TiFlashDisaggregated() != tc.TiFlashDisaggregated() {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "the architecture of an existing TiFlash can not be changed"))
+	}
 	return allErrs
 }
 
@@ -319,6 +591,44 @@ func validateDMClusterSpec(spec *v1alpha1.DMClusterSpec, fldPath *field.Path) fi
 	if spec.Worker != nil {
 		allErrs = append(allErrs, validateWorkerSpec(spec.Worker, fldPath.Child("worker"))...)
 	}
+	// the volumes of TLSClientSecretNames are named after the secrets
+	clientSecretVols := map[string]struct{}{}
+	for _, name := range spec.TLSClientSecretNames {
+		clientSecretVols[name] = struct{}{}
+	}
+	allErrs = append(allErrs, validateDMTLSClientSecrets("upstream-tls", spec.TLSUpstreams, clientSecretVols, fldPath.Child("tlsUpstreams"))...)
+	allErrs = append(allErrs, validateDMTLSClientSecrets("downstream-tls", spec.TLSDownstreams, clientSecretVols, fldPath.Child("tlsDownstreams"))...)
+	return allErrs
+}
+
+// validateDMTLSClientSecrets validates the client certificates of the upstream or downstream databases,
+// the name is used as the directory name of the mount path and in the volume name `<volPrefix>-<name>`,
+// which must not collide with the volumes in usedVols.
+func validateDMTLSClientSecrets(volPrefix string, secrets []v1alpha1.DMTLSClientSecret, usedVols map[string]struct{}, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := map[string]struct{}{}
+	for i := range secrets {
+		secret := &secrets[i]
+		idxPath := fldPath.Index(i)
+		if secret.SecretName == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("secretName"), "secretName must not be empty"))
+		}
+		if secret.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name must not be empty"))
+			continue
+		}
+		volName := fmt.Sprintf("%s-%s", volPrefix, secret.Name)
+		for _, msg := range validation.IsDNS1123Label(volName) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), secret.Name, msg))
+		}
+		if _, ok := usedVols[volName]; ok {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), secret.Name, fmt.Sprintf("volume %s collides with the secret in tlsClientSecretNames", volName)))
+		}
+		if _, ok := names[secret.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), secret.Name))
+		}
+		names[secret.Name] = struct{}{}
+	}
 	return allErrs
 }
 
@@ -343,6 +653,25 @@ func validateMasterSpec(spec *v1alpha1.MasterSpec, fldPath *field.Path) field.Er
 func validateWorkerSpec(spec *v1alpha1.WorkerSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateComponentSpec(&spec.ComponentSpec, fldPath)...)
+	allErrs = append(allErrs, validateDMSources(spec.Sources, fldPath.Child("sources"))...)
+	return allErrs
+}
+
+func validateDMSources(sources []v1alpha1.DMSourceSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := map[string]struct{}{}
+	for i := range sources {
+		source := &sources[i]
+		idxPath := fldPath.Index(i)
+		if source.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "name of the source must not be empty"))
+			continue
+		}
+		if _, ok := names[source.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), source.Name))
+		}
+		names[source.Name] = struct{}{}
+	}
 	return allErrs
 }
 
@@ -539,6 +868,8 @@ func ValidateUpdateTidbCluster(old, tc *v1alpha1.TidbCluster) field.ErrorList {
 	allErrs = append(allErrs, validateUpdatePDConfig(old.Spec.PD, tc.Spec.PD, field.NewPath("spec.pd.config"))...)
 	allErrs = append(allErrs, disallowMutateBootstrapSQLConfigMapName(old.Spec.TiDB, tc.Spec.TiDB, field.NewPath("spec.tidb.bootstrapSQLConfigMapName"))...)
 	allErrs = append(allErrs, disallowUsingLegacyAPIInNewCluster(old, tc)...)
+	allErrs = append(allErrs, validateUpdateTiKVGroups(old, tc, field.NewPath("spec", "tikvGroups"))...)
+	allErrs = append(allErrs, validateUpdateTiFlashDisaggregated(old, tc, field.NewPath("spec", "tiflash", "disaggregated"))...)
 
 	return allErrs
 }
@@ -563,6 +894,11 @@ func validateNewTidbClusterSpec(spec *v1alpha1.TidbClusterSpec, path *field.Path
 	if tikvSpecified && spec.TiKV.BaseImage == "" {
 		allErrs = append(allErrs, field.Invalid(path.Child("tikv.baseImage"), spec.TiKV.BaseImage, "baseImage of TiKV must not be empty"))
 	}
+	for i, group := range spec.TiKVGroups {
+		if group.BaseImage == "" {
+			allErrs = append(allErrs, field.Invalid(path.Child("tikvGroups").Index(i).Child("baseImage"), group.BaseImage, "baseImage of TiKV must not be empty"))
+		}
+	}
 	if tidbSpecified && spec.TiDB.Image != "" {
 		allErrs = append(allErrs, field.Invalid(path.Child("tidb.image"), spec.TiDB.Image, "image has been deprecated, use baseImage instead"))
 	}
@@ -16,6 +16,7 @@ package validation
 import (
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
@@ -495,11 +496,15 @@ func TestValidateTidbMonitor(t *testing.T) {
 func TestValidateDMCluster(t *testing.T) {
 	g := NewGomegaWithT(t)
 	tests := []struct {
-		name              string
-		version           string
-		masterReplicas    int32
-		masterStorageSize string
-		expectedError     string
+		name                 string
+		version              string
+		masterReplicas       int32
+		masterStorageSize    string
+		sources              []v1alpha1.DMSourceSpec
+		tlsUpstreams         []v1alpha1.DMTLSClientSecret
+		tlsDownstreams       []v1alpha1.DMTLSClientSecret
+		tlsClientSecretNames []string
+		expectedError        string
 	}{
 		{
 			name:          "invalid version",
@@ -519,6 +524,64 @@ func TestValidateDMCluster(t *testing.T) {
 			masterStorageSize: "10Gi",
 			expectedError:     "",
 		},
+		{
+			name:              "source name not given",
+			version:           "nightly",
+			masterReplicas:    3,
+			masterStorageSize: "10Gi",
+			sources:           []v1alpha1.DMSourceSpec{{PreferredWorkers: []string{"dm-worker-0"}}},
+			expectedError:     "name of the source must not be empty",
+		},
+		{
+			name:              "duplicated sources",
+			version:           "nightly",
+			masterReplicas:    3,
+			masterStorageSize: "10Gi",
+			sources:           []v1alpha1.DMSourceSpec{{Name: "mysql1"}, {Name: "mysql1"}},
+			expectedError:     `Duplicate value: "mysql1"`,
+		},
+		{
+			name:              "tls secrets of upstreams and downstreams",
+			version:           "nightly",
+			masterReplicas:    3,
+			masterStorageSize: "10Gi",
+			tlsUpstreams:      []v1alpha1.DMTLSClientSecret{{Name: "mysql1", SecretName: "mysql1-client"}, {Name: "mysql2", SecretName: "mysql2-client"}},
+			tlsDownstreams:    []v1alpha1.DMTLSClientSecret{{Name: "mysql1", SecretName: "tidb-client"}},
+			expectedError:     "",
+		},
+		{
+			name:              "tls secret name not given",
+			version:           "nightly",
+			masterReplicas:    3,
+			masterStorageSize: "10Gi",
+			tlsUpstreams:      []v1alpha1.DMTLSClientSecret{{Name: "mysql1"}},
+			expectedError:     "secretName must not be empty",
+		},
+		{
+			name:              "invalid tls upstream name",
+			version:           "nightly",
+			masterReplicas:    3,
+			masterStorageSize: "10Gi",
+			tlsUpstreams:      []v1alpha1.DMTLSClientSecret{{Name: "MySQL_1", SecretName: "mysql1-client"}},
+			expectedError:     "spec.tlsUpstreams[0].name",
+		},
+		{
+			name:              "duplicated tls downstreams",
+			version:           "nightly",
+			masterReplicas:    3,
+			masterStorageSize: "10Gi",
+			tlsDownstreams:    []v1alpha1.DMTLSClientSecret{{Name: "tidb", SecretName: "tidb-client"}, {Name: "tidb", SecretName: "tidb-client-2"}},
+			expectedError:     `Duplicate value: "tidb"`,
+		},
+		{
+			name:                 "tls upstream volume collides with tls client secret",
+			version:              "nightly",
+			masterReplicas:       3,
+			masterStorageSize:    "10Gi",
+			tlsClientSecretNames: []string{"upstream-tls-mysql1"},
+			tlsUpstreams:         []v1alpha1.DMTLSClientSecret{{Name: "mysql1", SecretName: "mysql1-client"}},
+			expectedError:        "collides with the secret in tlsClientSecretNames",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -526,10 +589,16 @@ func TestValidateDMCluster(t *testing.T) {
 			dc.Spec.Version = tt.version
 			dc.Spec.Master.Replicas = tt.masterReplicas
 			dc.Spec.Master.StorageSize = tt.masterStorageSize
+			dc.Spec.Worker.Sources = tt.sources
+			dc.Spec.TLSClientSecretNames = tt.tlsClientSecretNames
+			dc.Spec.TLSUpstreams = tt.tlsUpstreams
+			dc.Spec.TLSDownstreams = tt.tlsDownstreams
 			err := ValidateDMCluster(dc)
 			if tt.expectedError != "" {
 				g.Expect(len(err)).Should(Equal(1))
-				g.Expect(err[0].Detail).To(ContainSubstring(tt.expectedError))
+				g.Expect(err[0].Error()).To(ContainSubstring(tt.expectedError))
+			} else {
+				g.Expect(err).To(BeEmpty())
 			}
 		})
 	}
@@ -826,3 +895,491 @@ func Test_disallowMutateBootstrapSQLConfigMapName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTiKVGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		update         func(tc *v1alpha1.TidbCluster)
+		expectedErrors int
+	}{
+		{
+			name: "valid groups",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{{Name: "g1"}, {Name: "g-2"}}
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "invalid name",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{{Name: "G_1"}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "reserved name",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{{Name: "peer"}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "duplicated name",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{{Name: "g1"}, {Name: "g1"}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "tikv is not specified",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiKV = nil
+				tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{{Name: "g1"}}
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbCluster()
+			tt.update(tc)
+			for i := range tc.Spec.TiKVGroups {
+				tc.Spec.TiKVGroups[i].ResourceRequirements.Requests = corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("10G"),
+				}
+			}
+			err := validateTiKVGroups(&tc.Spec, field.NewPath("spec", "tikvGroups"))
+			g.Expect(err).Should(HaveLen(tt.expectedErrors))
+		})
+	}
+
+	tc := newTidbCluster()
+	tc.Labels = map[string]string{label.TiKVGroupLabelKey: "g1"}
+	g.Expect(ValidateTidbCluster(tc)).ShouldNot(BeEmpty())
+}
+
+func TestValidatePDMS(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		update         func(tc *v1alpha1.TidbCluster)
+		expectedErrors int
+		expectedField  string
+	}{
+		{
+			name: "valid microservices",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PDMS = []v1alpha1.PDMSSpec{{Name: "tso"}, {Name: "scheduling"}}
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "unknown name",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PDMS = []v1alpha1.PDMSSpec{{Name: "resource-manager"}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "duplicated name",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PDMS = []v1alpha1.PDMSSpec{{Name: "tso"}, {Name: "tso"}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "pd is not in ms mode",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PD.Mode = ""
+				tc.Spec.PDMS = []v1alpha1.PDMSSpec{{Name: "tso"}}
+			},
+			expectedErrors: 1,
+			expectedField:  "spec.pd.mode",
+		},
+		{
+			name: "pd is not specified",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PD = nil
+				tc.Spec.PDMS = []v1alpha1.PDMSSpec{{Name: "tso"}}
+			},
+			expectedErrors: 1,
+			expectedField:  "spec.pd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbCluster()
+			tc.Spec.StartScriptVersion = v1alpha1.StartScriptV2
+			tc.Spec.PD.Mode = v1alpha1.PDModeMS
+			tt.update(tc)
+			err := validatePDMS(&tc.Spec, field.NewPath("spec"))
+			g.Expect(err).Should(HaveLen(tt.expectedErrors))
+			if tt.expectedField != "" {
+				g.Expect(err[0].Field).Should(Equal(tt.expectedField))
+			}
+		})
+	}
+
+	// ms mode requires the v2 start script
+	tc := newTidbCluster()
+	tc.Spec.PD.Mode = v1alpha1.PDModeMS
+	tc.Spec.PD.ResourceRequirements.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10G")}
+	tc.Spec.TiKV.ResourceRequirements.Requests = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10G")}
+	err := validateTiDBClusterSpec(&tc.Spec, field.NewPath("spec"))
+	g.Expect(err).Should(HaveLen(1))
+	g.Expect(err[0].Field).Should(Equal("spec.pd.mode"))
+	tc.Spec.StartScriptVersion = v1alpha1.StartScriptV2
+	g.Expect(validateTiDBClusterSpec(&tc.Spec, field.NewPath("spec"))).Should(BeEmpty())
+}
+
+func TestValidateUpdateTiKVGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	old := newTidbCluster()
+	old.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{
+		{Name: "g1", TiKVSpec: v1alpha1.TiKVSpec{Replicas: 3}},
+		{Name: "g2", TiKVSpec: v1alpha1.TiKVSpec{Replicas: 0}},
+	}
+
+	tc := old.DeepCopy()
+	tc.Spec.TiKVGroups = tc.Spec.TiKVGroups[:1]
+	g.Expect(validateUpdateTiKVGroups(old, tc, field.NewPath("spec", "tikvGroups"))).Should(BeEmpty())
+
+	tc = old.DeepCopy()
+	tc.Spec.TiKVGroups = tc.Spec.TiKVGroups[1:]
+	g.Expect(validateUpdateTiKVGroups(old, tc, field.NewPath("spec", "tikvGroups"))).Should(HaveLen(1))
+}
+
+func TestValidateTiFlashDisaggregated(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		update         func(spec *v1alpha1.TiFlashDisaggregatedSpec)
+		expectedErrors int
+	}{
+		{
+			name:           "valid",
+			update:         func(spec *v1alpha1.TiFlashDisaggregatedSpec) {},
+			expectedErrors: 0,
+		},
+		{
+			name: "s3 is not specified",
+			update: func(spec *v1alpha1.TiFlashDisaggregatedSpec) {
+				spec.S3 = nil
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "bucket is empty",
+			update: func(spec *v1alpha1.TiFlashDisaggregatedSpec) {
+				spec.S3.Bucket = ""
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "gcs is not supported",
+			update: func(spec *v1alpha1.TiFlashDisaggregatedSpec) {
+				spec.Gcs = &v1alpha1.GcsStorageProvider{}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "storage request of cache is empty",
+			update: func(spec *v1alpha1.TiFlashDisaggregatedSpec) {
+				spec.Compute.StorageClaims = []v1alpha1.StorageClaim{{}}
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &v1alpha1.TiFlashDisaggregatedSpec{
+				StorageProvider: v1alpha1.StorageProvider{
+					S3: &v1alpha1.S3StorageProvider{Bucket: "tiflash"},
+				},
+				Compute: v1alpha1.TiFlashComputeSpec{Replicas: 1},
+			}
+			tt.update(spec)
+			err := validateTiFlashDisaggregated(spec, field.NewPath("spec", "tiflash", "disaggregated"))
+			g.Expect(err).Should(HaveLen(tt.expectedErrors))
+		})
+	}
+}
+
+func TestValidateUpdateTiFlashDisaggregated(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	old := newTidbCluster()
+	old.Spec.TiFlash = &v1alpha1.TiFlashSpec{}
+
+	tc := old.DeepCopy()
+	tc.Spec.TiFlash.Replicas = 3
+	g.Expect(validateUpdateTiFlashDisaggregated(old, tc, field.NewPath("spec", "tiflash", "disaggregated"))).Should(BeEmpty())
+
+	tc = old.DeepCopy()
+	tc.Spec.TiFlash.Disaggregated = &v1alpha1.TiFlashDisaggregatedSpec{}
+	g.Expect(validateUpdateTiFlashDisaggregated(old, tc, field.NewPath("spec", "tiflash", "disaggregated"))).Should(HaveLen(1))
+}
+
+func TestValidateResourceGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		update         func(tc *v1alpha1.TidbCluster)
+		expectedErrors int
+	}{
+		{
+			name: "valid groups",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.ResourceGroups = []v1alpha1.ResourceGroupSpec{
+					{Name: "rg_1", RUPerSec: 1000},
+					{Name: "default", RUPerSec: 2000, Priority: v1alpha1.ResourceGroupPriorityHigh, Burstable: true},
+				}
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "invalid name",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.ResourceGroups = []v1alpha1.ResourceGroupSpec{{Name: "RG-1", RUPerSec: 1000}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "duplicated name",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.ResourceGroups = []v1alpha1.ResourceGroupSpec{{Name: "rg1", RUPerSec: 1000}, {Name: "rg1", RUPerSec: 1000}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "invalid ruPerSec and priority",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.ResourceGroups = []v1alpha1.ResourceGroupSpec{{Name: "rg1", Priority: "URGENT"}}
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "sqlAccess is not specified",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.SQLAccess = nil
+				tc.Spec.ResourceGroups = []v1alpha1.ResourceGroupSpec{{Name: "rg1", RUPerSec: 1000}}
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "tidb is not specified",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiDB = nil
+				tc.Spec.ResourceGroups = []v1alpha1.ResourceGroupSpec{{Name: "rg1", RUPerSec: 1000}}
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbCluster()
+			tc.Spec.SQLAccess = &v1alpha1.TiDBSQLAccessConfig{SecretName: "tidb-secret"}
+			tt.update(tc)
+			err := validateResourceGroups(&tc.Spec, field.NewPath("spec", "resourceGroups"))
+			g.Expect(err).Should(HaveLen(tt.expectedErrors))
+		})
+	}
+}
+
+func TestValidateTLSIssuer(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		issuer         v1alpha1.TLSIssuer
+		expectedErrors int
+	}{
+		{
+			name:           "internal issuer",
+			issuer:         v1alpha1.TLSIssuer{Type: v1alpha1.TLSIssuerTypeInternal},
+			expectedErrors: 0,
+		},
+		{
+			name: "cert-manager issuer",
+			issuer: v1alpha1.TLSIssuer{
+				Type:      v1alpha1.TLSIssuerTypeCertManager,
+				IssuerRef: &v1alpha1.CertManagerIssuerRef{Name: "ca-issuer", Kind: "ClusterIssuer"},
+			},
+			expectedErrors: 0,
+		},
+		{
+			name:           "cert-manager issuer without issuerRef",
+			issuer:         v1alpha1.TLSIssuer{Type: v1alpha1.TLSIssuerTypeCertManager},
+			expectedErrors: 1,
+		},
+		{
+			name:           "unknown type",
+			issuer:         v1alpha1.TLSIssuer{Type: "Vault"},
+			expectedErrors: 1,
+		},
+		{
+			name: "duration is too long for the internal issuer",
+			issuer: v1alpha1.TLSIssuer{
+				Type:     v1alpha1.TLSIssuerTypeInternal,
+				Duration: &metav1.Duration{Duration: 2 * v1alpha1.MaxInternalTLSCertDuration},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "duration is shorter than renewBefore",
+			issuer: v1alpha1.TLSIssuer{
+				Type:        v1alpha1.TLSIssuerTypeInternal,
+				Duration:    &metav1.Duration{Duration: 24 * time.Hour},
+				RenewBefore: &metav1.Duration{Duration: 48 * time.Hour},
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "negative renewBefore",
+			issuer: v1alpha1.TLSIssuer{
+				Type:        v1alpha1.TLSIssuerTypeInternal,
+				RenewBefore: &metav1.Duration{Duration: -time.Hour},
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTLSIssuer(&tt.issuer, field.NewPath("spec", "tlsCluster", "issuer"))
+			g.Expect(err).Should(HaveLen(tt.expectedErrors))
+		})
+	}
+}
+
+func TestValidateTiDBUser(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		update         func(user *v1alpha1.TiDBUser)
+		expectedErrors int
+	}{
+		{
+			name:           "valid user",
+			update:         func(user *v1alpha1.TiDBUser) {},
+			expectedErrors: 0,
+		},
+		{
+			name: "valid role",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.Role = true
+				user.Spec.PasswordSecret = nil
+			},
+			expectedErrors: 0,
+		},
+		{
+			name: "role with password",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.Role = true
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "user without password",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.PasswordSecret = nil
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "user name is too long",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.UserName = strings.Repeat("u", 33)
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "duplicated roles",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.Roles = []string{"r1", "r1", ""}
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "cluster is not specified",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.Cluster.Name = ""
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := &v1alpha1.TiDBUser{
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "ns"},
+				Spec: v1alpha1.TiDBUserSpec{
+					Cluster: v1alpha1.TidbClusterRef{Name: "basic"},
+					PasswordSecret: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "app-password"},
+						Key:                  "password",
+					},
+				},
+			}
+			tt.update(user)
+			g.Expect(ValidateTiDBUser(user)).Should(HaveLen(tt.expectedErrors))
+		})
+	}
+}
+
+func TestValidateTiDBGrant(t *testing.T) {
+	g := NewGomegaWithT(t)
+	tests := []struct {
+		name           string
+		update         func(grant *v1alpha1.TiDBGrant)
+		expectedErrors int
+	}{
+		{
+			name:           "valid grant",
+			update:         func(grant *v1alpha1.TiDBGrant) {},
+			expectedErrors: 0,
+		},
+		{
+			name: "invalid privileges",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Spec.Privileges = []string{"SELECT", "select", "DROP; DROP USER"}
+			},
+			expectedErrors: 2,
+		},
+		{
+			name: "empty privileges",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Spec.Privileges = nil
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "table without database",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Spec.Database = ""
+				grant.Spec.Table = "t"
+			},
+			expectedErrors: 1,
+		},
+		{
+			name: "user is not specified",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Spec.User = ""
+			},
+			expectedErrors: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant := &v1alpha1.TiDBGrant{
+				ObjectMeta: metav1.ObjectMeta{Name: "app-read", Namespace: "ns"},
+				Spec: v1alpha1.TiDBGrantSpec{
+					User:       "app",
+					Privileges: []string{"SELECT", "CREATE VIEW"},
+					Database:   "app",
+				},
+			}
+			tt.update(grant)
+			g.Expect(ValidateTiDBGrant(grant)).Should(HaveLen(tt.expectedErrors))
+		})
+	}
+}
@@ -691,6 +691,22 @@ func (in *CDCConfigWraper) DeepCopy() *CDCConfigWraper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerRef.
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CleanOption) DeepCopyInto(out *CleanOption) {
 	*out = *in
@@ -1221,13 +1237,23 @@ func (in *DMClusterSpec) DeepCopyInto(out *DMClusterSpec) {
 	if in.TLSCluster != nil {
 		in, out := &in.TLSCluster, &out.TLSCluster
 		*out = new(TLSCluster)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TLSClientSecretNames != nil {
 		in, out := &in.TLSClientSecretNames, &out.TLSClientSecretNames
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TLSUpstreams != nil {
+		in, out := &in.TLSUpstreams, &out.TLSUpstreams
+		*out = make([]DMTLSClientSecret, len(*in))
+		copy(*out, *in)
+	}
+	if in.TLSDownstreams != nil {
+		in, out := &in.TLSDownstreams, &out.TLSDownstreams
+		*out = make([]DMTLSClientSecret, len(*in))
+		copy(*out, *in)
+	}
 	if in.HostNetwork != nil {
 		in, out := &in.HostNetwork, &out.HostNetwork
 		*out = new(bool)
@@ -1316,6 +1342,20 @@ func (in *DMClusterStatus) DeepCopyInto(out *DMClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TLSUpstreams != nil {
+		in, out := &in.TLSUpstreams, &out.TLSUpstreams
+		*out = make(map[string]DMTLSClientPaths, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TLSDownstreams != nil {
+		in, out := &in.TLSDownstreams, &out.TLSDownstreams
+		*out = make(map[string]DMTLSClientPaths, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1389,6 +1429,22 @@ func (in *DMMonitorSpec) DeepCopy() *DMMonitorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DMRelaySpec) DeepCopyInto(out *DMRelaySpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DMRelaySpec.
+func (in *DMRelaySpec) DeepCopy() *DMRelaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DMRelaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DMSecurityConfig) DeepCopyInto(out *DMSecurityConfig) {
 	*out = *in
@@ -1425,6 +1481,81 @@ func (in *DMSecurityConfig) DeepCopy() *DMSecurityConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DMSourceSpec) DeepCopyInto(out *DMSourceSpec) {
+	*out = *in
+	if in.PreferredWorkers != nil {
+		in, out := &in.PreferredWorkers, &out.PreferredWorkers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Relay != nil {
+		in, out := &in.Relay, &out.Relay
+		*out = new(DMRelaySpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DMSourceSpec.
+func (in *DMSourceSpec) DeepCopy() *DMSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DMSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DMSourceStatus) DeepCopyInto(out *DMSourceStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DMSourceStatus.
+func (in *DMSourceStatus) DeepCopy() *DMSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DMSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DMTLSClientPaths) DeepCopyInto(out *DMTLSClientPaths) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DMTLSClientPaths.
+func (in *DMTLSClientPaths) DeepCopy() *DMTLSClientPaths {
+	if in == nil {
+		return nil
+	}
+	out := new(DMTLSClientPaths)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DMTLSClientSecret) DeepCopyInto(out *DMTLSClientSecret) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DMTLSClientSecret.
+func (in *DMTLSClientSecret) DeepCopy() *DMTLSClientSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(DMTLSClientSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardConfig) DeepCopyInto(out *DashboardConfig) {
 	*out = *in
@@ -1570,6 +1701,11 @@ func (in *DiscoverySpec) DeepCopyInto(out *DiscoverySpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1723,6 +1859,23 @@ func (in *Failover) DeepCopy() *Failover {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverRecord) DeepCopyInto(out *FailoverRecord) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverRecord.
+func (in *FailoverRecord) DeepCopy() *FailoverRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileLogConfig) DeepCopyInto(out *FileLogConfig) {
 	*out = *in
@@ -3346,6 +3499,77 @@ func (in *PDLogConfig) DeepCopy() *PDLogConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDMSSpec) DeepCopyInto(out *PDMSSpec) {
+	*out = *in
+	in.ComponentSpec.DeepCopyInto(&out.ComponentSpec)
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(PDConfigWraper)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDMSSpec.
+func (in *PDMSSpec) DeepCopy() *PDMSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PDMSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PDMSStatus) DeepCopyInto(out *PDMSStatus) {
+	*out = *in
+	if in.StatefulSet != nil {
+		in, out := &in.StatefulSet, &out.StatefulSet
+		*out = new(appsv1.StatefulSetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make(map[StorageVolumeName]*StorageVolumeStatus, len(*in))
+		for key, val := range *in {
+			var outVal *StorageVolumeStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(StorageVolumeStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PDMSStatus.
+func (in *PDMSStatus) DeepCopy() *PDMSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PDMSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PDMember) DeepCopyInto(out *PDMember) {
 	*out = *in
@@ -4694,6 +4918,38 @@ func (in *RemoteWriteSpec) DeepCopy() *RemoteWriteSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceGroupSpec) DeepCopyInto(out *ResourceGroupSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroupSpec.
+func (in *ResourceGroupSpec) DeepCopy() *ResourceGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceGroupStatus) DeepCopyInto(out *ResourceGroupStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceGroupStatus.
+func (in *ResourceGroupStatus) DeepCopy() *ResourceGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Restore) DeepCopyInto(out *Restore) {
 	*out = *in
@@ -5310,9 +5566,32 @@ func (in *SuspendAction) DeepCopy() *SuspendAction {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSCertificateStatus) DeepCopyInto(out *TLSCertificateStatus) {
+	*out = *in
+	in.NotBefore.DeepCopyInto(&out.NotBefore)
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSCertificateStatus.
+func (in *TLSCertificateStatus) DeepCopy() *TLSCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSCluster) DeepCopyInto(out *TLSCluster) {
 	*out = *in
+	if in.Issuer != nil {
+		in, out := &in.Issuer, &out.Issuer
+		*out = new(TLSIssuer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5343,6 +5622,37 @@ func (in *TLSConfig) DeepCopy() *TLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSIssuer) DeepCopyInto(out *TLSIssuer) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(CertManagerIssuerRef)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSIssuer.
+func (in *TLSIssuer) DeepCopy() *TLSIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThanosSpec) DeepCopyInto(out *ThanosSpec) {
 	*out = *in
@@ -5841,34 +6151,187 @@ func (in *TiDBFailureMember) DeepCopy() *TiDBFailureMember {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TiDBInitializer) DeepCopyInto(out *TiDBInitializer) {
+func (in *TiDBGrant) DeepCopyInto(out *TiDBGrant) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBInitializer.
-func (in *TiDBInitializer) DeepCopy() *TiDBInitializer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBGrant.
+func (in *TiDBGrant) DeepCopy() *TiDBGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(TiDBInitializer)
+	out := new(TiDBGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TiDBGrant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TiDBMember) DeepCopyInto(out *TiDBMember) {
+func (in *TiDBGrantApplied) DeepCopyInto(out *TiDBGrantApplied) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	out.Cluster = in.Cluster
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBMember.
-func (in *TiDBMember) DeepCopy() *TiDBMember {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBGrantApplied.
+func (in *TiDBGrantApplied) DeepCopy() *TiDBGrantApplied {
 	if in == nil {
 		return nil
 	}
-	out := new(TiDBMember)
+	out := new(TiDBGrantApplied)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBGrantList) DeepCopyInto(out *TiDBGrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TiDBGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBGrantList.
+func (in *TiDBGrantList) DeepCopy() *TiDBGrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBGrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TiDBGrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBGrantSpec) DeepCopyInto(out *TiDBGrantSpec) {
+	*out = *in
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBGrantSpec.
+func (in *TiDBGrantSpec) DeepCopy() *TiDBGrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBGrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBGrantStatus) DeepCopyInto(out *TiDBGrantStatus) {
+	*out = *in
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = new(TiDBGrantApplied)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBGrantStatus.
+func (in *TiDBGrantStatus) DeepCopy() *TiDBGrantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBGrantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBInitializer) DeepCopyInto(out *TiDBInitializer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBInitializer.
+func (in *TiDBInitializer) DeepCopy() *TiDBInitializer {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBInitializer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBMember) DeepCopyInto(out *TiDBMember) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBMember.
+func (in *TiDBMember) DeepCopy() *TiDBMember {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBSQLAccessConfig) DeepCopyInto(out *TiDBSQLAccessConfig) {
+	*out = *in
+	if in.TLSClientSecretName != nil {
+		in, out := &in.TLSClientSecretName, &out.TLSClientSecretName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBSQLAccessConfig.
+func (in *TiDBSQLAccessConfig) DeepCopy() *TiDBSQLAccessConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBSQLAccessConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -6112,6 +6575,122 @@ func (in *TiDBTLSClient) DeepCopy() *TiDBTLSClient {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBUser) DeepCopyInto(out *TiDBUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBUser.
+func (in *TiDBUser) DeepCopy() *TiDBUser {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TiDBUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBUserList) DeepCopyInto(out *TiDBUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TiDBUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBUserList.
+func (in *TiDBUserList) DeepCopy() *TiDBUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TiDBUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBUserSpec) DeepCopyInto(out *TiDBUserSpec) {
+	*out = *in
+	out.Cluster = in.Cluster
+	if in.PasswordSecret != nil {
+		in, out := &in.PasswordSecret, &out.PasswordSecret
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBUserSpec.
+func (in *TiDBUserSpec) DeepCopy() *TiDBUserSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBUserSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiDBUserStatus) DeepCopyInto(out *TiDBUserStatus) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiDBUserStatus.
+func (in *TiDBUserStatus) DeepCopy() *TiDBUserStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TiDBUserStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiFlashCommonConfigWraper) DeepCopyInto(out *TiFlashCommonConfigWraper) {
 	*out = *in
@@ -6132,6 +6711,36 @@ func (in *TiFlashCommonConfigWraper) DeepCopy() *TiFlashCommonConfigWraper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiFlashComputeSpec) DeepCopyInto(out *TiFlashComputeSpec) {
+	*out = *in
+	in.ComponentSpec.DeepCopyInto(&out.ComponentSpec)
+	in.ResourceRequirements.DeepCopyInto(&out.ResourceRequirements)
+	if in.StorageClaims != nil {
+		in, out := &in.StorageClaims, &out.StorageClaims
+		*out = make([]StorageClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(TiFlashConfigWraper)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashComputeSpec.
+func (in *TiFlashComputeSpec) DeepCopy() *TiFlashComputeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiFlashComputeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiFlashConfig) DeepCopyInto(out *TiFlashConfig) {
 	*out = *in
@@ -6184,6 +6793,24 @@ func (in *TiFlashConfigWraper) DeepCopy() *TiFlashConfigWraper {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiFlashDisaggregatedSpec) DeepCopyInto(out *TiFlashDisaggregatedSpec) {
+	*out = *in
+	in.StorageProvider.DeepCopyInto(&out.StorageProvider)
+	in.Compute.DeepCopyInto(&out.Compute)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiFlashDisaggregatedSpec.
+func (in *TiFlashDisaggregatedSpec) DeepCopy() *TiFlashDisaggregatedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiFlashDisaggregatedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiFlashProxyConfigWraper) DeepCopyInto(out *TiFlashProxyConfigWraper) {
 	*out = *in
@@ -6247,6 +6874,11 @@ func (in *TiFlashSpec) DeepCopyInto(out *TiFlashSpec) {
 		**out = **in
 	}
 	in.ScalePolicy.DeepCopyInto(&out.ScalePolicy)
+	if in.Disaggregated != nil {
+		in, out := &in.Disaggregated, &out.Disaggregated
+		*out = new(TiFlashDisaggregatedSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -7121,6 +7753,18 @@ func (in *TiKVFailureStore) DeepCopyInto(out *TiKVFailureStore) {
 		}
 	}
 	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	if in.PVNames != nil {
+		in, out := &in.PVNames, &out.PVNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]FailoverRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -7170,6 +7814,23 @@ func (in *TiKVGCConfig) DeepCopy() *TiKVGCConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TiKVGroupSpec) DeepCopyInto(out *TiKVGroupSpec) {
+	*out = *in
+	in.TiKVSpec.DeepCopyInto(&out.TiKVSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TiKVGroupSpec.
+func (in *TiKVGroupSpec) DeepCopy() *TiKVGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TiKVGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TiKVImportConfig) DeepCopyInto(out *TiKVImportConfig) {
 	*out = *in
@@ -8138,6 +8799,11 @@ func (in *TiKVSpec) DeepCopyInto(out *TiKVSpec) {
 		copy(*out, *in)
 	}
 	in.ScalePolicy.DeepCopyInto(&out.ScalePolicy)
+	if in.VolumeReplace != nil {
+		in, out := &in.VolumeReplace, &out.VolumeReplace
+		*out = new(VolumeReplaceSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -8913,6 +9579,13 @@ func (in *TidbClusterSpec) DeepCopyInto(out *TidbClusterSpec) {
 		*out = new(PDSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PDMS != nil {
+		in, out := &in.PDMS, &out.PDMS
+		*out = make([]PDMSSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.TiDB != nil {
 		in, out := &in.TiDB, &out.TiDB
 		*out = new(TiDBSpec)
@@ -8923,6 +9596,13 @@ func (in *TidbClusterSpec) DeepCopyInto(out *TidbClusterSpec) {
 		*out = new(TiKVSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TiKVGroups != nil {
+		in, out := &in.TiKVGroups, &out.TiKVGroups
+		*out = make([]TiKVGroupSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.TiFlash != nil {
 		in, out := &in.TiFlash, &out.TiFlash
 		*out = new(TiFlashSpec)
@@ -8966,7 +9646,7 @@ func (in *TidbClusterSpec) DeepCopyInto(out *TidbClusterSpec) {
 	if in.TLSCluster != nil {
 		in, out := &in.TLSCluster, &out.TLSCluster
 		*out = new(TLSCluster)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.HostNetwork != nil {
 		in, out := &in.HostNetwork, &out.HostNetwork
@@ -9051,6 +9731,16 @@ func (in *TidbClusterSpec) DeepCopyInto(out *TidbClusterSpec) {
 		*out = new(SuspendAction)
 		**out = **in
 	}
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make([]ResourceGroupSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.SQLAccess != nil {
+		in, out := &in.SQLAccess, &out.SQLAccess
+		*out = new(TiDBSQLAccessConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -9068,7 +9758,29 @@ func (in *TidbClusterSpec) DeepCopy() *TidbClusterSpec {
 func (in *TidbClusterStatus) DeepCopyInto(out *TidbClusterStatus) {
 	*out = *in
 	in.PD.DeepCopyInto(&out.PD)
+	if in.PDMS != nil {
+		in, out := &in.PDMS, &out.PDMS
+		*out = make(map[string]*PDMSStatus, len(*in))
+		for key, val := range *in {
+			var outVal *PDMSStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(PDMSStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	in.TiKV.DeepCopyInto(&out.TiKV)
+	if in.TiKVGroups != nil {
+		in, out := &in.TiKVGroups, &out.TiKVGroups
+		*out = make(map[string]TiKVStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	in.TiDB.DeepCopyInto(&out.TiDB)
 	in.Pump.DeepCopyInto(&out.Pump)
 	in.TiFlash.DeepCopyInto(&out.TiFlash)
@@ -9079,6 +9791,21 @@ func (in *TidbClusterStatus) DeepCopyInto(out *TidbClusterStatus) {
 		*out = new(TidbClusterAutoScalerRef)
 		**out = **in
 	}
+	in.TiFlashCompute.DeepCopyInto(&out.TiFlashCompute)
+	if in.ResourceGroups != nil {
+		in, out := &in.ResourceGroups, &out.ResourceGroups
+		*out = make(map[string]ResourceGroupStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TLSCertificates != nil {
+		in, out := &in.TLSCertificates, &out.TLSCertificates
+		*out = make(map[string]TLSCertificateStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]TidbClusterCondition, len(*in))
@@ -9860,6 +10587,27 @@ func (in *User) DeepCopy() *User {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeReplaceSpec) DeepCopyInto(out *VolumeReplaceSpec) {
+	*out = *in
+	if in.SpareReplicas != nil {
+		in, out := &in.SpareReplicas, &out.SpareReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeReplaceSpec.
+func (in *VolumeReplaceSpec) DeepCopy() *VolumeReplaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeReplaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	*out = *in
@@ -9976,6 +10724,13 @@ func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 		*out = new(Failover)
 		**out = **in
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]DMSourceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -10033,6 +10788,13 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make(map[string]DMSourceStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
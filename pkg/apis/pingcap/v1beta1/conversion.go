@@ -0,0 +1,351 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+// The deprecated v1alpha1 fields don't exist in v1beta1. To make the conversion lossless,
+// their values are kept in the `tidb.pingcap.com/v1alpha1-fields` annotation of the
+// v1beta1 object and restored when it's converted back to v1alpha1.
+
+// tidbClusterV1alpha1Fields holds the values of the TidbCluster fields removed in v1beta1
+type tidbClusterV1alpha1Fields struct {
+	Services []v1alpha1.Service `json:"services,omitempty"`
+	// Images maps the component, e.g. `pd` or `tikvGroups[0]`, to its `image`
+	Images                       map[string]string  `json:"images,omitempty"`
+	EnableDashboardInternalProxy *bool              `json:"pdEnableDashboardInternalProxy,omitempty"`
+	SlowLogTailerImage           *string            `json:"tidbSlowLogTailerImage,omitempty"`
+	SlowLogTailerImagePullPolicy *corev1.PullPolicy `json:"tidbSlowLogTailerImagePullPolicy,omitempty"`
+}
+
+// brV1alpha1Fields holds the values of the BRConfig fields removed in v1beta1
+type brV1alpha1Fields struct {
+	DB    string `json:"db,omitempty"`
+	Table string `json:"table,omitempty"`
+}
+
+// backupV1alpha1Fields holds the values of the BackupSpec fields removed in v1beta1
+type backupV1alpha1Fields struct {
+	BR                  *brV1alpha1Fields `json:"br,omitempty"`
+	DumplingTableFilter []string          `json:"dumplingTableFilter,omitempty"`
+}
+
+// restoreV1alpha1Fields holds the values of the RestoreSpec fields removed in v1beta1
+type restoreV1alpha1Fields struct {
+	BR *brV1alpha1Fields `json:"br,omitempty"`
+}
+
+// backupScheduleV1alpha1Fields holds the values of the BackupScheduleSpec fields removed in v1beta1
+type backupScheduleV1alpha1Fields struct {
+	BackupTemplate    *backupV1alpha1Fields `json:"backupTemplate,omitempty"`
+	LogBackupTemplate *backupV1alpha1Fields `json:"logBackupTemplate,omitempty"`
+}
+
+// Convert_v1alpha1_TidbCluster_To_v1beta1_TidbCluster converts a v1alpha1 TidbCluster to v1beta1
+func Convert_v1alpha1_TidbCluster_To_v1beta1_TidbCluster(in *v1alpha1.TidbCluster, out *TidbCluster, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_TidbCluster_To_v1beta1_TidbCluster(in, out, s); err != nil {
+		return err
+	}
+	return setV1alpha1Fields(&out.ObjectMeta, newTidbClusterV1alpha1Fields(&in.Spec))
+}
+
+// Convert_v1beta1_TidbCluster_To_v1alpha1_TidbCluster converts a v1beta1 TidbCluster to v1alpha1
+func Convert_v1beta1_TidbCluster_To_v1alpha1_TidbCluster(in *TidbCluster, out *v1alpha1.TidbCluster, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_TidbCluster_To_v1alpha1_TidbCluster(in, out, s); err != nil {
+		return err
+	}
+	fields := &tidbClusterV1alpha1Fields{}
+	if err := getV1alpha1Fields(&out.ObjectMeta, fields); err != nil {
+		return err
+	}
+	fields.restore(&out.Spec)
+	return nil
+}
+
+// Convert_v1alpha1_TidbClusterSpec_To_v1beta1_TidbClusterSpec drops `services`,
+// which is preserved by Convert_v1alpha1_TidbCluster_To_v1beta1_TidbCluster
+func Convert_v1alpha1_TidbClusterSpec_To_v1beta1_TidbClusterSpec(in *v1alpha1.TidbClusterSpec, out *TidbClusterSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TidbClusterSpec_To_v1beta1_TidbClusterSpec(in, out, s)
+}
+
+// Convert_v1alpha1_ComponentSpec_To_v1beta1_ComponentSpec drops `image`,
+// which is preserved by Convert_v1alpha1_TidbCluster_To_v1beta1_TidbCluster
+func Convert_v1alpha1_ComponentSpec_To_v1beta1_ComponentSpec(in *v1alpha1.ComponentSpec, out *ComponentSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentSpec_To_v1beta1_ComponentSpec(in, out, s)
+}
+
+// Convert_v1alpha1_PDSpec_To_v1beta1_PDSpec drops `enableDashboardInternalProxy`,
+// which is preserved by Convert_v1alpha1_TidbCluster_To_v1beta1_TidbCluster
+func Convert_v1alpha1_PDSpec_To_v1beta1_PDSpec(in *v1alpha1.PDSpec, out *PDSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PDSpec_To_v1beta1_PDSpec(in, out, s)
+}
+
+// Convert_v1alpha1_TiDBSlowLogTailerSpec_To_v1beta1_TiDBSlowLogTailerSpec drops `image` and `imagePullPolicy`,
+// which are preserved by Convert_v1alpha1_TidbCluster_To_v1beta1_TidbCluster
+func Convert_v1alpha1_TiDBSlowLogTailerSpec_To_v1beta1_TiDBSlowLogTailerSpec(in *v1alpha1.TiDBSlowLogTailerSpec, out *TiDBSlowLogTailerSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TiDBSlowLogTailerSpec_To_v1beta1_TiDBSlowLogTailerSpec(in, out, s)
+}
+
+// Convert_v1alpha1_Backup_To_v1beta1_Backup converts a v1alpha1 Backup to v1beta1
+func Convert_v1alpha1_Backup_To_v1beta1_Backup(in *v1alpha1.Backup, out *Backup, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_Backup_To_v1beta1_Backup(in, out, s); err != nil {
+		return err
+	}
+	return setV1alpha1Fields(&out.ObjectMeta, newBackupV1alpha1Fields(&in.Spec))
+}
+
+// Convert_v1beta1_Backup_To_v1alpha1_Backup converts a v1beta1 Backup to v1alpha1
+func Convert_v1beta1_Backup_To_v1alpha1_Backup(in *Backup, out *v1alpha1.Backup, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_Backup_To_v1alpha1_Backup(in, out, s); err != nil {
+		return err
+	}
+	var fields *backupV1alpha1Fields
+	if err := getV1alpha1Fields(&out.ObjectMeta, &fields); err != nil {
+		return err
+	}
+	fields.restore(&out.Spec)
+	return nil
+}
+
+// Convert_v1alpha1_Restore_To_v1beta1_Restore converts a v1alpha1 Restore to v1beta1
+func Convert_v1alpha1_Restore_To_v1beta1_Restore(in *v1alpha1.Restore, out *Restore, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_Restore_To_v1beta1_Restore(in, out, s); err != nil {
+		return err
+	}
+	return setV1alpha1Fields(&out.ObjectMeta, &restoreV1alpha1Fields{BR: newBRV1alpha1Fields(in.Spec.BR)})
+}
+
+// Convert_v1beta1_Restore_To_v1alpha1_Restore converts a v1beta1 Restore to v1alpha1
+func Convert_v1beta1_Restore_To_v1alpha1_Restore(in *Restore, out *v1alpha1.Restore, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_Restore_To_v1alpha1_Restore(in, out, s); err != nil {
+		return err
+	}
+	fields := &restoreV1alpha1Fields{}
+	if err := getV1alpha1Fields(&out.ObjectMeta, fields); err != nil {
+		return err
+	}
+	fields.BR.restore(out.Spec.BR)
+	return nil
+}
+
+// Convert_v1alpha1_BackupSchedule_To_v1beta1_BackupSchedule converts a v1alpha1 BackupSchedule to v1beta1
+func Convert_v1alpha1_BackupSchedule_To_v1beta1_BackupSchedule(in *v1alpha1.BackupSchedule, out *BackupSchedule, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_BackupSchedule_To_v1beta1_BackupSchedule(in, out, s); err != nil {
+		return err
+	}
+	fields := &backupScheduleV1alpha1Fields{
+		BackupTemplate: newBackupV1alpha1Fields(&in.Spec.BackupTemplate),
+	}
+	if in.Spec.LogBackupTemplate != nil {
+		fields.LogBackupTemplate = newBackupV1alpha1Fields(in.Spec.LogBackupTemplate)
+	}
+	return setV1alpha1Fields(&out.ObjectMeta, fields)
+}
+
+// Convert_v1beta1_BackupSchedule_To_v1alpha1_BackupSchedule converts a v1beta1 BackupSchedule to v1alpha1
+func Convert_v1beta1_BackupSchedule_To_v1alpha1_BackupSchedule(in *BackupSchedule, out *v1alpha1.BackupSchedule, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_BackupSchedule_To_v1alpha1_BackupSchedule(in, out, s); err != nil {
+		return err
+	}
+	fields := &backupScheduleV1alpha1Fields{}
+	if err := getV1alpha1Fields(&out.ObjectMeta, fields); err != nil {
+		return err
+	}
+	fields.BackupTemplate.restore(&out.Spec.BackupTemplate)
+	if out.Spec.LogBackupTemplate != nil {
+		fields.LogBackupTemplate.restore(out.Spec.LogBackupTemplate)
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BRConfig_To_v1beta1_BRConfig drops `db` and `table`,
+// which are preserved by the conversion of the owner object
+func Convert_v1alpha1_BRConfig_To_v1beta1_BRConfig(in *v1alpha1.BRConfig, out *BRConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BRConfig_To_v1beta1_BRConfig(in, out, s)
+}
+
+// Convert_v1alpha1_DumplingConfig_To_v1beta1_DumplingConfig drops `tableFilter`,
+// which is preserved by the conversion of the owner object
+func Convert_v1alpha1_DumplingConfig_To_v1beta1_DumplingConfig(in *v1alpha1.DumplingConfig, out *DumplingConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DumplingConfig_To_v1beta1_DumplingConfig(in, out, s)
+}
+
+func newTidbClusterV1alpha1Fields(spec *v1alpha1.TidbClusterSpec) *tidbClusterV1alpha1Fields {
+	fields := &tidbClusterV1alpha1Fields{
+		Services: spec.Services,
+	}
+	for name, component := range componentSpecs(spec) {
+		if component.Image == "" {
+			continue
+		}
+		if fields.Images == nil {
+			fields.Images = map[string]string{}
+		}
+		fields.Images[name] = component.Image
+	}
+	if spec.PD != nil {
+		fields.EnableDashboardInternalProxy = spec.PD.EnableDashboardInternalProxy
+	}
+	if spec.TiDB != nil && spec.TiDB.SlowLogTailer != nil {
+		fields.SlowLogTailerImage = spec.TiDB.SlowLogTailer.Image
+		fields.SlowLogTailerImagePullPolicy = spec.TiDB.SlowLogTailer.ImagePullPolicy
+	}
+	return fields
+}
+
+func (f *tidbClusterV1alpha1Fields) restore(spec *v1alpha1.TidbClusterSpec) {
+	spec.Services = f.Services
+	components := componentSpecs(spec)
+	for name, image := range f.Images {
+		if component, ok := components[name]; ok {
+			component.Image = image
+		}
+	}
+	if spec.PD != nil {
+		spec.PD.EnableDashboardInternalProxy = f.EnableDashboardInternalProxy
+	}
+	if spec.TiDB != nil && spec.TiDB.SlowLogTailer != nil {
+		spec.TiDB.SlowLogTailer.Image = f.SlowLogTailerImage
+		spec.TiDB.SlowLogTailer.ImagePullPolicy = f.SlowLogTailerImagePullPolicy
+	}
+}
+
+// componentSpecs returns the ComponentSpec of each component in the spec, keyed by the component name
+func componentSpecs(spec *v1alpha1.TidbClusterSpec) map[string]*v1alpha1.ComponentSpec {
+	components := map[string]*v1alpha1.ComponentSpec{}
+	if spec.Discovery.ComponentSpec != nil {
+		components["discovery"] = spec.Discovery.ComponentSpec
+	}
+	if spec.PD != nil {
+		components["pd"] = &spec.PD.ComponentSpec
+	}
+	for i := range spec.PDMS {
+		components[fmt.Sprintf("pdms[%d]", i)] = &spec.PDMS[i].ComponentSpec
+	}
+	if spec.TiDB != nil {
+		components["tidb"] = &spec.TiDB.ComponentSpec
+	}
+	if spec.TiKV != nil {
+		components["tikv"] = &spec.TiKV.ComponentSpec
+	}
+	for i := range spec.TiKVGroups {
+		components[fmt.Sprintf("tikvGroups[%d]", i)] = &spec.TiKVGroups[i].ComponentSpec
+	}
+	if spec.TiFlash != nil {
+		components["tiflash"] = &spec.TiFlash.ComponentSpec
+		if spec.TiFlash.Disaggregated != nil {
+			components["tiflashCompute"] = &spec.TiFlash.Disaggregated.Compute.ComponentSpec
+		}
+	}
+	if spec.TiCDC != nil {
+		components["ticdc"] = &spec.TiCDC.ComponentSpec
+	}
+	if spec.TiProxy != nil {
+		components["tiproxy"] = &spec.TiProxy.ComponentSpec
+	}
+	if spec.Pump != nil {
+		components["pump"] = &spec.Pump.ComponentSpec
+	}
+	return components
+}
+
+func newBackupV1alpha1Fields(spec *v1alpha1.BackupSpec) *backupV1alpha1Fields {
+	fields := &backupV1alpha1Fields{
+		BR: newBRV1alpha1Fields(spec.BR),
+	}
+	if spec.Dumpling != nil {
+		fields.DumplingTableFilter = spec.Dumpling.TableFilter
+	}
+	if fields.BR == nil && len(fields.DumplingTableFilter) == 0 {
+		return nil
+	}
+	return fields
+}
+
+func (f *backupV1alpha1Fields) restore(spec *v1alpha1.BackupSpec) {
+	if f == nil {
+		return
+	}
+	f.BR.restore(spec.BR)
+	if spec.Dumpling != nil {
+		spec.Dumpling.TableFilter = f.DumplingTableFilter
+	}
+}
+
+func newBRV1alpha1Fields(br *v1alpha1.BRConfig) *brV1alpha1Fields {
+	if br == nil || (br.DB == "" && br.Table == "") {
+		return nil
+	}
+	return &brV1alpha1Fields{DB: br.DB, Table: br.Table}
+}
+
+func (f *brV1alpha1Fields) restore(br *v1alpha1.BRConfig) {
+	if f == nil || br == nil {
+		return
+	}
+	br.DB = f.DB
+	br.Table = f.Table
+}
+
+// setV1alpha1Fields saves the fields into the annotation, nothing is saved if none of the fields is set
+func setV1alpha1Fields(meta *metav1.ObjectMeta, fields interface{}) error {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return fmt.Errorf("failed to marshal v1alpha1 fields of %s/%s: %v", meta.Namespace, meta.Name, err)
+	}
+	if s := string(data); s == "{}" || s == "null" {
+		return nil
+	}
+	// the annotations map may be shared with the object being converted, don't modify it in place
+	annotations := make(map[string]string, len(meta.Annotations)+1)
+	for k, v := range meta.Annotations {
+		annotations[k] = v
+	}
+	annotations[label.AnnV1alpha1Fields] = string(data)
+	meta.Annotations = annotations
+	return nil
+}
+
+// getV1alpha1Fields loads the fields from the annotation and removes the annotation
+func getV1alpha1Fields(meta *metav1.ObjectMeta, fields interface{}) error {
+	data, ok := meta.Annotations[label.AnnV1alpha1Fields]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(data), fields); err != nil {
+		return fmt.Errorf("failed to unmarshal annotation %s of %s/%s: %v", label.AnnV1alpha1Fields, meta.Namespace, meta.Name, err)
+	}
+	var annotations map[string]string
+	for k, v := range meta.Annotations {
+		if k == label.AnnV1alpha1Fields {
+			continue
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[k] = v
+	}
+	meta.Annotations = annotations
+	return nil
+}
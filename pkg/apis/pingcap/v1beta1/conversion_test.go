@@ -0,0 +1,178 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"math/rand"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/pointer"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+)
+
+func newScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(AddToScheme(scheme))
+	return scheme
+}
+
+func fuzzerFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		// gofuzz can't fill the interface{} values of the config
+		func(c *config.GenericConfig, f fuzz.Continue) {
+			c.MP = map[string]interface{}{f.RandString(): f.RandString()}
+		},
+	}
+}
+
+func TestRoundTripFuzz(t *testing.T) {
+	scheme := newScheme()
+	f := fuzzer.FuzzerFor(
+		fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, fuzzerFuncs),
+		rand.NewSource(rand.Int63()),
+		runtimeserializer.NewCodecFactory(scheme),
+	)
+
+	// each pair is the v1alpha1 object and its v1beta1 peer
+	kinds := [][2]func() runtime.Object{
+		{func() runtime.Object { return &v1alpha1.TidbCluster{} }, func() runtime.Object { return &TidbCluster{} }},
+		{func() runtime.Object { return &v1alpha1.TidbClusterList{} }, func() runtime.Object { return &TidbClusterList{} }},
+		{func() runtime.Object { return &v1alpha1.Backup{} }, func() runtime.Object { return &Backup{} }},
+		{func() runtime.Object { return &v1alpha1.BackupList{} }, func() runtime.Object { return &BackupList{} }},
+		{func() runtime.Object { return &v1alpha1.Restore{} }, func() runtime.Object { return &Restore{} }},
+		{func() runtime.Object { return &v1alpha1.RestoreList{} }, func() runtime.Object { return &RestoreList{} }},
+		{func() runtime.Object { return &v1alpha1.BackupSchedule{} }, func() runtime.Object { return &BackupSchedule{} }},
+		{func() runtime.Object { return &v1alpha1.BackupScheduleList{} }, func() runtime.Object { return &BackupScheduleList{} }},
+	}
+	roundTrip := func(newIn, newPeer func() runtime.Object) {
+		in := newIn()
+		f.Fuzz(in)
+		peer := newPeer()
+		if err := scheme.Convert(in.DeepCopyObject(), peer, nil); err != nil {
+			t.Fatalf("failed to convert %T to %T: %v", in, peer, err)
+		}
+		out := newIn()
+		if err := scheme.Convert(peer, out, nil); err != nil {
+			t.Fatalf("failed to convert %T to %T: %v", peer, out, err)
+		}
+		if !apiequality.Semantic.DeepEqual(in, out) {
+			t.Errorf("%T is changed after round trip: %s", in, diff.ObjectReflectDiff(in, out))
+		}
+	}
+	for _, kind := range kinds {
+		for i := 0; i < 50; i++ {
+			roundTrip(kind[0], kind[1])
+			roundTrip(kind[1], kind[0])
+		}
+	}
+}
+
+func TestConvertTidbClusterV1alpha1Fields(t *testing.T) {
+	g := NewGomegaWithT(t)
+	scheme := newScheme()
+
+	pullPolicy := corev1.PullAlways
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "basic", Annotations: map[string]string{"foo": "bar"}},
+		Spec: v1alpha1.TidbClusterSpec{
+			Services: []v1alpha1.Service{{Name: "pd", Type: "NodePort"}},
+			PD: &v1alpha1.PDSpec{
+				ComponentSpec:                v1alpha1.ComponentSpec{Image: "pingcap/pd:v7.5.0"},
+				EnableDashboardInternalProxy: pointer.BoolPtr(true),
+			},
+			PDMS: []v1alpha1.PDMSSpec{
+				{Name: "tso", ComponentSpec: v1alpha1.ComponentSpec{Image: "pingcap/pd:v8.0.0"}},
+			},
+			TiDB: &v1alpha1.TiDBSpec{
+				SlowLogTailer: &v1alpha1.TiDBSlowLogTailerSpec{
+					Image:           pointer.StringPtr("busybox:1.26.2"),
+					ImagePullPolicy: &pullPolicy,
+				},
+			},
+			TiKVGroups: []v1alpha1.TiKVGroupSpec{
+				{Name: "g0"},
+				{Name: "g1", TiKVSpec: v1alpha1.TiKVSpec{ComponentSpec: v1alpha1.ComponentSpec{Image: "pingcap/tikv:v7.5.0"}}},
+			},
+		},
+	}
+
+	beta := &TidbCluster{}
+	g.Expect(scheme.Convert(tc.DeepCopy(), beta, nil)).To(Succeed())
+	g.Expect(beta.Annotations).To(HaveKeyWithValue("foo", "bar"))
+	g.Expect(beta.Annotations[label.AnnV1alpha1Fields]).To(MatchJSON(`{
+		"services": [{"name": "pd", "type": "NodePort"}],
+		"images": {"pd": "pingcap/pd:v7.5.0", "pdms[0]": "pingcap/pd:v8.0.0", "tikvGroups[1]": "pingcap/tikv:v7.5.0"},
+		"pdEnableDashboardInternalProxy": true,
+		"tidbSlowLogTailerImage": "busybox:1.26.2",
+		"tidbSlowLogTailerImagePullPolicy": "Always"
+	}`))
+
+	alpha := &v1alpha1.TidbCluster{}
+	g.Expect(scheme.Convert(beta, alpha, nil)).To(Succeed())
+	g.Expect(alpha).To(Equal(tc))
+
+	// no annotation is added if no deprecated field is set
+	tc.Spec = v1alpha1.TidbClusterSpec{PD: &v1alpha1.PDSpec{Replicas: 3}}
+	beta = &TidbCluster{}
+	g.Expect(scheme.Convert(tc.DeepCopy(), beta, nil)).To(Succeed())
+	g.Expect(beta.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+	g.Expect(beta.Spec.PD.Replicas).To(Equal(int32(3)))
+
+	beta.Annotations[label.AnnV1alpha1Fields] = "invalid"
+	g.Expect(scheme.Convert(beta, &v1alpha1.TidbCluster{}, nil)).NotTo(Succeed())
+}
+
+func TestConvertBackupScheduleV1alpha1Fields(t *testing.T) {
+	g := NewGomegaWithT(t)
+	scheme := newScheme()
+
+	bs := &v1alpha1.BackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "schedule"},
+		Spec: v1alpha1.BackupScheduleSpec{
+			BackupTemplate: v1alpha1.BackupSpec{
+				BR:       &v1alpha1.BRConfig{Cluster: "basic", DB: "test", Table: "t"},
+				Dumpling: &v1alpha1.DumplingConfig{TableFilter: []string{"test.*"}},
+			},
+			LogBackupTemplate: &v1alpha1.BackupSpec{
+				BR: &v1alpha1.BRConfig{Cluster: "basic"},
+			},
+		},
+	}
+
+	beta := &BackupSchedule{}
+	g.Expect(scheme.Convert(bs.DeepCopy(), beta, nil)).To(Succeed())
+	g.Expect(beta.Annotations[label.AnnV1alpha1Fields]).To(MatchJSON(`{
+		"backupTemplate": {"br": {"db": "test", "table": "t"}, "dumplingTableFilter": ["test.*"]}
+	}`))
+	g.Expect(beta.Spec.BackupTemplate.BR.Cluster).To(Equal("basic"))
+
+	alpha := &v1alpha1.BackupSchedule{}
+	g.Expect(scheme.Convert(beta, alpha, nil)).To(Succeed())
+	g.Expect(alpha).To(Equal(bs))
+}
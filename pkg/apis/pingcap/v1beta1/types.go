@@ -0,0 +1,1326 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/util/config"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TidbCluster is the control script's spec
+//
+// +kubebuilder:resource:shortName="tc"
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="PD",type=string,JSONPath=`.status.pd.image`,description="The image for PD cluster"
+// +kubebuilder:printcolumn:name="Storage",type=string,JSONPath=`.spec.pd.requests.storage`,description="The storage size specified for PD node"
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.pd.statefulSet.readyReplicas`,description="The desired replicas number of PD cluster"
+// +kubebuilder:printcolumn:name="Desire",type=integer,JSONPath=`.spec.pd.replicas`,description="The desired replicas number of PD cluster"
+// +kubebuilder:printcolumn:name="TiKV",type=string,JSONPath=`.status.tikv.image`,description="The image for TiKV cluster"
+// +kubebuilder:printcolumn:name="Storage",type=string,JSONPath=`.spec.tikv.requests.storage`,description="The storage size specified for TiKV node"
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.tikv.statefulSet.readyReplicas`,description="The ready replicas number of TiKV cluster"
+// +kubebuilder:printcolumn:name="Desire",type=integer,JSONPath=`.spec.tikv.replicas`,description="The desired replicas number of TiKV cluster"
+// +kubebuilder:printcolumn:name="TiDB",type=string,JSONPath=`.status.tidb.image`,description="The image for TiDB cluster"
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.tidb.statefulSet.readyReplicas`,description="The ready replicas number of TiDB cluster"
+// +kubebuilder:printcolumn:name="Desire",type=integer,JSONPath=`.spec.tidb.replicas`,description="The desired replicas number of TiDB cluster"
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].message`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type TidbCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	// Spec defines the behavior of a tidb cluster
+	Spec TidbClusterSpec `json:"spec"`
+
+	// Most recently observed status of the tidb cluster
+	Status v1alpha1.TidbClusterStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TidbClusterList is TidbCluster list
+type TidbClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TidbCluster `json:"items"`
+}
+
+// TidbClusterSpec describes the attributes that a user creates on a tidb cluster
+type TidbClusterSpec struct {
+	// Discovery spec
+	Discovery DiscoverySpec `json:"discovery,omitempty"`
+
+	// Specify a Service Account
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// PD cluster spec
+	// +optional
+	PD *PDSpec `json:"pd,omitempty"`
+
+	// PDMS are the microservices split from PD, each of them is managed by its own StatefulSet.
+	// It requires `spec.pd.mode` to be `ms`.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	PDMS []PDMSSpec `json:"pdms,omitempty"`
+
+	// TiDB cluster spec
+	// +optional
+	TiDB *TiDBSpec `json:"tidb,omitempty"`
+
+	// TiKV cluster spec
+	// +optional
+	TiKV *TiKVSpec `json:"tikv,omitempty"`
+
+	// TiKVGroups are additional groups of TiKV stores, each group is managed by its own StatefulSet
+	// so that it can have different storage, resources and store labels from `spec.tikv`
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	TiKVGroups []TiKVGroupSpec `json:"tikvGroups,omitempty"`
+
+	// TiFlash cluster spec
+	// +optional
+	TiFlash *TiFlashSpec `json:"tiflash,omitempty"`
+
+	// TiCDC cluster spec
+	// +optional
+	TiCDC *TiCDCSpec `json:"ticdc,omitempty"`
+
+	// TiProxy cluster spec
+	// +optional
+	TiProxy *TiProxySpec `json:"tiproxy,omitempty"`
+
+	// Pump cluster spec
+	// +optional
+	Pump *PumpSpec `json:"pump,omitempty"`
+
+	// Helper spec
+	// +optional
+	Helper *v1alpha1.HelperSpec `json:"helper,omitempty"`
+
+	// Indicates that the tidb cluster is paused and will not be processed by
+	// the controller.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Whether RecoveryMode is enabled for TiDB cluster to restore
+	// Optional: Defaults to false
+	// +optional
+	RecoveryMode bool `json:"recoveryMode,omitempty"`
+
+	// TiDB cluster version
+	// +optional
+	Version string `json:"version"`
+	// TODO: remove optional after defaulting logic introduced
+
+	// SchedulerName of TiDB cluster Pods
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// Persistent volume reclaim policy applied to the PVs that consumed by TiDB cluster
+	// +kubebuilder:default=Retain
+	PVReclaimPolicy *corev1.PersistentVolumeReclaimPolicy `json:"pvReclaimPolicy,omitempty"`
+
+	// ImagePullPolicy of TiDB cluster Pods
+	// +kubebuilder:default=IfNotPresent
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// ConfigUpdateStrategy determines how the configuration change is applied to the cluster.
+	// UpdateStrategyInPlace will update the ConfigMap of configuration in-place and an extra rolling-update of the
+	// cluster component is needed to reload the configuration change.
+	// UpdateStrategyRollingUpdate will create a new ConfigMap with the new configuration and rolling-update the
+	// related components to use the new ConfigMap, that is, the new configuration will be applied automatically.
+	ConfigUpdateStrategy v1alpha1.ConfigUpdateStrategy `json:"configUpdateStrategy,omitempty"`
+
+	// Whether enable PVC reclaim for orphan PVC left by statefulset scale-in
+	// Optional: Defaults to false
+	// +optional
+	EnablePVReclaim *bool `json:"enablePVReclaim,omitempty"`
+
+	// Whether enable the TLS connection between TiDB server components
+	// Optional: Defaults to nil
+	// +optional
+	TLSCluster *v1alpha1.TLSCluster `json:"tlsCluster,omitempty"`
+
+	// Whether Hostnetwork is enabled for TiDB cluster Pods
+	// Optional: Defaults to false
+	// +optional
+	HostNetwork *bool `json:"hostNetwork,omitempty"`
+
+	// Affinity of TiDB cluster Pods.
+	// Will be overwritten by each cluster component's specific affinity setting, e.g. `spec.tidb.affinity`
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// PriorityClassName of TiDB cluster Pods
+	// Optional: Defaults to omitted
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+
+	// Base node selectors of TiDB cluster Pods, components may add or override selectors upon this respectively
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Base annotations for TiDB cluster, all Pods in the cluster should have these annotations.
+	// Can be overrode by annotations in the specific component spec.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Base labels for TiDB cluster, all Pods in the cluster should have these labels.
+	// Can be overrode by labels in the specific component spec.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Base tolerations of TiDB cluster Pods, components may add more tolerations upon this respectively
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// DNSConfig Specifies the DNS parameters of a pod.
+	// +optional
+	DNSConfig *corev1.PodDNSConfig `json:"dnsConfig,omitempty"`
+
+	// DNSPolicy Specifies the DNSPolicy parameters of a pod.
+	// +optional
+	DNSPolicy corev1.DNSPolicy `json:"dnsPolicy,omitempty"`
+
+	// Time zone of TiDB cluster Pods
+	// Optional: Defaults to UTC
+	// +optional
+	Timezone string `json:"timezone,omitempty"`
+
+	// EnableDynamicConfiguration indicates whether to append `--advertise-status-addr` to the startup parameters of TiKV.
+	// +optional
+	EnableDynamicConfiguration *bool `json:"enableDynamicConfiguration,omitempty"`
+	// TODO: rename this into tikv-specific config name
+
+	// ClusterDomain is the Kubernetes Cluster Domain of TiDB cluster
+	// Optional: Defaults to ""
+	// +optional
+	ClusterDomain string `json:"clusterDomain,omitempty"`
+
+	// AcrossK8s indicates whether deploy TiDB cluster across multiple Kubernetes clusters
+	// +optional
+	AcrossK8s bool `json:"acrossK8s,omitempty"`
+
+	// Cluster is the external cluster, if configured, the components in this TidbCluster will join to this configured cluster.
+	// +optional
+	Cluster *v1alpha1.TidbClusterRef `json:"cluster,omitempty"`
+
+	// PDAddresses are the external PD addresses, if configured, the PDs in this TidbCluster will join to the configured PD cluster.
+	// +optional
+	PDAddresses []string `json:"pdAddresses,omitempty"`
+
+	// StatefulSetUpdateStrategy of TiDB cluster StatefulSets
+	// +optional
+	StatefulSetUpdateStrategy apps.StatefulSetUpdateStrategyType `json:"statefulSetUpdateStrategy,omitempty"`
+
+	// PodManagementPolicy of TiDB cluster StatefulSets
+	// +optional
+	PodManagementPolicy apps.PodManagementPolicyType `json:"podManagementPolicy,omitempty"`
+
+	// PodSecurityContext of the component
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// TopologySpreadConstraints describes how a group of pods ought to spread across topology
+	// domains. Scheduler will schedule pods in a way which abides by the constraints.
+	// This field is is only honored by clusters that enables the EvenPodsSpread feature.
+	// All topologySpreadConstraints are ANDed.
+	// +optional
+	// +listType=map
+	// +listMapKey=topologyKey
+	TopologySpreadConstraints []v1alpha1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// StartScriptVersion is the version of start script
+	//
+	// default to "v1"
+	// +optional
+	// +kubebuilder:validation:Enum:="";"v1";"v2"
+	StartScriptVersion v1alpha1.StartScriptVersion `json:"startScriptVersion,omitempty"`
+
+	// SuspendAction defines the suspend actions for all component.
+	// +optional
+	SuspendAction *v1alpha1.SuspendAction `json:"suspendAction,omitempty"`
+
+	// PreferIPv6 indicates whether to prefer IPv6 addresses for all components.
+	PreferIPv6 bool `json:"preferIPv6,omitempty"`
+
+	// ResourceGroups are the resource groups of TiDB managed by the operator, requires TiDB v7.1.0+.
+	// The operator executes `CREATE/ALTER/DROP RESOURCE GROUP` to make the resource groups match the list,
+	// resource groups not created by the operator are not touched.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ResourceGroups []v1alpha1.ResourceGroupSpec `json:"resourceGroups,omitempty"`
+
+	// SQLAccess is the account used by the operator to execute SQL in TiDB,
+	// it's required if resourceGroups is not empty.
+	// +optional
+	SQLAccess *v1alpha1.TiDBSQLAccessConfig `json:"sqlAccess,omitempty"`
+}
+
+// DiscoverySpec contains details of Discovery members
+type DiscoverySpec struct {
+	*ComponentSpec              `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Replicas is the number of discovery pods. If it is larger than 1, the pods
+	// elect a leader to serve the requests and the others stand by.
+	// Optional: Defaults to 1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// PDSpec contains details of PD members
+type PDSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Specify a Service Account for pd
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// +kubebuilder:default=pingcap/pd
+	// +optional
+	BaseImage string `json:"baseImage"`
+
+	// Service defines a Kubernetes service of PD cluster.
+	// Optional: Defaults to `.spec.services` in favor of backward compatibility
+	// +optional
+	Service *v1alpha1.ServiceSpec `json:"service,omitempty"`
+
+	// MaxFailoverCount limit the max replicas could be added in failover, 0 means no failover.
+	// Optional: Defaults to 3
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFailoverCount *int32 `json:"maxFailoverCount,omitempty"`
+
+	// The storageClassName of the persistent volume for PD data storage.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// StorageVolumes configure additional storage for PD pods.
+	// +optional
+	StorageVolumes []v1alpha1.StorageVolume `json:"storageVolumes,omitempty"`
+
+	// Subdirectory within the volume to store PD Data. By default, the data
+	// is stored in the root directory of volume which is mounted at
+	// /var/lib/pd.
+	// Specifying this will change the data directory to a subdirectory, e.g.
+	// /var/lib/pd/data if you set the value to "data".
+	// It's dangerous to change this value for a running cluster as it will
+	// upgrade your cluster to use a new storage directory.
+	// Defaults to "" (volume's root).
+	// +optional
+	DataSubDir string `json:"dataSubDir,omitempty"`
+
+	// Config is the Configuration of pd-servers
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *v1alpha1.PDConfigWraper `json:"config,omitempty"`
+
+	// TLSClientSecretName is the name of secret which stores tidb server client certificate
+	// which used by Dashboard.
+	// +optional
+	TLSClientSecretName *string `json:"tlsClientSecretName,omitempty"`
+
+	// MountClusterClientSecret indicates whether to mount `cluster-client-secret` to the Pod
+	// +optional
+	MountClusterClientSecret *bool `json:"mountClusterClientSecret,omitempty"`
+
+	// Start up script version
+	// +optional
+	// +kubebuilder:validation:Enum:="";"v1"
+	StartUpScriptVersion string `json:"startUpScriptVersion,omitempty"`
+
+	// Mode is the mode of PD cluster, the valid value is one of:
+	//
+	// - "": PD serves all the services.
+	// - "ms": PD only serves the API service and the others are served by the microservices in `spec.pdms`.
+	//
+	// Optional: Defaults to ""
+	// +optional
+	// +kubebuilder:validation:Enum:="";"ms"
+	Mode string `json:"mode,omitempty"`
+}
+
+// PDMSSpec contains details of the members of a PD microservice
+type PDMSSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Name of the microservice
+	// +kubebuilder:validation:Enum:="tso";"scheduling"
+	Name string `json:"name"`
+
+	// Specify a Service Account for the microservice
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// +kubebuilder:default=pingcap/pd
+	// +optional
+	BaseImage string `json:"baseImage"`
+
+	// Config is the Configuration of the microservice
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *v1alpha1.PDConfigWraper `json:"config,omitempty"`
+}
+
+// TiKVSpec contains details of TiKV members
+type TiKVSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Specify a Service Account for tikv
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// +kubebuilder:default=pingcap/tikv
+	// +optional
+	BaseImage string `json:"baseImage"`
+
+	// Whether create the TiKV container in privileged mode, it is highly discouraged to enable this in
+	// critical environment.
+	// Optional: defaults to false
+	// +optional
+	Privileged *bool `json:"privileged,omitempty"`
+
+	// MaxFailoverCount limit the max replicas could be added in failover, 0 means no failover
+	// Optional: Defaults to 3
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFailoverCount *int32 `json:"maxFailoverCount,omitempty"`
+
+	// Whether output the RocksDB log in a separate sidecar container
+	// Optional: Defaults to false
+	// +optional
+	SeparateRocksDBLog *bool `json:"separateRocksDBLog,omitempty"`
+
+	// Whether output the Raft log in a separate sidecar container
+	// Optional: Defaults to false
+	// +optional
+	SeparateRaftLog *bool `json:"separateRaftLog,omitempty"`
+
+	// Optional volume name configuration for rocksdb log.
+	// +optional
+	RocksDBLogVolumeName string `json:"rocksDBLogVolumeName,omitempty"`
+
+	// Optional volume name configuration for raft log.
+	// +optional
+	RaftLogVolumeName string `json:"raftLogVolumeName,omitempty"`
+
+	// LogTailer is the configurations of the log tailers for TiKV
+	// +optional
+	LogTailer *v1alpha1.LogTailerSpec `json:"logTailer,omitempty"`
+
+	// The storageClassName of the persistent volume for TiKV data storage.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Subdirectory within the volume to store TiKV Data. By default, the data
+	// is stored in the root directory of volume which is mounted at
+	// /var/lib/tikv.
+	// Specifying this will change the data directory to a subdirectory, e.g.
+	// /var/lib/tikv/data if you set the value to "data".
+	// It's dangerous to change this value for a running cluster as it will
+	// upgrade your cluster to use a new storage directory.
+	// Defaults to "" (volume's root).
+	// +optional
+	DataSubDir string `json:"dataSubDir,omitempty"`
+
+	// Config is the Configuration of tikv-servers
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *v1alpha1.TiKVConfigWraper `json:"config,omitempty"`
+
+	// RecoverFailover indicates that Operator can recover the failed Pods
+	// +optional
+	RecoverFailover bool `json:"recoverFailover,omitempty"`
+
+	// Failover is the configurations of failover
+	// +optional
+	Failover *v1alpha1.Failover `json:"failover,omitempty"`
+
+	// MountClusterClientSecret indicates whether to mount `cluster-client-secret` to the Pod
+	// +optional
+	MountClusterClientSecret *bool `json:"mountClusterClientSecret,omitempty"`
+
+	// EvictLeaderTimeout indicates the timeout to evict tikv leader, in the format of Go Duration.
+	// Defaults to 1500min
+	// +optional
+	EvictLeaderTimeout *string `json:"evictLeaderTimeout,omitempty"`
+
+	// WaitLeaderTransferBackTimeout indicates the timeout to wait for leader transfer back before
+	// the next tikv upgrade.
+	//
+	// Defaults to 400s
+	// +optional
+	WaitLeaderTransferBackTimeout *metav1.Duration `json:"waitLeaderTransferBackTimeout,omitempty"`
+
+	// StorageVolumes configure additional storage for TiKV pods.
+	// +optional
+	StorageVolumes []v1alpha1.StorageVolume `json:"storageVolumes,omitempty"`
+
+	// StoreLabels configures additional labels for TiKV stores.
+	// +optional
+	StoreLabels []string `json:"storeLabels,omitempty"`
+
+	// EnableNamedStatusPort enables status port(20180) in the Pod spec.
+	// If you set it to `true` for an existing cluster, the TiKV cluster will be rolling updated.
+	EnableNamedStatusPort bool `json:"enableNamedStatusPort,omitempty"`
+
+	// ScalePolicy is the scale configuration for TiKV
+	// +optional
+	ScalePolicy v1alpha1.ScalePolicy `json:"scalePolicy,omitempty"`
+
+	// VolumeReplace enables migrating volumes by replacing stores one by one if the change of
+	// `storageClassName`, `requests.storage` or `storageVolumes` can't be applied in place,
+	// e.g. shrinking the size or changing to a storage class of another provisioner.
+	// +optional
+	VolumeReplace *v1alpha1.VolumeReplaceSpec `json:"volumeReplace,omitempty"`
+}
+
+// TiKVGroupSpec contains details of a group of TiKV members
+type TiKVGroupSpec struct {
+	// Name of the group, the StatefulSet of the group is named `${cluster}-tikv-${name}`.
+	// If TLS is enabled, the certificate of TiKV should also be valid for the peer service of the group,
+	// that is `*.${cluster}-tikv-${name}-peer`.
+	// +kubebuilder:validation:Pattern=`^[a-z]([-a-z0-9]*[a-z0-9])?$`
+	Name string `json:"name"`
+
+	TiKVSpec `json:",inline"`
+}
+
+// TiFlashSpec contains details of TiFlash members
+type TiFlashSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Specify a Service Account for TiFlash
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// +kubebuilder:default=pingcap/tiflash
+	// +optional
+	BaseImage string `json:"baseImage"`
+
+	// Whether create the TiFlash container in privileged mode, it is highly discouraged to enable this in
+	// critical environment.
+	// Optional: defaults to false
+	// +optional
+	Privileged *bool `json:"privileged,omitempty"`
+
+	// MaxFailoverCount limit the max replicas could be added in failover, 0 means no failover
+	// Optional: Defaults to 3
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFailoverCount *int32 `json:"maxFailoverCount,omitempty"`
+
+	// The persistent volume claims of the TiFlash data storages.
+	// TiFlash supports multiple disks.
+	StorageClaims []v1alpha1.StorageClaim `json:"storageClaims"`
+
+	// Config is the Configuration of TiFlash
+	// +optional
+	Config *v1alpha1.TiFlashConfigWraper `json:"config,omitempty"`
+
+	// Initializer is the configurations of the init container for TiFlash
+	//
+	// +optional
+	Initializer *v1alpha1.InitContainerSpec `json:"initializer,omitempty"`
+
+	// LogTailer is the configurations of the log tailers for TiFlash
+	// +optional
+	LogTailer *v1alpha1.LogTailerSpec `json:"logTailer,omitempty"`
+
+	// RecoverFailover indicates that Operator can recover the failover Pods
+	// +optional
+	RecoverFailover bool `json:"recoverFailover,omitempty"`
+
+	// Failover is the configurations of failover
+	// +optional
+	Failover *v1alpha1.Failover `json:"failover,omitempty"`
+
+	// ScalePolicy is the scale configuration for TiFlash
+	// +optional
+	ScalePolicy v1alpha1.ScalePolicy `json:"scalePolicy,omitempty"`
+
+	// Disaggregated enables the disaggregated storage and compute architecture of TiFlash, which requires v7.0.0+.
+	// If it's set, the TiFlash nodes above work as write nodes and upload data to the shared storage,
+	// and the compute nodes are managed by the StatefulSet `${cluster}-tiflash-compute`.
+	// +optional
+	Disaggregated *TiFlashDisaggregatedSpec `json:"disaggregated,omitempty"`
+}
+
+// TiFlashDisaggregatedSpec contains details of TiFlash in the disaggregated storage and compute architecture
+type TiFlashDisaggregatedSpec struct {
+	// StorageProvider is the shared storage of write nodes and compute nodes, only S3 is supported now.
+	// If `secretName` is set, the secret should contain `access_key` and `secret_key`.
+	v1alpha1.StorageProvider `json:",inline"`
+
+	// Compute is the spec of TiFlash compute nodes, which are stateless and read data from the shared storage
+	Compute TiFlashComputeSpec `json:"compute"`
+}
+
+// TiFlashComputeSpec contains details of TiFlash compute nodes
+type TiFlashComputeSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// Optional: Defaults to `spec.tiflash.baseImage`
+	// +optional
+	BaseImage string `json:"baseImage,omitempty"`
+
+	// The persistent volume claims of the local cache of data in the shared storage.
+	// Only the first claim is used, and an emptyDir volume without cache is used if it's empty.
+	// +optional
+	StorageClaims []v1alpha1.StorageClaim `json:"storageClaims,omitempty"`
+
+	// Config is the Configuration of TiFlash compute nodes
+	// +optional
+	Config *v1alpha1.TiFlashConfigWraper `json:"config,omitempty"`
+}
+
+// TiCDCSpec contains details of TiCDC members
+type TiCDCSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Specify a Service Account for TiCDC
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// TLSClientSecretNames are the names of secrets that store the
+	// client certificates for the downstream.
+	// +optional
+	TLSClientSecretNames []string `json:"tlsClientSecretNames,omitempty"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// +kubebuilder:default=pingcap/ticdc
+	// +optional
+	BaseImage string `json:"baseImage"`
+
+	// Config is the Configuration of tidbcdc servers
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *v1alpha1.CDCConfigWraper `json:"config,omitempty"`
+
+	// StorageVolumes configure additional storage for TiCDC pods.
+	// +optional
+	StorageVolumes []v1alpha1.StorageVolume `json:"storageVolumes,omitempty"`
+
+	// The storageClassName of the persistent volume for TiCDC data storage.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// GracefulShutdownTimeout is the timeout of gracefully shutdown a TiCDC pod.
+	// Encoded in the format of Go Duration.
+	// Defaults to 10m
+	// +optional
+	GracefulShutdownTimeout *metav1.Duration `json:"gracefulShutdownTimeout,omitempty"`
+}
+
+// TiProxySpec contains details of TiProxy members
+type TiProxySpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Specify a Service Account for TiProxy
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// TLSClientSecretName is the name of secret which stores tidb server client certificate
+	// used by TiProxy to check health status.
+	// +optional
+	TLSClientSecretName *string `json:"tlsClientSecretName,omitempty"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// +kubebuilder:default=pingcap/tiproxy
+	// +optional
+	BaseImage string `json:"baseImage"`
+
+	// Config is the Configuration of tiproxy-servers
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *v1alpha1.TiProxyConfigWraper `json:"config,omitempty"`
+
+	// StorageVolumes configure additional storage for TiProxy pods.
+	// +optional
+	StorageVolumes []v1alpha1.StorageVolume `json:"storageVolumes,omitempty"`
+
+	// The storageClassName of the persistent volume for TiProxy data storage.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// TiDBSpec contains details of TiDB members
+type TiDBSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Specify a Service Account for tidb
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// +kubebuilder:default=pingcap/tidb
+	// +optional
+	BaseImage string `json:"baseImage"`
+
+	// Service defines a Kubernetes service of TiDB cluster.
+	// Optional: No kubernetes service will be created by default.
+	// +optional
+	Service *v1alpha1.TiDBServiceSpec `json:"service,omitempty"`
+
+	// Whether enable TiDB Binlog, it is encouraged to not set this field and rely on the default behavior
+	// Optional: Defaults to true if PumpSpec is non-nil, otherwise false
+	// +optional
+	BinlogEnabled *bool `json:"binlogEnabled,omitempty"`
+
+	// MaxFailoverCount limit the max replicas could be added in failover, 0 means no failover
+	// Optional: Defaults to 3
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFailoverCount *int32 `json:"maxFailoverCount,omitempty"`
+
+	// Whether output the slow log in an separate sidecar container
+	// Optional: Defaults to true
+	// +optional
+	SeparateSlowLog *bool `json:"separateSlowLog,omitempty"`
+
+	// Optional volume name configuration for slow query log.
+	// +optional
+	SlowLogVolumeName string `json:"slowLogVolumeName,omitempty"`
+
+	// The specification of the slow log tailer sidecar
+	// +optional
+	SlowLogTailer *TiDBSlowLogTailerSpec `json:"slowLogTailer,omitempty"`
+
+	// Whether enable the TLS connection between the SQL client and TiDB server
+	// Optional: Defaults to nil
+	// +optional
+	TLSClient *v1alpha1.TiDBTLSClient `json:"tlsClient,omitempty"`
+
+	// Whether enable `tidb_auth_token` authentication method. The tidb_auth_token authentication method is used only for the internal operation of TiDB Cloud.
+	// Optional: Defaults to false
+	// +optional
+	TokenBasedAuthEnabled *bool `json:"tokenBasedAuthEnabled,omitempty"`
+
+	// Plugins is a list of plugins that are loaded by TiDB server, empty means plugin disabled
+	// +optional
+	Plugins []string `json:"plugins,omitempty"`
+	// TODO: additional volumes should be used to hold .so plugin binaries.
+	// Because this is not a complete implementation, maybe we can change this without backward compatibility.
+
+	// Config is the Configuration of tidb-servers
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *v1alpha1.TiDBConfigWraper `json:"config,omitempty"`
+
+	// Lifecycle describes actions that the management system should take in response to container lifecycle
+	// events. For the PostStart and PreStop lifecycle handlers, management of the container blocks
+	// until the action is complete, unless the container process fails, in which case the handler is aborted.
+	// +optional
+	Lifecycle *corev1.Lifecycle `json:"lifecycle,omitempty"`
+
+	// StorageVolumes configure additional storage for TiDB pods.
+	// +optional
+	StorageVolumes []v1alpha1.StorageVolume `json:"storageVolumes,omitempty"`
+
+	// The storageClassName of the persistent volume for TiDB data storage.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Initializer is the init configurations of TiDB
+	//
+	// +optional
+	Initializer *v1alpha1.TiDBInitializer `json:"initializer,omitempty"`
+
+	// BootstrapSQLConfigMapName is the name of the ConfigMap which contains the bootstrap SQL file with the key `bootstrap-sql`,
+	// which will only be executed when a TiDB cluster bootstrap on the first time.
+	// The field should be set ONLY when create a TC, since it only take effect on the first time bootstrap.
+	// Only v6.6.0+ supports this feature.
+	// +optional
+	BootstrapSQLConfigMapName *string `json:"bootstrapSQLConfigMapName,omitempty"`
+}
+
+// PumpSpec contains details of Pump members
+type PumpSpec struct {
+	ComponentSpec               `json:",inline"`
+	corev1.ResourceRequirements `json:",inline"`
+
+	// Specify a Service Account for pump
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+
+	// The desired ready replicas
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas"`
+
+	// Base image of the component, image tag is now allowed during validation
+	// +kubebuilder:default=pingcap/tidb-binlog
+	// +optional
+	BaseImage string `json:"baseImage"`
+
+	// The storageClassName of the persistent volume for Pump data storage.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// The configuration of Pump cluster.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:XPreserveUnknownFields
+	Config *config.GenericConfig `json:"config,omitempty"`
+
+	// For backward compatibility with helm chart
+	SetTimeZone *bool `json:"setTimeZone,omitempty"`
+}
+
+// TiDBSlowLogTailerSpec represents an optional log tailer sidecar with TiDB
+type TiDBSlowLogTailerSpec struct {
+	corev1.ResourceRequirements `json:",inline"`
+}
+
+// ComponentSpec is the base spec of each component, the fields should always accessed by the Basic<Component>Spec() method to respect the cluster-level properties
+type ComponentSpec struct {
+
+	// Version of the component. Override the cluster-level version if non-empty
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// ImagePullPolicy of the component. Override the cluster-level imagePullPolicy if present
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	ImagePullPolicy *corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// Whether Hostnetwork of the component is enabled. Override the cluster-level setting if present
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	HostNetwork *bool `json:"hostNetwork,omitempty"`
+
+	// Affinity of the component. Override the cluster-level setting if present.
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// PriorityClassName of the component. Override the cluster-level one if present
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	PriorityClassName *string `json:"priorityClassName,omitempty"`
+
+	// SchedulerName of the component. Override the cluster-level one if present
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	SchedulerName *string `json:"schedulerName,omitempty"`
+
+	// NodeSelector of the component. Merged into the cluster-level nodeSelector if non-empty
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Annotations for the component. Merge into the cluster-level annotations if non-empty
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Labels for the component. Merge into the cluster-level labels if non-empty
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Tolerations of the component. Override the cluster-level tolerations if non-empty
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// PodSecurityContext of the component
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// ConfigUpdateStrategy of the component. Override the cluster-level updateStrategy if present
+	// Optional: Defaults to cluster-level setting
+	// +optional
+	ConfigUpdateStrategy *v1alpha1.ConfigUpdateStrategy `json:"configUpdateStrategy,omitempty"`
+
+	// List of environment variables to set in the container, like v1.Container.Env.
+	// Note that the following env names cannot be used and will be overridden by TiDB Operator builtin envs
+	// - NAMESPACE
+	// - TZ
+	// - SERVICE_NAME
+	// - PEER_SERVICE_NAME
+	// - HEADLESS_SERVICE_NAME
+	// - SET_NAME
+	// - HOSTNAME
+	// - CLUSTER_NAME
+	// - POD_NAME
+	// - BINLOG_ENABLED
+	// - SLOW_LOG_FILE
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// Extend the use scenarios for env
+	// +optional
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+
+	// Init containers of the components
+	// +optional
+	InitContainers []corev1.Container `json:"initContainers,omitempty"`
+
+	// Additional containers of the component.
+	// If the container names in this field match with the ones generated by
+	// TiDB Operator, the container configurations will be merged into the
+	// containers generated by TiDB Operator via strategic merge patch.
+	// If the container names in this field do not match with the ones
+	// generated by TiDB Operator, the container configurations will be
+	// appended to the Pod container spec directly.
+	// +optional
+	AdditionalContainers []corev1.Container `json:"additionalContainers,omitempty"`
+
+	// Additional volumes of component pod.
+	// +optional
+	AdditionalVolumes []corev1.Volume `json:"additionalVolumes,omitempty"`
+
+	// Additional volume mounts of component pod.
+	AdditionalVolumeMounts []corev1.VolumeMount `json:"additionalVolumeMounts,omitempty"`
+
+	// DNSConfig Specifies the DNS parameters of a pod.
+	// +optional
+	DNSConfig *corev1.PodDNSConfig `json:"dnsConfig,omitempty"`
+
+	// DNSPolicy Specifies the DNSPolicy parameters of a pod.
+	// +optional
+	DNSPolicy corev1.DNSPolicy `json:"dnsPolicy,omitempty"`
+
+	// Optional duration in seconds the pod needs to terminate gracefully. May be decreased in delete request.
+	// Value must be non-negative integer. The value zero indicates delete immediately.
+	// If this value is nil, the default grace period will be used instead.
+	// The grace period is the duration in seconds after the processes running in the pod are sent
+	// a termination signal and the time when the processes are forcibly halted with a kill signal.
+	// Set this value longer than the expected cleanup time for your process.
+	// Defaults to 30 seconds.
+	// +optional
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// StatefulSetUpdateStrategy indicates the StatefulSetUpdateStrategy that will be
+	// employed to update Pods in the StatefulSet when a revision is made to
+	// Template.
+	// +optional
+	StatefulSetUpdateStrategy apps.StatefulSetUpdateStrategyType `json:"statefulSetUpdateStrategy,omitempty"`
+
+	// PodManagementPolicy of TiDB cluster StatefulSets
+	// +optional
+	PodManagementPolicy apps.PodManagementPolicyType `json:"podManagementPolicy,omitempty"`
+
+	// TopologySpreadConstraints describes how a group of pods ought to spread across topology
+	// domains. Scheduler will schedule pods in a way which abides by the constraints.
+	// This field is is only honored by clusters that enables the EvenPodsSpread feature.
+	// All topologySpreadConstraints are ANDed.
+	// +optional
+	// +listType=map
+	// +listMapKey=topologyKey
+	TopologySpreadConstraints []v1alpha1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// SuspendAction defines the suspend actions for all component.
+	// +optional
+	SuspendAction *v1alpha1.SuspendAction `json:"suspendAction,omitempty"`
+
+	// ReadinessProbe describes actions that probe the pd's readiness.
+	// the default behavior is like setting type as "tcp"
+	// +optional
+	ReadinessProbe *v1alpha1.Probe `json:"readinessProbe,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Backup is a backup of tidb cluster.
+//
+// +kubebuilder:resource:shortName="bk"
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.backupType`,description="the type of backup, such as full, db, table. Only used when Mode = snapshot."
+// +kubebuilder:printcolumn:name="Mode",type=string,JSONPath=`.spec.backupMode`,description="the mode of backup, such as snapshot, log."
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`,description="The current status of the backup"
+// +kubebuilder:printcolumn:name="BackupPath",type=string,JSONPath=`.status.backupPath`,description="The full path of backup data"
+// +kubebuilder:printcolumn:name="BackupSize",type=string,JSONPath=`.status.backupSizeReadable`,description="The data size of the backup"
+// +kubebuilder:printcolumn:name="CommitTS",type=string,JSONPath=`.status.commitTs`,description="The commit ts of the backup"
+// +kubebuilder:printcolumn:name="LogTruncateUntil",type=string,JSONPath=`.status.logSuccessTruncateUntil`,description="The log backup truncate until ts"
+// +kubebuilder:printcolumn:name="Started",type=date,JSONPath=`.status.timeStarted`,description="The time at which the backup was started",priority=1
+// +kubebuilder:printcolumn:name="Completed",type=date,JSONPath=`.status.timeCompleted`,description="The time at which the backup was completed",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type Backup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   BackupSpec            `json:"spec"`
+	Status v1alpha1.BackupStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupList contains a list of Backup.
+type BackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Backup `json:"items"`
+}
+
+// BackupSpec contains the backup specification for a tidb cluster.
+type BackupSpec struct {
+	corev1.ResourceRequirements `json:"resources,omitempty"`
+	// List of environment variables to set in the container, like v1.Container.Env.
+	// Note that the following builtin env vars will be overwritten by values set here
+	// - S3_PROVIDER
+	// - S3_ENDPOINT
+	// - AWS_REGION
+	// - AWS_ACL
+	// - AWS_STORAGE_CLASS
+	// - AWS_DEFAULT_REGION
+	// - AWS_ACCESS_KEY_ID
+	// - AWS_SECRET_ACCESS_KEY
+	// - GCS_PROJECT_ID
+	// - GCS_OBJECT_ACL
+	// - GCS_BUCKET_ACL
+	// - GCS_LOCATION
+	// - GCS_STORAGE_CLASS
+	// - GCS_SERVICE_ACCOUNT_JSON_KEY
+	// - BR_LOG_TO_TERM
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// From is the tidb cluster that needs to backup.
+	From *v1alpha1.TiDBAccessConfig `json:"from,omitempty"`
+	// Type is the backup type for tidb cluster and only used when Mode = snapshot, such as full, db, table.
+	Type v1alpha1.BackupType `json:"backupType,omitempty"`
+	// Mode is the backup mode, such as snapshot backup or log backup.
+	// +kubebuilder:default=snapshot
+	Mode v1alpha1.BackupMode `json:"backupMode,omitempty"`
+	// TikvGCLifeTime is to specify the safe gc life time for backup.
+	// The time limit during which data is retained for each GC, in the format of Go Duration.
+	// When a GC happens, the current time minus this value is the safe point.
+	TikvGCLifeTime *string `json:"tikvGCLifeTime,omitempty"`
+	// StorageProvider configures where and how backups should be stored.
+	v1alpha1.StorageProvider `json:",inline"`
+	// The storageClassName of the persistent volume for Backup data storage.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// StorageSize is the request storage size for backup job
+	StorageSize string `json:"storageSize,omitempty"`
+	// BRConfig is the configs for BR
+	BR *BRConfig `json:"br,omitempty"`
+	// CommitTs is the commit ts of the backup, snapshot ts for full backup or start ts for log backup.
+	// Format supports TSO or datetime, e.g. '400036290571534337', '2018-05-11 01:42:23'.
+	// Default is current timestamp.
+	// +optional
+	CommitTs string `json:"commitTs,omitempty"`
+	// LogTruncateUntil is log backup truncate until timestamp.
+	// Format supports TSO or datetime, e.g. '400036290571534337', '2018-05-11 01:42:23'.
+	// +optional
+	LogTruncateUntil string `json:"logTruncateUntil,omitempty"`
+	// LogStop indicates that will stop the log backup.
+	// +optional
+	LogStop bool `json:"logStop,omitempty"`
+	// DumplingConfig is the configs for dumpling
+	Dumpling *DumplingConfig `json:"dumpling,omitempty"`
+	// Base tolerations of backup Pods, components may add more tolerations upon this respectively
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// ToolImage specifies the tool image used in `Backup`, which supports BR and Dumpling images.
+	// For examples `spec.toolImage: pingcap/br:v4.0.8` or `spec.toolImage: pingcap/dumpling:v4.0.8`
+	// For BR image, if it does not contain tag, Pod will use image 'ToolImage:${TiKV_Version}'.
+	// +optional
+	ToolImage string `json:"toolImage,omitempty"`
+	// ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// TableFilter means Table filter expression for 'db.table' matching. BR supports this from v4.0.3.
+	TableFilter []string `json:"tableFilter,omitempty"`
+	// Affinity of backup Pods
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// Use KMS to decrypt the secrets
+	UseKMS bool `json:"useKMS,omitempty"`
+	// Specify service account of backup
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// CleanPolicy denotes whether to clean backup data when the object is deleted from the cluster, if not set, the backup data will be retained
+	CleanPolicy v1alpha1.CleanPolicyType `json:"cleanPolicy,omitempty"`
+	// CleanOption controls the behavior of clean.
+	CleanOption *v1alpha1.CleanOption `json:"cleanOption,omitempty"`
+
+	// PodSecurityContext of the component
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// PriorityClassName of Backup Job Pods
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// BackoffRetryPolicy the backoff retry policy, currently only valid for snapshot backup
+	BackoffRetryPolicy v1alpha1.BackoffRetryPolicy `json:"backoffRetryPolicy,omitempty"`
+}
+
+// DumplingConfig contains config for dumpling
+type DumplingConfig struct {
+	// Options means options for backup data to remote storage with dumpling.
+	Options []string `json:"options,omitempty"`
+}
+
+// BRConfig contains config for BR
+type BRConfig struct {
+	// ClusterName of backup/restore cluster
+	Cluster string `json:"cluster"`
+	// Namespace of backup/restore cluster
+	ClusterNamespace string `json:"clusterNamespace,omitempty"`
+	// LogLevel is the log level
+	LogLevel string `json:"logLevel,omitempty"`
+	// StatusAddr is the HTTP listening address for the status report service. Set to empty string to disable
+	StatusAddr string `json:"statusAddr,omitempty"`
+	// Concurrency is the size of thread pool on each node that execute the backup task
+	Concurrency *uint32 `json:"concurrency,omitempty"`
+	// RateLimit is the rate limit of the backup task, MB/s per node
+	RateLimit *uint `json:"rateLimit,omitempty"`
+	// TimeAgo is the history version of the backup task, e.g. 1m, 1h
+	TimeAgo string `json:"timeAgo,omitempty"`
+	// Checksum specifies whether to run checksum after backup
+	Checksum *bool `json:"checksum,omitempty"`
+	// CheckRequirements specifies whether to check requirements
+	CheckRequirements *bool `json:"checkRequirements,omitempty"`
+	// SendCredToTikv specifies whether to send credentials to TiKV
+	SendCredToTikv *bool `json:"sendCredToTikv,omitempty"`
+	// OnLine specifies whether online during restore
+	OnLine *bool `json:"onLine,omitempty"`
+	// Options means options for backup data to remote storage with BR. These options has highest priority.
+	Options []string `json:"options,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupSchedule is a backup schedule of tidb cluster.
+//
+// +kubebuilder:resource:shortName="bks"
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`,description="The cron format string used for backup scheduling"
+// +kubebuilder:printcolumn:name="MaxBackups",type=integer,JSONPath=`.spec.maxBackups`,description="The max number of backups we want to keep"
+// +kubebuilder:printcolumn:name="LastBackup",type=string,JSONPath=`.status.lastBackup`,description="The last backup CR name",priority=1
+// +kubebuilder:printcolumn:name="LastBackupTime",type=date,JSONPath=`.status.lastBackupTime`,description="The last time the backup was successfully created",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type BackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   BackupScheduleSpec            `json:"spec"`
+	Status v1alpha1.BackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BackupScheduleList contains a list of BackupSchedule.
+type BackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []BackupSchedule `json:"items"`
+}
+
+// BackupScheduleSpec contains the backup schedule specification for a tidb cluster.
+type BackupScheduleSpec struct {
+	// Schedule specifies the cron string used for backup scheduling.
+	Schedule string `json:"schedule"`
+	// Pause means paused backupSchedule
+	Pause bool `json:"pause,omitempty"`
+	// MaxBackups is to specify how many backups we want to keep
+	// 0 is magic number to indicate un-limited backups.
+	// if MaxBackups and MaxReservedTime are set at the same time, MaxReservedTime is preferred
+	// and MaxBackups is ignored.
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+	// MaxReservedTime is to specify how long backups we want to keep.
+	MaxReservedTime *string `json:"maxReservedTime,omitempty"`
+	// BackupTemplate is the specification of the backup structure to get scheduled.
+	BackupTemplate BackupSpec `json:"backupTemplate"`
+	// LogBackupTemplate is the specification of the log backup structure to get scheduled.
+	LogBackupTemplate *BackupSpec `json:"logBackupTemplate"`
+	// The storageClassName of the persistent volume for Backup data storage if not storage class name set in BackupSpec.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// StorageSize is the request storage size for backup job
+	StorageSize string `json:"storageSize,omitempty"`
+	// ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Restore represents the restoration of backup of a tidb cluster.
+//
+// +kubebuilder:resource:shortName="rt"
+// +kubebuilder:unservedversion
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.status.phase`,description="The current status of the restore"
+// +kubebuilder:printcolumn:name="Started",type=date,JSONPath=`.status.timeStarted`,description="The time at which the restore was started",priority=1
+// +kubebuilder:printcolumn:name="Completed",type=date,JSONPath=`.status.timeCompleted`,description="The time at which the restore was completed",priority=1
+// +kubebuilder:printcolumn:name="CommitTS",type=string,JSONPath=`.status.commitTs`,description="The commit ts of tidb cluster restore"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+type Restore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+
+	Spec   RestoreSpec            `json:"spec"`
+	Status v1alpha1.RestoreStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RestoreList contains a list of Restore.
+type RestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Restore `json:"items"`
+}
+
+// RestoreSpec contains the specification for a restore of a tidb cluster backup.
+type RestoreSpec struct {
+	corev1.ResourceRequirements `json:"resources,omitempty"`
+	// List of environment variables to set in the container, like v1.Container.Env.
+	// Note that the following builtin env vars will be overwritten by values set here
+	// - S3_PROVIDER
+	// - S3_ENDPOINT
+	// - AWS_REGION
+	// - AWS_ACL
+	// - AWS_STORAGE_CLASS
+	// - AWS_DEFAULT_REGION
+	// - AWS_ACCESS_KEY_ID
+	// - AWS_SECRET_ACCESS_KEY
+	// - GCS_PROJECT_ID
+	// - GCS_OBJECT_ACL
+	// - GCS_BUCKET_ACL
+	// - GCS_LOCATION
+	// - GCS_STORAGE_CLASS
+	// - GCS_SERVICE_ACCOUNT_JSON_KEY
+	// - BR_LOG_TO_TERM
+	// +optional
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// To is the tidb cluster that needs to restore.
+	To *v1alpha1.TiDBAccessConfig `json:"to,omitempty"`
+	// Type is the backup type for tidb cluster and only used when Mode = snapshot, such as full, db, table.
+	Type v1alpha1.BackupType `json:"backupType,omitempty"`
+	// Mode is the restore mode. such as snapshot or pitr.
+	// +kubebuilder:default=snapshot
+	Mode v1alpha1.RestoreMode `json:"restoreMode,omitempty"`
+	// PitrRestoredTs is the pitr restored ts.
+	PitrRestoredTs string `json:"pitrRestoredTs,omitempty"`
+	// LogRestoreStartTs is the start timestamp which log restore from and it will be used in the future.
+	LogRestoreStartTs string `json:"logRestoreStartTs,omitempty"`
+	// TikvGCLifeTime is to specify the safe gc life time for restore.
+	// The time limit during which data is retained for each GC, in the format of Go Duration.
+	// When a GC happens, the current time minus this value is the safe point.
+	TikvGCLifeTime *string `json:"tikvGCLifeTime,omitempty"`
+	// StorageProvider configures where and how backups should be stored.
+	v1alpha1.StorageProvider `json:",inline"`
+	// PitrFullBackupStorageProvider configures where and how pitr dependent full backup should be stored.
+	PitrFullBackupStorageProvider v1alpha1.StorageProvider `json:"pitrFullBackupStorageProvider,omitempty"`
+	// The storageClassName of the persistent volume for Restore data storage.
+	// Defaults to Kubernetes default storage class.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// StorageSize is the request storage size for backup job
+	StorageSize string `json:"storageSize,omitempty"`
+	// BR is the configs for BR.
+	BR *BRConfig `json:"br,omitempty"`
+	// Base tolerations of restore Pods, components may add more tolerations upon this respectively
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity of restore Pods
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// Use KMS to decrypt the secrets
+	UseKMS bool `json:"useKMS,omitempty"`
+	// Specify service account of restore
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// ToolImage specifies the tool image used in `Restore`, which supports BR and TiDB Lightning images.
+	// For examples `spec.toolImage: pingcap/br:v4.0.8` or `spec.toolImage: pingcap/tidb-lightning:v4.0.8`
+	// For BR image, if it does not contain tag, Pod will use image 'ToolImage:${TiKV_Version}'.
+	// +optional
+	ToolImage string `json:"toolImage,omitempty"`
+	// ImagePullSecrets is an optional list of references to secrets in the same namespace to use for pulling any of the images.
+	// +optional
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// TableFilter means Table filter expression for 'db.table' matching. BR supports this from v4.0.3.
+	TableFilter []string `json:"tableFilter,omitempty"`
+
+	// PodSecurityContext of the component
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// PriorityClassName of Restore Job Pods
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+}
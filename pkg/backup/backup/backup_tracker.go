@@ -24,6 +24,7 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -132,6 +133,7 @@ func (bt *backupTracker) removeLogBackup(ns, name string) {
 	bt.operateLock.Lock()
 	defer bt.operateLock.Unlock()
 	delete(bt.logBackups, genLogBackupKey(ns, name))
+	metrics.LogBackupCheckpointLag.DeleteLabelValues(ns, name)
 }
 
 // getLogBackupTC gets log backup's tidb cluster info.
@@ -217,7 +219,11 @@ func (bt *backupTracker) doRefreshLogBackupCheckpointTs(backup *v1alpha1.Backup,
 		klog.Errorf("log backup %s/%s checkpointTS not found", ns, name)
 		return
 	}
-	ckTS := strconv.FormatUint(binary.BigEndian.Uint64(kvs[0].Value), 10)
+	tso := binary.BigEndian.Uint64(kvs[0].Value)
+	ckTS := strconv.FormatUint(tso, 10)
+	// the physical part of a TSO is the unix time in milliseconds
+	lag := time.Since(time.UnixMilli(int64(tso >> 18)))
+	metrics.LogBackupCheckpointLag.WithLabelValues(ns, name).Set(lag.Seconds())
 
 	klog.Infof("update log backup %s/%s checkpointTS %s", ns, name, ckTS)
 	updateStatus := &controller.BackupUpdateStatus{
@@ -45,6 +45,14 @@ func (c *FakePingcapV1alpha1) Restores(namespace string) v1alpha1.RestoreInterfa
 	return &FakeRestores{c, namespace}
 }
 
+func (c *FakePingcapV1alpha1) TiDBGrants(namespace string) v1alpha1.TiDBGrantInterface {
+	return &FakeTiDBGrants{c, namespace}
+}
+
+func (c *FakePingcapV1alpha1) TiDBUsers(namespace string) v1alpha1.TiDBUserInterface {
+	return &FakeTiDBUsers{c, namespace}
+}
+
 func (c *FakePingcapV1alpha1) TidbClusters(namespace string) v1alpha1.TidbClusterInterface {
 	return &FakeTidbClusters{c, namespace}
 }
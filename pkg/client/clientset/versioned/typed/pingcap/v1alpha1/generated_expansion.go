@@ -25,6 +25,10 @@ type DataResourceExpansion interface{}
 
 type RestoreExpansion interface{}
 
+type TiDBGrantExpansion interface{}
+
+type TiDBUserExpansion interface{}
+
 type TidbClusterExpansion interface{}
 
 type TidbClusterAutoScalerExpansion interface{}
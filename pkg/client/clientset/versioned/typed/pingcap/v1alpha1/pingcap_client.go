@@ -28,6 +28,8 @@ type PingcapV1alpha1Interface interface {
 	DMClustersGetter
 	DataResourcesGetter
 	RestoresGetter
+	TiDBGrantsGetter
+	TiDBUsersGetter
 	TidbClustersGetter
 	TidbClusterAutoScalersGetter
 	TidbDashboardsGetter
@@ -61,6 +63,14 @@ func (c *PingcapV1alpha1Client) Restores(namespace string) RestoreInterface {
 	return newRestores(c, namespace)
 }
 
+func (c *PingcapV1alpha1Client) TiDBGrants(namespace string) TiDBGrantInterface {
+	return newTiDBGrants(c, namespace)
+}
+
+func (c *PingcapV1alpha1Client) TiDBUsers(namespace string) TiDBUserInterface {
+	return newTiDBUsers(c, namespace)
+}
+
 func (c *PingcapV1alpha1Client) TidbClusters(namespace string) TidbClusterInterface {
 	return newTidbClusters(c, namespace)
 }
@@ -31,6 +31,10 @@ type Interface interface {
 	DataResources() DataResourceInformer
 	// Restores returns a RestoreInformer.
 	Restores() RestoreInformer
+	// TiDBGrants returns a TiDBGrantInformer.
+	TiDBGrants() TiDBGrantInformer
+	// TiDBUsers returns a TiDBUserInformer.
+	TiDBUsers() TiDBUserInformer
 	// TidbClusters returns a TidbClusterInformer.
 	TidbClusters() TidbClusterInformer
 	// TidbClusterAutoScalers returns a TidbClusterAutoScalerInformer.
@@ -81,6 +85,16 @@ func (v *version) Restores() RestoreInformer {
 	return &restoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TiDBGrants returns a TiDBGrantInformer.
+func (v *version) TiDBGrants() TiDBGrantInformer {
+	return &tiDBGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TiDBUsers returns a TiDBUserInformer.
+func (v *version) TiDBUsers() TiDBUserInformer {
+	return &tiDBUserInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TidbClusters returns a TidbClusterInformer.
 func (v *version) TidbClusters() TidbClusterInformer {
 	return &tidbClusterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
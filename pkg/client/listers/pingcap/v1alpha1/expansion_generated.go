@@ -55,6 +55,22 @@ type RestoreListerExpansion interface{}
 // RestoreNamespaceLister.
 type RestoreNamespaceListerExpansion interface{}
 
+// TiDBGrantListerExpansion allows custom methods to be added to
+// TiDBGrantLister.
+type TiDBGrantListerExpansion interface{}
+
+// TiDBGrantNamespaceListerExpansion allows custom methods to be added to
+// TiDBGrantNamespaceLister.
+type TiDBGrantNamespaceListerExpansion interface{}
+
+// TiDBUserListerExpansion allows custom methods to be added to
+// TiDBUserLister.
+type TiDBUserListerExpansion interface{}
+
+// TiDBUserNamespaceListerExpansion allows custom methods to be added to
+// TiDBUserNamespaceLister.
+type TiDBUserNamespaceListerExpansion interface{}
+
 // TidbClusterListerExpansion allows custom methods to be added to
 // TidbClusterLister.
 type TidbClusterListerExpansion interface{}
@@ -140,6 +140,7 @@ func (c *Controller) sync(key string) error {
 	backup, err := c.deps.BackupLister.Backups(ns).Get(name)
 	if errors.IsNotFound(err) {
 		klog.Infof("Backup has been deleted %v", key)
+		deleteBackupMetrics(ns, name)
 		return nil
 	}
 	if err != nil {
@@ -161,10 +162,13 @@ func (c *Controller) updateBackup(cur interface{}) {
 	if newBackup.DeletionTimestamp != nil {
 		// the backup is being deleted, we need to do some cleanup work, enqueue backup.
 		klog.Infof("backup %s/%s is being deleted", ns, name)
+		deleteBackupMetrics(ns, name)
 		c.enqueueBackup(newBackup)
 		return
 	}
 
+	recordBackupMetrics(newBackup)
+
 	if v1alpha1.IsBackupInvalid(newBackup) {
 		klog.V(4).Infof("backup %s/%s is invalid, skipping.", ns, name)
 		return
@@ -618,3 +622,22 @@ func isCurrentBackoffRetryDone(backup *v1alpha1.Backup) bool {
 	}
 	return backup.Status.BackoffRetryStatus[len(backup.Status.BackoffRetryStatus)-1].RealRetryAt != nil
 }
+
+// recordBackupMetrics records the duration and size of a completed backup.
+func recordBackupMetrics(backup *v1alpha1.Backup) {
+	if !v1alpha1.IsBackupComplete(backup) || backup.Status.TimeStarted.IsZero() || backup.Status.TimeCompleted.IsZero() {
+		return
+	}
+	mode := string(backup.Spec.Mode)
+	duration := backup.Status.TimeCompleted.Sub(backup.Status.TimeStarted.Time)
+	metrics.BackupDuration.WithLabelValues(backup.Namespace, backup.Name, mode).Set(duration.Seconds())
+	metrics.BackupSize.WithLabelValues(backup.Namespace, backup.Name, mode).Set(float64(backup.Status.BackupSize))
+}
+
+func deleteBackupMetrics(ns, name string) {
+	for _, mode := range []v1alpha1.BackupMode{v1alpha1.BackupModeSnapshot, v1alpha1.BackupModeLog, v1alpha1.BackupModeVolumeSnapshot, ""} {
+		metrics.BackupDuration.DeleteLabelValues(ns, name, string(mode))
+		metrics.BackupSize.DeleteLabelValues(ns, name, string(mode))
+	}
+	metrics.LogBackupCheckpointLag.DeleteLabelValues(ns, name)
+}
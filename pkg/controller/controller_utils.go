@@ -286,6 +286,16 @@ func PDPeerMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-pd-peer", clusterName)
 }
 
+// PDMSMemberName returns the member name of the PD microservice
+func PDMSMemberName(clusterName, name string) string {
+	return fmt.Sprintf("%s-%s", clusterName, name)
+}
+
+// PDMSPeerMemberName returns the peer service name of the PD microservice
+func PDMSPeerMemberName(clusterName, name string) string {
+	return fmt.Sprintf("%s-%s-peer", clusterName, name)
+}
+
 // TiKVMemberName returns tikv member name
 func TiKVMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-tikv", clusterName)
@@ -296,6 +306,16 @@ func TiKVPeerMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-tikv-peer", clusterName)
 }
 
+// TiKVGroupMemberName returns tikv member name of a TiKV group
+func TiKVGroupMemberName(clusterName, group string) string {
+	return fmt.Sprintf("%s-tikv-%s", clusterName, group)
+}
+
+// TiKVGroupPeerMemberName returns tikv peer service name of a TiKV group
+func TiKVGroupPeerMemberName(clusterName, group string) string {
+	return fmt.Sprintf("%s-tikv-%s-peer", clusterName, group)
+}
+
 // TiFlashMemberName returns tiflash member name
 func TiFlashMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-tiflash", clusterName)
@@ -306,6 +326,16 @@ func TiFlashPeerMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-tiflash-peer", clusterName)
 }
 
+// TiFlashComputeMemberName returns the name of tiflash compute nodes
+func TiFlashComputeMemberName(clusterName string) string {
+	return fmt.Sprintf("%s-tiflash-compute", clusterName)
+}
+
+// TiFlashComputePeerMemberName returns the peer service name of tiflash compute nodes
+func TiFlashComputePeerMemberName(clusterName string) string {
+	return fmt.Sprintf("%s-tiflash-compute-peer", clusterName)
+}
+
 // TiProxyMemberName returns tiproxy member name
 func TiProxyMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-tiproxy", clusterName)
@@ -357,6 +387,11 @@ func DiscoveryMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-discovery", clusterName)
 }
 
+// DiscoveryStateName returns the name of the ConfigMap which persists the peer knowledge of tidb discovery
+func DiscoveryStateName(clusterName string) string {
+	return fmt.Sprintf("%s-discovery-state", clusterName)
+}
+
 // DMMasterMemberName returns dm-master member name
 func DMMasterMemberName(clusterName string) string {
 	return fmt.Sprintf("%s-dm-master", clusterName)
@@ -408,6 +443,11 @@ func PDPeerFullyDomain(name, ns, clusterDomain string) string {
 	return fmt.Sprintf("%s.%s.svc%s", PDPeerMemberName(name), ns, FormatClusterDomain(clusterDomain))
 }
 
+// TiDBFullyDomain returns the domain of the TiDB service of the cluster
+func TiDBFullyDomain(name, ns, clusterDomain string) string {
+	return fmt.Sprintf("%s.%s.svc%s", TiDBMemberName(name), ns, FormatClusterDomain(clusterDomain))
+}
+
 // AnnAdditionalProm adds additional prometheus scarping configuration annotation for the pod
 // which has multiple metrics endpoint
 // we assumes that the metrics path is as same as the previous metrics path
@@ -242,6 +242,13 @@ func TestPDPeerMemberName(t *testing.T) {
 	g.Expect(PDPeerMemberName("demo")).To(Equal("demo-pd-peer"))
 }
 
+func TestPDMSMemberName(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(PDMSMemberName("demo", "tso")).To(Equal("demo-tso"))
+	g.Expect(MemberName("demo", v1alpha1.PDMSTSOMemberType)).To(Equal("demo-tso"))
+	g.Expect(PDMSPeerMemberName("demo", "tso")).To(Equal("demo-tso-peer"))
+}
+
 func TestTiKVMemberName(t *testing.T) {
 	g := NewGomegaWithT(t)
 	g.Expect(TiKVMemberName("demo")).To(Equal("demo-tikv"))
@@ -253,6 +260,18 @@ func TestTiKVPeerMemberName(t *testing.T) {
 	g.Expect(TiKVPeerMemberName("demo")).To(Equal("demo-tikv-peer"))
 }
 
+func TestTiKVGroupMemberName(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(TiKVGroupMemberName("demo", "hot")).To(Equal("demo-tikv-hot"))
+	g.Expect(TiKVGroupPeerMemberName("demo", "hot")).To(Equal("demo-tikv-hot-peer"))
+}
+
+func TestTiFlashComputeMemberName(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(TiFlashComputeMemberName("demo")).To(Equal("demo-tiflash-compute"))
+	g.Expect(TiFlashComputePeerMemberName("demo")).To(Equal("demo-tiflash-compute-peer"))
+}
+
 func TestTiDBMemberName(t *testing.T) {
 	g := NewGomegaWithT(t)
 	g.Expect(TiDBMemberName("demo")).To(Equal("demo-tidb"))
@@ -264,6 +283,12 @@ func TestTiDBPeerMemberName(t *testing.T) {
 	g.Expect(TiDBPeerMemberName("demo")).To(Equal("demo-tidb-peer"))
 }
 
+func TestTiDBFullyDomain(t *testing.T) {
+	g := NewGomegaWithT(t)
+	g.Expect(TiDBFullyDomain("demo", "ns", "")).To(Equal("demo-tidb.ns.svc"))
+	g.Expect(TiDBFullyDomain("demo", "ns", "cluster.local")).To(Equal("demo-tidb.ns.svc.cluster.local"))
+}
+
 func TestPumpMemberName(t *testing.T) {
 	g := NewGomegaWithT(t)
 	g.Expect(PumpMemberName("demo")).To(Equal("demo-pump"))
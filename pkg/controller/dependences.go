@@ -95,6 +95,12 @@ type CLIConfig struct {
 	// KubeClientQPS indicates the maximum QPS to the kubenetes API server from client.
 	KubeClientQPS   float64
 	KubeClientBurst int
+
+	// TracingEndpoint is the OTLP/HTTP endpoint to export the spans of reconciliation to,
+	// tracing is disabled if it's empty.
+	TracingEndpoint string
+	// TracingSampleRatio is the ratio of the reconciliations to be traced.
+	TracingSampleRatio float64
 }
 
 // DefaultCLIConfig returns the default command line configuration
@@ -119,6 +125,7 @@ func DefaultCLIConfig() *CLIConfig {
 		TiDBBackupManagerImage: "pingcap/tidb-backup-manager:latest",
 		TiDBDiscoveryImage:     "pingcap/tidb-operator:latest",
 		Selector:               "",
+		TracingSampleRatio:     1,
 	}
 }
 
@@ -153,6 +160,8 @@ func (c *CLIConfig) AddFlag(_ *flag.FlagSet) {
 	flag.DurationVar(&c.RetryPeriod, "leader-retry-period", c.RetryPeriod, "leader-retry-period is the duration the LeaderElector clients should wait between tries of actions")
 	flag.Float64Var(&c.KubeClientQPS, "kube-client-qps", c.KubeClientQPS, "The maximum QPS to the kubenetes API server from client")
 	flag.IntVar(&c.KubeClientBurst, "kube-client-burst", c.KubeClientBurst, "The maximum burst for throttle to the kubenetes API server from client")
+	flag.StringVar(&c.TracingEndpoint, "tracing-endpoint", c.TracingEndpoint, "The OTLP/HTTP endpoint to export the traces of reconciliation to, e.g. http://otel-collector:4318, tracing is disabled if it's empty")
+	flag.Float64Var(&c.TracingSampleRatio, "tracing-sample-ratio", c.TracingSampleRatio, "The ratio of the reconciliations to be traced")
 }
 
 // HasNodePermission returns whether the user has permission for node operations.
@@ -190,6 +199,7 @@ type Controls struct {
 	CDCControl         TiCDCControlInterface
 	ProxyControl       TiProxyControlInterface
 	TiDBControl        TiDBControlInterface
+	TiDBSQLControl     TiDBSQLControlInterface
 	BackupControl      BackupControlInterface
 	RestoreControl     RestoreControlInterface
 	SecretControl      SecretControlInterface
@@ -235,6 +245,8 @@ type Dependencies struct {
 	TiDBMonitorLister           listers.TidbMonitorLister
 	TiDBNGMonitoringLister      listers.TidbNGMonitoringLister
 	TiDBDashboardLister         listers.TidbDashboardLister
+	TiDBUserLister              listers.TiDBUserLister
+	TiDBGrantLister             listers.TiDBGrantLister
 
 	// Controls
 	Controls
@@ -291,6 +303,7 @@ func newRealControls(
 		CDCControl:         NewDefaultTiCDCControl(secretLister),
 		ProxyControl:       NewDefaultTiProxyControl(secretLister),
 		TiDBControl:        NewDefaultTiDBControl(secretLister),
+		TiDBSQLControl:     NewDefaultTiDBSQLControl(secretLister),
 		BackupControl:      NewRealBackupControl(clientset, recorder),
 		RestoreControl:     NewRealRestoreControl(clientset, restoreLister, recorder),
 		SecretControl:      NewRealSecretControl(kubeClientset, secretLister, recorder),
@@ -380,6 +393,8 @@ func newDependencies(
 		TiDBMonitorLister:           informerFactory.Pingcap().V1alpha1().TidbMonitors().Lister(),
 		TiDBNGMonitoringLister:      informerFactory.Pingcap().V1alpha1().TidbNGMonitorings().Lister(),
 		TiDBDashboardLister:         informerFactory.Pingcap().V1alpha1().TidbDashboards().Lister(),
+		TiDBUserLister:              informerFactory.Pingcap().V1alpha1().TiDBUsers().Lister(),
+		TiDBGrantLister:             informerFactory.Pingcap().V1alpha1().TiDBGrants().Lister(),
 
 		AWSConfig: cfg,
 	}, nil
@@ -450,6 +465,7 @@ func newFakeControl(kubeClientset kubernetes.Interface, informerFactory informer
 		TiDBClusterControl: NewFakeTidbClusterControl(informerFactory.Pingcap().V1alpha1().TidbClusters()),
 		CDCControl:         NewFakeTiCDCControl(),
 		TiDBControl:        NewFakeTiDBControl(kubeInformerFactory.Core().V1().Secrets().Lister()),
+		TiDBSQLControl:     NewFakeTiDBSQLControl(),
 		BackupControl:      NewFakeBackupControl(informerFactory.Pingcap().V1alpha1().Backups()),
 		SecretControl:      NewFakeSecretControl(kubeInformerFactory.Core().V1().Secrets()),
 	}
@@ -50,9 +50,9 @@ func NewDefaultDMClusterControl(
 	recorder record.EventRecorder) ControlInterface {
 	return &defaultDMClusterControl{
 		dcControl,
-		masterMemberManager,
-		workerMemberManager,
-		reclaimPolicyManager,
+		manager.WithDMTracing("dm-master", masterMemberManager),
+		manager.WithDMTracing("dm-worker", workerMemberManager),
+		manager.WithDMTracing("reclaim-policy", reclaimPolicyManager),
 		//metaManager,
 		orphanPodsCleaner,
 		pvcCleaner,
@@ -169,6 +169,11 @@ func (c *defaultDMClusterControl) updateDMCluster(dc *v1alpha1.DMCluster) error
 		errs = append(errs, err)
 	}
 
+	// the client certificates of the upstream and downstream databases are mounted to
+	// dm-master and dm-worker, expose their paths for the source and task configs
+	dc.Status.TLSUpstreams = dc.TLSUpstreamPaths()
+	dc.Status.TLSDownstreams = dc.TLSDownstreamPaths()
+
 	// TODO: syncing labels for dm: syncing the labels from Pod to PVC and PV, these labels include:
 	//   - label.StoreIDLabelKey
 	//   - label.MemberIDLabelKey
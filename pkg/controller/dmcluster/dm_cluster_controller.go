@@ -24,7 +24,9 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/manager/meta"
 	"github.com/pingcap/tidb-operator/pkg/manager/suspender"
 	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/pingcap/tidb-operator/pkg/tracing"
 
+	"go.opentelemetry.io/otel/attribute"
 	apps "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -53,7 +55,7 @@ func NewController(deps *controller.Dependencies) *Controller {
 		control: NewDefaultDMClusterControl(
 			deps.DMClusterControl,
 			mm.NewMasterMemberManager(deps, mm.NewMasterScaler(deps), mm.NewMasterUpgrader(deps), mm.NewMasterFailover(deps), suspender),
-			mm.NewWorkerMemberManager(deps, mm.NewWorkerScaler(deps), mm.NewWorkerFailover(deps), suspender),
+			mm.NewWorkerMemberManager(deps, mm.NewWorkerScaler(deps), mm.NewWorkerUpgrader(deps), mm.NewWorkerFailover(deps), suspender),
 			meta.NewReclaimPolicyManager(deps),
 			mm.NewOrphanPodsCleaner(deps),
 			mm.NewRealPVCCleaner(deps),
@@ -163,7 +165,9 @@ func (c *Controller) sync(key string) error {
 }
 
 func (c *Controller) syncDMCluster(dc *v1alpha1.DMCluster) error {
-	return c.control.UpdateDMCluster(dc)
+	return tracing.Reconcile(tracing.DMClusterKey(dc.Namespace, dc.Name), "DMCluster.Reconcile", func() error {
+		return c.control.UpdateDMCluster(dc)
+	}, attribute.String("namespace", dc.Namespace), attribute.String("name", dc.Name))
 }
 
 // enqueueDMCluster enqueues the given dmcluster in the work queue.
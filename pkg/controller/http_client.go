@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/tracing"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	v1 "k8s.io/api/core/v1"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
@@ -30,8 +31,11 @@ type httpClient struct {
 }
 
 func (c *httpClient) getHTTPClient(tc *v1alpha1.TidbCluster) (*http.Client, error) {
+	key := tracing.TidbClusterKey(tc.Namespace, tc.Name)
 	httpClient := &http.Client{Timeout: timeout}
 	if !tc.IsTLSClusterEnabled() {
+		// the transport is nil if tracing is not enabled, the default transport is used then
+		httpClient.Transport = tracing.NewTransport(nil, key)
 		return httpClient, nil
 	}
 
@@ -60,7 +64,7 @@ func (c *httpClient) getHTTPClient(tc *v1alpha1.TidbCluster) (*http.Client, erro
 		RootCAs:      rootCAs,
 		Certificates: []tls.Certificate{tlsCert},
 	}
-	httpClient.Transport = &http.Transport{TLSClientConfig: config, DisableKeepAlives: true}
+	httpClient.Transport = tracing.NewTransport(&http.Transport{TLSClientConfig: config, DisableKeepAlives: true}, key)
 
 	return httpClient, nil
 }
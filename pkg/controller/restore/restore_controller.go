@@ -133,6 +133,7 @@ func (c *Controller) sync(key string) error {
 	restore, err := c.deps.RestoreLister.Restores(ns).Get(name)
 	if errors.IsNotFound(err) {
 		klog.Infof("Restore has been deleted %v", key)
+		deleteRestoreMetrics(ns, name)
 		return nil
 	}
 	if err != nil {
@@ -160,6 +161,7 @@ func (c *Controller) updateRestore(cur interface{}) {
 
 	if v1alpha1.IsRestoreComplete(newRestore) {
 		klog.V(4).Infof("restore %s/%s is Complete, skipping.", ns, name)
+		recordRestoreMetrics(newRestore)
 		return
 	}
 
@@ -251,3 +253,18 @@ func (c *Controller) getTC(restore *v1alpha1.Restore) (*v1alpha1.TidbCluster, er
 
 	return c.deps.TiDBClusterLister.TidbClusters(restoreNamespace).Get(restore.Spec.BR.Cluster)
 }
+
+// recordRestoreMetrics records the duration of a completed restore.
+func recordRestoreMetrics(restore *v1alpha1.Restore) {
+	if restore.Status.TimeStarted.IsZero() || restore.Status.TimeCompleted.IsZero() {
+		return
+	}
+	duration := restore.Status.TimeCompleted.Sub(restore.Status.TimeStarted.Time)
+	metrics.RestoreDuration.WithLabelValues(restore.Namespace, restore.Name, string(restore.Spec.Mode)).Set(duration.Seconds())
+}
+
+func deleteRestoreMetrics(ns, name string) {
+	for _, mode := range []v1alpha1.RestoreMode{v1alpha1.RestoreModeSnapshot, v1alpha1.RestoreModePiTR, v1alpha1.RestoreModeVolumeSnapshot, ""} {
+		metrics.RestoreDuration.DeleteLabelValues(ns, name, string(mode))
+	}
+}
@@ -0,0 +1,701 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/tls"
+	"crypto/x509"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	corev1 "k8s.io/api/core/v1"
+	corelisterv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	defaultSQLUser = "root"
+	// resourceGroupUnlimited is the RU_PER_SEC of a resource group without quota, e.g. the `default` resource group
+	resourceGroupUnlimited = "UNLIMITED"
+	// NativePasswordPlugin is the default authentication plugin of TiDB
+	NativePasswordPlugin = "mysql_native_password"
+)
+
+// TiDBAccount identifies a user or a role in TiDB
+type TiDBAccount struct {
+	User string
+	Host string
+}
+
+// String returns the account in the format of `'user'@'host'`
+func (a TiDBAccount) String() string {
+	return fmt.Sprintf("%s@%s", quoteString(a.User), quoteString(a.Host))
+}
+
+// TiDBUserInfo is the authentication info of a user in TiDB
+type TiDBUserInfo struct {
+	Plugin               string
+	AuthenticationString string
+}
+
+// TiDBSQLControlInterface is the interface that knows how to execute SQL in TiDB of a TidbCluster
+// with the account in `spec.sqlAccess`
+type TiDBSQLControlInterface interface {
+	// GetResourceGroups returns all resource groups in TiDB, RUPerSec is 0 if the resource group is unlimited
+	GetResourceGroups(tc *v1alpha1.TidbCluster) ([]v1alpha1.ResourceGroupSpec, error)
+	// CreateResourceGroup creates a resource group
+	CreateResourceGroup(tc *v1alpha1.TidbCluster, group *v1alpha1.ResourceGroupSpec) error
+	// AlterResourceGroup alters a resource group to match the spec
+	AlterResourceGroup(tc *v1alpha1.TidbCluster, group *v1alpha1.ResourceGroupSpec) error
+	// DropResourceGroup drops a resource group if it exists
+	DropResourceGroup(tc *v1alpha1.TidbCluster, name string) error
+
+	// GetUser returns the authentication info of a user or a role, it returns nil if the account does not exist
+	GetUser(tc *v1alpha1.TidbCluster, account TiDBAccount) (*TiDBUserInfo, error)
+	// CreateUser creates a user with the password
+	CreateUser(tc *v1alpha1.TidbCluster, account TiDBAccount, password string) error
+	// CreateRole creates a role
+	CreateRole(tc *v1alpha1.TidbCluster, account TiDBAccount) error
+	// SetPassword changes the password of a user
+	SetPassword(tc *v1alpha1.TidbCluster, account TiDBAccount, password string) error
+	// DropUser drops a user or a role if it exists
+	DropUser(tc *v1alpha1.TidbCluster, account TiDBAccount, role bool) error
+	// ShowGrants returns the result of `SHOW GRANTS FOR account`
+	ShowGrants(tc *v1alpha1.TidbCluster, account TiDBAccount) ([]string, error)
+	// Grant grants the privileges on `db.table` to the account, `*` means all databases or tables
+	Grant(tc *v1alpha1.TidbCluster, account TiDBAccount, privileges []string, db, table string, withGrantOption bool) error
+	// Revoke revokes the privileges on `db.table` from the account
+	Revoke(tc *v1alpha1.TidbCluster, account TiDBAccount, privileges []string, db, table string) error
+	// GrantRoles grants the roles to the account and activates all the granted roles by default
+	GrantRoles(tc *v1alpha1.TidbCluster, account TiDBAccount, roles []TiDBAccount) error
+	// RevokeRoles revokes the roles from the account
+	RevokeRoles(tc *v1alpha1.TidbCluster, account TiDBAccount, roles []TiDBAccount) error
+}
+
+type cachedDB struct {
+	dsn string
+	// tlsKey is the key of the TLS config registered to the mysql driver, it's empty if TLS is disabled
+	tlsKey string
+	db     *sql.DB
+}
+
+// defaultTiDBSQLControl is the default implementation of TiDBSQLControlInterface.
+type defaultTiDBSQLControl struct {
+	mutex        sync.Mutex
+	secretLister corelisterv1.SecretLister
+	// key: namespace/name of the TidbCluster
+	dbs map[string]*cachedDB
+}
+
+// NewDefaultTiDBSQLControl returns a defaultTiDBSQLControl instance
+func NewDefaultTiDBSQLControl(secretLister corelisterv1.SecretLister) *defaultTiDBSQLControl {
+	return &defaultTiDBSQLControl{secretLister: secretLister, dbs: map[string]*cachedDB{}}
+}
+
+func (c *defaultTiDBSQLControl) GetResourceGroups(tc *v1alpha1.TidbCluster) ([]v1alpha1.ResourceGroupSpec, error) {
+	db, err := c.getDB(tc)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	rows, err := db.QueryContext(ctx, "SELECT NAME, RU_PER_SEC, PRIORITY, BURSTABLE FROM information_schema.resource_groups")
+	if err != nil {
+		return nil, fmt.Errorf("query resource groups of cluster %s/%s failed, err: %v", tc.Namespace, tc.Name, err)
+	}
+	defer rows.Close()
+
+	var groups []v1alpha1.ResourceGroupSpec
+	for rows.Next() {
+		var name, ruPerSec, priority, burstable string
+		if err := rows.Scan(&name, &ruPerSec, &priority, &burstable); err != nil {
+			return nil, err
+		}
+		group := v1alpha1.ResourceGroupSpec{
+			Name:      name,
+			Priority:  v1alpha1.ResourceGroupPriority(strings.ToUpper(priority)),
+			Burstable: strings.EqualFold(burstable, "YES"),
+		}
+		if ruPerSec != resourceGroupUnlimited {
+			group.RUPerSec, err = strconv.ParseInt(ruPerSec, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected RU_PER_SEC %q of resource group %s", ruPerSec, name)
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups, rows.Err()
+}
+
+func (c *defaultTiDBSQLControl) CreateResourceGroup(tc *v1alpha1.TidbCluster, group *v1alpha1.ResourceGroupSpec) error {
+	return c.exec(tc, fmt.Sprintf("CREATE RESOURCE GROUP IF NOT EXISTS %s %s", quoteIdentifier(group.Name), resourceGroupOptions(group)))
+}
+
+func (c *defaultTiDBSQLControl) AlterResourceGroup(tc *v1alpha1.TidbCluster, group *v1alpha1.ResourceGroupSpec) error {
+	options := resourceGroupOptions(group)
+	if !group.Burstable {
+		options += " BURSTABLE = FALSE"
+	}
+	return c.exec(tc, fmt.Sprintf("ALTER RESOURCE GROUP %s %s", quoteIdentifier(group.Name), options))
+}
+
+func (c *defaultTiDBSQLControl) DropResourceGroup(tc *v1alpha1.TidbCluster, name string) error {
+	return c.exec(tc, fmt.Sprintf("DROP RESOURCE GROUP IF EXISTS %s", quoteIdentifier(name)))
+}
+
+func (c *defaultTiDBSQLControl) GetUser(tc *v1alpha1.TidbCluster, account TiDBAccount) (*TiDBUserInfo, error) {
+	db, err := c.getDB(tc)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	info := &TiDBUserInfo{}
+	row := db.QueryRowContext(ctx, "SELECT plugin, authentication_string FROM mysql.user WHERE User = ? AND Host = ?", account.User, account.Host)
+	err = row.Scan(&info.Plugin, &info.AuthenticationString)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query user %s of cluster %s/%s failed, err: %v", account, tc.Namespace, tc.Name, err)
+	}
+	return info, nil
+}
+
+func (c *defaultTiDBSQLControl) CreateUser(tc *v1alpha1.TidbCluster, account TiDBAccount, password string) error {
+	return c.exec(tc, fmt.Sprintf("CREATE USER IF NOT EXISTS %s IDENTIFIED BY %s", account, quoteString(password)))
+}
+
+func (c *defaultTiDBSQLControl) CreateRole(tc *v1alpha1.TidbCluster, account TiDBAccount) error {
+	return c.exec(tc, fmt.Sprintf("CREATE ROLE IF NOT EXISTS %s", account))
+}
+
+func (c *defaultTiDBSQLControl) SetPassword(tc *v1alpha1.TidbCluster, account TiDBAccount, password string) error {
+	return c.exec(tc, fmt.Sprintf("ALTER USER %s IDENTIFIED BY %s", account, quoteString(password)))
+}
+
+func (c *defaultTiDBSQLControl) DropUser(tc *v1alpha1.TidbCluster, account TiDBAccount, role bool) error {
+	if role {
+		return c.exec(tc, fmt.Sprintf("DROP ROLE IF EXISTS %s", account))
+	}
+	return c.exec(tc, fmt.Sprintf("DROP USER IF EXISTS %s", account))
+}
+
+func (c *defaultTiDBSQLControl) ShowGrants(tc *v1alpha1.TidbCluster, account TiDBAccount) ([]string, error) {
+	db, err := c.getDB(tc)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("SHOW GRANTS FOR %s", account))
+	if err != nil {
+		return nil, fmt.Errorf("show grants for %s of cluster %s/%s failed, err: %v", account, tc.Namespace, tc.Name, err)
+	}
+	defer rows.Close()
+
+	var grants []string
+	for rows.Next() {
+		var grant string
+		if err := rows.Scan(&grant); err != nil {
+			return nil, err
+		}
+		grants = append(grants, grant)
+	}
+	return grants, rows.Err()
+}
+
+func (c *defaultTiDBSQLControl) Grant(tc *v1alpha1.TidbCluster, account TiDBAccount, privileges []string, db, table string, withGrantOption bool) error {
+	stmt := fmt.Sprintf("GRANT %s ON %s TO %s", strings.Join(privileges, ", "), grantObject(db, table), account)
+	if withGrantOption {
+		stmt += " WITH GRANT OPTION"
+	}
+	return c.exec(tc, stmt)
+}
+
+func (c *defaultTiDBSQLControl) Revoke(tc *v1alpha1.TidbCluster, account TiDBAccount, privileges []string, db, table string) error {
+	return c.exec(tc, fmt.Sprintf("REVOKE %s ON %s FROM %s", strings.Join(privileges, ", "), grantObject(db, table), account))
+}
+
+func (c *defaultTiDBSQLControl) GrantRoles(tc *v1alpha1.TidbCluster, account TiDBAccount, roles []TiDBAccount) error {
+	if err := c.exec(tc, fmt.Sprintf("GRANT %s TO %s", joinAccounts(roles), account)); err != nil {
+		return err
+	}
+	return c.exec(tc, fmt.Sprintf("SET DEFAULT ROLE ALL TO %s", account))
+}
+
+func (c *defaultTiDBSQLControl) RevokeRoles(tc *v1alpha1.TidbCluster, account TiDBAccount, roles []TiDBAccount) error {
+	return c.exec(tc, fmt.Sprintf("REVOKE %s FROM %s", joinAccounts(roles), account))
+}
+
+func (c *defaultTiDBSQLControl) exec(tc *v1alpha1.TidbCluster, stmt string) error {
+	db, err := c.getDB(tc)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if _, err := db.ExecContext(ctx, stmt); err != nil {
+		return fmt.Errorf("execute %q in cluster %s/%s failed, err: %v", stmt, tc.Namespace, tc.Name, err)
+	}
+	return nil
+}
+
+// getDB returns the connection pool of the cluster, the pool is recreated if the account or the
+// client certificate is changed
+func (c *defaultTiDBSQLControl) getDB(tc *v1alpha1.TidbCluster) (*sql.DB, error) {
+	cfg, err := c.getConfig(tc)
+	if err != nil {
+		return nil, err
+	}
+	dsn := cfg.FormatDSN()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := fmt.Sprintf("%s/%s", tc.Namespace, tc.Name)
+	if cached, ok := c.dbs[key]; ok {
+		if cached.dsn == dsn {
+			return cached.db, nil
+		}
+		if err := cached.db.Close(); err != nil {
+			klog.Warningf("close db connection of cluster %s failed, err: %v", key, err)
+		}
+		if cached.tlsKey != "" && cached.tlsKey != cfg.TLSConfig {
+			mysql.DeregisterTLSConfig(cached.tlsKey)
+		}
+		delete(c.dbs, key)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	db, err := util.OpenDB(ctx, dsn)
+	if err != nil {
+		return nil, err
+	}
+	c.dbs[key] = &cachedDB{dsn: dsn, tlsKey: cfg.TLSConfig, db: db}
+	return db, nil
+}
+
+// getConfig returns the config to connect TiDB of the cluster with the account in `spec.sqlAccess`
+func (c *defaultTiDBSQLControl) getConfig(tc *v1alpha1.TidbCluster) (*mysql.Config, error) {
+	ns := tc.Namespace
+	access := tc.Spec.SQLAccess
+	if access == nil {
+		return nil, fmt.Errorf("spec.sqlAccess of cluster %s/%s is not set", ns, tc.Name)
+	}
+	if tc.Spec.TiDB == nil {
+		return nil, fmt.Errorf("spec.tidb of cluster %s/%s is not set", ns, tc.Name)
+	}
+
+	secret, err := c.secretLister.Secrets(ns).Get(access.SecretName)
+	if err != nil {
+		return nil, fmt.Errorf("get secret %s/%s failed, err: %v", ns, access.SecretName, err)
+	}
+	password, ok := secret.Data[constants.TidbPasswordKey]
+	if !ok {
+		return nil, fmt.Errorf("key %s does not exist in secret %s/%s", constants.TidbPasswordKey, ns, access.SecretName)
+	}
+	user := access.User
+	if user == "" {
+		user = defaultSQLUser
+	}
+
+	cfg := mysql.NewConfig()
+	cfg.User = user
+	cfg.Passwd = string(password)
+	cfg.Net = "tcp"
+	host := TiDBFullyDomain(tc.Name, ns, tc.Spec.ClusterDomain)
+	cfg.Addr = fmt.Sprintf("%s:%d", host, tc.Spec.TiDB.GetServicePort())
+	cfg.Params = map[string]string{"charset": "utf8mb4"}
+	if tc.Spec.TiDB.IsTLSClientEnabled() {
+		tlsKey, err := c.registerTLSConfig(tc, host)
+		if err != nil {
+			return nil, err
+		}
+		cfg.TLSConfig = tlsKey
+	}
+	return cfg, nil
+}
+
+// registerTLSConfig registers the TLS config of the cluster to the mysql driver and returns its key.
+// The key contains the hash of the certificates, so that the connection pool is recreated once
+// the certificates are rotated.
+func (c *defaultTiDBSQLControl) registerTLSConfig(tc *v1alpha1.TidbCluster, host string) (string, error) {
+	ns := tc.Namespace
+	secretName := util.TiDBClientTLSSecretName(tc.Name, tc.Spec.SQLAccess.TLSClientSecretName)
+	secret, err := c.secretLister.Secrets(ns).Get(secretName)
+	if err != nil {
+		return "", fmt.Errorf("get secret %s/%s failed, err: %v", ns, secretName, err)
+	}
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return "", fmt.Errorf("unable to load certificates from secret %s/%s: %v", ns, secretName, err)
+	}
+
+	skipCA := tc.Spec.TiDB.TLSClient.SkipInternalClientCA
+	rootCAs := x509.NewCertPool()
+	if !skipCA {
+		rootCAs.AppendCertsFromPEM(secret.Data[corev1.ServiceAccountRootCAKey])
+	}
+	h := sha1.New()
+	for _, k := range []string{corev1.ServiceAccountRootCAKey, corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		h.Write(secret.Data[k])
+	}
+	key := fmt.Sprintf("%s-%s-sql-%s", ns, tc.Name, hex.EncodeToString(h.Sum(nil))[:8])
+	err = mysql.RegisterTLSConfig(key, &tls.Config{
+		RootCAs:            rootCAs,
+		Certificates:       []tls.Certificate{cert},
+		ServerName:         host,
+		InsecureSkipVerify: skipCA,
+	})
+	return key, err
+}
+
+func resourceGroupOptions(group *v1alpha1.ResourceGroupSpec) string {
+	priority := group.Priority
+	if priority == "" {
+		priority = v1alpha1.ResourceGroupPriorityMedium
+	}
+	options := fmt.Sprintf("RU_PER_SEC = %d PRIORITY = %s", group.RUPerSec, priority)
+	if group.Burstable {
+		options += " BURSTABLE"
+	}
+	return options
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func grantObject(db, table string) string {
+	quote := func(name string) string {
+		if name == "*" {
+			return name
+		}
+		return quoteIdentifier(name)
+	}
+	return quote(db) + "." + quote(table)
+}
+
+func joinAccounts(accounts []TiDBAccount) string {
+	names := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		names = append(names, account.String())
+	}
+	return strings.Join(names, ", ")
+}
+
+// NativePasswordHash returns the authentication string of the password with the mysql_native_password plugin
+func NativePasswordHash(password string) string {
+	if password == "" {
+		return ""
+	}
+	hash1 := sha1.Sum([]byte(password))
+	hash2 := sha1.Sum(hash1[:])
+	return "*" + strings.ToUpper(hex.EncodeToString(hash2[:]))
+}
+
+// FakeTiDBUser is a user or a role kept in memory by FakeTiDBSQLControl
+type FakeTiDBUser struct {
+	Role     bool
+	Password string
+	// key: object in the format of `db.table`, value: privileges
+	Privileges map[string][]string
+	// key: object in the format of `db.table`
+	GrantOption map[string]bool
+	Roles       []TiDBAccount
+}
+
+// FakeTiDBSQLControl is a fake implementation of TiDBSQLControlInterface which keeps resource groups and users in memory.
+type FakeTiDBSQLControl struct {
+	// key: group name
+	ResourceGroups map[string]v1alpha1.ResourceGroupSpec
+	Users          map[TiDBAccount]*FakeTiDBUser
+	// Statements are the executed statements, e.g. `CREATE RESOURCE GROUP rg1`
+	Statements []string
+	err        error
+}
+
+// NewFakeTiDBSQLControl returns a FakeTiDBSQLControl instance
+func NewFakeTiDBSQLControl() *FakeTiDBSQLControl {
+	return &FakeTiDBSQLControl{
+		ResourceGroups: map[string]v1alpha1.ResourceGroupSpec{},
+		Users:          map[TiDBAccount]*FakeTiDBUser{},
+	}
+}
+
+// SetError sets the error returned by all methods
+func (c *FakeTiDBSQLControl) SetError(err error) {
+	c.err = err
+}
+
+func (c *FakeTiDBSQLControl) GetResourceGroups(tc *v1alpha1.TidbCluster) ([]v1alpha1.ResourceGroupSpec, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	var groups []v1alpha1.ResourceGroupSpec
+	for _, group := range c.ResourceGroups {
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+func (c *FakeTiDBSQLControl) CreateResourceGroup(tc *v1alpha1.TidbCluster, group *v1alpha1.ResourceGroupSpec) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.Statements = append(c.Statements, "CREATE RESOURCE GROUP "+group.Name)
+	c.ResourceGroups[group.Name] = normalizeResourceGroup(group)
+	return nil
+}
+
+func (c *FakeTiDBSQLControl) AlterResourceGroup(tc *v1alpha1.TidbCluster, group *v1alpha1.ResourceGroupSpec) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.Statements = append(c.Statements, "ALTER RESOURCE GROUP "+group.Name)
+	c.ResourceGroups[group.Name] = normalizeResourceGroup(group)
+	return nil
+}
+
+func (c *FakeTiDBSQLControl) DropResourceGroup(tc *v1alpha1.TidbCluster, name string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.Statements = append(c.Statements, "DROP RESOURCE GROUP "+name)
+	delete(c.ResourceGroups, name)
+	return nil
+}
+
+func (c *FakeTiDBSQLControl) GetUser(tc *v1alpha1.TidbCluster, account TiDBAccount) (*TiDBUserInfo, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	user, ok := c.Users[account]
+	if !ok {
+		return nil, nil
+	}
+	return &TiDBUserInfo{Plugin: NativePasswordPlugin, AuthenticationString: NativePasswordHash(user.Password)}, nil
+}
+
+func (c *FakeTiDBSQLControl) CreateUser(tc *v1alpha1.TidbCluster, account TiDBAccount, password string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.Statements = append(c.Statements, "CREATE USER "+account.String())
+	if _, ok := c.Users[account]; !ok {
+		c.Users[account] = &FakeTiDBUser{Password: password}
+	}
+	return nil
+}
+
+func (c *FakeTiDBSQLControl) CreateRole(tc *v1alpha1.TidbCluster, account TiDBAccount) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.Statements = append(c.Statements, "CREATE ROLE "+account.String())
+	if _, ok := c.Users[account]; !ok {
+		c.Users[account] = &FakeTiDBUser{Role: true}
+	}
+	return nil
+}
+
+func (c *FakeTiDBSQLControl) SetPassword(tc *v1alpha1.TidbCluster, account TiDBAccount, password string) error {
+	if c.err != nil {
+		return c.err
+	}
+	user, ok := c.Users[account]
+	if !ok {
+		return fmt.Errorf("user %s does not exist", account)
+	}
+	c.Statements = append(c.Statements, "ALTER USER "+account.String())
+	user.Password = password
+	return nil
+}
+
+func (c *FakeTiDBSQLControl) DropUser(tc *v1alpha1.TidbCluster, account TiDBAccount, role bool) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.Statements = append(c.Statements, "DROP USER "+account.String())
+	delete(c.Users, account)
+	return nil
+}
+
+// ShowGrants returns the grants in the format of TiDB
+func (c *FakeTiDBSQLControl) ShowGrants(tc *v1alpha1.TidbCluster, account TiDBAccount) ([]string, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	user, ok := c.Users[account]
+	if !ok {
+		return nil, fmt.Errorf("user %s does not exist", account)
+	}
+	grants := []string{fmt.Sprintf("GRANT USAGE ON *.* TO %s", account)}
+	objects := make([]string, 0, len(user.Privileges))
+	for object := range user.Privileges {
+		objects = append(objects, object)
+	}
+	sort.Strings(objects)
+	for _, object := range objects {
+		if len(user.Privileges[object]) == 0 {
+			continue
+		}
+		grant := fmt.Sprintf("GRANT %s ON %s TO %s", strings.Join(user.Privileges[object], ","), object, account)
+		if user.GrantOption[object] {
+			grant += " WITH GRANT OPTION"
+		}
+		grants = append(grants, grant)
+	}
+	if len(user.Roles) > 0 {
+		grants = append(grants, fmt.Sprintf("GRANT %s TO %s", strings.ReplaceAll(joinAccounts(user.Roles), ", ", ","), account))
+	}
+	return grants, nil
+}
+
+func (c *FakeTiDBSQLControl) Grant(tc *v1alpha1.TidbCluster, account TiDBAccount, privileges []string, db, table string, withGrantOption bool) error {
+	if c.err != nil {
+		return c.err
+	}
+	user, ok := c.Users[account]
+	if !ok {
+		return fmt.Errorf("user %s does not exist", account)
+	}
+	object := grantObject(db, table)
+	c.Statements = append(c.Statements, fmt.Sprintf("GRANT %s ON %s TO %s", strings.Join(privileges, ","), object, account))
+	if user.Privileges == nil {
+		user.Privileges = map[string][]string{}
+		user.GrantOption = map[string]bool{}
+	}
+	for _, privilege := range privileges {
+		if !containsString(user.Privileges[object], privilege) {
+			user.Privileges[object] = append(user.Privileges[object], privilege)
+		}
+	}
+	if withGrantOption {
+		user.GrantOption[object] = true
+	}
+	return nil
+}
+
+func (c *FakeTiDBSQLControl) Revoke(tc *v1alpha1.TidbCluster, account TiDBAccount, privileges []string, db, table string) error {
+	if c.err != nil {
+		return c.err
+	}
+	user, ok := c.Users[account]
+	if !ok {
+		return fmt.Errorf("user %s does not exist", account)
+	}
+	object := grantObject(db, table)
+	c.Statements = append(c.Statements, fmt.Sprintf("REVOKE %s ON %s FROM %s", strings.Join(privileges, ","), object, account))
+	if containsString(privileges, "GRANT OPTION") {
+		delete(user.GrantOption, object)
+	}
+	var remained []string
+	for _, privilege := range user.Privileges[object] {
+		if !containsString(privileges, privilege) {
+			remained = append(remained, privilege)
+		}
+	}
+	if len(remained) == 0 {
+		delete(user.Privileges, object)
+		delete(user.GrantOption, object)
+		return nil
+	}
+	user.Privileges[object] = remained
+	return nil
+}
+
+func (c *FakeTiDBSQLControl) GrantRoles(tc *v1alpha1.TidbCluster, account TiDBAccount, roles []TiDBAccount) error {
+	if c.err != nil {
+		return c.err
+	}
+	user, ok := c.Users[account]
+	if !ok {
+		return fmt.Errorf("user %s does not exist", account)
+	}
+	c.Statements = append(c.Statements, fmt.Sprintf("GRANT %s TO %s", joinAccounts(roles), account))
+	for _, role := range roles {
+		if !containsAccount(user.Roles, role) {
+			user.Roles = append(user.Roles, role)
+		}
+	}
+	return nil
+}
+
+func (c *FakeTiDBSQLControl) RevokeRoles(tc *v1alpha1.TidbCluster, account TiDBAccount, roles []TiDBAccount) error {
+	if c.err != nil {
+		return c.err
+	}
+	user, ok := c.Users[account]
+	if !ok {
+		return fmt.Errorf("user %s does not exist", account)
+	}
+	c.Statements = append(c.Statements, fmt.Sprintf("REVOKE %s FROM %s", joinAccounts(roles), account))
+	var remained []TiDBAccount
+	for _, role := range user.Roles {
+		if !containsAccount(roles, role) {
+			remained = append(remained, role)
+		}
+	}
+	user.Roles = remained
+	return nil
+}
+
+func containsString(ss []string, s string) bool {
+	for _, item := range ss {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAccount(accounts []TiDBAccount, account TiDBAccount) bool {
+	for _, item := range accounts {
+		if item == account {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeResourceGroup(group *v1alpha1.ResourceGroupSpec) v1alpha1.ResourceGroupSpec {
+	g := *group
+	if g.Priority == "" {
+		g.Priority = v1alpha1.ResourceGroupPriorityMedium
+	}
+	return g
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestTiDBSQLControlGetConfig(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	informer := kubeinformers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Core().V1().Secrets()
+	indexer := informer.Informer().GetIndexer()
+	g.Expect(indexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "demo-sql"},
+		Data:       map[string][]byte{constants.TidbPasswordKey: []byte("pass")},
+	})).To(Succeed())
+	clientSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "demo-tidb-client-secret"},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:              []byte(certData),
+			corev1.TLSPrivateKeyKey:        []byte(keyData),
+			corev1.ServiceAccountRootCAKey: []byte(caData),
+		},
+	}
+	g.Expect(indexer.Add(clientSecret)).To(Succeed())
+
+	c := NewDefaultTiDBSQLControl(informer.Lister())
+	tc := getTidbCluster()
+	tc.Spec.ClusterDomain = "cluster.local"
+	tc.Spec.SQLAccess = &v1alpha1.TiDBSQLAccessConfig{SecretName: "demo-sql"}
+	tc.Spec.TiDB.TLSClient = &v1alpha1.TiDBTLSClient{Enabled: true}
+
+	cfg, err := c.getConfig(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cfg.Addr).To(Equal("demo-tidb.default.svc.cluster.local:4000"))
+	g.Expect(cfg.User).To(Equal(defaultSQLUser))
+	tlsKey := cfg.TLSConfig
+	g.Expect(tlsKey).To(HavePrefix("default-demo-sql-"))
+
+	// the key is kept if the certificates are not changed
+	cfg, err = c.getConfig(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cfg.TLSConfig).To(Equal(tlsKey))
+
+	// the key is changed once the certificates are rotated
+	clientSecret = clientSecret.DeepCopy()
+	clientSecret.Data[corev1.ServiceAccountRootCAKey] = []byte(caData + "\n")
+	g.Expect(indexer.Update(clientSecret)).To(Succeed())
+	cfg, err = c.getConfig(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cfg.TLSConfig).NotTo(Equal(tlsKey))
+}
@@ -364,6 +364,10 @@ func (c *PodController) syncTiKVPod(ctx context.Context, pod *corev1.Pod, tc *v1
 			if err != nil {
 				return perrors.Annotatef(err, "failed to remove evict leader scheduler for store %d, pod %s/%s", storeID, pod.Namespace, pod.Name)
 			}
+			if evictStatus := tc.Status.TiKV.EvictLeader[pod.Name]; evictStatus != nil && !evictStatus.BeginTime.IsZero() {
+				metrics.ObserveClusterHistogram(metrics.ClusterLeaderEvictionDuration, time.Since(evictStatus.BeginTime.Time).Seconds(),
+					tc.Namespace, tc.Name, v1alpha1.TiKVMemberType.String())
+			}
 
 			err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 				delete(tc.Status.TiKV.EvictLeader, pod.Name)
@@ -14,12 +14,19 @@
 package tidbcluster
 
 import (
+	"fmt"
+
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	utiltidbcluster "github.com/pingcap/tidb-operator/pkg/util/tidbcluster"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// defaultMaxReplicas is the default `replication.max-replicas` of PD
+const defaultMaxReplicas = 3
+
 // TidbClusterConditionUpdater interface that translates cluster state into
 // into tidb cluster status conditions.
 type TidbClusterConditionUpdater interface {
@@ -33,6 +40,7 @@ var _ TidbClusterConditionUpdater = &tidbClusterConditionUpdater{}
 
 func (u *tidbClusterConditionUpdater) Update(tc *v1alpha1.TidbCluster) error {
 	u.updateReadyCondition(tc)
+	u.updateComponentConditions(tc)
 	// in the future, we may return error when we need to Kubernetes API, etc.
 	return nil
 }
@@ -44,10 +52,16 @@ func allStatefulSetsAreUpToDate(tc *v1alpha1.TidbCluster) bool {
 		}
 		return status.CurrentRevision == status.UpdateRevision
 	}
+	for _, status := range tc.Status.TiKVGroups {
+		if !isUpToDate(status.StatefulSet, false) {
+			return false
+		}
+	}
 	return (isUpToDate(tc.Status.PD.StatefulSet, false)) &&
 		(isUpToDate(tc.Status.TiKV.StatefulSet, false)) &&
 		(isUpToDate(tc.Status.TiDB.StatefulSet, false)) &&
-		isUpToDate(tc.Status.TiFlash.StatefulSet, false)
+		isUpToDate(tc.Status.TiFlash.StatefulSet, false) &&
+		isUpToDate(tc.Status.TiFlashCompute.StatefulSet, false)
 }
 
 func (u *tidbClusterConditionUpdater) updateReadyCondition(tc *v1alpha1.TidbCluster) {
@@ -62,13 +76,13 @@ func (u *tidbClusterConditionUpdater) updateReadyCondition(tc *v1alpha1.TidbClus
 	case tc.Spec.PD != nil && !tc.PDAllMembersReady():
 		reason = utiltidbcluster.PDUnhealthy
 		message = "PD(s) are not healthy"
-	case tc.Spec.TiKV != nil && !tc.TiKVAllStoresReady():
+	case tc.Spec.TiKV != nil && (!tc.TiKVAllStoresReady() || !tc.TiKVGroupsAllStoresReady()):
 		reason = utiltidbcluster.TiKVStoreNotUp
 		message = "TiKV store(s) are not up"
 	case tc.Spec.TiDB != nil && !tc.TiDBAllMembersReady():
 		reason = utiltidbcluster.TiDBUnhealthy
 		message = "TiDB(s) are not healthy"
-	case tc.Spec.TiFlash != nil && !tc.TiFlashAllStoresReady():
+	case tc.Spec.TiFlash != nil && (!tc.TiFlashAllStoresReady() || !tc.TiFlashComputeAllStoresReady()):
 		reason = utiltidbcluster.TiFlashStoreNotUp
 		message = "TiFlash store(s) are not up"
 	case tc.Spec.TiCDC != nil && !tc.TiCDCAllCapturesReady():
@@ -82,3 +96,248 @@ func (u *tidbClusterConditionUpdater) updateReadyCondition(tc *v1alpha1.TidbClus
 	cond := utiltidbcluster.NewTidbClusterCondition(v1alpha1.TidbClusterReady, status, reason, message)
 	utiltidbcluster.SetTidbClusterCondition(&tc.Status, *cond)
 }
+
+// componentHealth summarizes the members of a component.
+type componentHealth struct {
+	status v1alpha1.ComponentStatus
+	// desired is the number of replicas in spec
+	desired int
+	// healthy is the number of healthy members, i.e. healthy PD members, Up stores, ready captures, etc.
+	healthy int
+	// failures is the number of members which are failed over
+	failures int
+	// tolerable is the number of members which can be lost without breaking the raft quorum,
+	// it's -1 if the component is not based on raft.
+	tolerable int
+	// save writes the status back if it's a copy, e.g. the status of a TiKV group
+	save func()
+}
+
+// minAvailable returns the minimum number of healthy members to serve.
+func (h *componentHealth) minAvailable() int {
+	if h.tolerable < 0 {
+		return 1
+	}
+	return h.desired - h.tolerable
+}
+
+func getComponentHealths(tc *v1alpha1.TidbCluster) []*componentHealth {
+	var healths []*componentHealth
+
+	if tc.Spec.PD != nil {
+		h := &componentHealth{status: &tc.Status.PD, desired: int(tc.Spec.PD.Replicas), failures: len(tc.Status.PD.FailureMembers)}
+		for _, member := range tc.Status.PD.Members {
+			if member.Health {
+				h.healthy++
+			}
+		}
+		h.tolerable = (h.desired - 1) / 2
+		healths = append(healths, h)
+	}
+	if tc.Spec.TiKV != nil {
+		healths = append(healths, newTiKVHealth(tc, &tc.Status.TiKV, tc.Spec.TiKV.Replicas))
+	}
+	// each TiKV group is evaluated on its own, as if the regions are replicated in the group
+	for i := range tc.Spec.TiKVGroups {
+		name := tc.Spec.TiKVGroups[i].Name
+		status, ok := tc.Status.TiKVGroups[name]
+		if !ok {
+			continue
+		}
+		h := newTiKVHealth(tc, &status, tc.Spec.TiKVGroups[i].Replicas)
+		h.save = func() { tc.Status.TiKVGroups[name] = status }
+		healths = append(healths, h)
+	}
+	if tc.Spec.TiDB != nil {
+		h := &componentHealth{status: &tc.Status.TiDB, desired: int(tc.Spec.TiDB.Replicas), failures: len(tc.Status.TiDB.FailureMembers), tolerable: -1}
+		for _, member := range tc.Status.TiDB.Members {
+			if member.Health {
+				h.healthy++
+			}
+		}
+		healths = append(healths, h)
+	}
+	if tc.Spec.TiFlash != nil {
+		h := &componentHealth{status: &tc.Status.TiFlash, desired: int(tc.Spec.TiFlash.Replicas), failures: len(tc.Status.TiFlash.FailureStores), tolerable: -1}
+		for _, store := range tc.Status.TiFlash.Stores {
+			if store.State == v1alpha1.TiKVStateUp {
+				h.healthy++
+			}
+		}
+		healths = append(healths, h)
+	}
+	if tc.Spec.TiCDC != nil {
+		h := &componentHealth{status: &tc.Status.TiCDC, desired: int(tc.Spec.TiCDC.Replicas), tolerable: -1}
+		for _, capture := range tc.Status.TiCDC.Captures {
+			if capture.Ready {
+				h.healthy++
+			}
+		}
+		healths = append(healths, h)
+	}
+	if tc.Spec.Pump != nil {
+		h := &componentHealth{status: &tc.Status.Pump, desired: int(tc.Spec.Pump.Replicas), tolerable: -1}
+		for _, member := range tc.Status.Pump.Members {
+			if member.State == v1alpha1.PumpStateOnline {
+				h.healthy++
+			}
+		}
+		healths = append(healths, h)
+	}
+	if tc.Spec.TiProxy != nil {
+		h := &componentHealth{status: &tc.Status.TiProxy, desired: int(tc.Spec.TiProxy.Replicas), tolerable: -1}
+		for _, member := range tc.Status.TiProxy.Members {
+			if member.Health {
+				h.healthy++
+			}
+		}
+		healths = append(healths, h)
+	}
+
+	return healths
+}
+
+func newTiKVHealth(tc *v1alpha1.TidbCluster, status *v1alpha1.TiKVStatus, replicas int32) *componentHealth {
+	h := &componentHealth{status: status, desired: int(replicas), failures: len(status.FailureStores)}
+	for _, store := range status.Stores {
+		if store.State == v1alpha1.TiKVStateUp {
+			h.healthy++
+		}
+	}
+	h.tolerable = (getMaxReplicas(tc) - 1) / 2
+	return h
+}
+
+// getMaxReplicas returns the `replication.max-replicas` of PD, which determines the
+// number of TiKV stores that can be lost without losing the quorum of the regions.
+func getMaxReplicas(tc *v1alpha1.TidbCluster) int {
+	if tc.Spec.PD != nil && tc.Spec.PD.Config != nil {
+		if v := tc.Spec.PD.Config.Get("replication.max-replicas"); v != nil {
+			if n, err := v.AsInt(); err == nil && n > 0 {
+				return int(n)
+			}
+		}
+	}
+	return defaultMaxReplicas
+}
+
+func (u *tidbClusterConditionUpdater) updateComponentConditions(tc *v1alpha1.TidbCluster) {
+	for _, h := range getComponentHealths(tc) {
+		h.status.SetCondition(availableCondition(h))
+		h.status.SetCondition(progressingCondition(h))
+		h.status.SetCondition(degradedCondition(h))
+		if h.tolerable >= 0 {
+			h.status.SetCondition(quorumAtRiskCondition(h))
+		}
+
+		// the blocked conditions are set by the upgrader and the scaler,
+		// clear them once the component is not upgrading or scaling any more
+		phase := h.status.GetPhase()
+		ensureUnblocked(h.status, v1alpha1.ComponentConditionUpgradeBlocked, phase != v1alpha1.UpgradePhase && isStatefulSetUpToDate(h.status))
+		ensureUnblocked(h.status, v1alpha1.ComponentConditionScaleBlocked, phase != v1alpha1.ScalePhase)
+		if h.save != nil {
+			h.save()
+		}
+	}
+}
+
+func availableCondition(h *componentHealth) metav1.Condition {
+	cond := metav1.Condition{Type: v1alpha1.ComponentConditionAvailable}
+	minAvailable := h.minAvailable()
+	switch {
+	case h.status.GetPhase() == v1alpha1.SuspendPhase:
+		cond.Status, cond.Reason = metav1.ConditionFalse, v1alpha1.ConditionReasonSuspended
+		cond.Message = fmt.Sprintf("%s is suspended", h.status.MemberType())
+	case h.desired == 0:
+		cond.Status, cond.Reason = metav1.ConditionFalse, v1alpha1.ConditionReasonNoReplicas
+		cond.Message = fmt.Sprintf("%s has no replicas", h.status.MemberType())
+	case h.healthy < minAvailable:
+		cond.Status, cond.Reason = metav1.ConditionFalse, v1alpha1.ConditionReasonMinimumMembersUnavailable
+		cond.Message = fmt.Sprintf("%d healthy member(s), at least %d required", h.healthy, minAvailable)
+	default:
+		cond.Status, cond.Reason = metav1.ConditionTrue, v1alpha1.ConditionReasonMinimumMembersAvailable
+		cond.Message = fmt.Sprintf("%d healthy member(s), at least %d required", h.healthy, minAvailable)
+	}
+	return cond
+}
+
+func progressingCondition(h *componentHealth) metav1.Condition {
+	cond := metav1.Condition{Type: v1alpha1.ComponentConditionProgressing}
+	switch {
+	case h.status.GetPhase() == v1alpha1.UpgradePhase:
+		cond.Status, cond.Reason = metav1.ConditionTrue, v1alpha1.ConditionReasonUpgrading
+		cond.Message = fmt.Sprintf("%s is upgrading", h.status.MemberType())
+	case h.status.GetPhase() == v1alpha1.ScalePhase:
+		cond.Status, cond.Reason = metav1.ConditionTrue, v1alpha1.ConditionReasonScaling
+		cond.Message = fmt.Sprintf("%s is scaling", h.status.MemberType())
+	case !isStatefulSetUpToDate(h.status):
+		cond.Status, cond.Reason = metav1.ConditionTrue, v1alpha1.ConditionReasonStatefulSetNotUpToDate
+		cond.Message = fmt.Sprintf("statefulset of %s is in progress", h.status.MemberType())
+	default:
+		cond.Status, cond.Reason = metav1.ConditionFalse, v1alpha1.ConditionReasonUpToDate
+		cond.Message = fmt.Sprintf("%s is up to date", h.status.MemberType())
+	}
+	return cond
+}
+
+func degradedCondition(h *componentHealth) metav1.Condition {
+	cond := metav1.Condition{Type: v1alpha1.ComponentConditionDegraded}
+	switch {
+	case h.healthy < h.desired && h.status.GetPhase() != v1alpha1.SuspendPhase:
+		cond.Status, cond.Reason = metav1.ConditionTrue, v1alpha1.ConditionReasonMembersUnhealthy
+		cond.Message = fmt.Sprintf("%d of %d member(s) are healthy", h.healthy, h.desired)
+	case h.failures > 0:
+		cond.Status, cond.Reason = metav1.ConditionTrue, v1alpha1.ConditionReasonFailureMembers
+		cond.Message = fmt.Sprintf("%d member(s) are failed over", h.failures)
+	default:
+		cond.Status, cond.Reason = metav1.ConditionFalse, v1alpha1.ConditionReasonAllMembersHealthy
+		cond.Message = fmt.Sprintf("all %d member(s) are healthy", h.desired)
+	}
+	return cond
+}
+
+func quorumAtRiskCondition(h *componentHealth) metav1.Condition {
+	cond := metav1.Condition{Type: v1alpha1.ComponentConditionQuorumAtRisk}
+	lost := h.desired - h.healthy
+	if lost < 0 {
+		lost = 0
+	}
+	switch {
+	case h.desired == 0 || h.status.GetPhase() == v1alpha1.SuspendPhase:
+		cond.Status, cond.Reason = metav1.ConditionFalse, v1alpha1.ConditionReasonQuorumHealthy
+		cond.Message = fmt.Sprintf("%s has no running members", h.status.MemberType())
+	case h.tolerable == 0 && lost == 0:
+		// e.g. 1 or 2 PD members, the quorum can't tolerate any failure by design
+		cond.Status, cond.Reason = metav1.ConditionFalse, v1alpha1.ConditionReasonNoRedundancy
+		cond.Message = fmt.Sprintf("all %d member(s) are healthy, but no failure can be tolerated with %d member(s)", h.desired, h.desired)
+	case lost > h.tolerable:
+		cond.Status, cond.Reason = metav1.ConditionTrue, v1alpha1.ConditionReasonQuorumLost
+		cond.Message = fmt.Sprintf("%d member(s) are unhealthy, at most %d can be tolerated", lost, h.tolerable)
+	case lost == h.tolerable:
+		cond.Status, cond.Reason = metav1.ConditionTrue, v1alpha1.ConditionReasonNoFaultTolerance
+		cond.Message = fmt.Sprintf("%d member(s) are unhealthy, no more failure can be tolerated", lost)
+	default:
+		cond.Status, cond.Reason = metav1.ConditionFalse, v1alpha1.ConditionReasonQuorumHealthy
+		cond.Message = fmt.Sprintf("%d more member failure(s) can be tolerated", h.tolerable-lost)
+	}
+	return cond
+}
+
+// ensureUnblocked makes the blocked condition present so that it can be waited for,
+// and turns it False once the operation is not in progress.
+func ensureUnblocked(status v1alpha1.ComponentStatus, conditionType string, done bool) {
+	cond := meta.FindStatusCondition(status.GetConditions(), conditionType)
+	if cond != nil && (cond.Status == metav1.ConditionFalse || !done) {
+		return
+	}
+	status.SetCondition(metav1.Condition{
+		Type:   conditionType,
+		Status: metav1.ConditionFalse,
+		Reason: v1alpha1.ConditionReasonUnblocked,
+	})
+}
+
+func isStatefulSetUpToDate(status v1alpha1.ComponentStatus) bool {
+	sts := status.GetStatefulSet()
+	return sts == nil || sts.CurrentRevision == sts.UpdateRevision
+}
@@ -14,6 +14,7 @@
 package tidbcluster
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -21,6 +22,8 @@ import (
 	utiltidbcluster "github.com/pingcap/tidb-operator/pkg/util/tidbcluster"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestTidbClusterConditionUpdater_Ready(t *testing.T) {
@@ -428,3 +431,217 @@ func TestTidbClusterConditionUpdater_Ready(t *testing.T) {
 		})
 	}
 }
+
+func TestTidbClusterConditionUpdater_ComponentConditions(t *testing.T) {
+	pdMembers := func(healthy ...bool) map[string]v1alpha1.PDMember {
+		members := map[string]v1alpha1.PDMember{}
+		for i, h := range healthy {
+			members[fmt.Sprintf("pd-%d", i)] = v1alpha1.PDMember{Health: h}
+		}
+		return members
+	}
+	tikvStores := func(states ...string) map[string]v1alpha1.TiKVStore {
+		stores := map[string]v1alpha1.TiKVStore{}
+		for i, state := range states {
+			stores[fmt.Sprintf("%d", i)] = v1alpha1.TiKVStore{State: state}
+		}
+		return stores
+	}
+	pdConfig := v1alpha1.NewPDConfig()
+	pdConfig.Set("replication.max-replicas", 5)
+
+	tests := []struct {
+		name   string
+		tc     *v1alpha1.TidbCluster
+		status func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus
+		// condition type -> reason
+		want map[string]string
+	}{
+		{
+			name: "all pd members are healthy",
+			tc: &v1alpha1.TidbCluster{
+				Spec:   v1alpha1.TidbClusterSpec{PD: &v1alpha1.PDSpec{Replicas: 3}},
+				Status: v1alpha1.TidbClusterStatus{PD: v1alpha1.PDStatus{Phase: v1alpha1.NormalPhase, Members: pdMembers(true, true, true)}},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus { return &tc.Status.PD },
+			want: map[string]string{
+				v1alpha1.ComponentConditionAvailable:      v1alpha1.ConditionReasonMinimumMembersAvailable,
+				v1alpha1.ComponentConditionProgressing:    v1alpha1.ConditionReasonUpToDate,
+				v1alpha1.ComponentConditionDegraded:       v1alpha1.ConditionReasonAllMembersHealthy,
+				v1alpha1.ComponentConditionQuorumAtRisk:   v1alpha1.ConditionReasonQuorumHealthy,
+				v1alpha1.ComponentConditionUpgradeBlocked: v1alpha1.ConditionReasonUnblocked,
+				v1alpha1.ComponentConditionScaleBlocked:   v1alpha1.ConditionReasonUnblocked,
+			},
+		},
+		{
+			name: "one of three pd members is unhealthy",
+			tc: &v1alpha1.TidbCluster{
+				Spec:   v1alpha1.TidbClusterSpec{PD: &v1alpha1.PDSpec{Replicas: 3}},
+				Status: v1alpha1.TidbClusterStatus{PD: v1alpha1.PDStatus{Phase: v1alpha1.NormalPhase, Members: pdMembers(true, true, false)}},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus { return &tc.Status.PD },
+			want: map[string]string{
+				v1alpha1.ComponentConditionAvailable:    v1alpha1.ConditionReasonMinimumMembersAvailable,
+				v1alpha1.ComponentConditionDegraded:     v1alpha1.ConditionReasonMembersUnhealthy,
+				v1alpha1.ComponentConditionQuorumAtRisk: v1alpha1.ConditionReasonNoFaultTolerance,
+			},
+		},
+		{
+			name: "pd quorum is lost",
+			tc: &v1alpha1.TidbCluster{
+				Spec:   v1alpha1.TidbClusterSpec{PD: &v1alpha1.PDSpec{Replicas: 3}},
+				Status: v1alpha1.TidbClusterStatus{PD: v1alpha1.PDStatus{Phase: v1alpha1.NormalPhase, Members: pdMembers(true, false, false)}},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus { return &tc.Status.PD },
+			want: map[string]string{
+				v1alpha1.ComponentConditionAvailable:    v1alpha1.ConditionReasonMinimumMembersUnavailable,
+				v1alpha1.ComponentConditionQuorumAtRisk: v1alpha1.ConditionReasonQuorumLost,
+			},
+		},
+		{
+			name: "single pd member has no redundancy",
+			tc: &v1alpha1.TidbCluster{
+				Spec:   v1alpha1.TidbClusterSpec{PD: &v1alpha1.PDSpec{Replicas: 1}},
+				Status: v1alpha1.TidbClusterStatus{PD: v1alpha1.PDStatus{Phase: v1alpha1.NormalPhase, Members: pdMembers(true)}},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus { return &tc.Status.PD },
+			want: map[string]string{
+				v1alpha1.ComponentConditionAvailable:    v1alpha1.ConditionReasonMinimumMembersAvailable,
+				v1alpha1.ComponentConditionQuorumAtRisk: v1alpha1.ConditionReasonNoRedundancy,
+			},
+		},
+		{
+			name: "one of two pd members is unhealthy",
+			tc: &v1alpha1.TidbCluster{
+				Spec:   v1alpha1.TidbClusterSpec{PD: &v1alpha1.PDSpec{Replicas: 2}},
+				Status: v1alpha1.TidbClusterStatus{PD: v1alpha1.PDStatus{Phase: v1alpha1.NormalPhase, Members: pdMembers(true, false)}},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus { return &tc.Status.PD },
+			want: map[string]string{
+				v1alpha1.ComponentConditionAvailable:    v1alpha1.ConditionReasonMinimumMembersUnavailable,
+				v1alpha1.ComponentConditionQuorumAtRisk: v1alpha1.ConditionReasonQuorumLost,
+			},
+		},
+		{
+			name: "tikv group is evaluated on its own",
+			tc: &v1alpha1.TidbCluster{
+				Spec: v1alpha1.TidbClusterSpec{
+					TiKV:       &v1alpha1.TiKVSpec{Replicas: 3},
+					TiKVGroups: []v1alpha1.TiKVGroupSpec{{Name: "g1", TiKVSpec: v1alpha1.TiKVSpec{Replicas: 3}}},
+				},
+				Status: v1alpha1.TidbClusterStatus{
+					TiKV: v1alpha1.TiKVStatus{
+						Phase:  v1alpha1.NormalPhase,
+						Stores: tikvStores(v1alpha1.TiKVStateUp, v1alpha1.TiKVStateUp, v1alpha1.TiKVStateUp),
+					},
+					TiKVGroups: map[string]v1alpha1.TiKVStatus{
+						"g1": {
+							Phase:  v1alpha1.NormalPhase,
+							Stores: tikvStores(v1alpha1.TiKVStateUp, v1alpha1.TiKVStateUp, v1alpha1.TiKVStateDown),
+						},
+					},
+				},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus {
+				status := tc.Status.TiKVGroups["g1"]
+				return &status
+			},
+			want: map[string]string{
+				v1alpha1.ComponentConditionDegraded:     v1alpha1.ConditionReasonMembersUnhealthy,
+				v1alpha1.ComponentConditionQuorumAtRisk: v1alpha1.ConditionReasonNoFaultTolerance,
+			},
+		},
+		{
+			name: "tikv tolerates failures according to max-replicas",
+			tc: &v1alpha1.TidbCluster{
+				Spec: v1alpha1.TidbClusterSpec{
+					PD:   &v1alpha1.PDSpec{Replicas: 1, Config: pdConfig},
+					TiKV: &v1alpha1.TiKVSpec{Replicas: 5},
+				},
+				Status: v1alpha1.TidbClusterStatus{TiKV: v1alpha1.TiKVStatus{
+					Phase:  v1alpha1.NormalPhase,
+					Stores: tikvStores(v1alpha1.TiKVStateUp, v1alpha1.TiKVStateUp, v1alpha1.TiKVStateUp, v1alpha1.TiKVStateDown, v1alpha1.TiKVStateDown),
+				}},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus { return &tc.Status.TiKV },
+			want: map[string]string{
+				v1alpha1.ComponentConditionAvailable:    v1alpha1.ConditionReasonMinimumMembersAvailable,
+				v1alpha1.ComponentConditionDegraded:     v1alpha1.ConditionReasonMembersUnhealthy,
+				v1alpha1.ComponentConditionQuorumAtRisk: v1alpha1.ConditionReasonNoFaultTolerance,
+			},
+		},
+		{
+			name: "tikv upgrade is blocked",
+			tc: &v1alpha1.TidbCluster{
+				Spec: v1alpha1.TidbClusterSpec{TiKV: &v1alpha1.TiKVSpec{Replicas: 1}},
+				Status: v1alpha1.TidbClusterStatus{TiKV: v1alpha1.TiKVStatus{
+					Phase:  v1alpha1.UpgradePhase,
+					Stores: tikvStores(v1alpha1.TiKVStateUp),
+					Conditions: []metav1.Condition{{
+						Type:   v1alpha1.ComponentConditionUpgradeBlocked,
+						Status: metav1.ConditionTrue,
+						Reason: v1alpha1.EventReasonUpgradeBlocked,
+					}},
+				}},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus { return &tc.Status.TiKV },
+			want: map[string]string{
+				v1alpha1.ComponentConditionProgressing:    v1alpha1.ConditionReasonUpgrading,
+				v1alpha1.ComponentConditionUpgradeBlocked: v1alpha1.EventReasonUpgradeBlocked,
+			},
+		},
+		{
+			name: "stale upgrade blocked condition is cleared",
+			tc: &v1alpha1.TidbCluster{
+				Spec: v1alpha1.TidbClusterSpec{TiKV: &v1alpha1.TiKVSpec{Replicas: 1}},
+				Status: v1alpha1.TidbClusterStatus{TiKV: v1alpha1.TiKVStatus{
+					Phase:  v1alpha1.NormalPhase,
+					Stores: tikvStores(v1alpha1.TiKVStateUp),
+					Conditions: []metav1.Condition{{
+						Type:   v1alpha1.ComponentConditionUpgradeBlocked,
+						Status: metav1.ConditionTrue,
+						Reason: v1alpha1.EventReasonUpgradeBlocked,
+					}},
+				}},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus { return &tc.Status.TiKV },
+			want: map[string]string{
+				v1alpha1.ComponentConditionProgressing:    v1alpha1.ConditionReasonUpToDate,
+				v1alpha1.ComponentConditionUpgradeBlocked: v1alpha1.ConditionReasonUnblocked,
+			},
+		},
+		{
+			name: "tidb is suspended",
+			tc: &v1alpha1.TidbCluster{
+				Spec:   v1alpha1.TidbClusterSpec{TiDB: &v1alpha1.TiDBSpec{Replicas: 2}},
+				Status: v1alpha1.TidbClusterStatus{TiDB: v1alpha1.TiDBStatus{Phase: v1alpha1.SuspendPhase}},
+			},
+			status: func(tc *v1alpha1.TidbCluster) v1alpha1.ComponentStatus { return &tc.Status.TiDB },
+			want: map[string]string{
+				v1alpha1.ComponentConditionAvailable: v1alpha1.ConditionReasonSuspended,
+				v1alpha1.ComponentConditionDegraded:  v1alpha1.ConditionReasonAllMembersHealthy,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditionUpdater := &tidbClusterConditionUpdater{}
+			conditionUpdater.Update(tt.tc)
+			conds := tt.status(tt.tc).GetConditions()
+			for typ, reason := range tt.want {
+				cond := meta.FindStatusCondition(conds, typ)
+				if cond == nil {
+					t.Errorf("condition %s is not found", typ)
+					continue
+				}
+				if diff := cmp.Diff(reason, cond.Reason); diff != "" {
+					t.Errorf("unexpected reason of %s (-want, +got): %s", typ, diff)
+				}
+			}
+			if tt.tc.Spec.TiDB != nil && meta.FindStatusCondition(tt.tc.Status.TiDB.Conditions, v1alpha1.ComponentConditionQuorumAtRisk) != nil {
+				t.Errorf("unexpected condition %s for tidb", v1alpha1.ComponentConditionQuorumAtRisk)
+			}
+		})
+	}
+}
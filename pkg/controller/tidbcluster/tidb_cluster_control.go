@@ -44,10 +44,13 @@ type ControlInterface interface {
 func NewDefaultTidbClusterControl(
 	tcControl controller.TidbClusterControlInterface,
 	pdMemberManager manager.Manager,
+	pdmsMemberManager manager.Manager,
 	tikvMemberManager manager.Manager,
 	tidbMemberManager manager.Manager,
+	resourceGroupManager manager.Manager,
 	tiproxyMemberManager manager.Manager,
 	reclaimPolicyManager manager.Manager,
+	tlsCertManager manager.Manager,
 	metaManager manager.Manager,
 	orphanPodsCleaner member.OrphanPodsCleaner,
 	pvcCleaner member.PVCCleanerInterface,
@@ -62,20 +65,23 @@ func NewDefaultTidbClusterControl(
 	recorder record.EventRecorder) ControlInterface {
 	return &defaultTidbClusterControl{
 		tcControl:                tcControl,
-		pdMemberManager:          pdMemberManager,
-		tikvMemberManager:        tikvMemberManager,
-		tidbMemberManager:        tidbMemberManager,
-		tiproxyMemberManager:     tiproxyMemberManager,
-		reclaimPolicyManager:     reclaimPolicyManager,
-		metaManager:              metaManager,
+		pdMemberManager:          manager.WithTracing("pd", pdMemberManager),
+		pdmsMemberManager:        manager.WithTracing("pdms", pdmsMemberManager),
+		tikvMemberManager:        manager.WithTracing("tikv", tikvMemberManager),
+		tidbMemberManager:        manager.WithTracing("tidb", tidbMemberManager),
+		resourceGroupManager:     manager.WithTracing("resource-group", resourceGroupManager),
+		tiproxyMemberManager:     manager.WithTracing("tiproxy", tiproxyMemberManager),
+		reclaimPolicyManager:     manager.WithTracing("reclaim-policy", reclaimPolicyManager),
+		tlsCertManager:           manager.WithTracing("tls-cert", tlsCertManager),
+		metaManager:              manager.WithTracing("meta", metaManager),
 		orphanPodsCleaner:        orphanPodsCleaner,
 		pvcCleaner:               pvcCleaner,
 		pvcModifier:              pvcModifier,
-		pumpMemberManager:        pumpMemberManager,
-		tiflashMemberManager:     tiflashMemberManager,
-		ticdcMemberManager:       ticdcMemberManager,
+		pumpMemberManager:        manager.WithTracing("pump", pumpMemberManager),
+		tiflashMemberManager:     manager.WithTracing("tiflash", tiflashMemberManager),
+		ticdcMemberManager:       manager.WithTracing("ticdc", ticdcMemberManager),
 		discoveryManager:         discoveryManager,
-		tidbClusterStatusManager: tidbClusterStatusManager,
+		tidbClusterStatusManager: manager.WithTracing("status", tidbClusterStatusManager),
 		conditionUpdater:         conditionUpdater,
 		recorder:                 recorder,
 	}
@@ -84,10 +90,13 @@ func NewDefaultTidbClusterControl(
 type defaultTidbClusterControl struct {
 	tcControl                controller.TidbClusterControlInterface
 	pdMemberManager          manager.Manager
+	pdmsMemberManager        manager.Manager
 	tikvMemberManager        manager.Manager
 	tidbMemberManager        manager.Manager
+	resourceGroupManager     manager.Manager
 	tiproxyMemberManager     manager.Manager
 	reclaimPolicyManager     manager.Manager
+	tlsCertManager           manager.Manager
 	metaManager              manager.Manager
 	orphanPodsCleaner        member.OrphanPodsCleaner
 	pvcCleaner               member.PVCCleanerInterface
@@ -163,6 +172,12 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 		}
 	}
 
+	// issuing or renewing the certificates of the components if they're managed by the operator,
+	// the components are rolling restarted by the member managers to load the renewed certificates
+	if err := c.tlsCertManager.Sync(tc); err != nil {
+		return err
+	}
+
 	// reconcile TiDB discovery service
 	if err := c.discoveryManager.Reconcile(tc); err != nil {
 		return err
@@ -183,6 +198,17 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 		return err
 	}
 
+	// works that should be done to make the pd microservices current state match the desired state:
+	//   - waiting for the pd cluster available
+	//   - create or update the headless service of each microservice
+	//   - create the statefulset of each microservice
+	//   - sync the members registered in pd to TidbCluster object
+	//   - upgrade the microservices after pd, tso before scheduling
+	//   - scale out/in the microservices
+	if err := c.pdmsMemberManager.Sync(tc); err != nil {
+		return err
+	}
+
 	// works that should be done to make the tiproxy cluster current state match the desired state:
 	//   - create or update the tiproxy service
 	//   - create or update the tiproxy headless service
@@ -238,6 +264,16 @@ func (c *defaultTidbClusterControl) updateTidbCluster(tc *v1alpha1.TidbCluster)
 		return err
 	}
 
+	// syncing the resource groups in TiDB:
+	//   - waiting for the tidb cluster available(at least one member is healthy)
+	//   - create or alter the resource groups in spec.resourceGroups
+	//   - drop the resource groups removed from spec.resourceGroups
+	//   - sync the consumed RU of resource groups from pd to TidbCluster object
+	// failures are recorded as Warning events and don't block the sync of the later components
+	if err := c.resourceGroupManager.Sync(tc); err != nil {
+		return err
+	}
+
 	// works that should be done to make the ticdc cluster current state match the desired state:
 	//   - waiting for the pd cluster available(pd cluster is in quorum)
 	//   - waiting for the tikv cluster available(at least one peer works)
@@ -299,6 +335,29 @@ func (c *defaultTidbClusterControl) recordMetrics(tc *v1alpha1.TidbCluster) {
 	if tc.Spec.Pump != nil {
 		metrics.ClusterSpecReplicas.WithLabelValues(ns, tcName, "pump").Set(float64(tc.Spec.Pump.Replicas))
 	}
+
+	for _, status := range tc.AllComponentStatus() {
+		component := status.MemberType().String()
+		if phase := status.GetPhase(); phase != "" {
+			metrics.ObserveComponentPhase(ns, tcName, component, string(phase), allMemberPhases)
+		}
+		for name, vol := range status.GetVolumes() {
+			metrics.SetClusterGauge(metrics.ClusterVolumeCount, float64(vol.BoundCount), ns, tcName, component, string(name), "bound")
+			metrics.SetClusterGauge(metrics.ClusterVolumeCount, float64(vol.ModifiedCount), ns, tcName, component, string(name), "modified")
+			metrics.SetClusterGauge(metrics.ClusterVolumeCount, float64(vol.ReplacedCount), ns, tcName, component, string(name), "replaced")
+		}
+	}
+	metrics.SetClusterGauge(metrics.ClusterFailureMembers, float64(len(tc.Status.PD.FailureMembers)), ns, tcName, v1alpha1.PDMemberType.String())
+	metrics.SetClusterGauge(metrics.ClusterFailureMembers, float64(len(tc.Status.TiKV.FailureStores)), ns, tcName, v1alpha1.TiKVMemberType.String())
+	metrics.SetClusterGauge(metrics.ClusterFailureMembers, float64(len(tc.Status.TiDB.FailureMembers)), ns, tcName, v1alpha1.TiDBMemberType.String())
+	metrics.SetClusterGauge(metrics.ClusterFailureMembers, float64(len(tc.Status.TiFlash.FailureStores)), ns, tcName, v1alpha1.TiFlashMemberType.String())
+}
+
+var allMemberPhases = []string{
+	string(v1alpha1.NormalPhase),
+	string(v1alpha1.UpgradePhase),
+	string(v1alpha1.ScalePhase),
+	string(v1alpha1.SuspendPhase),
 }
 
 var _ ControlInterface = &defaultTidbClusterControl{}
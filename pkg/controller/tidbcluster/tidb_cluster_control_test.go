@@ -310,8 +310,10 @@ func newFakeTidbClusterControl() (
 
 	tcUpdater := controller.NewFakeTidbClusterControl(tcInformer)
 	pdMemberManager := mm.NewFakePDMemberManager()
+	pdmsMemberManager := mm.NewFakePDMSMemberManager()
 	tikvMemberManager := mm.NewFakeTiKVMemberManager()
 	tidbMemberManager := mm.NewFakeTiDBMemberManager()
+	resourceGroupManager := mm.NewFakeResourceGroupManager()
 	reclaimPolicyManager := meta.NewFakeReclaimPolicyManager()
 	metaManager := meta.NewFakeMetaManager()
 	orphanPodCleaner := mm.NewFakeOrphanPodsCleaner()
@@ -326,10 +328,13 @@ func newFakeTidbClusterControl() (
 	control := NewDefaultTidbClusterControl(
 		tcUpdater,
 		pdMemberManager,
+		pdmsMemberManager,
 		tikvMemberManager,
 		tidbMemberManager,
+		resourceGroupManager,
 		tiproxyMemberManager,
 		reclaimPolicyManager,
+		mm.NewFakeTLSCertManager(),
 		metaManager,
 		orphanPodCleaner,
 		pvcCleaner,
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	perrors "github.com/pingcap/errors"
+	"go.opentelemetry.io/otel/attribute"
 	apps "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +35,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/manager/suspender"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
 	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/pingcap/tidb-operator/pkg/tracing"
 )
 
 // Controller controls tidbclusters.
@@ -56,10 +58,13 @@ func NewController(deps *controller.Dependencies) *Controller {
 		control: NewDefaultTidbClusterControl(
 			deps.TiDBClusterControl,
 			mm.NewPDMemberManager(deps, mm.NewPDScaler(deps), mm.NewPDUpgrader(deps), mm.NewPDFailover(deps), suspender, podVolumeModifier),
+			mm.NewPDMSMemberManager(deps, suspender),
 			mm.NewTiKVMemberManager(deps, mm.NewTiKVFailover(deps), mm.NewTiKVScaler(deps), mm.NewTiKVUpgrader(deps, podVolumeModifier), suspender, podVolumeModifier),
 			mm.NewTiDBMemberManager(deps, mm.NewTiDBScaler(deps), mm.NewTiDBUpgrader(deps), mm.NewTiDBFailover(deps), suspender, podVolumeModifier),
+			mm.NewResourceGroupManager(deps),
 			mm.NewTiProxyMemberManager(deps, mm.NewTiProxyScaler(deps), mm.NewTiProxyUpgrader(deps), suspender),
 			meta.NewReclaimPolicyManager(deps),
+			mm.NewTLSCertManager(deps),
 			meta.NewMetaManager(deps),
 			mm.NewOrphanPodsCleaner(deps),
 			mm.NewRealPVCCleaner(deps),
@@ -164,6 +169,7 @@ func (c *Controller) sync(key string) error {
 	tc, err := c.deps.TiDBClusterLister.TidbClusters(ns).Get(name)
 	if errors.IsNotFound(err) {
 		klog.Infof("TidbCluster has been deleted %v", key)
+		metrics.DeleteClusterMetrics(ns, name)
 		return nil
 	}
 	if err != nil {
@@ -174,7 +180,9 @@ func (c *Controller) sync(key string) error {
 }
 
 func (c *Controller) syncTidbCluster(tc *v1alpha1.TidbCluster) error {
-	return c.control.UpdateTidbCluster(tc)
+	return tracing.Reconcile(tracing.TidbClusterKey(tc.Namespace, tc.Name), "TidbCluster.Reconcile", func() error {
+		return c.control.UpdateTidbCluster(tc)
+	}, attribute.String("namespace", tc.Namespace), attribute.String("name", tc.Name))
 }
 
 // enqueueTidbCluster enqueues the given tidbcluster in the work queue.
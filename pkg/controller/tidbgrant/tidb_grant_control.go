@@ -0,0 +1,194 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbgrant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1alpha1validation "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1/validation"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager"
+
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/util/slice"
+)
+
+// ControlInterface abstracts the business logic for TiDBGrant reconciliation.
+type ControlInterface interface {
+	Reconcile(*v1alpha1.TiDBGrant) error
+}
+
+func NewTiDBGrantControl(
+	deps *controller.Dependencies,
+	grantManager manager.TiDBGrantManager,
+	recorder record.EventRecorder,
+) ControlInterface {
+	return &defaultTiDBGrantControl{
+		deps:         deps,
+		recorder:     recorder,
+		grantManager: grantManager,
+	}
+}
+
+type defaultTiDBGrantControl struct {
+	deps     *controller.Dependencies
+	recorder record.EventRecorder
+
+	grantManager manager.TiDBGrantManager
+}
+
+func (c *defaultTiDBGrantControl) Reconcile(grant *v1alpha1.TiDBGrant) error {
+	// the deletion is handled before the validation, otherwise the finalizer of a TiDBGrant whose spec
+	// becomes invalid is never removed
+	if grant.DeletionTimestamp != nil {
+		if !slice.ContainsString(grant.Finalizers, label.TiDBGrantProtectionFinalizer, nil) {
+			return nil
+		}
+		// the privileges are revoked from the cluster recorded in the status
+		if err := c.grantManager.Clean(grant); err != nil {
+			return err
+		}
+		return c.removeProtectionFinalizer(grant)
+	}
+
+	if !c.validate(grant) {
+		return nil
+	}
+
+	ns := grant.GetNamespace()
+	user, err := c.deps.TiDBUserLister.TiDBUsers(ns).Get(grant.Spec.User)
+	if errors.IsNotFound(err) {
+		return controller.RequeueErrorf("TiDBUser %s/%s does not exist", ns, grant.Spec.User)
+	}
+	if err != nil {
+		return fmt.Errorf("get TiDBUser %s/%s failed: %s", ns, grant.Spec.User, err)
+	}
+	if user.DeletionTimestamp != nil {
+		return controller.RequeueErrorf("TiDBUser %s/%s is being deleted", ns, grant.Spec.User)
+	}
+	tcNamespace := user.GetClusterNamespace()
+	tc, err := c.deps.TiDBClusterLister.TidbClusters(tcNamespace).Get(user.Spec.Cluster.Name)
+	if errors.IsNotFound(err) {
+		return controller.RequeueErrorf("tc %s/%s does not exist", tcNamespace, user.Spec.Cluster.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("get tc %s/%s failed: %s", tcNamespace, user.Spec.Cluster.Name, err)
+	}
+
+	if grant, err = c.addProtectionFinalizer(grant); err != nil {
+		return err
+	}
+
+	oldStatus := grant.Status.DeepCopy()
+	syncErr := c.grantManager.Sync(grant, user, tc)
+	if !apiequality.Semantic.DeepEqual(&grant.Status, oldStatus) {
+		if _, err := c.updateStatus(grant.DeepCopy()); err != nil {
+			return err
+		}
+	}
+	return syncErr
+}
+
+// addProtectionFinalizer makes sure the privileges are revoked before the TiDBGrant is deleted
+func (c *defaultTiDBGrantControl) addProtectionFinalizer(grant *v1alpha1.TiDBGrant) (*v1alpha1.TiDBGrant, error) {
+	if slice.ContainsString(grant.Finalizers, label.TiDBGrantProtectionFinalizer, nil) {
+		return grant, nil
+	}
+	grant.Finalizers = append(grant.Finalizers, label.TiDBGrantProtectionFinalizer)
+	updated, err := c.deps.Clientset.PingcapV1alpha1().TiDBGrants(grant.Namespace).Update(context.TODO(), grant, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("add TiDBGrant %s/%s protection finalizers failed, err: %v", grant.Namespace, grant.Name, err)
+	}
+	return updated, nil
+}
+
+func (c *defaultTiDBGrantControl) removeProtectionFinalizer(grant *v1alpha1.TiDBGrant) error {
+	grant.Finalizers = slice.RemoveString(grant.Finalizers, label.TiDBGrantProtectionFinalizer, nil)
+	_, err := c.deps.Clientset.PingcapV1alpha1().TiDBGrants(grant.Namespace).Update(context.TODO(), grant, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("remove TiDBGrant %s/%s protection finalizers failed, err: %v", grant.Namespace, grant.Name, err)
+	}
+	klog.Infof("remove TiDBGrant %s/%s protection finalizers success", grant.Namespace, grant.Name)
+	return nil
+}
+
+func (c *defaultTiDBGrantControl) updateStatus(grant *v1alpha1.TiDBGrant) (*v1alpha1.TiDBGrant, error) {
+	var (
+		ns     = grant.GetNamespace()
+		name   = grant.GetName()
+		status = grant.Status.DeepCopy()
+		update *v1alpha1.TiDBGrant
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var updateErr error
+		update, updateErr = c.deps.Clientset.PingcapV1alpha1().TiDBGrants(ns).UpdateStatus(context.TODO(), grant, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.Infof("TiDBGrant: [%s/%s], update status successfully", ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("TiDBGrant: [%s/%s], update status failed, error: %v", ns, name, updateErr)
+
+		if updated, err := c.deps.TiDBGrantLister.TiDBGrants(ns).Get(name); err == nil {
+			grant = updated.DeepCopy()
+			grant.Status = *status
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated TiDBGrant %s/%s from lister: %v", ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("TiDBGrant: [%s/%s], failed to updateStatus, error: %v", ns, name, err)
+	}
+
+	return update, err
+}
+
+func (c *defaultTiDBGrantControl) validate(grant *v1alpha1.TiDBGrant) bool {
+	errs := v1alpha1validation.ValidateTiDBGrant(grant)
+	if len(errs) > 0 {
+		aggregatedErr := errs.ToAggregate()
+		klog.Errorf("TiDBGrant %s/%s is not valid and must be fixed first, aggregated error: %v", grant.GetNamespace(), grant.GetName(), aggregatedErr)
+		c.recorder.Event(grant, v1.EventTypeWarning, "FailedValidation", aggregatedErr.Error())
+		return false
+	}
+	return true
+}
+
+type FakeTiDBGrantControl struct {
+	reconcile func(grant *v1alpha1.TiDBGrant) error
+}
+
+func (c *FakeTiDBGrantControl) MockReconcile(reconcile func(*v1alpha1.TiDBGrant) error) {
+	c.reconcile = reconcile
+}
+
+func (c *FakeTiDBGrantControl) Reconcile(grant *v1alpha1.TiDBGrant) error {
+	if c.reconcile != nil {
+		return c.reconcile(grant)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbgrant
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/tidbuser"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReconcile(t *testing.T) {
+	type testcase struct {
+		name string
+
+		update      func(grant *v1alpha1.TiDBGrant)
+		noUser      bool
+		userDeleted bool
+		syncErr     error
+		errExpectFn func(*GomegaWithT, error)
+		expectFn    func(*GomegaWithT, *v1alpha1.TiDBGrant)
+	}
+
+	deleted := func(grant *v1alpha1.TiDBGrant) {
+		now := metav1.Now()
+		grant.DeletionTimestamp = &now
+		grant.Finalizers = []string{label.TiDBGrantProtectionFinalizer}
+	}
+
+	cases := []testcase{
+		{
+			name: "add finalizer",
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(Succeed())
+			},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant) {
+				g.Expect(grant.Finalizers).Should(ConsistOf(label.TiDBGrantProtectionFinalizer))
+			},
+		},
+		{
+			name: "validate failed",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Spec.Privileges = nil
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(Succeed())
+			},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant) {
+				g.Expect(grant.Finalizers).Should(BeEmpty())
+			},
+		},
+		{
+			name: "remove finalizer when validate failed",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				deleted(grant)
+				grant.Spec.Privileges = nil
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(Succeed())
+			},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant) {
+				g.Expect(grant.Finalizers).Should(BeEmpty())
+			},
+		},
+		{
+			name:   "user does not exist",
+			noUser: true,
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(controller.IsRequeueError(err)).Should(BeTrue())
+			},
+		},
+		{
+			name:        "user is being deleted",
+			userDeleted: true,
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(controller.IsRequeueError(err)).Should(BeTrue())
+			},
+		},
+		{
+			name:    "sync failed",
+			syncErr: fmt.Errorf("sync failed"),
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(MatchError("sync failed"))
+			},
+		},
+		{
+			name:   "remove finalizer",
+			update: deleted,
+			noUser: true,
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(Succeed())
+			},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant) {
+				g.Expect(grant.Finalizers).Should(BeEmpty())
+			},
+		},
+		{
+			name:    "clean failed",
+			update:  deleted,
+			syncErr: fmt.Errorf("clean failed"),
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(MatchError("clean failed"))
+			},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant) {
+				g.Expect(grant.Finalizers).Should(ConsistOf(label.TiDBGrantProtectionFinalizer))
+			},
+		},
+	}
+
+	for _, testcase := range cases {
+		t.Run(testcase.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			deps := controller.NewFakeDependencies()
+			grantManager := tidbuser.NewFakeGrantManager()
+			grantManager.SetSyncError(testcase.syncErr)
+			control := NewTiDBGrantControl(deps, grantManager, deps.Recorder)
+
+			tc := &v1alpha1.TidbCluster{ObjectMeta: metav1.ObjectMeta{Name: "basic", Namespace: corev1.NamespaceDefault}}
+			g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).Should(Succeed())
+			if !testcase.noUser {
+				user := newTiDBUserForTest()
+				if testcase.userDeleted {
+					now := metav1.Now()
+					user.DeletionTimestamp = &now
+				}
+				g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TiDBUsers().Informer().GetIndexer().Add(user)).Should(Succeed())
+			}
+			grant := newTiDBGrantForTest()
+			if testcase.update != nil {
+				testcase.update(grant)
+			}
+			_, err := deps.Clientset.PingcapV1alpha1().TiDBGrants(grant.Namespace).Create(context.TODO(), grant, metav1.CreateOptions{})
+			g.Expect(err).Should(Succeed())
+
+			err = control.Reconcile(grant.DeepCopy())
+			testcase.errExpectFn(g, err)
+			if testcase.expectFn != nil {
+				updated, err := deps.Clientset.PingcapV1alpha1().TiDBGrants(grant.Namespace).Get(context.TODO(), grant.Name, metav1.GetOptions{})
+				g.Expect(err).Should(Succeed())
+				testcase.expectFn(g, updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbgrant
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestControllerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type testcase struct {
+		name string
+
+		addGrantIndexer bool
+		reconcile       func(grant *v1alpha1.TiDBGrant) error
+
+		expectErrFn func(error)
+	}
+
+	cases := []testcase{
+		{
+			name:            "sync succeeded",
+			addGrantIndexer: true,
+			expectErrFn: func(err error) {
+				g.Expect(err).Should(Succeed())
+			},
+		},
+		{
+			name:            "tidb grant isn't found",
+			addGrantIndexer: false,
+			reconcile: func(grant *v1alpha1.TiDBGrant) error {
+				return fmt.Errorf("shouldn't arrive")
+			},
+			expectErrFn: func(err error) {
+				g.Expect(err).Should(Succeed())
+			},
+		},
+		{
+			name:            "reconcile tidb grant failed",
+			addGrantIndexer: true,
+			reconcile: func(grant *v1alpha1.TiDBGrant) error {
+				return fmt.Errorf("reconcile failed")
+			},
+			expectErrFn: func(err error) {
+				g.Expect(err).Should(MatchError("reconcile failed"))
+			},
+		},
+	}
+
+	for _, testcase := range cases {
+		t.Logf("testcase: %s", testcase.name)
+
+		fakeController, indexer := newFakeControllerForTest()
+		control := fakeController.control.(*FakeTiDBGrantControl)
+
+		grant := newTiDBGrantForTest()
+
+		if testcase.reconcile != nil {
+			control.MockReconcile(testcase.reconcile)
+		}
+		if testcase.addGrantIndexer {
+			err := indexer.Add(grant)
+			g.Expect(err).Should(Succeed())
+		}
+
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(grant)
+		g.Expect(err).Should(Succeed())
+
+		err = fakeController.sync(key)
+		testcase.expectErrFn(err)
+	}
+}
+
+func TestEnqueueGrantsForUser(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	fakeController, indexer := newFakeControllerForTest()
+	g.Expect(indexer.Add(newTiDBGrantForTest())).Should(Succeed())
+	other := newTiDBGrantForTest()
+	other.Name = "other"
+	other.Spec.User = "other"
+	g.Expect(indexer.Add(other)).Should(Succeed())
+
+	fakeController.enqueueGrantsForUser(newTiDBUserForTest())
+	g.Expect(fakeController.queue.Len()).Should(Equal(1))
+	key, _ := fakeController.queue.Get()
+	g.Expect(key).Should(Equal("default/app-db1"))
+}
+
+func newFakeControllerForTest() (*Controller, cache.Indexer) {
+	fakeDeps := controller.NewFakeDependencies()
+	indexer := fakeDeps.InformerFactory.Pingcap().V1alpha1().TiDBGrants().Informer().GetIndexer()
+	control := &FakeTiDBGrantControl{}
+
+	fakeController := NewController(fakeDeps)
+	fakeController.control = control
+
+	return fakeController, indexer
+}
+
+func newTiDBGrantForTest() *v1alpha1.TiDBGrant {
+	return &v1alpha1.TiDBGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-db1",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: v1alpha1.TiDBGrantSpec{
+			User:       "app",
+			Privileges: []string{"SELECT"},
+			Database:   "db1",
+		},
+	}
+}
+
+func newTiDBUserForTest() *v1alpha1.TiDBUser {
+	return &v1alpha1.TiDBUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: v1alpha1.TiDBUserSpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "basic"},
+			PasswordSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "app-password"},
+				Key:                  "password",
+			},
+		},
+	}
+}
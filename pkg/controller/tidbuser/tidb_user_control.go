@@ -0,0 +1,186 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1alpha1validation "github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1/validation"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager"
+
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/util/slice"
+)
+
+// ControlInterface abstracts the business logic for TiDBUser reconciliation.
+type ControlInterface interface {
+	Reconcile(*v1alpha1.TiDBUser) error
+}
+
+func NewTiDBUserControl(
+	deps *controller.Dependencies,
+	userManager manager.TiDBUserManager,
+	recorder record.EventRecorder,
+) ControlInterface {
+	return &defaultTiDBUserControl{
+		deps:        deps,
+		recorder:    recorder,
+		userManager: userManager,
+	}
+}
+
+type defaultTiDBUserControl struct {
+	deps     *controller.Dependencies
+	recorder record.EventRecorder
+
+	userManager manager.TiDBUserManager
+}
+
+func (c *defaultTiDBUserControl) Reconcile(user *v1alpha1.TiDBUser) error {
+	ns := user.GetClusterNamespace()
+	tc, err := c.deps.TiDBClusterLister.TidbClusters(ns).Get(user.Spec.Cluster.Name)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("get tc %s/%s failed: %s", ns, user.Spec.Cluster.Name, err)
+	}
+
+	// the deletion is handled before the validation, otherwise the finalizer of a TiDBUser whose spec
+	// becomes invalid is never removed. The user to drop is recorded in the status.
+	if user.DeletionTimestamp != nil {
+		if !slice.ContainsString(user.Finalizers, label.TiDBUserProtectionFinalizer, nil) {
+			return nil
+		}
+		// the user is dropped together with the cluster
+		if tc != nil {
+			if err := c.userManager.Clean(user, tc); err != nil {
+				return err
+			}
+		}
+		return c.removeProtectionFinalizer(user)
+	}
+
+	if !c.validate(user) {
+		return nil
+	}
+
+	if tc == nil {
+		return controller.RequeueErrorf("tc %s/%s does not exist", ns, user.Spec.Cluster.Name)
+	}
+
+	if user, err = c.addProtectionFinalizer(user); err != nil {
+		return err
+	}
+
+	oldStatus := user.Status.DeepCopy()
+	syncErr := c.userManager.Sync(user, tc)
+	if !apiequality.Semantic.DeepEqual(&user.Status, oldStatus) {
+		if _, err := c.updateStatus(user.DeepCopy()); err != nil {
+			return err
+		}
+	}
+	return syncErr
+}
+
+// addProtectionFinalizer makes sure the user in TiDB is dropped before the TiDBUser is deleted
+func (c *defaultTiDBUserControl) addProtectionFinalizer(user *v1alpha1.TiDBUser) (*v1alpha1.TiDBUser, error) {
+	if slice.ContainsString(user.Finalizers, label.TiDBUserProtectionFinalizer, nil) {
+		return user, nil
+	}
+	user.Finalizers = append(user.Finalizers, label.TiDBUserProtectionFinalizer)
+	updated, err := c.deps.Clientset.PingcapV1alpha1().TiDBUsers(user.Namespace).Update(context.TODO(), user, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("add TiDBUser %s/%s protection finalizers failed, err: %v", user.Namespace, user.Name, err)
+	}
+	return updated, nil
+}
+
+func (c *defaultTiDBUserControl) removeProtectionFinalizer(user *v1alpha1.TiDBUser) error {
+	user.Finalizers = slice.RemoveString(user.Finalizers, label.TiDBUserProtectionFinalizer, nil)
+	_, err := c.deps.Clientset.PingcapV1alpha1().TiDBUsers(user.Namespace).Update(context.TODO(), user, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("remove TiDBUser %s/%s protection finalizers failed, err: %v", user.Namespace, user.Name, err)
+	}
+	klog.Infof("remove TiDBUser %s/%s protection finalizers success", user.Namespace, user.Name)
+	return nil
+}
+
+func (c *defaultTiDBUserControl) updateStatus(user *v1alpha1.TiDBUser) (*v1alpha1.TiDBUser, error) {
+	var (
+		ns     = user.GetNamespace()
+		name   = user.GetName()
+		status = user.Status.DeepCopy()
+		update *v1alpha1.TiDBUser
+	)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var updateErr error
+		update, updateErr = c.deps.Clientset.PingcapV1alpha1().TiDBUsers(ns).UpdateStatus(context.TODO(), user, metav1.UpdateOptions{})
+		if updateErr == nil {
+			klog.Infof("TiDBUser: [%s/%s], update status successfully", ns, name)
+			return nil
+		}
+
+		klog.V(4).Infof("TiDBUser: [%s/%s], update status failed, error: %v", ns, name, updateErr)
+
+		if updated, err := c.deps.TiDBUserLister.TiDBUsers(ns).Get(name); err == nil {
+			user = updated.DeepCopy()
+			user.Status = *status
+		} else {
+			utilruntime.HandleError(fmt.Errorf("error getting updated TiDBUser %s/%s from lister: %v", ns, name, err))
+		}
+
+		return updateErr
+	})
+	if err != nil {
+		klog.Errorf("TiDBUser: [%s/%s], failed to updateStatus, error: %v", ns, name, err)
+	}
+
+	return update, err
+}
+
+func (c *defaultTiDBUserControl) validate(user *v1alpha1.TiDBUser) bool {
+	errs := v1alpha1validation.ValidateTiDBUser(user)
+	if len(errs) > 0 {
+		aggregatedErr := errs.ToAggregate()
+		klog.Errorf("TiDBUser %s/%s is not valid and must be fixed first, aggregated error: %v", user.GetNamespace(), user.GetName(), aggregatedErr)
+		c.recorder.Event(user, v1.EventTypeWarning, "FailedValidation", aggregatedErr.Error())
+		return false
+	}
+	return true
+}
+
+type FakeTiDBUserControl struct {
+	reconcile func(user *v1alpha1.TiDBUser) error
+}
+
+func (c *FakeTiDBUserControl) MockReconcile(reconcile func(*v1alpha1.TiDBUser) error) {
+	c.reconcile = reconcile
+}
+
+func (c *FakeTiDBUserControl) Reconcile(user *v1alpha1.TiDBUser) error {
+	if c.reconcile != nil {
+		return c.reconcile(user)
+	}
+	return nil
+}
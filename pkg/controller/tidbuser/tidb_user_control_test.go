@@ -0,0 +1,171 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/tidbuser"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReconcile(t *testing.T) {
+	type testcase struct {
+		name string
+
+		update      func(user *v1alpha1.TiDBUser)
+		noTC        bool
+		syncErr     error
+		errExpectFn func(*GomegaWithT, error)
+		expectFn    func(*GomegaWithT, *v1alpha1.TiDBUser)
+	}
+
+	cases := []testcase{
+		{
+			name: "add finalizer",
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(Succeed())
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser) {
+				g.Expect(user.Finalizers).Should(ConsistOf(label.TiDBUserProtectionFinalizer))
+			},
+		},
+		{
+			name: "validate failed",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.PasswordSecret = nil
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(Succeed())
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser) {
+				g.Expect(user.Finalizers).Should(BeEmpty())
+			},
+		},
+		{
+			name: "tc does not exist",
+			noTC: true,
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(controller.IsRequeueError(err)).Should(BeTrue())
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser) {
+				g.Expect(user.Finalizers).Should(BeEmpty())
+			},
+		},
+		{
+			name:    "sync failed",
+			syncErr: fmt.Errorf("sync failed"),
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(MatchError("sync failed"))
+			},
+		},
+		{
+			name: "remove finalizer",
+			update: func(user *v1alpha1.TiDBUser) {
+				now := metav1.Now()
+				user.DeletionTimestamp = &now
+				user.Finalizers = []string{label.TiDBUserProtectionFinalizer}
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(Succeed())
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser) {
+				g.Expect(user.Finalizers).Should(BeEmpty())
+			},
+		},
+		{
+			name: "remove finalizer when validate failed",
+			update: func(user *v1alpha1.TiDBUser) {
+				now := metav1.Now()
+				user.DeletionTimestamp = &now
+				user.Finalizers = []string{label.TiDBUserProtectionFinalizer}
+				user.Spec.PasswordSecret = nil
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(Succeed())
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser) {
+				g.Expect(user.Finalizers).Should(BeEmpty())
+			},
+		},
+		{
+			name: "remove finalizer when tc does not exist",
+			noTC: true,
+			update: func(user *v1alpha1.TiDBUser) {
+				now := metav1.Now()
+				user.DeletionTimestamp = &now
+				user.Finalizers = []string{label.TiDBUserProtectionFinalizer}
+			},
+			syncErr: fmt.Errorf("shouldn't clean"),
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(Succeed())
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser) {
+				g.Expect(user.Finalizers).Should(BeEmpty())
+			},
+		},
+		{
+			name: "clean failed",
+			update: func(user *v1alpha1.TiDBUser) {
+				now := metav1.Now()
+				user.DeletionTimestamp = &now
+				user.Finalizers = []string{label.TiDBUserProtectionFinalizer}
+			},
+			syncErr: fmt.Errorf("clean failed"),
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).Should(MatchError("clean failed"))
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser) {
+				g.Expect(user.Finalizers).Should(ConsistOf(label.TiDBUserProtectionFinalizer))
+			},
+		},
+	}
+
+	for _, testcase := range cases {
+		t.Run(testcase.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			deps := controller.NewFakeDependencies()
+			userManager := tidbuser.NewFakeUserManager()
+			userManager.SetSyncError(testcase.syncErr)
+			control := NewTiDBUserControl(deps, userManager, deps.Recorder)
+
+			if !testcase.noTC {
+				tc := &v1alpha1.TidbCluster{ObjectMeta: metav1.ObjectMeta{Name: "basic", Namespace: corev1.NamespaceDefault}}
+				g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).Should(Succeed())
+			}
+			user := newTiDBUserForTest()
+			if testcase.update != nil {
+				testcase.update(user)
+			}
+			_, err := deps.Clientset.PingcapV1alpha1().TiDBUsers(user.Namespace).Create(context.TODO(), user, metav1.CreateOptions{})
+			g.Expect(err).Should(Succeed())
+
+			err = control.Reconcile(user.DeepCopy())
+			testcase.errExpectFn(g, err)
+			if testcase.expectFn != nil {
+				updated, err := deps.Clientset.PingcapV1alpha1().TiDBUsers(user.Namespace).Get(context.TODO(), user.Name, metav1.GetOptions{})
+				g.Expect(err).Should(Succeed())
+				testcase.expectFn(g, updated)
+			}
+		})
+	}
+}
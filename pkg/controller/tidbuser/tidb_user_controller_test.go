@@ -0,0 +1,145 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestControllerSync(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type testcase struct {
+		name string
+
+		addUserIndexer bool
+		reconcile      func(user *v1alpha1.TiDBUser) error
+
+		expectErrFn func(error)
+	}
+
+	cases := []testcase{
+		{
+			name:           "sync succeeded",
+			addUserIndexer: true,
+			expectErrFn: func(err error) {
+				g.Expect(err).Should(Succeed())
+			},
+		},
+		{
+			name:           "tidb user isn't found",
+			addUserIndexer: false,
+			reconcile: func(user *v1alpha1.TiDBUser) error {
+				return fmt.Errorf("shouldn't arrive")
+			},
+			expectErrFn: func(err error) {
+				g.Expect(err).Should(Succeed())
+			},
+		},
+		{
+			name:           "reconcile tidb user failed",
+			addUserIndexer: true,
+			reconcile: func(user *v1alpha1.TiDBUser) error {
+				return fmt.Errorf("reconcile failed")
+			},
+			expectErrFn: func(err error) {
+				g.Expect(err).Should(MatchError("reconcile failed"))
+			},
+		},
+	}
+
+	for _, testcase := range cases {
+		t.Logf("testcase: %s", testcase.name)
+
+		fakeController, indexer := newFakeControllerForTest()
+		control := fakeController.control.(*FakeTiDBUserControl)
+
+		user := newTiDBUserForTest()
+
+		if testcase.reconcile != nil {
+			control.MockReconcile(testcase.reconcile)
+		}
+		if testcase.addUserIndexer {
+			err := indexer.Add(user)
+			g.Expect(err).Should(Succeed())
+		}
+
+		key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(user)
+		g.Expect(err).Should(Succeed())
+
+		err = fakeController.sync(key)
+		testcase.expectErrFn(err)
+	}
+}
+
+func TestEnqueueUsersForSecret(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	fakeController, indexer := newFakeControllerForTest()
+	user := newTiDBUserForTest()
+	g.Expect(indexer.Add(user)).Should(Succeed())
+	role := newTiDBUserForTest()
+	role.Name = "role"
+	role.Spec.Role = true
+	role.Spec.PasswordSecret = nil
+	g.Expect(indexer.Add(role)).Should(Succeed())
+
+	fakeController.enqueueUsersForSecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "other"},
+	})
+	g.Expect(fakeController.queue.Len()).Should(Equal(0))
+
+	fakeController.enqueueUsersForSecret(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceDefault, Name: "app-password"},
+	})
+	g.Expect(fakeController.queue.Len()).Should(Equal(1))
+	key, _ := fakeController.queue.Get()
+	g.Expect(key).Should(Equal("default/app"))
+}
+
+func newFakeControllerForTest() (*Controller, cache.Indexer) {
+	fakeDeps := controller.NewFakeDependencies()
+	indexer := fakeDeps.InformerFactory.Pingcap().V1alpha1().TiDBUsers().Informer().GetIndexer()
+	control := &FakeTiDBUserControl{}
+
+	fakeController := NewController(fakeDeps)
+	fakeController.control = control
+
+	return fakeController, indexer
+}
+
+func newTiDBUserForTest() *v1alpha1.TiDBUser {
+	return &v1alpha1.TiDBUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: v1alpha1.TiDBUserSpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "basic"},
+			PasswordSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "app-password"},
+				Key:                  "password",
+			},
+		},
+	}
+}
@@ -14,17 +14,21 @@
 package discovery
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 	"sync"
 
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/dmapi"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 )
@@ -33,7 +37,13 @@ import (
 type TiDBDiscovery interface {
 	Discover(string) (string, error)
 	DiscoverDM(string) (string, error)
+	// DiscoverMS returns the start args of the PD microservice member with the advertise listen url
+	DiscoverMS(string) (string, error)
 	VerifyPDEndpoint(string) (string, error)
+	// Reload replaces the peer knowledge with the one in the state store
+	Reload() error
+	// Status returns the current peer knowledge
+	Status() *State
 }
 
 type tidbDiscovery struct {
@@ -43,11 +53,31 @@ type tidbDiscovery struct {
 	dmClusters    map[string]*clusterInfo
 	pdControl     pdapi.PDControlInterface
 	masterControl dmapi.MasterControlInterface
+	store         StateStore
+	// saved is the state last saved to the store
+	saved []byte
 }
 
 type clusterInfo struct {
+	uid             types.UID
 	resourceVersion string
 	peers           map[string]struct{}
+	// initialMember is the member told to initialize the cluster, it is kept
+	// until the cluster is recreated so that only one member initializes it
+	initialMember string
+	// joined is set once another member is told to join the cluster, the
+	// initial member can not initialize it again since then
+	joined bool
+}
+
+// Option configures the TiDBDiscovery
+type Option func(*tidbDiscovery)
+
+// WithStateStore persists the peer knowledge in the store
+func WithStateStore(store StateStore) Option {
+	return func(d *tidbDiscovery) {
+		d.store = store
+	}
 }
 
 type pdEndpointURL struct {
@@ -58,14 +88,19 @@ type pdEndpointURL struct {
 }
 
 // NewTiDBDiscovery returns a TiDBDiscovery
-func NewTiDBDiscovery(pdControl pdapi.PDControlInterface, masterControl dmapi.MasterControlInterface, cli versioned.Interface, kubeCli kubernetes.Interface) TiDBDiscovery {
-	return &tidbDiscovery{
+func NewTiDBDiscovery(pdControl pdapi.PDControlInterface, masterControl dmapi.MasterControlInterface, cli versioned.Interface, kubeCli kubernetes.Interface, opts ...Option) TiDBDiscovery {
+	d := &tidbDiscovery{
 		cli:           cli,
 		pdControl:     pdControl,
 		masterControl: masterControl,
 		clusters:      map[string]*clusterInfo{},
 		dmClusters:    map[string]*clusterInfo{},
+		store:         NewMemoryStateStore(),
 	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
 }
 
 func (d *tidbDiscovery) Discover(advertisePeerUrl string) (string, error) {
@@ -96,30 +131,35 @@ func (d *tidbDiscovery) Discover(advertisePeerUrl string) (string, error) {
 	}
 	keyName := fmt.Sprintf("%s/%s", ns, tcName)
 
-	currentCluster := d.clusters[keyName]
-	if currentCluster == nil || currentCluster.resourceVersion != tc.ResourceVersion {
-		d.clusters[keyName] = &clusterInfo{
-			resourceVersion: tc.ResourceVersion,
-			peers:           map[string]struct{}{},
-		}
-	}
-	currentCluster = d.clusters[keyName]
-	currentCluster.peers[podName] = struct{}{}
+	currentCluster := getClusterInfo(d.clusters, keyName, tc.UID, tc.ResourceVersion)
+	defer d.saveStateOrLog()
 
-	// Should take failover replicas into consideration
-	if len(currentCluster.peers) == int(tc.PDStsDesiredReplicas()) && tc.Spec.Cluster == nil {
-		delete(currentCluster.peers, podName)
-		pdAddresses := tc.Spec.PDAddresses
-		// Join an existing PD cluster if tc.Spec.PDAddresses is set
-		if len(pdAddresses) != 0 {
-			return fmt.Sprintf("--join=%s", strings.Join(pdAddresses, ",")), nil
-		}
+	initialClusterArg := func() string {
 		// Initialize the PD cluster with the FQDN format service record if deploy across k8s or tc.Spec.ClusterDomain is set
 		if tc.AcrossK8s() || tc.Spec.ClusterDomain != "" {
-			return fmt.Sprintf("--initial-cluster=%s=%s://%s", strArr[0], tc.Scheme(), advertisePeerUrl), nil
+			return fmt.Sprintf("--initial-cluster=%s=%s://%s", strArr[0], tc.Scheme(), advertisePeerUrl)
 		}
 		// Initialize the PD cluster in the normal format service record.
-		return fmt.Sprintf("--initial-cluster=%s=%s://%s", podName, tc.Scheme(), advertisePeerUrl), nil
+		return fmt.Sprintf("--initial-cluster=%s=%s://%s", podName, tc.Scheme(), advertisePeerUrl)
+	}
+
+	// Once a member is told to initialize the cluster, all the other members join it
+	if currentCluster.initialMember == "" {
+		currentCluster.peers[podName] = struct{}{}
+
+		// Should take failover replicas into consideration
+		if len(currentCluster.peers) == int(tc.PDStsDesiredReplicas()) && tc.Spec.Cluster == nil {
+			delete(currentCluster.peers, podName)
+			pdAddresses := tc.Spec.PDAddresses
+			// Join an existing PD cluster if tc.Spec.PDAddresses is set
+			if len(pdAddresses) != 0 {
+				return fmt.Sprintf("--join=%s", strings.Join(pdAddresses, ",")), nil
+			}
+			if err := d.setInitialMember(currentCluster, podName); err != nil {
+				return "", err
+			}
+			return initialClusterArg(), nil
+		}
 	}
 
 	var pdClients []pdapi.PDClient
@@ -156,6 +196,14 @@ func (d *tidbDiscovery) Discover(advertisePeerUrl string) (string, error) {
 		}
 	}
 	if err != nil {
+		// The initial member asks again before the PD cluster is up, e.g. it
+		// is restarted before PD starts, so it is still the one to initialize
+		// the cluster if no other member has joined. Otherwise PD may just be
+		// unreachable and a second cluster would be initialized.
+		if currentCluster.initialMember == podName && !currentCluster.joined {
+			klog.Infof("PD cluster %s is not up, tell the initial member %s to initialize it again: %v", keyName, podName, err)
+			return initialClusterArg(), nil
+		}
 		return "", err
 	}
 
@@ -177,10 +225,71 @@ func (d *tidbDiscovery) Discover(advertisePeerUrl string) (string, error) {
 		memberURL := strings.ReplaceAll(member.PeerUrls[0], ":2380", ":2379")
 		membersArr = append(membersArr, memberURL)
 	}
+	if err := d.setJoined(currentCluster, podName); err != nil {
+		return "", err
+	}
 	delete(currentCluster.peers, podName)
 	return fmt.Sprintf("--join=%s", strings.Join(membersArr, ",")), nil
 }
 
+func (d *tidbDiscovery) DiscoverMS(advertiseListenUrl string) (string, error) {
+	if advertiseListenUrl == "" {
+		return "", fmt.Errorf("pd microservice advertiseListenUrl is empty")
+	}
+	klog.Infof("pd microservice advertiseListenUrl is: %s", advertiseListenUrl)
+	strArr := strings.Split(advertiseListenUrl, ":")
+	hostArr := strings.Split(strArr[0], ".")
+
+	if len(hostArr) < 4 || hostArr[3] != "svc" {
+		return "", fmt.Errorf("pd microservice advertiseListenUrl format is wrong: %s", advertiseListenUrl)
+	}
+
+	peerServiceName, ns := hostArr[1], hostArr[2]
+	podNamespace := os.Getenv("MY_POD_NAMESPACE")
+	if ns != podNamespace {
+		return "", fmt.Errorf("the pd microservice's namespace: %s is not equal to discovery namespace: %s", ns, podNamespace)
+	}
+
+	// the peer service name is <tc>-<microservice>-peer
+	tcName := ""
+	for _, name := range []string{label.PDMSTSOLabelVal, label.PDMSSchedulingLabelVal} {
+		suffix := fmt.Sprintf("-%s-peer", name)
+		if strings.HasSuffix(peerServiceName, suffix) {
+			tcName = strings.TrimSuffix(peerServiceName, suffix)
+			break
+		}
+	}
+	if tcName == "" {
+		return "", fmt.Errorf("pd microservice advertiseListenUrl format is wrong: %s", advertiseListenUrl)
+	}
+
+	tc, err := d.cli.PingcapV1alpha1().TidbClusters(ns).Get(context.TODO(), tcName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if tc.Spec.PD == nil {
+		return "", fmt.Errorf("pd of TidbCluster %s/%s is not specified", ns, tcName)
+	}
+
+	pdClient := d.pdControl.GetPDClient(pdapi.Namespace(ns), tcName, tc.IsTLSClusterEnabled())
+	membersInfo, err := pdClient.GetMembers()
+	if err != nil {
+		return "", err
+	}
+
+	membersArr := make([]string, 0, len(membersInfo.Members))
+	for _, member := range membersInfo.Members {
+		if len(member.ClientUrls) == 0 {
+			continue
+		}
+		membersArr = append(membersArr, member.ClientUrls[0])
+	}
+	if len(membersArr) == 0 {
+		return "", fmt.Errorf("no pd member of TidbCluster %s/%s is available", ns, tcName)
+	}
+	return fmt.Sprintf("--backend-endpoints=%s", strings.Join(membersArr, ",")), nil
+}
+
 func (d *tidbDiscovery) DiscoverDM(advertisePeerUrl string) (string, error) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -209,24 +318,31 @@ func (d *tidbDiscovery) DiscoverDM(advertisePeerUrl string) (string, error) {
 	}
 	keyName := fmt.Sprintf("%s/%s", ns, dcName)
 
-	currentCluster := d.dmClusters[keyName]
-	if currentCluster == nil || currentCluster.resourceVersion != dc.ResourceVersion {
-		d.dmClusters[keyName] = &clusterInfo{
-			resourceVersion: dc.ResourceVersion,
-			peers:           map[string]struct{}{},
-		}
-	}
-	currentCluster = d.dmClusters[keyName]
-	currentCluster.peers[podName] = struct{}{}
+	currentCluster := getClusterInfo(d.dmClusters, keyName, dc.UID, dc.ResourceVersion)
+	defer d.saveStateOrLog()
 
-	if len(currentCluster.peers) == int(dc.MasterStsDesiredReplicas()) {
-		delete(currentCluster.peers, podName)
-		return fmt.Sprintf("--initial-cluster=%s=%s://%s", podName, dc.Scheme(), advertisePeerUrl), nil
+	initialClusterArg := fmt.Sprintf("--initial-cluster=%s=%s://%s", podName, dc.Scheme(), advertisePeerUrl)
+	// Once a member is told to initialize the cluster, all the other members join it
+	if currentCluster.initialMember == "" {
+		currentCluster.peers[podName] = struct{}{}
+
+		if len(currentCluster.peers) == int(dc.MasterStsDesiredReplicas()) {
+			delete(currentCluster.peers, podName)
+			if err := d.setInitialMember(currentCluster, podName); err != nil {
+				return "", err
+			}
+			return initialClusterArg, nil
+		}
 	}
 
 	masterClient := d.masterControl.GetMasterClient(dc.GetNamespace(), dc.GetName(), dc.IsTLSClusterEnabled())
 	mastersInfos, err := masterClient.GetMasters()
 	if err != nil {
+		// see Discover
+		if currentCluster.initialMember == podName && !currentCluster.joined {
+			klog.Infof("dm-master cluster %s is not up, tell the initial member %s to initialize it again: %v", keyName, podName, err)
+			return initialClusterArg, nil
+		}
 		return "", err
 	}
 
@@ -241,10 +357,129 @@ func (d *tidbDiscovery) DiscoverDM(advertisePeerUrl string) (string, error) {
 		memberURL := strings.ReplaceAll(master.PeerURLs[0], ":8291", ":8261")
 		mastersArr = append(mastersArr, memberURL)
 	}
+	if err := d.setJoined(currentCluster, podName); err != nil {
+		return "", err
+	}
 	delete(currentCluster.peers, podName)
 	return fmt.Sprintf("--join=%s", strings.Join(mastersArr, ",")), nil
 }
 
+func (d *tidbDiscovery) Reload() error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	state, err := d.store.Load()
+	if err != nil {
+		return err
+	}
+	d.clusters = map[string]*clusterInfo{}
+	for key, cs := range state.Clusters {
+		d.clusters[key] = fromClusterState(cs)
+	}
+	d.dmClusters = map[string]*clusterInfo{}
+	for key, cs := range state.DMClusters {
+		d.dmClusters[key] = fromClusterState(cs)
+	}
+	d.saved, err = json.Marshal(d.state())
+	if err != nil {
+		return err
+	}
+	klog.Infof("discovery state is reloaded, %d PD clusters, %d dm-master clusters", len(d.clusters), len(d.dmClusters))
+	return nil
+}
+
+func (d *tidbDiscovery) Status() *State {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.state()
+}
+
+// state returns the persisted form of the peer knowledge, the caller must hold the lock
+func (d *tidbDiscovery) state() *State {
+	state := &State{
+		Clusters:   map[string]*ClusterState{},
+		DMClusters: map[string]*ClusterState{},
+	}
+	for key, c := range d.clusters {
+		state.Clusters[key] = c.toClusterState()
+	}
+	for key, c := range d.dmClusters {
+		state.DMClusters[key] = c.toClusterState()
+	}
+	return state
+}
+
+// saveState saves the peer knowledge to the store if it is changed, the caller must hold the lock
+func (d *tidbDiscovery) saveState() error {
+	state := d.state()
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(data, d.saved) {
+		return nil
+	}
+	if err := d.store.Save(state); err != nil {
+		return err
+	}
+	d.saved = data
+	return nil
+}
+
+// saveStateOrLog saves the peers collected, which only delays the bootstrap
+// if they are lost, so the error is logged and the request goes on.
+func (d *tidbDiscovery) saveStateOrLog() {
+	if err := d.saveState(); err != nil {
+		klog.Errorf("failed to save discovery state: %v", err)
+	}
+}
+
+// setInitialMember records the member to initialize the cluster. It must be
+// saved before the member is told, otherwise another member may be told to
+// initialize a separate cluster after the discovery service restarts.
+func (d *tidbDiscovery) setInitialMember(c *clusterInfo, podName string) error {
+	c.initialMember = podName
+	if err := d.saveState(); err != nil {
+		c.initialMember = ""
+		return fmt.Errorf("failed to save the initial member %s: %v", podName, err)
+	}
+	return nil
+}
+
+// setJoined records that a member other than the initial member joins the
+// cluster. It must be saved before the member is told, otherwise the initial
+// member may initialize the cluster again after the discovery service restarts.
+func (d *tidbDiscovery) setJoined(c *clusterInfo, podName string) error {
+	if c.joined || c.initialMember == "" || c.initialMember == podName {
+		return nil
+	}
+	c.joined = true
+	if err := d.saveState(); err != nil {
+		c.joined = false
+		return fmt.Errorf("failed to save that %s joins the cluster: %v", podName, err)
+	}
+	return nil
+}
+
+// getClusterInfo returns the peer knowledge of the cluster. The peers are
+// reset if the cluster is changed and everything is reset if it is recreated.
+func getClusterInfo(clusters map[string]*clusterInfo, keyName string, uid types.UID, resourceVersion string) *clusterInfo {
+	c := clusters[keyName]
+	if c == nil || (c.uid != "" && c.uid != uid) {
+		c = &clusterInfo{
+			uid:             uid,
+			resourceVersion: resourceVersion,
+			peers:           map[string]struct{}{},
+		}
+		clusters[keyName] = c
+	} else if c.resourceVersion != resourceVersion {
+		c.uid = uid
+		c.resourceVersion = resourceVersion
+		c.peers = map[string]struct{}{}
+	}
+	return c
+}
+
 func (d *tidbDiscovery) VerifyPDEndpoint(pdURL string) (string, error) {
 	pdEndpoint := parsePDURL(pdURL)
 	klog.Infof("Get PD endpoint URL: %s, scheme is %s, pdMemberName is %s, pdMemberPort is %s, tcName is %s", pdURL, pdEndpoint.scheme, pdEndpoint.pdMemberName, pdEndpoint.pdMemberPort, pdEndpoint.tcName)
@@ -27,6 +27,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/dmapi"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeinformers "k8s.io/client-go/informers"
@@ -1148,6 +1149,201 @@ func TestDiscoveryVerifyPDEndpoint(t *testing.T) {
 	}
 }
 
+func TestDiscoveryStatePersisted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	os.Setenv("MY_POD_NAMESPACE", "default")
+	cli := fake.NewSimpleClientset()
+	kubeCli := kubefake.NewSimpleClientset()
+	informer := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
+	fakePDControl := pdapi.NewFakePDControl(informer.Core().V1().Secrets().Lister())
+	fakeMasterControl := dmapi.NewFakeMasterControl(informer.Core().V1().Secrets().Lister())
+	pdClient := pdapi.NewFakePDClient()
+	pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+		return nil, fmt.Errorf("PD is not up")
+	})
+
+	tc := newTC()
+	tc.UID = "uid-1"
+	_, err := cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), tc, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	fakePDControl.SetPDClient(pdapi.Namespace(tc.Namespace), tc.Name, pdClient)
+	_, err = kubeCli.CoreV1().ConfigMaps(tc.Namespace).Create(context.TODO(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: tc.Namespace, Name: "demo-discovery-state"},
+	}, metav1.CreateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	newDiscovery := func() TiDBDiscovery {
+		store := NewConfigMapStateStore(kubeCli, tc.Namespace, "demo-discovery-state")
+		td := NewTiDBDiscovery(fakePDControl, fakeMasterControl, cli, kubeCli, WithStateStore(store))
+		g.Expect(td.Reload()).To(Succeed())
+		return td
+	}
+	url := func(ordinal int) string {
+		return fmt.Sprintf("demo-pd-%d.demo-pd-peer.default.svc:2380", ordinal)
+	}
+
+	td := newDiscovery()
+	_, err = td.Discover(url(0))
+	g.Expect(err).To(HaveOccurred())
+	_, err = td.Discover(url(1))
+	g.Expect(err).To(HaveOccurred())
+
+	// the peers are kept after restart
+	td = newDiscovery()
+	g.Expect(td.Status().Clusters["default/demo"].Peers).To(Equal([]string{"demo-pd-0", "demo-pd-1"}))
+	re, err := td.Discover(url(2))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(re).To(Equal("--initial-cluster=demo-pd-2=http://demo-pd-2.demo-pd-peer.default.svc:2380"))
+
+	// restart and the tidb cluster is changed, no other member initializes a cluster
+	td = newDiscovery()
+	g.Expect(td.Status().Clusters["default/demo"].InitialMember).To(Equal("demo-pd-2"))
+	tc.ResourceVersion = "2"
+	_, err = cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Update(context.TODO(), tc, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	for _, ordinal := range []int{0, 1} {
+		_, err = td.Discover(url(ordinal))
+		g.Expect(err).To(HaveOccurred())
+	}
+	// the initial member is restarted before PD is up
+	re, err = td.Discover(url(2))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(re).To(Equal("--initial-cluster=demo-pd-2=http://demo-pd-2.demo-pd-peer.default.svc:2380"))
+
+	// another member joins once PD is up
+	pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.MembersInfo{
+			Members: []*pdpb.Member{
+				{
+					Name:     "demo-pd-2",
+					PeerUrls: []string{"http://demo-pd-2.demo-pd-peer.default.svc:2380"},
+				},
+			},
+		}, nil
+	})
+	re, err = td.Discover(url(0))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(re).To(Equal("--join=http://demo-pd-2.demo-pd-peer.default.svc:2379"))
+
+	// restart and PD is unreachable, the initial member must not initialize a new cluster
+	pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+		return nil, fmt.Errorf("PD is unreachable")
+	})
+	td = newDiscovery()
+	g.Expect(td.Status().Clusters["default/demo"].Joined).To(BeTrue())
+	_, err = td.Discover(url(2))
+	g.Expect(err).To(HaveOccurred())
+
+	// the tidb cluster is recreated
+	tc.UID = "uid-2"
+	tc.ResourceVersion = "3"
+	_, err = cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Update(context.TODO(), tc, metav1.UpdateOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = td.Discover(url(1))
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(newDiscovery().Status().Clusters["default/demo"]).To(Equal(&ClusterState{
+		UID:             "uid-2",
+		ResourceVersion: "3",
+		Peers:           []string{"demo-pd-1"},
+	}))
+}
+
+func TestDiscoveryMSDiscovery(t *testing.T) {
+	os.Setenv("MY_POD_NAMESPACE", "default")
+
+	tests := []struct {
+		name       string
+		url        string
+		getMembers func() (*pdapi.MembersInfo, error)
+		expectErr  bool
+		expectArgs string
+	}{
+		{
+			name:      "url is empty",
+			url:       "",
+			expectErr: true,
+		},
+		{
+			name:      "url format is wrong",
+			url:       "demo-tso-0.demo-tso-peer:2379",
+			expectErr: true,
+		},
+		{
+			name:      "namespace is different",
+			url:       "demo-tso-0.demo-tso-peer.other.svc:2379",
+			expectErr: true,
+		},
+		{
+			name:      "unknown microservice",
+			url:       "demo-foo-0.demo-foo-peer.default.svc:2379",
+			expectErr: true,
+		},
+		{
+			name: "failed to get pd members",
+			url:  "demo-tso-0.demo-tso-peer.default.svc:2379",
+			getMembers: func() (*pdapi.MembersInfo, error) {
+				return nil, fmt.Errorf("PD is not up")
+			},
+			expectErr: true,
+		},
+		{
+			name: "tso",
+			url:  "demo-tso-0.demo-tso-peer.default.svc:2379",
+			getMembers: func() (*pdapi.MembersInfo, error) {
+				return &pdapi.MembersInfo{Members: []*pdpb.Member{
+					{Name: "demo-pd-0", ClientUrls: []string{"http://demo-pd-0.demo-pd-peer.default.svc:2379"}},
+					{Name: "demo-pd-1", ClientUrls: []string{"http://demo-pd-1.demo-pd-peer.default.svc:2379"}},
+				}}, nil
+			},
+			expectArgs: "--backend-endpoints=http://demo-pd-0.demo-pd-peer.default.svc:2379,http://demo-pd-1.demo-pd-peer.default.svc:2379",
+		},
+		{
+			name: "scheduling with cluster domain",
+			url:  "demo-scheduling-0.demo-scheduling-peer.default.svc.cluster.local:2379",
+			getMembers: func() (*pdapi.MembersInfo, error) {
+				return &pdapi.MembersInfo{Members: []*pdpb.Member{
+					{Name: "demo-pd-0", ClientUrls: []string{"http://demo-pd-0.demo-pd-peer.default.svc:2379"}},
+				}}, nil
+			},
+			expectArgs: "--backend-endpoints=http://demo-pd-0.demo-pd-peer.default.svc:2379",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			cli := fake.NewSimpleClientset()
+			kubeCli := kubefake.NewSimpleClientset()
+			informer := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
+			fakePDControl := pdapi.NewFakePDControl(informer.Core().V1().Secrets().Lister())
+			fakeMasterControl := dmapi.NewFakeMasterControl(informer.Core().V1().Secrets().Lister())
+			pdClient := pdapi.NewFakePDClient()
+			if test.getMembers != nil {
+				pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+					return test.getMembers()
+				})
+			}
+
+			tc := newTC()
+			tc.Spec.PD.Mode = v1alpha1.PDModeMS
+			_, err := cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), tc, metav1.CreateOptions{})
+			g.Expect(err).NotTo(HaveOccurred())
+			fakePDControl.SetPDClient(pdapi.Namespace(tc.Namespace), tc.Name, pdClient)
+
+			td := NewTiDBDiscovery(fakePDControl, fakeMasterControl, cli, kubeCli)
+			args, err := td.DiscoverMS(test.url)
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(args).To(Equal(test.expectArgs))
+		})
+	}
+}
+
 func newTC() *v1alpha1.TidbCluster {
 	return &v1alpha1.TidbCluster{
 		TypeMeta: metav1.TypeMeta{Kind: "TidbCluster", APIVersion: "v1alpha1"},
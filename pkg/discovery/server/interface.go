@@ -16,3 +16,11 @@ package server
 type Server interface {
 	ListenAndServe(addr string)
 }
+
+// DiscoveryServer serves the discovery requests of PD and dm-master members.
+type DiscoveryServer interface {
+	Server
+	// StartLeading loads the persisted peer knowledge and starts to serve the
+	// discovery requests
+	StartLeading() error
+}
@@ -19,6 +19,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync/atomic"
 
 	"github.com/pingcap/tidb-operator/pkg/dmapi"
 
@@ -33,13 +34,48 @@ import (
 type server struct {
 	discovery discovery.TiDBDiscovery
 	container *restful.Container
+	options   Options
+	// leading is 1 if the discovery requests are served
+	leading int32
 }
 
-// NewServer creates a new server.
+// Options are the options of the discovery server.
+type Options struct {
+	// StateStore persists the peer knowledge, it is kept in memory if nil
+	StateStore discovery.StateStore
+	// Identity of the discovery server shown in the status
+	Identity string
+}
+
+// Status is the response of the /status endpoint
+type Status struct {
+	Identity string           `json:"identity,omitempty"`
+	Leader   bool             `json:"leader"`
+	State    *discovery.State `json:"state"`
+}
+
+// NewServer creates a new server which keeps the peer knowledge in memory.
 func NewServer(pdControl pdapi.PDControlInterface, masterControl dmapi.MasterControlInterface, cli versioned.Interface, kubeCli kubernetes.Interface) Server {
+	s := newServer(pdControl, masterControl, cli, kubeCli, Options{})
+	s.leading = 1
+	return s
+}
+
+// NewServerWithOptions creates a new server, StartLeading must be called
+// before the discovery requests are served.
+func NewServerWithOptions(pdControl pdapi.PDControlInterface, masterControl dmapi.MasterControlInterface, cli versioned.Interface, kubeCli kubernetes.Interface, options Options) DiscoveryServer {
+	return newServer(pdControl, masterControl, cli, kubeCli, options)
+}
+
+func newServer(pdControl pdapi.PDControlInterface, masterControl dmapi.MasterControlInterface, cli versioned.Interface, kubeCli kubernetes.Interface, options Options) *server {
+	var opts []discovery.Option
+	if options.StateStore != nil {
+		opts = append(opts, discovery.WithStateStore(options.StateStore))
+	}
 	s := &server{
-		discovery: discovery.NewTiDBDiscovery(pdControl, masterControl, cli, kubeCli),
+		discovery: discovery.NewTiDBDiscovery(pdControl, masterControl, cli, kubeCli, opts...),
 		container: restful.NewContainer(),
+		options:   options,
 	}
 	s.registerHandlers()
 	return s
@@ -50,14 +86,36 @@ func (s *server) registerHandlers() {
 	ws.Route(ws.GET("/new/{advertise-peer-url}").To(s.newHandler))
 	ws.Route(ws.GET("/new/{advertise-peer-url}/{register-type}").To(s.newHandler))
 	ws.Route(ws.GET("/verify/{pd-url}").To(s.newVerifyHandler))
+	ws.Route(ws.GET("/status").To(s.statusHandler))
+	ws.Route(ws.GET("/ready").To(s.readyHandler))
 	s.container.Add(ws)
 }
 
+func (s *server) StartLeading() error {
+	if err := s.discovery.Reload(); err != nil {
+		return err
+	}
+	atomic.StoreInt32(&s.leading, 1)
+	klog.Infof("discovery server %s starts to serve the discovery requests", s.options.Identity)
+	return nil
+}
+
+func (s *server) isLeading() bool {
+	return atomic.LoadInt32(&s.leading) == 1
+}
+
 func (s *server) ListenAndServe(addr string) {
 	klog.Fatal(http.ListenAndServe(addr, s.container.ServeMux))
 }
 
 func (s *server) newHandler(req *restful.Request, resp *restful.Response) {
+	if !s.isLeading() {
+		// the peer knowledge may be stale, let the member retry and reach the leader
+		if werr := resp.WriteError(http.StatusServiceUnavailable, fmt.Errorf("discovery server %s is not the leader", s.options.Identity)); werr != nil {
+			klog.Errorf("failed to writeError: %v", werr)
+		}
+		return
+	}
 	encodedAdvertisePeerURL := req.PathParameter("advertise-peer-url")
 	registerType := req.PathParameter("register-type")
 	if registerType == "" {
@@ -79,6 +137,8 @@ func (s *server) newHandler(req *restful.Request, resp *restful.Response) {
 		result, err = s.discovery.Discover(advertisePeerURL)
 	case "dm":
 		result, err = s.discovery.DiscoverDM(advertisePeerURL)
+	case "pdms":
+		result, err = s.discovery.DiscoverMS(advertisePeerURL)
 	default:
 		err = fmt.Errorf("invalid register-type %s", registerType)
 		klog.Errorf("%v", err)
@@ -131,3 +191,26 @@ func (s *server) newVerifyHandler(req *restful.Request, resp *restful.Response)
 		klog.Errorf("failed to writeString: %s, %v", result, err)
 	}
 }
+
+func (s *server) statusHandler(req *restful.Request, resp *restful.Response) {
+	status := Status{
+		Identity: s.options.Identity,
+		Leader:   s.isLeading(),
+		State:    s.discovery.Status(),
+	}
+	if err := resp.WriteAsJson(status); err != nil {
+		klog.Errorf("failed to write status: %v", err)
+	}
+}
+
+// readyHandler reports ready only if the discovery requests are served, so
+// that the service only routes them to the leader.
+func (s *server) readyHandler(req *restful.Request, resp *restful.Response) {
+	if !s.isLeading() {
+		resp.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	if _, err := io.WriteString(resp, "ok"); err != nil {
+		klog.Errorf("failed to writeString: %v", err)
+	}
+}
@@ -16,6 +16,7 @@ package server
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -30,6 +31,7 @@ import (
 	"github.com/pingcap/kvproto/pkg/pdpb"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned/fake"
+	"github.com/pingcap/tidb-operator/pkg/discovery"
 	"github.com/pingcap/tidb-operator/pkg/dmapi"
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"golang.org/x/sync/errgroup"
@@ -151,6 +153,44 @@ func TestServer(t *testing.T) {
 	}
 }
 
+func TestPDMSServer(t *testing.T) {
+	os.Setenv("MY_POD_NAMESPACE", "default")
+	cli := fake.NewSimpleClientset()
+	kubeCli := kubefake.NewSimpleClientset()
+	informer := kubeinformers.NewSharedInformerFactory(kubeCli, 0)
+	fakePDControl := pdapi.NewFakePDControl(informer.Core().V1().Secrets().Lister())
+	fakeMasterControl := dmapi.NewFakeMasterControl(informer.Core().V1().Secrets().Lister())
+	pdClient := pdapi.NewFakePDClient()
+	pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.MembersInfo{Members: []*pdpb.Member{
+			{Name: "foo-pd-0", ClientUrls: []string{"http://foo-pd-0.foo-pd-peer.default.svc:2379"}},
+		}}, nil
+	})
+	cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), tc, metav1.CreateOptions{})
+	fakePDControl.SetPDClient(pdapi.Namespace(tc.Namespace), tc.Name, pdClient)
+	s := NewServer(fakePDControl, fakeMasterControl, cli, kubeCli)
+	httpServer := httptest.NewServer(s.(*server).container.ServeMux)
+	defer httpServer.Close()
+
+	svc := "foo-tso-0.foo-tso-peer.default.svc:2379"
+	resp, err := http.Get(httpServer.URL + fmt.Sprintf("/new/%s/pdms", base64.StdEncoding.EncodeToString([]byte(svc))))
+	if err != nil {
+		t.Fatalf("get pd microservice start args failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status code expects %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("read response failed: %v", err)
+	}
+	expected := "--backend-endpoints=http://foo-pd-0.foo-pd-peer.default.svc:2379"
+	if string(data) != expected {
+		t.Errorf("start args expects %q, got %q", expected, string(data))
+	}
+}
+
 func TestDMServer(t *testing.T) {
 	os.Setenv("MY_POD_NAMESPACE", "default")
 	cli := fake.NewSimpleClientset()
@@ -265,3 +305,74 @@ func TestVerifyServer(t *testing.T) {
 		t.Errorf("verify pdEndpoint failed: %v", err)
 	}
 }
+
+func TestStatusServer(t *testing.T) {
+	os.Setenv("MY_POD_NAMESPACE", "default")
+	cli := fake.NewSimpleClientset()
+	kubeCli := kubefake.NewSimpleClientset()
+	informer := informers.NewSharedInformerFactory(kubeCli, 0)
+	fakePDControl := pdapi.NewFakePDControl(informer.Core().V1().Secrets().Lister())
+	fakeMasterControl := dmapi.NewFakeMasterControl(informer.Core().V1().Secrets().Lister())
+	pdClient := pdapi.NewFakePDClient()
+	pdClient.AddReaction(pdapi.GetMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+		return nil, fmt.Errorf("no members yet")
+	})
+	cli.PingcapV1alpha1().TidbClusters(tc.Namespace).Create(context.TODO(), tc, metav1.CreateOptions{})
+	fakePDControl.SetPDClient(pdapi.Namespace(tc.Namespace), tc.Name, pdClient)
+
+	s := NewServerWithOptions(fakePDControl, fakeMasterControl, cli, kubeCli, Options{
+		StateStore: discovery.NewMemoryStateStore(),
+		Identity:   "foo-discovery-0",
+	})
+	httpServer := httptest.NewServer(s.(*server).container.ServeMux)
+	defer httpServer.Close()
+
+	get := func(path string) (int, []byte) {
+		resp, err := http.Get(httpServer.URL + path)
+		if err != nil {
+			t.Fatalf("failed to get %s: %v", path, err)
+		}
+		defer resp.Body.Close()
+		data, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", path, err)
+		}
+		return resp.StatusCode, data
+	}
+	newPath := "/new/" + base64.StdEncoding.EncodeToString([]byte("foo-pd-0.foo-pd-peer.default.svc:2380"))
+
+	// the discovery requests are not served before leading
+	if code, _ := get("/ready"); code != http.StatusServiceUnavailable {
+		t.Errorf("ready expects %d before leading, got %d", http.StatusServiceUnavailable, code)
+	}
+	if code, _ := get(newPath); code != http.StatusServiceUnavailable {
+		t.Errorf("new expects %d before leading, got %d", http.StatusServiceUnavailable, code)
+	}
+
+	if err := s.StartLeading(); err != nil {
+		t.Fatalf("failed to start leading: %v", err)
+	}
+	if code, _ := get("/ready"); code != http.StatusOK {
+		t.Errorf("ready expects %d after leading, got %d", http.StatusOK, code)
+	}
+	// PD is not up, the peer is recorded and the member retries
+	if code, _ := get(newPath); code != http.StatusInternalServerError {
+		t.Errorf("new expects %d, got %d", http.StatusInternalServerError, code)
+	}
+
+	code, data := get("/status")
+	if code != http.StatusOK {
+		t.Fatalf("status expects %d, got %d", http.StatusOK, code)
+	}
+	status := &Status{}
+	if err := json.Unmarshal(data, status); err != nil {
+		t.Fatalf("failed to parse status %s: %v", data, err)
+	}
+	if status.Identity != "foo-discovery-0" || !status.Leader {
+		t.Errorf("unexpected status: %s", data)
+	}
+	cluster := status.State.Clusters["default/foo"]
+	if cluster == nil || len(cluster.Peers) != 1 || cluster.Peers[0] != "foo-pd-0" {
+		t.Errorf("unexpected peers in status: %s", data)
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// StateConfigMapKey is the key of the discovery state in the state ConfigMap
+	StateConfigMapKey = "state"
+)
+
+// State is the peer knowledge of the discovery service. It is persisted so
+// that the bootstrap decisions survive restarts and leader changes.
+type State struct {
+	Clusters   map[string]*ClusterState `json:"clusters,omitempty"`
+	DMClusters map[string]*ClusterState `json:"dmClusters,omitempty"`
+}
+
+// ClusterState is the peer knowledge of a PD or dm-master cluster.
+type ClusterState struct {
+	// UID of the TidbCluster or DMCluster, the state is dropped if the cluster is recreated
+	UID types.UID `json:"uid,omitempty"`
+	// ResourceVersion of the cluster when the peers are collected
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+	// Peers are the members waiting for the cluster to be initialized
+	Peers []string `json:"peers,omitempty"`
+	// InitialMember is the member told to initialize the cluster, all the
+	// other members must join it
+	InitialMember string `json:"initialMember,omitempty"`
+	// Joined is true once a member other than the initial member is told to
+	// join the cluster, the initial member is not told to initialize it again
+	Joined bool `json:"joined,omitempty"`
+}
+
+// StateStore loads and saves the discovery state.
+type StateStore interface {
+	Load() (*State, error)
+	Save(*State) error
+}
+
+type memoryStateStore struct {
+	lock  sync.Mutex
+	state *State
+}
+
+// NewMemoryStateStore returns a StateStore which keeps the state in memory,
+// the state is lost once the discovery service restarts.
+func NewMemoryStateStore() StateStore {
+	return &memoryStateStore{}
+}
+
+func (s *memoryStateStore) Load() (*State, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.state == nil {
+		return &State{}, nil
+	}
+	return s.state.DeepCopy(), nil
+}
+
+func (s *memoryStateStore) Save(state *State) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.state = state.DeepCopy()
+	return nil
+}
+
+type configMapStateStore struct {
+	kubeCli   kubernetes.Interface
+	namespace string
+	name      string
+	// cm is the ConfigMap last loaded or saved, its resource version guards
+	// the state from being overwritten by a stale leader
+	cm *corev1.ConfigMap
+}
+
+// NewConfigMapStateStore returns a StateStore which keeps the state in the
+// ConfigMap namespace/name. The ConfigMap is created by tidb-operator.
+func NewConfigMapStateStore(kubeCli kubernetes.Interface, namespace, name string) StateStore {
+	return &configMapStateStore{
+		kubeCli:   kubeCli,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+func (s *configMapStateStore) Load() (*State, error) {
+	cm, err := s.kubeCli.CoreV1().ConfigMaps(s.namespace).Get(context.TODO(), s.name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get discovery state configmap %s/%s: %v", s.namespace, s.name, err)
+	}
+	s.cm = cm
+	state := &State{}
+	data := cm.Data[StateConfigMapKey]
+	if data == "" {
+		return state, nil
+	}
+	if err := json.Unmarshal([]byte(data), state); err != nil {
+		return nil, fmt.Errorf("failed to parse discovery state in configmap %s/%s: %v", s.namespace, s.name, err)
+	}
+	return state, nil
+}
+
+func (s *configMapStateStore) Save(state *State) error {
+	if s.cm == nil {
+		if _, err := s.Load(); err != nil {
+			return err
+		}
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	cm := s.cm.DeepCopy()
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[StateConfigMapKey] = string(data)
+	// the update fails on conflict if the state is changed by someone else,
+	// e.g. another discovery pod which becomes the leader
+	updated, err := s.kubeCli.CoreV1().ConfigMaps(s.namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	if err != nil {
+		s.cm = nil
+		return fmt.Errorf("failed to update discovery state configmap %s/%s: %v", s.namespace, s.name, err)
+	}
+	s.cm = updated
+	return nil
+}
+
+// DeepCopy returns a deep copy of the state
+func (s *State) DeepCopy() *State {
+	if s == nil {
+		return nil
+	}
+	return &State{
+		Clusters:   copyClusterStates(s.Clusters),
+		DMClusters: copyClusterStates(s.DMClusters),
+	}
+}
+
+func copyClusterStates(in map[string]*ClusterState) map[string]*ClusterState {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]*ClusterState, len(in))
+	for k, v := range in {
+		cs := *v
+		cs.Peers = append([]string(nil), v.Peers...)
+		out[k] = &cs
+	}
+	return out
+}
+
+// toClusterState converts the in-memory cluster info to the persisted form
+func (c *clusterInfo) toClusterState() *ClusterState {
+	peers := make([]string, 0, len(c.peers))
+	for peer := range c.peers {
+		peers = append(peers, peer)
+	}
+	sort.Strings(peers)
+	return &ClusterState{
+		UID:             c.uid,
+		ResourceVersion: c.resourceVersion,
+		Peers:           peers,
+		InitialMember:   c.initialMember,
+		Joined:          c.joined,
+	}
+}
+
+// fromClusterState converts the persisted cluster state to the in-memory form
+func fromClusterState(cs *ClusterState) *clusterInfo {
+	c := &clusterInfo{
+		uid:             cs.UID,
+		resourceVersion: cs.ResourceVersion,
+		initialMember:   cs.InitialMember,
+		joined:          cs.Joined,
+		peers:           map[string]struct{}{},
+	}
+	for _, peer := range cs.Peers {
+		c.peers[peer] = struct{}{}
+	}
+	return c
+}
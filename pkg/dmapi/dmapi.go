@@ -14,10 +14,12 @@
 package dmapi
 
 import (
+	"bytes"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	httputil "github.com/pingcap/tidb-operator/pkg/util/http"
@@ -36,11 +38,21 @@ type MasterClient interface {
 	EvictLeader() error
 	DeleteMaster(name string) error
 	DeleteWorker(name string) error
+	// GetSources returns the upstream sources with their bindings and relay status,
+	// it requires the OpenAPI of dm-master to be enabled
+	GetSources() ([]*SourceInfo, error)
+	// TransferSource binds the source to the worker
+	TransferSource(source, worker string) error
+	// EnableRelay enables the relay log of the source on the worker it is bound to
+	EnableRelay(source string, req *EnableRelayRequest) error
+	// DisableRelay disables the relay log of the source
+	DisableRelay(source string) error
 }
 
 var (
 	membersPrefix = "apis/v1alpha1/members"
 	leaderPrefix  = "apis/v1alpha1/leader"
+	sourcesPrefix = "api/v1/sources"
 )
 
 type RespHeader struct {
@@ -106,6 +118,47 @@ type LeaderResp struct {
 	ListMemberResp []*ListMemberLeader `json:"members,omitempty"`
 }
 
+// SourceInfo is an upstream source returned by the OpenAPI of dm-master
+type SourceInfo struct {
+	SourceName  string          `json:"source_name"`
+	Enable      bool            `json:"enable,omitempty"`
+	RelayConfig *RelayConfig    `json:"relay_config,omitempty"`
+	StatusList  []*SourceStatus `json:"status_list,omitempty"`
+}
+
+type RelayConfig struct {
+	EnableRelay     bool   `json:"enable_relay,omitempty"`
+	RelayBinlogName string `json:"relay_binlog_name,omitempty"`
+	RelayBinlogGTID string `json:"relay_binlog_gtid,omitempty"`
+	RelayDir        string `json:"relay_dir,omitempty"`
+}
+
+// SourceStatus is the status of the source on a dm-worker
+type SourceStatus struct {
+	SourceName  string       `json:"source_name"`
+	WorkerName  string       `json:"worker_name,omitempty"`
+	RelayStatus *RelayStatus `json:"relay_status,omitempty"`
+	ErrorMsg    string       `json:"error_msg,omitempty"`
+}
+
+type RelayStatus struct {
+	Stage string `json:"stage,omitempty"`
+}
+
+type SourcesResp struct {
+	Data  []*SourceInfo `json:"data"`
+	Total int           `json:"total"`
+}
+
+type TransferSourceRequest struct {
+	WorkerName string `json:"worker_name"`
+}
+
+type EnableRelayRequest struct {
+	RelayBinlogName string `json:"relay_binlog_name,omitempty"`
+	RelayBinlogGTID string `json:"relay_binlog_gtid,omitempty"`
+}
+
 // masterClient is default implementation of MasterClient
 type masterClient struct {
 	url        string
@@ -225,6 +278,55 @@ func (c *masterClient) DeleteWorker(name string) error {
 	return c.deleteMember(query)
 }
 
+func (c *masterClient) GetSources() ([]*SourceInfo, error) {
+	apiURL := fmt.Sprintf("%s/%s?with_status=true", c.url, sourcesPrefix)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	sourcesResp := &SourcesResp{}
+	err = json.Unmarshal(body, sourcesResp)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unmarshal list sources resp: %s, err: %s", body, err)
+	}
+	return sourcesResp.Data, nil
+}
+
+func (c *masterClient) TransferSource(source, worker string) error {
+	apiURL := fmt.Sprintf("%s/%s/%s/transfer", c.url, sourcesPrefix, url.PathEscape(source))
+	return c.postJSON(apiURL, &TransferSourceRequest{WorkerName: worker})
+}
+
+func (c *masterClient) EnableRelay(source string, req *EnableRelayRequest) error {
+	apiURL := fmt.Sprintf("%s/%s/%s/relay/enable", c.url, sourcesPrefix, url.PathEscape(source))
+	if req == nil {
+		req = &EnableRelayRequest{}
+	}
+	return c.postJSON(apiURL, req)
+}
+
+func (c *masterClient) DisableRelay(source string) error {
+	apiURL := fmt.Sprintf("%s/%s/%s/relay/disable", c.url, sourcesPrefix, url.PathEscape(source))
+	return c.postJSON(apiURL, struct{}{})
+}
+
+// postJSON posts the request to the OpenAPI of dm-master, which only accepts json bodies
+func (c *masterClient) postJSON(apiURL string, req interface{}) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	res, err := c.httpClient.Post(apiURL, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer httputil.DeferClose(res.Body)
+	if res.StatusCode >= 400 {
+		return fmt.Errorf("error response %v URL %s, body response: %v", res.StatusCode, apiURL, httputil.ReadErrorBody(res.Body))
+	}
+	return nil
+}
+
 // NewMasterClient returns a new MasterClient
 func NewMasterClient(url string, timeout time.Duration, tlsConfig *tls.Config, disableKeepalive bool) MasterClient {
 	return &masterClient{
@@ -17,6 +17,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -208,3 +209,103 @@ func TestDeleteMember(t *testing.T) {
 		g.Expect(err).NotTo(HaveOccurred())
 	}
 }
+
+func TestGetSources(t *testing.T) {
+	g := NewGomegaWithT(t)
+	sources := []*SourceInfo{
+		{
+			SourceName:  "mysql-replica-01",
+			Enable:      true,
+			RelayConfig: &RelayConfig{EnableRelay: true},
+			StatusList: []*SourceStatus{
+				{SourceName: "mysql-replica-01", WorkerName: "dm-worker-0", RelayStatus: &RelayStatus{Stage: "Running"}},
+			},
+		},
+		{SourceName: "mysql-replica-02", Enable: true},
+	}
+	sourcesBytes, err := json.Marshal(SourcesResp{Data: sources, Total: len(sources)})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("GET"), "check method")
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s", sourcesPrefix)), "check url")
+		g.Expect(request.URL.Query().Get("with_status")).To(Equal("true"), "check query")
+
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		w.Write(sourcesBytes)
+	})
+	defer svc.Close()
+
+	masterClient := NewMasterClient(svc.URL, DefaultTimeout, &tls.Config{}, false)
+	result, err := masterClient.GetSources()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(Equal(sources))
+}
+
+func TestSourceOperations(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tcs := []struct {
+		caseName  string
+		path      string
+		body      string
+		status    int
+		call      func(MasterClient) error
+		errExpect bool
+	}{{
+		caseName: "TransferSource",
+		path:     fmt.Sprintf("/%s/mysql-replica-01/transfer", sourcesPrefix),
+		body:     `{"worker_name":"dm-worker-1"}`,
+		status:   http.StatusOK,
+		call: func(c MasterClient) error {
+			return c.TransferSource("mysql-replica-01", "dm-worker-1")
+		},
+	}, {
+		caseName: "EnableRelay",
+		path:     fmt.Sprintf("/%s/mysql-replica-01/relay/enable", sourcesPrefix),
+		body:     `{"relay_binlog_name":"mysql-bin.000001"}`,
+		status:   http.StatusOK,
+		call: func(c MasterClient) error {
+			return c.EnableRelay("mysql-replica-01", &EnableRelayRequest{RelayBinlogName: "mysql-bin.000001"})
+		},
+	}, {
+		caseName: "DisableRelay",
+		path:     fmt.Sprintf("/%s/mysql-replica-01/relay/disable", sourcesPrefix),
+		body:     `{}`,
+		status:   http.StatusOK,
+		call: func(c MasterClient) error {
+			return c.DisableRelay("mysql-replica-01")
+		},
+	}, {
+		caseName: "TransferSource failed",
+		path:     fmt.Sprintf("/%s/mysql-replica-01/transfer", sourcesPrefix),
+		body:     `{"worker_name":"dm-worker-1"}`,
+		status:   http.StatusBadRequest,
+		call: func(c MasterClient) error {
+			return c.TransferSource("mysql-replica-01", "dm-worker-1")
+		},
+		errExpect: true,
+	}}
+
+	for _, tc := range tcs {
+		svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+			g.Expect(request.Method).To(Equal("POST"), tc.caseName)
+			g.Expect(request.URL.Path).To(Equal(tc.path), tc.caseName)
+			g.Expect(request.Header.Get("Content-Type")).To(Equal(ContentTypeJSON), tc.caseName)
+			body, err := io.ReadAll(request.Body)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(body)).To(Equal(tc.body), tc.caseName)
+
+			w.WriteHeader(tc.status)
+		})
+		defer svc.Close()
+
+		masterClient := NewMasterClient(svc.URL, DefaultTimeout, &tls.Config{}, false)
+		err := tc.call(masterClient)
+		if tc.errExpect {
+			g.Expect(err).To(HaveOccurred(), tc.caseName)
+		} else {
+			g.Expect(err).NotTo(HaveOccurred(), tc.caseName)
+		}
+	}
+}
@@ -20,12 +20,16 @@ import (
 type ActionType string
 
 const (
-	GetMastersActionType   ActionType = "GetMasters"
-	GetWorkersActionType   ActionType = "GetWorkers"
-	GetLeaderActionType    ActionType = "GetLeader"
-	EvictLeaderActionType  ActionType = "EvictLeader"
-	DeleteMasterActionType ActionType = "DeleteMaster"
-	DeleteWorkerActionType ActionType = "DeleteWorker"
+	GetMastersActionType     ActionType = "GetMasters"
+	GetWorkersActionType     ActionType = "GetWorkers"
+	GetLeaderActionType      ActionType = "GetLeader"
+	EvictLeaderActionType    ActionType = "EvictLeader"
+	DeleteMasterActionType   ActionType = "DeleteMaster"
+	DeleteWorkerActionType   ActionType = "DeleteWorker"
+	GetSourcesActionType     ActionType = "GetSources"
+	TransferSourceActionType ActionType = "TransferSource"
+	EnableRelayActionType    ActionType = "EnableRelay"
+	DisableRelayActionType   ActionType = "DisableRelay"
 )
 
 type NotFoundReaction struct {
@@ -40,6 +44,10 @@ type Action struct {
 	ID     uint64
 	Name   string
 	Labels map[string]string
+	// Source and Worker are the arguments of the source operations
+	Source       string
+	Worker       string
+	RelayRequest *EnableRelayRequest
 }
 
 type Reaction func(action *Action) (interface{}, error)
@@ -113,3 +121,30 @@ func (c *FakeMasterClient) DeleteWorker(_ string) error {
 	_, err := c.fakeAPI(DeleteWorkerActionType, action)
 	return err
 }
+
+func (c *FakeMasterClient) GetSources() ([]*SourceInfo, error) {
+	action := &Action{}
+	result, err := c.fakeAPI(GetSourcesActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*SourceInfo), nil
+}
+
+func (c *FakeMasterClient) TransferSource(source, worker string) error {
+	action := &Action{Source: source, Worker: worker}
+	_, err := c.fakeAPI(TransferSourceActionType, action)
+	return err
+}
+
+func (c *FakeMasterClient) EnableRelay(source string, req *EnableRelayRequest) error {
+	action := &Action{Source: source, RelayRequest: req}
+	_, err := c.fakeAPI(EnableRelayActionType, action)
+	return err
+}
+
+func (c *FakeMasterClient) DisableRelay(source string) error {
+	action := &Action{Source: source}
+	_, err := c.fakeAPI(DisableRelayActionType, action)
+	return err
+}
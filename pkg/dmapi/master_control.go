@@ -19,6 +19,7 @@ import (
 	"sync"
 
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tracing"
 	"github.com/pingcap/tidb-operator/pkg/util"
 
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
@@ -58,15 +59,15 @@ func (mc *defaultMasterControl) GetMasterClient(namespace string, dcName string,
 		tlsConfig, err = pdapi.GetTLSConfig(mc.secretLister, pdapi.Namespace(namespace), util.DMClientTLSSecretName(dcName))
 		if err != nil {
 			klog.Errorf("Unable to get tls config for dm cluster %q, master client may not work: %v", dcName, err)
-			return NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, tlsConfig, true)
+			return withTracing(NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
 		}
 
-		return NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, tlsConfig, true)
+		return withTracing(NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
 	}
 
 	key := masterClientKey(scheme, namespace, dcName)
 	if _, ok := mc.masterClients[key]; !ok {
-		mc.masterClients[key] = NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, nil, false)
+		mc.masterClients[key] = withTracing(NewMasterClient(MasterClientURL(namespace, dcName, scheme), DefaultTimeout, nil, false), namespace, dcName)
 	}
 	return mc.masterClients[key]
 }
@@ -84,13 +85,13 @@ func (mc *defaultMasterControl) GetMasterPeerClient(namespace string, dcName str
 		tlsConfig, err = pdapi.GetTLSConfig(mc.secretLister, pdapi.Namespace(namespace), util.DMClientTLSSecretName(dcName))
 		if err != nil {
 			klog.Errorf("Unable to get tls config for dm cluster %q, master client may not work: %v", dcName, err)
-			return NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+			return withTracing(NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
 		}
 
-		return NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+		return withTracing(NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
 	}
 
-	return NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+	return withTracing(NewMasterClient(MasterPeerClientURL(namespace, dcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, dcName)
 }
 
 // masterClientKey returns the master client key
@@ -140,3 +141,11 @@ func (fmc *FakeMasterControl) GetMasterClient(namespace string, dcName string, t
 func (fmc *FakeMasterControl) GetMasterPeerClient(namespace, dcName, podName string, tlsEnabled bool) MasterClient {
 	return fmc.masterPeerClients[masterPeerClientKey("http", namespace, dcName, podName)]
 }
+
+// withTracing traces the requests of the client as children of the active span of the DMCluster.
+func withTracing(c MasterClient, namespace, name string) MasterClient {
+	if cli, ok := c.(*masterClient); ok {
+		cli.httpClient.Transport = tracing.NewTransport(cli.httpClient.Transport, tracing.DMClusterKey(namespace, name))
+	}
+	return c
+}
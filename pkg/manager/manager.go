@@ -35,3 +35,17 @@ type TiDBNGMonitoringManager interface {
 type TiDBDashboardManager interface {
 	Sync(*v1alpha1.TidbDashboard, *v1alpha1.TidbCluster) error
 }
+
+type TiDBUserManager interface {
+	// Sync makes the user in TiDB match the TiDBUser
+	Sync(*v1alpha1.TiDBUser, *v1alpha1.TidbCluster) error
+	// Clean drops the user from TiDB
+	Clean(*v1alpha1.TiDBUser, *v1alpha1.TidbCluster) error
+}
+
+type TiDBGrantManager interface {
+	// Sync makes the privileges in TiDB match the TiDBGrant
+	Sync(*v1alpha1.TiDBGrant, *v1alpha1.TiDBUser, *v1alpha1.TidbCluster) error
+	// Clean revokes the privileges granted by the TiDBGrant from TiDB
+	Clean(*v1alpha1.TiDBGrant) error
+}
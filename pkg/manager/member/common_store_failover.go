@@ -20,9 +20,11 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/util"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -32,6 +34,7 @@ import (
 // StoreAccess contains the common set of functions to access the properties of TiKV and TiFlash types
 type StoreAccess interface {
 	GetFailoverPeriod(cliConfig *controller.CLIConfig) time.Duration
+	GetFailoverMode(tc *v1alpha1.TidbCluster) v1alpha1.FailoverMode
 	GetMemberType() v1alpha1.MemberType
 	GetMaxFailoverCount(tc *v1alpha1.TidbCluster) *int32
 	GetStores(tc *v1alpha1.TidbCluster) map[string]v1alpha1.TiKVStore
@@ -79,10 +82,20 @@ func (sf *commonStoreFailover) Failover(tc *v1alpha1.TidbCluster) error {
 		return err
 	}
 
+	if err := sf.rebuildFailureStores(tc); err != nil {
+		if controller.IsIgnoreError(err) {
+			return nil
+		}
+		return err
+	}
+
 	if canAutoFailureRecovery(tc) {
 		// If the store has not come back Up in some time after pod was restarted, then delete store and remove failure PVC
 		failureStores := sf.storeAccess.GetFailureStores(tc)
 		for _, failureStore := range failureStores {
+			if failureStore.Mode == v1alpha1.FailoverModeRebuildLocalVolume {
+				continue
+			}
 			if failureStore.HostDown && !failureStore.StoreDeleted {
 				if err := sf.invokeDeleteFailureStore(tc, failureStore); err != nil {
 					if controller.IsIgnoreError(err) {
@@ -132,9 +145,14 @@ func (sf *commonStoreFailover) tryMarkAStoreAsFailure(tc *v1alpha1.TidbCluster)
 				if !exist {
 					sf.storeAccess.CreateFailureStoresIfAbsent(tc)
 					if len(sf.storeAccess.GetFailureStores(tc)) >= int(*maxFailoverCount) {
-						klog.Warningf("%s/%s %s failure stores count reached the limit: %d", ns, tcName, sf.storeAccess.GetMemberType(), maxFailoverCount)
+						msg := fmt.Sprintf("%s/%s %s failure stores count reached the limit: %d, store %s of pod %s is not failed over",
+							ns, tcName, sf.storeAccess.GetMemberType(), *maxFailoverCount, store.ID, podName)
+						klog.Warning(msg)
+						mngerutils.RecordBlocked(sf.deps.Recorder, tc, tc.ComponentStatus(sf.storeAccess.GetMemberType()),
+							v1alpha1.ComponentConditionFailoverBlocked, v1alpha1.EventReasonFailoverLimitReached, msg)
 						return nil
 					}
+					mngerutils.ClearBlocked(tc.ComponentStatus(sf.storeAccess.GetMemberType()), v1alpha1.ComponentConditionFailoverBlocked)
 					pvcs, err := sf.failureRecovery.getPodPvcs(tc, podName)
 					if err != nil {
 						return err
@@ -144,13 +162,25 @@ func (sf *commonStoreFailover) tryMarkAStoreAsFailure(tc *v1alpha1.TidbCluster)
 						pvcUIDSet[pvc.UID] = v1alpha1.EmptyStruct{}
 					}
 					klog.Infof("%s failover [tryMarkAStoreAsFailure] PVCUIDSet for failure store %s is %s", sf.storeAccess.GetMemberType(), store.ID, pvcUIDSet)
-					sf.storeAccess.SetFailureStore(tc, storeID, v1alpha1.TiKVFailureStore{
+					var nodeName string
+					pod, err := sf.deps.PodLister.Pods(ns).Get(podName)
+					if err != nil && !errors.IsNotFound(err) {
+						return err
+					}
+					if pod != nil {
+						nodeName = pod.Spec.NodeName
+					}
+					msg := fmt.Sprintf("store[%s] is Down", store.ID)
+					failureStore := v1alpha1.TiKVFailureStore{
 						PodName:   podName,
 						StoreID:   store.ID,
 						PVCUIDSet: pvcUIDSet,
 						CreatedAt: metav1.Now(),
-					})
-					msg := fmt.Sprintf("store[%s] is Down", store.ID)
+						Mode:      sf.storeAccess.GetFailoverMode(tc),
+						NodeName:  nodeName,
+					}
+					addFailoverRecord(&failureStore, v1alpha1.FailoverStepMarkedFailure, msg)
+					sf.storeAccess.SetFailureStore(tc, storeID, failureStore)
 					sf.deps.Recorder.Event(tc, corev1.EventTypeWarning, unHealthEventReason, fmt.Sprintf(unHealthEventMsgPattern, sf.storeAccess.GetMemberType(), podName, msg))
 				}
 			}
@@ -276,6 +306,9 @@ func (fsa *failureStoreAccess) IsHostDown(tc *v1alpha1.TidbCluster, storeId stri
 // SetHostDown sets the HostDown property in the given failure store
 func (fsa *failureStoreAccess) SetHostDown(tc *v1alpha1.TidbCluster, storeId string, hostDown bool) {
 	failureStore, _ := fsa.storeAccess.GetFailureStore(tc, storeId)
+	if hostDown && !failureStore.HostDown {
+		addFailoverRecord(&failureStore, v1alpha1.FailoverStepHostDown, fmt.Sprintf("node %s of pod %s is down", failureStore.NodeName, failureStore.PodName))
+	}
 	failureStore.HostDown = hostDown
 	fsa.storeAccess.SetFailureStore(tc, storeId, failureStore)
 }
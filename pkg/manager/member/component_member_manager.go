@@ -0,0 +1,173 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/suspender"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/klog/v2"
+)
+
+// ComponentDescriptor describes the specifics of a component of TidbCluster. The componentMemberManager
+// drives the sync flow shared by all components and calls the descriptor at each step of the flow.
+//
+// TiCDC, TiProxy and the PD microservices are built on it. PD, TiKV, TiFlash and TiDB keep their own
+// managers for now, because besides the shared flow they handle the storage volumes, the failover with
+// PVCs, the store labels, the TiKV groups and the TiFlash compute nodes, which the descriptor doesn't cover.
+type ComponentDescriptor interface {
+	// MemberType returns the member type of the component
+	MemberType() v1alpha1.MemberType
+	// Name returns the display name of the component used in the event reasons, e.g. TiCDC
+	Name() string
+	// Enabled returns whether the component is specified in the TidbCluster
+	Enabled(tc *v1alpha1.TidbCluster) bool
+	// StatefulSetName returns the name of the StatefulSet of the component
+	StatefulSetName(tc *v1alpha1.TidbCluster) string
+	// Phase returns the phase of the component in the status of the TidbCluster
+	Phase(tc *v1alpha1.TidbCluster) v1alpha1.MemberPhase
+
+	// Precheck returns an error to block the sync of the component, e.g. if the components it depends on are not available
+	Precheck(tc *v1alpha1.TidbCluster) error
+	// SyncServices syncs the Services of the component, it's skipped if the TidbCluster is paused
+	SyncServices(tc *v1alpha1.TidbCluster) error
+	// SyncStatus syncs the status of the component, the StatefulSet is nil if it's not created yet
+	SyncStatus(tc *v1alpha1.TidbCluster, sts *apps.StatefulSet) error
+	// SyncConfigMap syncs the ConfigMap used by the new StatefulSet, it returns nil if the component has no ConfigMap
+	SyncConfigMap(tc *v1alpha1.TidbCluster, sts *apps.StatefulSet) (*corev1.ConfigMap, error)
+	// NewStatefulSet returns the desired StatefulSet of the component
+	NewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.StatefulSet, error)
+}
+
+// componentMemberManager implements manager.Manager for the component described by the descriptor.
+type componentMemberManager struct {
+	deps       *controller.Dependencies
+	descriptor ComponentDescriptor
+	scaler     Scaler
+	upgrader   Upgrader
+	suspender  suspender.Suspender
+}
+
+// newComponentMemberManager returns the manager syncing the component described by the descriptor.
+func newComponentMemberManager(deps *controller.Dependencies, descriptor ComponentDescriptor, scaler Scaler, upgrader Upgrader, spder suspender.Suspender) *componentMemberManager {
+	return &componentMemberManager{
+		deps:       deps,
+		descriptor: descriptor,
+		scaler:     scaler,
+		upgrader:   upgrader,
+		suspender:  spder,
+	}
+}
+
+// Sync fulfills the manager.Manager interface.
+// As the managers of PD, TiKV and TiDB, the suspension is checked and the status is synced even if the
+// TidbCluster is paused, only the changes to the Services, ConfigMap and StatefulSet are skipped.
+func (m *componentMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
+	if !m.descriptor.Enabled(tc) {
+		return nil
+	}
+
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	// skip sync if the component is suspended
+	component := m.descriptor.MemberType()
+	needSuspend, err := m.suspender.SuspendComponent(tc, component)
+	if err != nil {
+		return fmt.Errorf("suspend %s failed: %v", component, err)
+	}
+	if needSuspend {
+		klog.Infof("component %s for cluster %s/%s is suspended, skip syncing", component, ns, tcName)
+		return nil
+	}
+
+	if err := m.descriptor.Precheck(tc); err != nil {
+		return err
+	}
+
+	if tc.Spec.Paused {
+		klog.Infof("TidbCluster %s/%s is paused, skip syncing %s service", ns, tcName, component)
+	} else if err := m.descriptor.SyncServices(tc); err != nil {
+		return err
+	}
+
+	return m.syncStatefulSet(tc)
+}
+
+func (m *componentMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	component := m.descriptor.MemberType()
+	stsName := m.descriptor.StatefulSetName(tc)
+
+	oldStsTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(stsName)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("syncStatefulSet: failed to get sts %s for cluster %s/%s, error: %s", stsName, ns, tcName, err)
+	}
+
+	stsNotExist := errors.IsNotFound(err)
+	oldSts := oldStsTmp.DeepCopy()
+
+	// failed to sync the status will not affect subsequent logic, just print the errors.
+	if err := m.descriptor.SyncStatus(tc, oldSts); err != nil {
+		klog.Errorf("failed to sync TidbCluster: [%s/%s]'s %s status, error: %v", ns, tcName, component, err)
+	}
+
+	if tc.Spec.Paused {
+		klog.Infof("TidbCluster %s/%s is paused, skip syncing %s statefulset", ns, tcName, component)
+		return nil
+	}
+
+	cm, err := m.descriptor.SyncConfigMap(tc, oldSts)
+	if err != nil {
+		return err
+	}
+
+	newSts, err := m.descriptor.NewStatefulSet(tc, cm)
+	if err != nil {
+		return err
+	}
+
+	if stsNotExist {
+		err = mngerutils.SetStatefulSetLastAppliedConfigAnnotation(newSts)
+		if err != nil {
+			return err
+		}
+		return m.deps.StatefulSetControl.CreateStatefulSet(tc, newSts)
+	}
+
+	// Scaling takes precedence over upgrading because:
+	// - if a pod fails in the upgrading, users may want to delete it or add
+	//   new replicas
+	// - it's ok to scale in the middle of upgrading (in statefulset controller
+	//   scaling takes precedence over upgrading too)
+	if err := m.scaler.Scale(tc, oldSts, newSts); err != nil {
+		return err
+	}
+
+	if !templateEqual(newSts, oldSts) || m.descriptor.Phase(tc) == v1alpha1.UpgradePhase {
+		if err := m.upgrader.Upgrade(tc, oldSts, newSts); err != nil {
+			return err
+		}
+	}
+
+	return mngerutils.UpdateStatefulSetWithPrecheck(m.deps, tc, fmt.Sprintf("FailedUpdate%sSTS", m.descriptor.Name()), newSts, oldSts)
+}
@@ -0,0 +1,275 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/suspender"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// componentConformance describes a component to run the conformance suite of componentMemberManager against.
+type componentConformance struct {
+	// newTidbCluster returns a TidbCluster with the component specified
+	newTidbCluster func() *v1alpha1.TidbCluster
+	// newManager returns the componentMemberManager of the component
+	newManager func(deps *controller.Dependencies) *componentMemberManager
+	// setReplicas sets the replicas of the component in the TidbCluster
+	setReplicas func(tc *v1alpha1.TidbCluster, replicas int32)
+	// disable removes the component from the TidbCluster
+	disable func(tc *v1alpha1.TidbCluster)
+}
+
+var componentConformances = map[v1alpha1.MemberType]componentConformance{
+	v1alpha1.TiProxyMemberType: {
+		newTidbCluster: func() *v1alpha1.TidbCluster {
+			tc := newTidbClusterForComponentConformance()
+			tc.Spec.TiProxy = &v1alpha1.TiProxySpec{Replicas: 3}
+			return tc
+		},
+		newManager: func(deps *controller.Dependencies) *componentMemberManager {
+			m := NewTiProxyMemberManager(deps, NewTiProxyScaler(deps), NewTiProxyUpgrader(deps), suspender.NewFakeSuspender())
+			return m.(*tiproxyMemberManager).componentMemberManager
+		},
+		setReplicas: func(tc *v1alpha1.TidbCluster, replicas int32) { tc.Spec.TiProxy.Replicas = replicas },
+		disable:     func(tc *v1alpha1.TidbCluster) { tc.Spec.TiProxy = nil },
+	},
+	v1alpha1.TiCDCMemberType: {
+		newTidbCluster: func() *v1alpha1.TidbCluster {
+			tc := newTidbClusterForComponentConformance()
+			tc.Spec.TiCDC = &v1alpha1.TiCDCSpec{Replicas: 3}
+			return tc
+		},
+		newManager: func(deps *controller.Dependencies) *componentMemberManager {
+			m := NewTiCDCMemberManager(deps, NewTiCDCScaler(deps), NewTiCDCUpgrader(deps), suspender.NewFakeSuspender(), &volumes.FakePodVolumeModifier{})
+			return m.(*ticdcMemberManager).componentMemberManager
+		},
+		setReplicas: func(tc *v1alpha1.TidbCluster, replicas int32) { tc.Spec.TiCDC.Replicas = replicas },
+		disable:     func(tc *v1alpha1.TidbCluster) { tc.Spec.TiCDC = nil },
+	},
+	v1alpha1.PDMSTSOMemberType:        newPDMSConformance(label.PDMSTSOLabelVal),
+	v1alpha1.PDMSSchedulingMemberType: newPDMSConformance(label.PDMSSchedulingLabelVal),
+}
+
+func newPDMSConformance(name string) componentConformance {
+	return componentConformance{
+		newTidbCluster: func() *v1alpha1.TidbCluster {
+			tc := newTidbClusterForComponentConformance()
+			tc.Spec.StartScriptVersion = v1alpha1.StartScriptV2
+			tc.Spec.PD = &v1alpha1.PDSpec{Replicas: 1, Mode: v1alpha1.PDModeMS}
+			tc.Spec.PDMS = []v1alpha1.PDMSSpec{{Name: name, Replicas: 3}}
+			tc.Status.PD.Members = map[string]v1alpha1.PDMember{"test-pd-0": {Name: "test-pd-0", Health: true}}
+			tc.Status.PD.StatefulSet = &apps.StatefulSetStatus{ReadyReplicas: 1}
+			tc.Status.PDMS = map[string]*v1alpha1.PDMSStatus{name: {Name: name, StatefulSet: &apps.StatefulSetStatus{}}}
+			return tc
+		},
+		newManager: func(deps *controller.Dependencies) *componentMemberManager {
+			pdClient := pdapi.NewFakePDClient()
+			pdClient.AddReaction(pdapi.GetMSMembersActionType, func(action *pdapi.Action) (interface{}, error) {
+				return []string{}, nil
+			})
+			deps.PDControl.(*pdapi.FakePDControl).SetPDClient(pdapi.Namespace(corev1.NamespaceDefault), "test", pdClient)
+			return newPDMSMemberManager(deps, name, NewPDMSScaler(deps, name), NewPDMSUpgrader(deps, name), suspender.NewFakeSuspender()).componentMemberManager
+		},
+		setReplicas: func(tc *v1alpha1.TidbCluster, replicas int32) { tc.Spec.PDMS[0].Replicas = replicas },
+		disable:     func(tc *v1alpha1.TidbCluster) { tc.Spec.PDMS = nil },
+	}
+}
+
+func TestComponentMemberManagerConformance(t *testing.T) {
+	for memberType, c := range componentConformances {
+		t.Run(memberType.String(), func(t *testing.T) {
+			testComponentMemberManagerConformance(t, c)
+		})
+	}
+}
+
+func testComponentMemberManagerConformance(t *testing.T, c componentConformance) {
+	type testcase struct {
+		name   string
+		modify func(tc *v1alpha1.TidbCluster, m *componentMemberManager)
+		err    bool
+		// created indicates whether the StatefulSet and Services of the component are created
+		created bool
+	}
+
+	tests := []testcase{
+		{
+			name:    "create",
+			created: true,
+		},
+		{
+			name:   "disabled",
+			modify: func(tc *v1alpha1.TidbCluster, _ *componentMemberManager) { c.disable(tc) },
+		},
+		{
+			name: "paused",
+			modify: func(tc *v1alpha1.TidbCluster, _ *componentMemberManager) {
+				tc.Spec.Paused = true
+			},
+		},
+		{
+			name: "suspended",
+			modify: func(_ *v1alpha1.TidbCluster, m *componentMemberManager) {
+				m.suspender.(*suspender.FakeSuspender).SuspendComponentFunc = func(v1alpha1.Cluster, v1alpha1.MemberType) (bool, error) {
+					return true, nil
+				}
+			},
+		},
+		{
+			name: "failed to suspend",
+			modify: func(_ *v1alpha1.TidbCluster, m *componentMemberManager) {
+				m.suspender.(*suspender.FakeSuspender).SuspendComponentFunc = func(v1alpha1.Cluster, v1alpha1.MemberType) (bool, error) {
+					return false, fmt.Errorf("suspend failed")
+				}
+			},
+			err: true,
+		},
+		{
+			name: "failed to create statefulset",
+			modify: func(_ *v1alpha1.TidbCluster, m *componentMemberManager) {
+				m.deps.StatefulSetControl.(*controller.FakeStatefulSetControl).SetCreateStatefulSetError(errors.NewInternalError(fmt.Errorf("API server failed")), 0)
+			},
+			err:     true,
+			created: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			tc := c.newTidbCluster()
+			m := c.newManager(controller.NewFakeDependencies())
+			if test.modify != nil {
+				test.modify(tc, m)
+			}
+			oldSpec := tc.Spec
+
+			err := m.Sync(tc)
+			if test.err {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(tc.Spec).To(Equal(oldSpec))
+
+			_, err = m.deps.StatefulSetLister.StatefulSets(tc.Namespace).Get(m.descriptor.StatefulSetName(tc))
+			if test.created {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(errors.IsNotFound(err)).To(BeTrue())
+			}
+
+			if test.name == "create" {
+				selector, err := label.New().Instance(tc.GetInstanceName()).Component(m.descriptor.MemberType().String()).Selector()
+				g.Expect(err).NotTo(HaveOccurred())
+				svcs, err := m.deps.ServiceLister.Services(tc.Namespace).List(selector)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(svcs).NotTo(BeEmpty())
+			}
+		})
+	}
+
+	t.Run("scale out", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		tc := c.newTidbCluster()
+		m := c.newManager(controller.NewFakeDependencies())
+		g.Expect(m.Sync(tc)).To(Succeed())
+		set, err := m.deps.StatefulSetLister.StatefulSets(tc.Namespace).Get(m.descriptor.StatefulSetName(tc))
+		g.Expect(err).NotTo(HaveOccurred())
+		replicas := *set.Spec.Replicas
+
+		c.setReplicas(tc, replicas+2)
+		g.Expect(m.Sync(tc)).To(Succeed())
+		set, err = m.deps.StatefulSetLister.StatefulSets(tc.Namespace).Get(m.descriptor.StatefulSetName(tc))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(*set.Spec.Replicas).To(Equal(replicas + 1)) // scale out one-by-one
+	})
+
+	t.Run("failed to update statefulset", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		tc := c.newTidbCluster()
+		m := c.newManager(controller.NewFakeDependencies())
+		g.Expect(m.Sync(tc)).To(Succeed())
+
+		m.deps.StatefulSetControl.(*controller.FakeStatefulSetControl).SetUpdateStatefulSetError(errors.NewInternalError(fmt.Errorf("API server failed")), 0)
+		c.setReplicas(tc, 5)
+		g.Expect(m.Sync(tc)).NotTo(Succeed())
+	})
+}
+
+func TestComponentMemberManagerPaused(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := componentConformances[v1alpha1.TiProxyMemberType]
+	tc := c.newTidbCluster()
+	m := c.newManager(controller.NewFakeDependencies())
+	g.Expect(m.Sync(tc)).To(Succeed())
+	oldSet, err := m.deps.StatefulSetLister.StatefulSets(tc.Namespace).Get(m.descriptor.StatefulSetName(tc))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	suspendChecked := 0
+	m.suspender.(*suspender.FakeSuspender).SuspendComponentFunc = func(v1alpha1.Cluster, v1alpha1.MemberType) (bool, error) {
+		suspendChecked++
+		return false, nil
+	}
+	tc.Spec.Paused = true
+	tc.Spec.TiProxy.Replicas = 5
+	tc.Status.TiProxy.StatefulSet = nil
+	g.Expect(m.Sync(tc)).To(Succeed())
+
+	// the suspension is checked and the status is synced while paused
+	g.Expect(suspendChecked).To(Equal(1))
+	g.Expect(tc.Status.TiProxy.StatefulSet).NotTo(BeNil())
+	// the statefulset is not changed
+	set, err := m.deps.StatefulSetLister.StatefulSets(tc.Namespace).Get(m.descriptor.StatefulSetName(tc))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(set.Spec.Replicas).To(Equal(oldSet.Spec.Replicas))
+}
+
+func newTidbClusterForComponentConformance() *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "TidbCluster",
+			APIVersion: "pingcap.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: corev1.NamespaceDefault,
+			UID:       types.UID("test"),
+		},
+		Spec: v1alpha1.TidbClusterSpec{
+			Version: "v7.5.0",
+		},
+		Status: v1alpha1.TidbClusterStatus{
+			TiProxy: v1alpha1.TiProxyStatus{StatefulSet: &apps.StatefulSetStatus{}},
+			TiCDC:   v1alpha1.TiCDCStatus{StatefulSet: &apps.StatefulSetStatus{}},
+		},
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/util"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -73,9 +74,12 @@ func (f *masterFailover) Failover(dc *v1alpha1.DMCluster) error {
 
 	failureReplicas := getDMMasterFailureReplicas(dc)
 	if failureReplicas >= int(*dc.Spec.Master.MaxFailoverCount) {
-		klog.Errorf("dm-master failover replicas (%d) reaches the limit (%d), skip failover", failureReplicas, *dc.Spec.Master.MaxFailoverCount)
+		msg := fmt.Sprintf("dm-master failover replicas (%d) reaches the limit (%d), skip failover", failureReplicas, *dc.Spec.Master.MaxFailoverCount)
+		klog.Error(msg)
+		mngerutils.RecordBlocked(f.deps.Recorder, dc, &dc.Status.Master, v1alpha1.ComponentConditionFailoverBlocked, v1alpha1.EventReasonFailoverLimitReached, msg)
 		return nil
 	}
+	mngerutils.ClearBlocked(&dc.Status.Master, v1alpha1.ComponentConditionFailoverBlocked)
 
 	notDeletedCount := 0
 	for _, masterMember := range dc.Status.Master.FailureMembers {
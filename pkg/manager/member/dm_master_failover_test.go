@@ -262,8 +262,9 @@ func TestMasterFailoverFailover(t *testing.T) {
 				g.Expect(int(dc.Spec.Master.Replicas)).To(Equal(3))
 				g.Expect(len(dc.Status.Master.FailureMembers)).To(Equal(0))
 				events := collectEvents(recorder.Events)
-				g.Expect(events).To(HaveLen(1))
+				g.Expect(events).To(HaveLen(2))
 				g.Expect(events[0]).To(ContainSubstring("test-dm-master-1(12891273174085095651) is unhealthy"))
+				g.Expect(events[1]).To(ContainSubstring("FailoverLimitReached"))
 			},
 		},
 		{
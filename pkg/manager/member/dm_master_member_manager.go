@@ -604,6 +604,9 @@ func getNewMasterSetForDMCluster(dc *v1alpha1.DMCluster, cm *corev1.ConfigMap) (
 			},
 		})
 	}
+	tlsClientVols, tlsClientVolMounts := dmTLSClientVolumes(dc)
+	vols = append(vols, tlsClientVols...)
+	volMounts = append(volMounts, tlsClientVolMounts...)
 
 	storageSize := DefaultStorageSize
 	if dc.Spec.Master.StorageSize != "" {
@@ -765,6 +768,12 @@ func getMasterConfigMap(dc *v1alpha1.DMCluster) (*corev1.ConfigMap, error) {
 		config.Set("ssl-key", path.Join(dmMasterClusterCertPath, corev1.TLSPrivateKeyKey))
 	}
 
+	// the OpenAPI is required to manage the upstream sources, `experimental.openapi`
+	// is used as it's recognized since DM v5.3.0
+	if dc.HasSources() && config.Get("openapi") == nil {
+		config.SetIfNil("experimental.openapi", true)
+	}
+
 	confText, err := config.MarshalTOML()
 	if err != nil {
 		return nil, err
@@ -1491,6 +1491,59 @@ func TestGetMasterConfigMap(t *testing.T) {
 					"config-file": `log-level = "debug"
 rpc-rate-limit = 15.0
 rpc-timeout = "40s"
+`,
+				},
+			},
+		},
+		{
+			name: "openapi is enabled for the managed sources",
+			dc: v1alpha1.DMCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "ns",
+				},
+				Spec: v1alpha1.DMClusterSpec{
+					Master: v1alpha1.MasterSpec{
+						Config: mustMasterConfig(&v1alpha1.MasterConfig{
+							LogLevel: pointer.StringPtr("debug"),
+						}),
+					},
+					Worker: &v1alpha1.WorkerSpec{
+						Sources: []v1alpha1.DMSourceSpec{{Name: "mysql1"}},
+					},
+				},
+			},
+			expected: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo-dm-master",
+					Namespace: "ns",
+					Labels: map[string]string{
+						"app.kubernetes.io/name":       "dm-cluster",
+						"app.kubernetes.io/managed-by": "tidb-operator",
+						"app.kubernetes.io/instance":   "foo",
+						"app.kubernetes.io/component":  "dm-master",
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "pingcap.com/v1alpha1",
+							Kind:       "DMCluster",
+							Name:       "foo",
+							UID:        "",
+							Controller: func(b bool) *bool {
+								return &b
+							}(true),
+							BlockOwnerDeletion: func(b bool) *bool {
+								return &b
+							}(true),
+						},
+					},
+				},
+				Data: map[string]string{
+					"startup-script": "",
+					"config-file": `log-level = "debug"
+
+[experimental]
+  openapi = true
 `,
 				},
 			},
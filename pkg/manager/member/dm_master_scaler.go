@@ -61,6 +61,7 @@ func (s *masterScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, ne
 	dcName := dc.GetName()
 
 	klog.Infof("scaling out dm-master statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.DMMasterMemberType, v1alpha1.EventReasonScaleOut, ordinal, replicas)
 	_, err := s.deleteDeferDeletingPVC(dc, v1alpha1.DMMasterMemberType, ordinal)
 	if err != nil {
 		return err
@@ -94,6 +95,7 @@ func (s *masterScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, new
 	}
 
 	klog.Infof("scaling in dm-master statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.DMMasterMemberType, v1alpha1.EventReasonScaleIn, ordinal, replicas)
 
 	// If the dm-master pod was dm-master leader during scale-in, we would evict dm-master leader first
 	// If the dm-master statefulSet would be scale-in to zero and the dm-master-0 was going to be deleted,
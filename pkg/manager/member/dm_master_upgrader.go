@@ -49,6 +49,8 @@ func (u *masterUpgrader) gracefulUpgrade(dc *v1alpha1.DMCluster, oldSet *apps.St
 	}
 	if dc.MasterScaling() {
 		klog.Infof("DMCluster: [%s/%s]'s dm-master is scaling, can not upgrade dm-master", ns, dcName)
+		mngerutils.RecordBlocked(u.deps.Recorder, dc, &dc.Status.Master, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked,
+			"dm-master is scaling, can not upgrade dm-master")
 		_, podSpec, err := GetLastAppliedConfig(oldSet)
 		if err != nil {
 			return err
@@ -56,6 +58,7 @@ func (u *masterUpgrader) gracefulUpgrade(dc *v1alpha1.DMCluster, oldSet *apps.St
 		newSet.Spec.Template.Spec = *podSpec
 		return nil
 	}
+	mngerutils.ClearBlocked(&dc.Status.Master, v1alpha1.ComponentConditionUpgradeBlocked)
 
 	dc.Status.Master.Phase = v1alpha1.UpgradePhase
 	if !templateEqual(newSet, oldSet) {
@@ -121,6 +124,7 @@ func (u *masterUpgrader) upgradeMasterPod(dc *v1alpha1.DMCluster, ordinal int32,
 		return controller.RequeueErrorf("dmcluster: [%s/%s]'s dm-master member: evicting [%s]'s leader", ns, dcName, upgradePodName)
 	}
 
+	recordUpgradePod(u.deps.Recorder, dc, newSet, v1alpha1.DMMasterMemberType, ordinal)
 	mngerutils.SetUpgradePartition(newSet, ordinal)
 	return nil
 }
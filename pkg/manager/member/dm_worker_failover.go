@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
@@ -67,9 +68,12 @@ func (f *workerFailover) Failover(dc *v1alpha1.DMCluster) error {
 					}
 					maxFailoverCount := *dc.Spec.Worker.MaxFailoverCount
 					if len(dc.Status.Worker.FailureMembers) >= int(maxFailoverCount) {
-						klog.Warningf("%s/%s failure workers count reached the limit: %d", ns, dcName, *dc.Spec.Worker.MaxFailoverCount)
+						msg := fmt.Sprintf("%s/%s failure workers count reached the limit: %d, worker %s is not failed over", ns, dcName, maxFailoverCount, podName)
+						klog.Warning(msg)
+						mngerutils.RecordBlocked(f.deps.Recorder, dc, &dc.Status.Worker, v1alpha1.ComponentConditionFailoverBlocked, v1alpha1.EventReasonFailoverLimitReached, msg)
 						return nil
 					}
+					mngerutils.ClearBlocked(&dc.Status.Worker, v1alpha1.ComponentConditionFailoverBlocked)
 					dc.Status.Worker.FailureMembers[podName] = v1alpha1.WorkerFailureMember{
 						PodName:   podName,
 						CreatedAt: metav1.Now(),
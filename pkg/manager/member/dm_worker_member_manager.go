@@ -48,15 +48,17 @@ const (
 type workerMemberManager struct {
 	deps      *controller.Dependencies
 	scaler    Scaler
+	upgrader  DMUpgrader
 	failover  DMFailover
 	suspender suspender.Suspender
 }
 
 // NewWorkerMemberManager returns a *ticdcMemberManager
-func NewWorkerMemberManager(deps *controller.Dependencies, scaler Scaler, failover DMFailover, spder suspender.Suspender) manager.DMManager {
+func NewWorkerMemberManager(deps *controller.Dependencies, scaler Scaler, upgrader DMUpgrader, failover DMFailover, spder suspender.Suspender) manager.DMManager {
 	return &workerMemberManager{
 		deps:      deps,
 		scaler:    scaler,
+		upgrader:  upgrader,
 		failover:  failover,
 		suspender: spder,
 	}
@@ -95,7 +97,12 @@ func (m *workerMemberManager) SyncDM(dc *v1alpha1.DMCluster) error {
 	}
 
 	// Sync dm-worker StatefulSet
-	return m.syncWorkerStatefulSetForDMCluster(dc)
+	if err := m.syncWorkerStatefulSetForDMCluster(dc); err != nil {
+		return err
+	}
+
+	// Sync the relay log and bindings of the upstream sources
+	return m.syncWorkerSources(dc)
 }
 
 func (m *workerMemberManager) syncWorkerHeadlessServiceForDMCluster(dc *v1alpha1.DMCluster) error {
@@ -235,6 +242,12 @@ func (m *workerMemberManager) syncWorkerStatefulSetForDMCluster(dc *v1alpha1.DMC
 		}
 	}
 
+	if !templateEqual(newSts, oldSts) || dc.Status.Worker.Phase == v1alpha1.UpgradePhase {
+		if err := m.upgrader.Upgrade(dc, oldSts, newSts); err != nil {
+			return err
+		}
+	}
+
 	return mngerutils.UpdateStatefulSet(m.deps.StatefulSetControl, dc, newSts, oldSts)
 }
 
@@ -295,6 +308,8 @@ func (m *workerMemberManager) syncDMClusterStatus(dc *v1alpha1.DMCluster, set *a
 		}
 	}
 
+	syncSourcesStatus(dc, dmClient, workersInfo)
+
 	dc.Status.Worker.Synced = true
 	dc.Status.Worker.Members = workerStatus
 	dc.Status.Worker.Image = ""
@@ -425,6 +440,9 @@ func getNewWorkerSetForDMCluster(dc *v1alpha1.DMCluster, cm *corev1.ConfigMap) (
 			},
 		})
 	}
+	tlsClientVols, tlsClientVolMounts := dmTLSClientVolumes(dc)
+	vols = append(vols, tlsClientVols...)
+	volMounts = append(volMounts, tlsClientVolMounts...)
 
 	storageSize := DefaultStorageSize
 	if dc.Spec.Worker.StorageSize != "" {
@@ -445,6 +463,10 @@ func getNewWorkerSetForDMCluster(dc *v1alpha1.DMCluster, cm *corev1.ConfigMap) (
 	podLabels := util.CombineStringMap(stsLabels, baseWorkerSpec.Labels())
 	podAnnotations := util.CombineStringMap(baseWorkerSpec.Annotations(), controller.AnnProm(8262, "/metrics"))
 	stsAnnotations := getStsAnnotations(dc.Annotations, label.DMWorkerLabelVal)
+	deleteSlotsNumber, err := util.GetDeleteSlotsNumber(stsAnnotations)
+	if err != nil {
+		return nil, fmt.Errorf("get delete slots number of statefulset %s/%s failed, err:%v", ns, setName, err)
+	}
 
 	workerContainer := corev1.Container{
 		Name:            v1alpha1.DMWorkerMemberType.String(),
@@ -511,6 +533,18 @@ func getNewWorkerSetForDMCluster(dc *v1alpha1.DMCluster, cm *corev1.ConfigMap) (
 	var initContainers []corev1.Container // no default initContainers now
 	podSpec.InitContainers = append(initContainers, baseWorkerSpec.InitContainers()...)
 
+	// the partition is lowered by the upgrader one by one, so that the source bound to a
+	// dm-worker can be transferred before the worker is restarted
+	updateStrategy := apps.StatefulSetUpdateStrategy{}
+	if baseWorkerSpec.StatefulSetUpdateStrategy() == apps.OnDeleteStatefulSetStrategyType {
+		updateStrategy.Type = apps.OnDeleteStatefulSetStrategyType
+	} else {
+		updateStrategy.Type = apps.RollingUpdateStatefulSetStrategyType
+		updateStrategy.RollingUpdate = &apps.RollingUpdateStatefulSetStrategy{
+			Partition: pointer.Int32Ptr(dc.WorkerStsDesiredReplicas() + deleteSlotsNumber),
+		}
+	}
+
 	workerSet := &apps.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            setName,
@@ -545,9 +579,7 @@ func getNewWorkerSetForDMCluster(dc *v1alpha1.DMCluster, cm *corev1.ConfigMap) (
 			},
 			ServiceName:         controller.DMWorkerPeerMemberName(dcName),
 			PodManagementPolicy: baseWorkerSpec.PodManagementPolicy(),
-			UpdateStrategy: apps.StatefulSetUpdateStrategy{
-				Type: baseWorkerSpec.StatefulSetUpdateStrategy(),
-			},
+			UpdateStrategy:      updateStrategy,
 		},
 	}
 
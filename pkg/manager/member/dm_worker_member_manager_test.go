@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
@@ -726,6 +727,7 @@ func newFakeWorkerMemberManager() (*workerMemberManager, *workerFakeControls, *w
 	pmm := &workerMemberManager{
 		deps:      fakeDeps,
 		scaler:    NewFakeWorkerScaler(),
+		upgrader:  NewFakeWorkerUpgrader(),
 		failover:  NewFakeWorkerFailover(),
 		suspender: suspender.NewFakeSuspender(),
 	}
@@ -792,6 +794,79 @@ func newDMClusterForWorker() *v1alpha1.DMCluster {
 	}
 }
 
+func TestWorkerMemberManagerSyncSources(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dc := newDMClusterForWorker()
+	podName0 := DMWorkerPodName(dc.Name, 0)
+	podName1 := DMWorkerPodName(dc.Name, 1)
+	podName2 := DMWorkerPodName(dc.Name, 2)
+	dc.Spec.Worker.Sources = []v1alpha1.DMSourceSpec{
+		{Name: "mysql1", PreferredWorkers: []string{podName2}, Relay: &v1alpha1.DMRelaySpec{Enabled: true, BinlogName: "mysql-bin.000001"}},
+		{Name: "mysql2", PreferredWorkers: []string{podName1}, Relay: &v1alpha1.DMRelaySpec{Enabled: true}},
+		{Name: "mysql3"},
+	}
+	dc.Status.Worker.Synced = true
+	dc.Status.Worker.Phase = v1alpha1.NormalPhase
+	dc.Status.Worker.Members = map[string]v1alpha1.WorkerMember{
+		podName0: {Name: podName0, Stage: v1alpha1.DMWorkerStateBound},
+		podName1: {Name: podName1, Stage: v1alpha1.DMWorkerStateBound},
+		podName2: {Name: podName2, Stage: v1alpha1.DMWorkerStateFree},
+	}
+	transitionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	dc.Status.Worker.Sources = map[string]v1alpha1.DMSourceStatus{
+		"mysql2": {Name: "mysql2", Worker: podName1, LastTransitionTime: transitionTime},
+	}
+
+	wmm, _, _, fakeMasterControl := newFakeWorkerMemberManager()
+	masterClient := controller.NewFakeMasterClient(fakeMasterControl, dc)
+	masterClient.AddReaction(dmapi.GetSourcesActionType, func(action *dmapi.Action) (interface{}, error) {
+		return []*dmapi.SourceInfo{
+			{SourceName: "mysql1"},
+			{
+				SourceName:  "mysql2",
+				RelayConfig: &dmapi.RelayConfig{EnableRelay: true},
+				StatusList:  []*dmapi.SourceStatus{{SourceName: "mysql2", WorkerName: podName1, RelayStatus: &dmapi.RelayStatus{Stage: "Running"}}},
+			},
+			{SourceName: "mysql3"},
+		}, nil
+	})
+	var actions []string
+	masterClient.AddReaction(dmapi.TransferSourceActionType, func(action *dmapi.Action) (interface{}, error) {
+		actions = append(actions, fmt.Sprintf("transfer %s to %s", action.Source, action.Worker))
+		return nil, nil
+	})
+	masterClient.AddReaction(dmapi.EnableRelayActionType, func(action *dmapi.Action) (interface{}, error) {
+		actions = append(actions, fmt.Sprintf("enable relay of %s from %s", action.Source, action.RelayRequest.RelayBinlogName))
+		return nil, nil
+	})
+
+	syncSourcesStatus(dc, masterClient, []*dmapi.WorkersInfo{
+		{Name: podName0, Stage: v1alpha1.DMWorkerStateBound, Source: "mysql1"},
+		{Name: podName1, Stage: v1alpha1.DMWorkerStateBound, Source: "mysql2"},
+		{Name: podName2, Stage: v1alpha1.DMWorkerStateFree},
+	})
+	g.Expect(dc.Status.Worker.Sources).To(HaveLen(3))
+	g.Expect(dc.Status.Worker.Sources["mysql1"].Worker).To(Equal(podName0))
+	g.Expect(dc.Status.Worker.Sources["mysql1"].RelayEnabled).To(BeFalse())
+	g.Expect(dc.Status.Worker.Sources["mysql2"].RelayEnabled).To(BeTrue())
+	g.Expect(dc.Status.Worker.Sources["mysql2"].RelayStage).To(Equal("Running"))
+	g.Expect(dc.Status.Worker.Sources["mysql2"].LastTransitionTime).To(Equal(transitionTime))
+	g.Expect(dc.Status.Worker.Sources["mysql3"].Worker).To(BeEmpty())
+
+	g.Expect(wmm.syncWorkerSources(dc)).To(Succeed())
+	g.Expect(actions).To(Equal([]string{
+		"enable relay of mysql1 from mysql-bin.000001",
+		"transfer mysql1 to " + podName2,
+	}))
+
+	// the sources are not moved while scaling or upgrading
+	actions = nil
+	dc.Status.Worker.Phase = v1alpha1.UpgradePhase
+	g.Expect(wmm.syncWorkerSources(dc)).To(Succeed())
+	g.Expect(actions).To(BeEmpty())
+}
+
 func TestGetNewWorkerHeadlessService(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1322,6 +1397,33 @@ func TestGetNewWorkerSetForDMCluster(t *testing.T) {
 				g.Expect(sts.Spec.PodManagementPolicy).To(Equal(appsv1.ParallelPodManagement))
 			},
 		},
+		{
+			name: "dm-worker with tls secrets of upstreams and downstreams",
+			dc: v1alpha1.DMCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "dc",
+					Namespace: "ns",
+				},
+				Spec: v1alpha1.DMClusterSpec{
+					Master:         v1alpha1.MasterSpec{},
+					Worker:         &v1alpha1.WorkerSpec{},
+					TLSUpstreams:   []v1alpha1.DMTLSClientSecret{{Name: "mysql1", SecretName: "mysql1-client"}},
+					TLSDownstreams: []v1alpha1.DMTLSClientSecret{{Name: "tidb", SecretName: "tidb-client"}},
+				},
+			},
+			testSts: func(sts *appsv1.StatefulSet) {
+				g := NewGomegaWithT(t)
+				podSpec := sts.Spec.Template.Spec
+				g.Expect(podSpec.Volumes).To(ContainElements(
+					corev1.Volume{Name: "upstream-tls-mysql1", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "mysql1-client"}}},
+					corev1.Volume{Name: "downstream-tls-tidb", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "tidb-client"}}},
+				))
+				g.Expect(podSpec.Containers[0].VolumeMounts).To(ContainElements(
+					corev1.VolumeMount{Name: "upstream-tls-mysql1", ReadOnly: true, MountPath: "/var/lib/upstream-tls/mysql1"},
+					corev1.VolumeMount{Name: "downstream-tls-tidb", ReadOnly: true, MountPath: "/var/lib/downstream-tls/tidb"},
+				))
+			},
+		},
 		// TODO add more tests
 	}
 
@@ -61,6 +61,7 @@ func (s *workerScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, ne
 	dcName := dc.GetName()
 
 	klog.Infof("scaling out dm-worker statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.DMWorkerMemberType, v1alpha1.EventReasonScaleOut, ordinal, replicas)
 	_, err := s.deleteDeferDeletingPVC(dc, v1alpha1.DMWorkerMemberType, ordinal)
 	if err != nil {
 		return err
@@ -94,7 +95,19 @@ func (s *workerScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, new
 		return fmt.Errorf("DMCluster: %s/%s's dm-worker status sync failed, can't scale in now", ns, dcName)
 	}
 
+	// transfer the source bound to the dm-worker before it's deleted, otherwise the source
+	// is interrupted until dm-master binds it to another worker after the keepalive lease is outdated
+	podName := DMWorkerPodName(dcName, ordinal)
+	transferring, err := transferSourceOfWorker(s.deps, dc, podName, nil)
+	if err != nil {
+		return err
+	}
+	if transferring {
+		return controller.RequeueErrorf("DMCluster: %s/%s's dm-worker %s is transferring its source, scale in later", ns, dcName, podName)
+	}
+
 	klog.Infof("scaling in dm-worker statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.DMWorkerMemberType, v1alpha1.EventReasonScaleIn, ordinal, replicas)
 
 	pvcName := ordinalPVCName(v1alpha1.DMWorkerMemberType, setName, ordinal)
 	pvc, err := s.deps.PVCLister.PersistentVolumeClaims(ns).Get(pvcName)
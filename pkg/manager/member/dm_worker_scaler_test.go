@@ -161,6 +161,9 @@ func TestWorkerScalerScaleIn(t *testing.T) {
 		err              bool
 		changed          bool
 		isLeader         bool
+		boundSource      bool
+		freeWorker       bool
+		transferTo       string
 	}
 
 	testFn := func(test testcase, t *testing.T) {
@@ -169,7 +172,31 @@ func TestWorkerScalerScaleIn(t *testing.T) {
 		newSet := oldSet.DeepCopy()
 		newSet.Spec.Replicas = pointer.Int32Ptr(3)
 
-		scaler, _, pvcIndexer, pvcControl := newFakeWorkerScaler()
+		scaler, masterControl, pvcIndexer, pvcControl := newFakeWorkerScaler()
+
+		transferTo := ""
+		if test.boundSource {
+			normalWorkerMember(dc)
+			scaledIn := ordinalPodName(v1alpha1.DMWorkerMemberType, dc.Name, 4)
+			dc.Status.Worker.Members[scaledIn] = v1alpha1.WorkerMember{Stage: v1alpha1.DMWorkerStateBound}
+			if !test.freeWorker {
+				for name := range dc.Status.Worker.Members {
+					dc.Status.Worker.Members[name] = v1alpha1.WorkerMember{Stage: v1alpha1.DMWorkerStateBound}
+				}
+			}
+			dc.Status.Worker.Sources = map[string]v1alpha1.DMSourceStatus{
+				"mysql1": {Name: "mysql1", Worker: scaledIn},
+			}
+			dc.Spec.Worker.Sources = []v1alpha1.DMSourceSpec{
+				{Name: "mysql1", PreferredWorkers: []string{scaledIn, ordinalPodName(v1alpha1.DMWorkerMemberType, dc.Name, 2)}},
+			}
+			masterClient := controller.NewFakeMasterClient(masterControl, dc)
+			masterClient.AddReaction(dmapi.TransferSourceActionType, func(action *dmapi.Action) (interface{}, error) {
+				g.Expect(action.Source).To(Equal("mysql1"))
+				transferTo = action.Worker
+				return nil, nil
+			})
+		}
 
 		if test.hasPVC {
 			pvc := newScaleInPVCForStatefulSet(oldSet, v1alpha1.DMWorkerMemberType, dc.Name)
@@ -196,6 +223,7 @@ func TestWorkerScalerScaleIn(t *testing.T) {
 		} else {
 			g.Expect(int(*newSet.Spec.Replicas)).To(Equal(5))
 		}
+		g.Expect(transferTo).To(Equal(test.transferTo))
 	}
 
 	tests := []testcase{
@@ -231,6 +259,28 @@ func TestWorkerScalerScaleIn(t *testing.T) {
 			err:              true,
 			changed:          false,
 		},
+		{
+			name:             "transfer the bound source to the preferred worker",
+			hasPVC:           true,
+			pvcUpdateErr:     false,
+			statusSyncFailed: false,
+			err:              true,
+			changed:          false,
+			isLeader:         true,
+			boundSource:      true,
+			freeWorker:       true,
+			transferTo:       "test-dm-worker-2",
+		},
+		{
+			name:             "no free worker to transfer the bound source",
+			hasPVC:           true,
+			pvcUpdateErr:     false,
+			statusSyncFailed: false,
+			err:              false,
+			changed:          true,
+			boundSource:      true,
+			freeWorker:       false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,252 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/dmapi"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+// syncSourcesStatus collects the bindings of the upstream sources from the dm-workers,
+// and the relay status from the OpenAPI of dm-master if the sources are managed.
+func syncSourcesStatus(dc *v1alpha1.DMCluster, dmClient dmapi.MasterClient, workers []*dmapi.WorkersInfo) {
+	sources := map[string]v1alpha1.DMSourceStatus{}
+	for _, worker := range workers {
+		if worker.Source == "" {
+			continue
+		}
+		sources[worker.Source] = v1alpha1.DMSourceStatus{
+			Name:   worker.Source,
+			Worker: worker.Name,
+		}
+	}
+
+	if dc.HasSources() {
+		sourcesInfo, err := dmClient.GetSources()
+		if err != nil {
+			// the bindings are still accurate without the relay status
+			klog.Errorf("failed to get the sources of DMCluster %s/%s, error: %v", dc.Namespace, dc.Name, err)
+			for name, status := range dc.Status.Worker.Sources {
+				if source, ok := sources[name]; ok {
+					source.RelayEnabled = status.RelayEnabled
+					source.RelayStage = status.RelayStage
+					sources[name] = source
+				}
+			}
+		}
+		for _, info := range sourcesInfo {
+			source, ok := sources[info.SourceName]
+			if !ok {
+				source = v1alpha1.DMSourceStatus{Name: info.SourceName}
+			}
+			source.RelayEnabled = info.RelayConfig != nil && info.RelayConfig.EnableRelay
+			for _, status := range info.StatusList {
+				if status.RelayStatus != nil && (source.Worker == "" || status.WorkerName == source.Worker) {
+					source.RelayStage = status.RelayStatus.Stage
+				}
+			}
+			sources[info.SourceName] = source
+		}
+	}
+
+	for name, source := range sources {
+		source.LastTransitionTime = metav1.Now()
+		if old, ok := dc.Status.Worker.Sources[name]; ok && old.Worker == source.Worker {
+			source.LastTransitionTime = old.LastTransitionTime
+		}
+		sources[name] = source
+	}
+	if len(sources) == 0 {
+		sources = nil
+	}
+	dc.Status.Worker.Sources = sources
+}
+
+// syncWorkerSources enables or disables the relay log of the upstream sources and
+// transfers the sources to their preferred dm-workers.
+func (m *workerMemberManager) syncWorkerSources(dc *v1alpha1.DMCluster) error {
+	if !dc.HasSources() {
+		return nil
+	}
+	ns := dc.GetNamespace()
+	dcName := dc.GetName()
+	// do not move the sources while the dm-workers are scaling or upgrading,
+	// the sources are transferred by the scaler and upgrader in these phases
+	if !dc.Status.Worker.Synced || dc.Status.Worker.Phase != v1alpha1.NormalPhase {
+		klog.V(4).Infof("DMCluster %s/%s's dm-worker is not synced or in %s phase, skip syncing sources", ns, dcName, dc.Status.Worker.Phase)
+		return nil
+	}
+
+	dmClient := controller.GetMasterClient(m.deps.DMMasterControl, dc)
+	var errs []error
+	for i := range dc.Spec.Worker.Sources {
+		source := &dc.Spec.Worker.Sources[i]
+		status, ok := dc.Status.Worker.Sources[source.Name]
+		if !ok {
+			klog.Warningf("source %s of DMCluster %s/%s is not found in dm-master", source.Name, ns, dcName)
+			continue
+		}
+
+		if source.Relay != nil && source.Relay.Enabled != status.RelayEnabled {
+			if err := m.syncRelay(dc, dmClient, source); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+		}
+
+		if len(source.PreferredWorkers) == 0 || isPreferredWorker(source, status.Worker) {
+			continue
+		}
+		worker := freePreferredWorker(dc, source, "")
+		if worker == "" {
+			klog.V(4).Infof("no preferred dm-worker of source %s is free in DMCluster %s/%s", source.Name, ns, dcName)
+			continue
+		}
+		if err := transferSource(m.deps, dc, source.Name, status.Worker, worker); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errorutils.NewAggregate(errs)
+}
+
+func (m *workerMemberManager) syncRelay(dc *v1alpha1.DMCluster, dmClient dmapi.MasterClient, source *v1alpha1.DMSourceSpec) error {
+	if !source.Relay.Enabled {
+		if err := dmClient.DisableRelay(source.Name); err != nil {
+			return fmt.Errorf("failed to disable relay of source %s for DMCluster %s/%s, error: %v", source.Name, dc.Namespace, dc.Name, err)
+		}
+		m.deps.Recorder.Eventf(dc, corev1.EventTypeNormal, v1alpha1.EventReasonSyncRelay, "disable relay of source %s", source.Name)
+		return nil
+	}
+
+	req := &dmapi.EnableRelayRequest{
+		RelayBinlogName: source.Relay.BinlogName,
+		RelayBinlogGTID: source.Relay.BinlogGTID,
+	}
+	if err := dmClient.EnableRelay(source.Name, req); err != nil {
+		return fmt.Errorf("failed to enable relay of source %s for DMCluster %s/%s, error: %v", source.Name, dc.Namespace, dc.Name, err)
+	}
+	m.deps.Recorder.Eventf(dc, corev1.EventTypeNormal, v1alpha1.EventReasonSyncRelay, "enable relay of source %s", source.Name)
+	return nil
+}
+
+// transferSourceOfWorker transfers the source bound to the dm-worker to a free worker before
+// the worker is deleted or restarted. Preferred workers of the source are picked first, then
+// the candidates in order, then any other free worker.
+// It returns true if the source begins to be transferred. If there is no free worker,
+// the source is left to dm-master, which binds it again once a worker is free.
+func transferSourceOfWorker(deps *controller.Dependencies, dc *v1alpha1.DMCluster, worker string, candidates []string) (bool, error) {
+	if !dc.OpenAPIEnabled() {
+		return false, nil
+	}
+	source := boundSource(dc, worker)
+	if source == "" {
+		return false, nil
+	}
+
+	var spec *v1alpha1.DMSourceSpec
+	for i := range dc.Spec.Worker.Sources {
+		if dc.Spec.Worker.Sources[i].Name == source {
+			spec = &dc.Spec.Worker.Sources[i]
+		}
+	}
+	target := freePreferredWorker(dc, spec, worker)
+	if target == "" {
+		target = firstFreeWorker(dc, candidates, worker)
+	}
+	if target == "" {
+		target = freeWorker(dc, worker)
+	}
+	if target == "" {
+		klog.Warningf("no free dm-worker to transfer source %s from dm-worker %s in DMCluster %s/%s", source, worker, dc.Namespace, dc.Name)
+		return false, nil
+	}
+
+	if err := transferSource(deps, dc, source, worker, target); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func transferSource(deps *controller.Dependencies, dc *v1alpha1.DMCluster, source, from, to string) error {
+	if err := controller.GetMasterClient(deps.DMMasterControl, dc).TransferSource(source, to); err != nil {
+		return fmt.Errorf("failed to transfer source %s to dm-worker %s for DMCluster %s/%s, error: %v", source, to, dc.Namespace, dc.Name, err)
+	}
+	klog.Infof("transfer source %s from dm-worker %q to %s for DMCluster %s/%s", source, from, to, dc.Namespace, dc.Name)
+	deps.Recorder.Eventf(dc, corev1.EventTypeNormal, v1alpha1.EventReasonTransferSource, "transfer source %s from dm-worker %q to %s", source, from, to)
+	return nil
+}
+
+// boundSource returns the source bound to the dm-worker
+func boundSource(dc *v1alpha1.DMCluster, worker string) string {
+	for name, status := range dc.Status.Worker.Sources {
+		if status.Worker == worker {
+			return name
+		}
+	}
+	return ""
+}
+
+func isPreferredWorker(source *v1alpha1.DMSourceSpec, worker string) bool {
+	if worker == "" {
+		return false
+	}
+	for _, w := range source.PreferredWorkers {
+		if w == worker {
+			return true
+		}
+	}
+	return false
+}
+
+// freePreferredWorker returns the first free preferred dm-worker of the source except the given one
+func freePreferredWorker(dc *v1alpha1.DMCluster, source *v1alpha1.DMSourceSpec, except string) string {
+	if source == nil {
+		return ""
+	}
+	return firstFreeWorker(dc, source.PreferredWorkers, except)
+}
+
+// freeWorker returns a free dm-worker except the given one
+func freeWorker(dc *v1alpha1.DMCluster, except string) string {
+	workers := make([]string, 0, len(dc.Status.Worker.Members))
+	for name := range dc.Status.Worker.Members {
+		workers = append(workers, name)
+	}
+	sort.Strings(workers)
+	return firstFreeWorker(dc, workers, except)
+}
+
+func firstFreeWorker(dc *v1alpha1.DMCluster, workers []string, except string) string {
+	for _, worker := range workers {
+		if worker != except && isWorkerFree(dc, worker) {
+			return worker
+		}
+	}
+	return ""
+}
+
+// isWorkerFree returns whether the dm-worker is free and is not going to be scaled in
+func isWorkerFree(dc *v1alpha1.DMCluster, worker string) bool {
+	member, ok := dc.Status.Worker.Members[worker]
+	return ok && member.Stage == v1alpha1.DMWorkerStateFree && isWorkerPodDesired(dc, worker)
+}
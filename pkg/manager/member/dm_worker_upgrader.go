@@ -0,0 +1,146 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+
+	apps "k8s.io/api/apps/v1"
+	"k8s.io/klog/v2"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+)
+
+type workerUpgrader struct {
+	deps *controller.Dependencies
+}
+
+// NewWorkerUpgrader returns a workerUpgrader
+func NewWorkerUpgrader(deps *controller.Dependencies) DMUpgrader {
+	return &workerUpgrader{
+		deps: deps,
+	}
+}
+
+func (u *workerUpgrader) Upgrade(dc *v1alpha1.DMCluster, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	return u.gracefulUpgrade(dc, oldSet, newSet)
+}
+
+func (u *workerUpgrader) gracefulUpgrade(dc *v1alpha1.DMCluster, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	ns := dc.GetNamespace()
+	dcName := dc.GetName()
+	if !dc.Status.Worker.Synced {
+		return fmt.Errorf("dmcluster: [%s/%s]'s dm-worker status sync failed, can not to be upgraded", ns, dcName)
+	}
+	if dc.WorkerStsDesiredReplicas() != *oldSet.Spec.Replicas {
+		klog.Infof("DMCluster: [%s/%s]'s dm-worker is scaling, can not upgrade dm-worker", ns, dcName)
+		mngerutils.RecordBlocked(u.deps.Recorder, dc, &dc.Status.Worker, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked,
+			"dm-worker is scaling, can not upgrade dm-worker")
+		_, podSpec, err := GetLastAppliedConfig(oldSet)
+		if err != nil {
+			return err
+		}
+		newSet.Spec.Template.Spec = *podSpec
+		return nil
+	}
+	mngerutils.ClearBlocked(&dc.Status.Worker, v1alpha1.ComponentConditionUpgradeBlocked)
+
+	dc.Status.Worker.Phase = v1alpha1.UpgradePhase
+	if !templateEqual(newSet, oldSet) {
+		return nil
+	}
+
+	if dc.Status.Worker.StatefulSet.UpdateRevision == dc.Status.Worker.StatefulSet.CurrentRevision {
+		return nil
+	}
+
+	if oldSet.Spec.UpdateStrategy.Type == apps.OnDeleteStatefulSetStrategyType || oldSet.Spec.UpdateStrategy.RollingUpdate == nil {
+		// The update strategy is modified manually, or the statefulset is created before the partition
+		// is managed by tidb-operator. Let the native statefulset controller do the upgrade completely,
+		// the sources bound to the dm-workers are not transferred before the workers are restarted.
+		newSet.Spec.UpdateStrategy = oldSet.Spec.UpdateStrategy
+		klog.Warningf("dmcluster: [%s/%s] dm-worker statefulset %s UpdateStrategy has been modified manually", ns, dcName, oldSet.GetName())
+		return nil
+	}
+
+	mngerutils.SetUpgradePartition(newSet, *oldSet.Spec.UpdateStrategy.RollingUpdate.Partition)
+	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
+	// the sources are transferred to the upgraded workers first, so that they're not moved again
+	var upgraded []string
+	for _i := len(podOrdinals) - 1; _i >= 0; _i-- {
+		i := podOrdinals[_i]
+		podName := DMWorkerPodName(dcName, i)
+		pod, err := u.deps.PodLister.Pods(ns).Get(podName)
+		if err != nil {
+			return fmt.Errorf("gracefulUpgrade: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, dcName, err)
+		}
+
+		revision, exist := pod.Labels[apps.ControllerRevisionHashLabelKey]
+		if !exist {
+			return controller.RequeueErrorf("dmcluster: [%s/%s]'s dm-worker pod: [%s] has no label: %s", ns, dcName, podName, apps.ControllerRevisionHashLabelKey)
+		}
+
+		if revision == dc.Status.Worker.StatefulSet.UpdateRevision {
+			if !podutil.IsPodReady(pod) {
+				return controller.RequeueErrorf("dmcluster: [%s/%s]'s upgraded dm-worker pod: [%s] is not ready", ns, dcName, podName)
+			}
+			if member, exist := dc.Status.Worker.Members[podName]; !exist || member.Stage == v1alpha1.DMWorkerStateOffline {
+				return controller.RequeueErrorf("dmcluster: [%s/%s]'s dm-worker upgraded pod: [%s] is not online", ns, dcName, podName)
+			}
+			upgraded = append(upgraded, podName)
+			continue
+		}
+
+		return u.upgradeWorkerPod(dc, i, newSet, upgraded)
+	}
+
+	return nil
+}
+
+func (u *workerUpgrader) upgradeWorkerPod(dc *v1alpha1.DMCluster, ordinal int32, newSet *apps.StatefulSet, upgraded []string) error {
+	ns := dc.GetNamespace()
+	dcName := dc.GetName()
+	upgradePodName := DMWorkerPodName(dcName, ordinal)
+	transferring, err := transferSourceOfWorker(u.deps, dc, upgradePodName, upgraded)
+	if err != nil {
+		klog.Errorf("dm-worker upgrader: failed to transfer the source of dm-worker %s: %v", upgradePodName, err)
+		return err
+	}
+	if transferring {
+		return controller.RequeueErrorf("dmcluster: [%s/%s]'s dm-worker member: transferring [%s]'s source", ns, dcName, upgradePodName)
+	}
+
+	recordUpgradePod(u.deps.Recorder, dc, newSet, v1alpha1.DMWorkerMemberType, ordinal)
+	mngerutils.SetUpgradePartition(newSet, ordinal)
+	return nil
+}
+
+type fakeWorkerUpgrader struct{}
+
+// NewFakeWorkerUpgrader returns a fakeWorkerUpgrader
+func NewFakeWorkerUpgrader() DMUpgrader {
+	return &fakeWorkerUpgrader{}
+}
+
+func (u *fakeWorkerUpgrader) Upgrade(dc *v1alpha1.DMCluster, _ *apps.StatefulSet, _ *apps.StatefulSet) error {
+	if !dc.Status.Worker.Synced {
+		return fmt.Errorf("dmcluster: dm-worker status sync failed,can not to be upgraded")
+	}
+	dc.Status.Worker.Phase = v1alpha1.UpgradePhase
+	return nil
+}
@@ -0,0 +1,316 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/dmapi"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+
+	. "github.com/onsi/gomega"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+)
+
+func TestWorkerUpgraderUpgrade(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	podName0 := DMWorkerPodName(upgradeTcName, 0)
+	podName1 := DMWorkerPodName(upgradeTcName, 1)
+	podName2 := DMWorkerPodName(upgradeTcName, 2)
+
+	type testcase struct {
+		name         string
+		changeFn     func(*v1alpha1.DMCluster)
+		changePods   func(pods []*corev1.Pod)
+		changeOldSet func(set *apps.StatefulSet)
+		transferErr  bool
+		errExpectFn  func(*GomegaWithT, error)
+		expectFn     func(g *GomegaWithT, dc *v1alpha1.DMCluster, newSet *apps.StatefulSet, transferTo string)
+	}
+
+	testFn := func(test *testcase) {
+		t.Log(test.name)
+		fakeDeps := controller.NewFakeDependencies()
+		upgrader := &workerUpgrader{deps: fakeDeps}
+		dc := newDMClusterForWorkerUpgrader()
+		if test.changeFn != nil {
+			test.changeFn(dc)
+		}
+
+		transferTo := ""
+		masterClient := controller.NewFakeMasterClient(fakeDeps.DMMasterControl.(*dmapi.FakeMasterControl), dc)
+		masterClient.AddReaction(dmapi.TransferSourceActionType, func(action *dmapi.Action) (interface{}, error) {
+			if test.transferErr {
+				return nil, fmt.Errorf("failed to transfer source")
+			}
+			transferTo = action.Worker
+			return nil, nil
+		})
+
+		pods := getWorkerPodsForUpgrader()
+		if test.changePods != nil {
+			test.changePods(pods)
+		}
+		podIndexer := fakeDeps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+		for i := range pods {
+			podIndexer.Add(pods[i])
+		}
+
+		newSet := newStatefulSetForWorkerUpgrader()
+		oldSet := newSet.DeepCopy()
+		if test.changeOldSet != nil {
+			test.changeOldSet(oldSet)
+		}
+		mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)
+
+		newSet.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Int32Ptr(3)
+
+		err := upgrader.Upgrade(dc, oldSet, newSet)
+		test.errExpectFn(g, err)
+		test.expectFn(g, dc, newSet, transferTo)
+	}
+
+	tests := []testcase{
+		{
+			name: "normal upgrade",
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+			expectFn: func(g *GomegaWithT, dc *v1alpha1.DMCluster, newSet *apps.StatefulSet, transferTo string) {
+				g.Expect(dc.Status.Worker.Phase).To(Equal(v1alpha1.UpgradePhase))
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(1)))
+				g.Expect(transferTo).To(BeEmpty())
+			},
+		},
+		{
+			name: "transfer the source to the upgraded worker first",
+			changeFn: func(dc *v1alpha1.DMCluster) {
+				dc.Status.Worker.Members[podName1] = v1alpha1.WorkerMember{Name: podName1, Stage: v1alpha1.DMWorkerStateBound}
+				dc.Status.Worker.Sources = map[string]v1alpha1.DMSourceStatus{
+					"mysql1": {Name: "mysql1", Worker: podName1},
+				}
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+			},
+			expectFn: func(g *GomegaWithT, dc *v1alpha1.DMCluster, newSet *apps.StatefulSet, transferTo string) {
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(2)))
+				g.Expect(transferTo).To(Equal(podName2))
+			},
+		},
+		{
+			name: "transfer the source to the preferred worker",
+			changeFn: func(dc *v1alpha1.DMCluster) {
+				dc.Status.Worker.Members[podName1] = v1alpha1.WorkerMember{Name: podName1, Stage: v1alpha1.DMWorkerStateBound}
+				dc.Status.Worker.Sources = map[string]v1alpha1.DMSourceStatus{
+					"mysql1": {Name: "mysql1", Worker: podName1},
+				}
+				dc.Spec.Worker.Sources = []v1alpha1.DMSourceSpec{
+					{Name: "mysql1", PreferredWorkers: []string{podName1, podName0}},
+				}
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+			},
+			expectFn: func(g *GomegaWithT, dc *v1alpha1.DMCluster, newSet *apps.StatefulSet, transferTo string) {
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(2)))
+				g.Expect(transferTo).To(Equal(podName0))
+			},
+		},
+		{
+			name: "failed to transfer the source",
+			changeFn: func(dc *v1alpha1.DMCluster) {
+				dc.Status.Worker.Members[podName1] = v1alpha1.WorkerMember{Name: podName1, Stage: v1alpha1.DMWorkerStateBound}
+				dc.Status.Worker.Sources = map[string]v1alpha1.DMSourceStatus{
+					"mysql1": {Name: "mysql1", Worker: podName1},
+				}
+			},
+			transferErr: true,
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(controller.IsRequeueError(err)).To(BeFalse())
+			},
+			expectFn: func(g *GomegaWithT, dc *v1alpha1.DMCluster, newSet *apps.StatefulSet, transferTo string) {
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(2)))
+			},
+		},
+		{
+			name: "no free worker to transfer the source",
+			changeFn: func(dc *v1alpha1.DMCluster) {
+				for name := range dc.Status.Worker.Members {
+					dc.Status.Worker.Members[name] = v1alpha1.WorkerMember{Name: name, Stage: v1alpha1.DMWorkerStateBound}
+				}
+				dc.Status.Worker.Sources = map[string]v1alpha1.DMSourceStatus{
+					"mysql1": {Name: "mysql1", Worker: podName1},
+				}
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+			expectFn: func(g *GomegaWithT, dc *v1alpha1.DMCluster, newSet *apps.StatefulSet, transferTo string) {
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(1)))
+				g.Expect(transferTo).To(BeEmpty())
+			},
+		},
+		{
+			name: "upgraded worker is offline",
+			changeFn: func(dc *v1alpha1.DMCluster) {
+				dc.Status.Worker.Members[podName2] = v1alpha1.WorkerMember{Name: podName2, Stage: v1alpha1.DMWorkerStateOffline}
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+			},
+			expectFn: func(g *GomegaWithT, dc *v1alpha1.DMCluster, newSet *apps.StatefulSet, transferTo string) {
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(2)))
+			},
+		},
+		{
+			name: "dm-worker is scaling",
+			changeFn: func(dc *v1alpha1.DMCluster) {
+				dc.Spec.Worker.Replicas = 4
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+			expectFn: func(g *GomegaWithT, dc *v1alpha1.DMCluster, newSet *apps.StatefulSet, transferTo string) {
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(3)))
+			},
+		},
+		{
+			name: "update strategy is modified manually",
+			changeOldSet: func(set *apps.StatefulSet) {
+				set.Spec.UpdateStrategy = apps.StatefulSetUpdateStrategy{Type: apps.OnDeleteStatefulSetStrategyType}
+			},
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+			expectFn: func(g *GomegaWithT, dc *v1alpha1.DMCluster, newSet *apps.StatefulSet, transferTo string) {
+				g.Expect(newSet.Spec.UpdateStrategy.Type).To(Equal(apps.OnDeleteStatefulSetStrategyType))
+			},
+		},
+	}
+
+	for i := range tests {
+		testFn(&tests[i])
+	}
+}
+
+func newStatefulSetForWorkerUpgrader() *apps.StatefulSet {
+	return &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controller.DMWorkerMemberName(upgradeTcName),
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: apps.StatefulSetSpec{
+			Replicas: pointer.Int32Ptr(3),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "dm-worker",
+							Image: "dm-test-image",
+						},
+					},
+				},
+			},
+			UpdateStrategy: apps.StatefulSetUpdateStrategy{
+				Type:          apps.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &apps.RollingUpdateStatefulSetStrategy{Partition: pointer.Int32Ptr(2)},
+			},
+		},
+	}
+}
+
+func newDMClusterForWorkerUpgrader() *v1alpha1.DMCluster {
+	podName0 := DMWorkerPodName(upgradeTcName, 0)
+	podName1 := DMWorkerPodName(upgradeTcName, 1)
+	podName2 := DMWorkerPodName(upgradeTcName, 2)
+	return &v1alpha1.DMCluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DMCluster",
+			APIVersion: "pingcap.com/v1alpha1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      upgradeTcName,
+			Namespace: corev1.NamespaceDefault,
+			UID:       types.UID(upgradeTcName),
+			Labels:    label.NewDM().Instance(upgradeInstanceName),
+		},
+		Spec: v1alpha1.DMClusterSpec{
+			Master: v1alpha1.MasterSpec{
+				BaseImage: "dm-test-image",
+				Replicas:  3,
+				Config:    mustMasterConfig(&v1alpha1.MasterConfig{Experimental: &v1alpha1.DMExperimental{OpenAPI: true}}),
+			},
+			Worker: &v1alpha1.WorkerSpec{
+				BaseImage: "dm-test-image",
+				Replicas:  3,
+			},
+			Version: "v6.5.0",
+		},
+		Status: v1alpha1.DMClusterStatus{
+			Worker: v1alpha1.WorkerStatus{
+				Synced: true,
+				Phase:  v1alpha1.NormalPhase,
+				StatefulSet: &apps.StatefulSetStatus{
+					CurrentRevision: "1",
+					UpdateRevision:  "2",
+					ReadyReplicas:   3,
+					Replicas:        3,
+					CurrentReplicas: 2,
+					UpdatedReplicas: 1,
+				},
+				Members: map[string]v1alpha1.WorkerMember{
+					podName0: {Name: podName0, Stage: v1alpha1.DMWorkerStateFree},
+					podName1: {Name: podName1, Stage: v1alpha1.DMWorkerStateFree},
+					podName2: {Name: podName2, Stage: v1alpha1.DMWorkerStateFree},
+				},
+			},
+		},
+	}
+}
+
+func getWorkerPodsForUpgrader() []*corev1.Pod {
+	var pods []*corev1.Pod
+	for i := int32(0); i < 3; i++ {
+		l := label.NewDM().Instance(upgradeInstanceName).DMWorker().Labels()
+		l[apps.ControllerRevisionHashLabelKey] = "1"
+		if i == 2 {
+			l[apps.ControllerRevisionHashLabelKey] = "2"
+		}
+		pods = append(pods, &corev1.Pod{
+			TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      DMWorkerPodName(upgradeTcName, i),
+				Namespace: corev1.NamespaceDefault,
+				Labels:    l,
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+				},
+			},
+		})
+	}
+	return pods
+}
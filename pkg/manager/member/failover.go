@@ -17,14 +17,12 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 )
 
-// TODO: move this to a centralized place
-// Since the "Unhealthy" is a very universal event reason string, which could apply to all the TiDB/DM cluster components,
-// we should make a global event module, and put event related constants there.
+// the event reasons are defined in the catalogue of v1alpha1
 const (
-	unHealthEventReason     = "Unhealthy"
+	unHealthEventReason     = v1alpha1.EventReasonUnhealthy
 	unHealthEventMsgPattern = "%s pod[%s] is unhealthy, msg:%s"
 	FailedSetStoreLabels    = "FailedSetStoreLabels"
-	recoveryEventReason     = "Recovery"
+	recoveryEventReason     = v1alpha1.EventReasonRecovery
 )
 
 // Failover implements the logic for pd/tikv/tidb's failover and recovery.
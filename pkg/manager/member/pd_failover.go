@@ -21,6 +21,7 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/util"
 
 	apiv1 "k8s.io/api/core/v1"
@@ -74,9 +75,12 @@ func (f *pdFailover) Failover(tc *v1alpha1.TidbCluster) error {
 
 	pdDeletedFailureReplicas := tc.GetPDDeletedFailureReplicas()
 	if pdDeletedFailureReplicas >= *tc.Spec.PD.MaxFailoverCount {
-		klog.Errorf("PD failover replicas (%d) reaches the limit (%d), skip failover", pdDeletedFailureReplicas, *tc.Spec.PD.MaxFailoverCount)
+		msg := fmt.Sprintf("PD failover replicas (%d) reaches the limit (%d), skip failover", pdDeletedFailureReplicas, *tc.Spec.PD.MaxFailoverCount)
+		klog.Error(msg)
+		mngerutils.RecordBlocked(f.deps.Recorder, tc, &tc.Status.PD, v1alpha1.ComponentConditionFailoverBlocked, v1alpha1.EventReasonFailoverLimitReached, msg)
 		return nil
 	}
+	mngerutils.ClearBlocked(&tc.Status.PD, v1alpha1.ComponentConditionFailoverBlocked)
 
 	notDeletedFailureReplicas := len(tc.Status.PD.FailureMembers) - int(pdDeletedFailureReplicas)
 
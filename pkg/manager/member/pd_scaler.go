@@ -18,12 +18,12 @@ import (
 
 	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
 	apps "k8s.io/api/apps/v1"
-	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/util"
 )
 
@@ -60,6 +60,7 @@ func (s *pdScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, newSet
 	tcName := tc.GetName()
 
 	klog.Infof("scaling out pd statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.PDMemberType, v1alpha1.EventReasonScaleOut, ordinal, replicas)
 	_, err := s.deleteDeferDeletingPVC(tc, v1alpha1.PDMemberType, ordinal)
 	if err != nil {
 		return err
@@ -93,6 +94,7 @@ func (s *pdScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSet
 	}
 
 	klog.Infof("scaling in pd statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.PDMemberType, v1alpha1.EventReasonScaleIn, ordinal, replicas)
 
 	// limit scale in when multi-cluster is enabled
 	if pass := s.preCheckUpMembers(tc, pdPodName); !pass {
@@ -179,10 +181,11 @@ func (s *pdScaler) preCheckUpMembers(tc *v1alpha1.TidbCluster, podName string) b
 	if upComponents != 0 && tc.Spec.PD.Replicas == 0 {
 		errMsg := fmt.Sprintf("The PD is in use by TidbCluster [%s/%s], can't scale in PD, podname %s, upComponents %d", tc.GetNamespace(), tc.GetName(), podName, upComponents)
 		klog.Error(errMsg)
-		s.deps.Recorder.Event(tc, v1.EventTypeWarning, "FailedScaleIn", errMsg)
+		mngerutils.RecordBlocked(s.deps.Recorder, tc, &tc.Status.PD, v1alpha1.ComponentConditionScaleBlocked, v1alpha1.EventReasonFailedScaleIn, errMsg)
 		return false
 	}
 
+	mngerutils.ClearBlocked(&tc.Status.PD, v1alpha1.ComponentConditionScaleBlocked)
 	return true
 }
 
@@ -50,6 +50,8 @@ func (u *pdUpgrader) gracefulUpgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Stat
 	if tc.PDScaling() {
 		klog.Infof("TidbCluster: [%s/%s]'s pd status is %v, can not upgrade pd",
 			ns, tcName, tc.Status.PD.Phase)
+		mngerutils.RecordBlocked(u.deps.Recorder, tc, &tc.Status.PD, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked,
+			fmt.Sprintf("pd status is %s, can not upgrade pd", tc.Status.PD.Phase))
 		_, podSpec, err := GetLastAppliedConfig(oldSet)
 		if err != nil {
 			return err
@@ -57,6 +59,7 @@ func (u *pdUpgrader) gracefulUpgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Stat
 		newSet.Spec.Template.Spec = *podSpec
 		return nil
 	}
+	mngerutils.ClearBlocked(&tc.Status.PD, v1alpha1.ComponentConditionUpgradeBlocked)
 
 	tc.Status.PD.Phase = v1alpha1.UpgradePhase
 	if !templateEqual(newSet, oldSet) {
@@ -139,6 +142,7 @@ func (u *pdUpgrader) upgradePDPod(tc *v1alpha1.TidbCluster, ordinal int32, newSe
 		}
 	}
 
+	recordUpgradePod(u.deps.Recorder, tc, newSet, v1alpha1.PDMemberType, ordinal)
 	mngerutils.SetUpgradePartition(newSet, ordinal)
 	return nil
 }
@@ -0,0 +1,490 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager"
+	"github.com/pingcap/tidb-operator/pkg/manager/member/startscript"
+	"github.com/pingcap/tidb-operator/pkg/manager/suspender"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	"github.com/pingcap/tidb-operator/pkg/util"
+
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+)
+
+// pdmsClientPort is the port the PD microservices listen on
+const pdmsClientPort = 2379
+
+// pdmsNames are the names of the PD microservices in the order they are synced
+var pdmsNames = []string{label.PDMSTSOLabelVal, label.PDMSSchedulingLabelVal}
+
+func labelPDMS(tc *v1alpha1.TidbCluster, name string) label.Label {
+	instanceName := tc.GetInstanceName()
+	return label.New().Instance(instanceName).PDMS(name)
+}
+
+// pdmsMembersManager implements manager.Manager, it syncs all the PD microservices of the TidbCluster.
+type pdmsMembersManager struct {
+	managers []*pdmsMemberManager
+}
+
+// NewPDMSMemberManager returns a manager.Manager syncing the PD microservices
+func NewPDMSMemberManager(deps *controller.Dependencies, spder suspender.Suspender) manager.Manager {
+	m := &pdmsMembersManager{}
+	for _, name := range pdmsNames {
+		m.managers = append(m.managers, newPDMSMemberManager(deps, name, NewPDMSScaler(deps, name), NewPDMSUpgrader(deps, name), spder))
+	}
+	return m
+}
+
+// Sync fulfills the manager.Manager interface
+func (m *pdmsMembersManager) Sync(tc *v1alpha1.TidbCluster) error {
+	for _, mm := range m.managers {
+		if err := mm.Sync(tc); err != nil {
+			return err
+		}
+	}
+
+	// clean up the status of the microservices removed from the spec
+	for name := range tc.Status.PDMS {
+		if tc.GetPDMSSpec(name) == nil {
+			delete(tc.Status.PDMS, name)
+		}
+	}
+	return nil
+}
+
+// pdmsMemberManager describes a PD microservice for the componentMemberManager.
+type pdmsMemberManager struct {
+	*componentMemberManager
+	deps *controller.Dependencies
+	name string
+}
+
+var _ ComponentDescriptor = &pdmsMemberManager{}
+
+func newPDMSMemberManager(deps *controller.Dependencies, name string, scaler Scaler, upgrader Upgrader, spder suspender.Suspender) *pdmsMemberManager {
+	m := &pdmsMemberManager{
+		deps: deps,
+		name: name,
+	}
+	m.componentMemberManager = newComponentMemberManager(deps, m, scaler, upgrader, spder)
+	return m
+}
+
+func (m *pdmsMemberManager) MemberType() v1alpha1.MemberType {
+	return v1alpha1.MemberType(m.name)
+}
+
+func (m *pdmsMemberManager) Name() string {
+	if m.name == label.PDMSTSOLabelVal {
+		return "TSO"
+	}
+	return "Scheduling"
+}
+
+func (m *pdmsMemberManager) Enabled(tc *v1alpha1.TidbCluster) bool {
+	return tc.GetPDMSSpec(m.name) != nil
+}
+
+func (m *pdmsMemberManager) StatefulSetName(tc *v1alpha1.TidbCluster) string {
+	return controller.PDMSMemberName(tc.GetName(), m.name)
+}
+
+func (m *pdmsMemberManager) Phase(tc *v1alpha1.TidbCluster) v1alpha1.MemberPhase {
+	return tc.GetPDMSStatus(m.name).Phase
+}
+
+func (m *pdmsMemberManager) Precheck(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	if tc.Spec.PD == nil || tc.Spec.PD.Mode != v1alpha1.PDModeMS {
+		return fmt.Errorf("TidbCluster: [%s/%s], PD microservice %s requires PD in %s mode", ns, tcName, m.name, v1alpha1.PDModeMS)
+	}
+	if !tc.PDIsAvailable() {
+		return controller.RequeueErrorf("TidbCluster: [%s/%s], PD microservice %s is waiting for PD cluster running", ns, tcName, m.name)
+	}
+	return nil
+}
+
+func (m *pdmsMemberManager) SyncServices(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	svcLabel := labelPDMS(tc, m.name)
+	newSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            controller.PDMSPeerMemberName(tc.GetName(), m.name),
+			Namespace:       ns,
+			Labels:          svcLabel.Copy().UsedByPeer(),
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: "None",
+			Ports: []corev1.ServicePort{
+				{
+					Name:       fmt.Sprintf("tcp-%s", m.name),
+					Port:       pdmsClientPort,
+					TargetPort: intstr.FromInt(pdmsClientPort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			Selector:                 svcLabel,
+			PublishNotReadyAddresses: true,
+		},
+	}
+
+	oldSvcTmp, err := m.deps.ServiceLister.Services(ns).Get(newSvc.Name)
+	if errors.IsNotFound(err) {
+		err = controller.SetServiceLastAppliedConfigAnnotation(newSvc)
+		if err != nil {
+			return err
+		}
+		return m.deps.ServiceControl.CreateService(tc, newSvc)
+	}
+	if err != nil {
+		return fmt.Errorf("syncPDMSService: failed to get svc %s for cluster %s/%s, error: %s", newSvc.Name, ns, tc.GetName(), err)
+	}
+
+	oldSvc := oldSvcTmp.DeepCopy()
+	equal, err := controller.ServiceEqual(newSvc, oldSvc)
+	if err != nil {
+		return err
+	}
+	if !equal {
+		svc := *oldSvc
+		svc.Spec = newSvc.Spec
+		err = controller.SetServiceLastAppliedConfigAnnotation(&svc)
+		if err != nil {
+			return err
+		}
+		_, err = m.deps.ServiceControl.UpdateService(tc, &svc)
+		return err
+	}
+	return nil
+}
+
+func (m *pdmsMemberManager) SyncConfigMap(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) (*corev1.ConfigMap, error) {
+	newCm, err := getPDMSConfigMap(tc, m.name)
+	if err != nil {
+		return nil, err
+	}
+
+	var inUseName string
+	if set != nil {
+		inUseName = mngerutils.FindConfigMapVolume(&set.Spec.Template.Spec, func(name string) bool {
+			return strings.HasPrefix(name, controller.PDMSMemberName(tc.Name, m.name))
+		})
+	}
+
+	klog.V(4).Infof("get pd microservice %s in use config map name: %s", m.name, inUseName)
+
+	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, v1alpha1.ConfigUpdateStrategyInPlace, inUseName, newCm)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.deps.TypedControl.CreateOrUpdateConfigMap(tc, newCm)
+}
+
+func getPDMSConfigMap(tc *v1alpha1.TidbCluster, name string) (*corev1.ConfigMap, error) {
+	spec := tc.GetPDMSSpec(name)
+
+	var config *v1alpha1.PDConfigWraper
+	if spec.Config != nil {
+		config = spec.Config.DeepCopy() // use copy to not update tc spec
+	} else {
+		config = v1alpha1.NewPDConfig()
+	}
+
+	// override CA if tls enabled
+	if tc.IsTLSClusterEnabled() {
+		config.Set("security.cacert-path", path.Join(pdClusterCertPath, tlsSecretRootCAKey))
+		config.Set("security.cert-path", path.Join(pdClusterCertPath, corev1.TLSCertKey))
+		config.Set("security.key-path", path.Join(pdClusterCertPath, corev1.TLSPrivateKeyKey))
+	}
+
+	confText, err := config.MarshalTOML()
+	if err != nil {
+		return nil, fmt.Errorf("render config of pd microservice %s for tc %s/%s failed: %v", name, tc.Namespace, tc.Name, err)
+	}
+
+	startScript, err := startscript.RenderPDMSStartScript(tc, name)
+	if err != nil {
+		return nil, fmt.Errorf("render start-script of pd microservice %s for tc %s/%s failed: %v", name, tc.Namespace, tc.Name, err)
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            controller.PDMSMemberName(tc.Name, name),
+			Namespace:       tc.Namespace,
+			Labels:          labelPDMS(tc, name),
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+		Data: map[string]string{
+			"config-file":    string(confText),
+			"startup-script": startScript,
+		},
+	}, nil
+}
+
+func (m *pdmsMemberManager) SyncStatus(tc *v1alpha1.TidbCluster, sts *apps.StatefulSet) error {
+	if sts == nil {
+		// skip if not created yet
+		return nil
+	}
+
+	status := tc.GetPDMSStatus(m.name)
+	status.StatefulSet = &sts.Status
+	upgrading, err := m.statefulSetIsUpgradingFn(m.deps.PodLister, sts, tc)
+	if err != nil {
+		status.Synced = false
+		return err
+	}
+	if tc.PDMSStsDesiredReplicas(m.name) != *sts.Spec.Replicas {
+		status.Phase = v1alpha1.ScalePhase
+	} else if upgrading {
+		status.Phase = v1alpha1.UpgradePhase
+	} else {
+		status.Phase = v1alpha1.NormalPhase
+	}
+
+	// the members of the microservice are registered in PD once they are healthy
+	pdClient := controller.GetPDClient(m.deps.PDControl, tc)
+	members, err := pdClient.GetMSMembers(m.name)
+	if err != nil {
+		status.Synced = false
+		return fmt.Errorf("failed to get members of pd microservice %s for cluster %s/%s, error: %v", m.name, tc.GetNamespace(), tc.GetName(), err)
+	}
+
+	status.Members = members
+	status.Image = tc.PDMSImage(m.name)
+	status.Synced = true
+	return nil
+}
+
+func (m *pdmsMemberManager) NewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.StatefulSet, error) {
+	return getNewPDMSStatefulSet(tc, cm, m.name)
+}
+
+func getNewPDMSStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap, name string) (*apps.StatefulSet, error) {
+	var err error
+
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	spec := tc.GetPDMSSpec(name)
+	basePDMSSpec := tc.BasePDMSSpec(spec)
+	stsLabels := labelPDMS(tc, name)
+	stsName := controller.PDMSMemberName(tcName, name)
+	podLabels := util.CombineStringMap(stsLabels, basePDMSSpec.Labels())
+	podAnnotations := util.CombineStringMap(basePDMSSpec.Annotations(), controller.AnnProm(pdmsClientPort, "/metrics"))
+	stsAnnotations := getStsAnnotations(tc.Annotations, name)
+	headlessSvcName := controller.PDMSPeerMemberName(tcName, name)
+
+	annMount, annVolume := annotationsMountVolume()
+	vols := []corev1.Volume{
+		{Name: "config",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: cm.Name,
+					},
+					Items: []corev1.KeyToPath{
+						{Key: "config-file", Path: "pd.toml"},
+						{Key: "startup-script", Path: "start.sh"},
+					},
+				},
+			},
+		},
+		annVolume,
+	}
+	volMounts := []corev1.VolumeMount{
+		{Name: "config", ReadOnly: true, MountPath: "/etc/pd"},
+		annMount,
+	}
+
+	if tc.IsTLSClusterEnabled() {
+		volMounts = append(volMounts, corev1.VolumeMount{
+			Name: "pd-tls", ReadOnly: true, MountPath: pdClusterCertPath,
+		})
+		vols = append(vols, corev1.Volume{
+			Name: "pd-tls", VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: util.ClusterTLSSecretName(tcName, name),
+				},
+			},
+		})
+	}
+
+	// handle AdditionalVolumeMounts in ComponentSpec
+	volMounts = append(volMounts, spec.AdditionalVolumeMounts...)
+
+	envs := []corev1.EnvVar{
+		{
+			Name: "POD_NAME",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.name",
+				},
+			},
+		},
+		{
+			Name: "NAMESPACE",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: "metadata.namespace",
+				},
+			},
+		},
+		{
+			Name:  "HEADLESS_SERVICE_NAME",
+			Value: headlessSvcName,
+		},
+	}
+
+	pdmsContainer := corev1.Container{
+		Name:            name,
+		Image:           tc.PDMSImage(name),
+		ImagePullPolicy: basePDMSSpec.ImagePullPolicy(),
+		Command:         []string{"/bin/sh", "/etc/pd/start.sh"},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          "client",
+				ContainerPort: pdmsClientPort,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		VolumeMounts: volMounts,
+		Resources:    controller.ContainerResource(spec.ResourceRequirements),
+		Env:          util.AppendEnv(append(envs, tlsCertHashEnv(tc, util.ClusterTLSSecretName(tcName, name))...), basePDMSSpec.Env()),
+		EnvFrom:      basePDMSSpec.EnvFrom(),
+	}
+
+	podSpec := basePDMSSpec.BuildPodSpec()
+
+	podSpec.Containers, err = MergePatchContainers([]corev1.Container{pdmsContainer}, basePDMSSpec.AdditionalContainers())
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge containers spec for PD microservice %s of [%s/%s], error: %v", name, ns, tcName, err)
+	}
+
+	podSpec.Volumes = append(vols, basePDMSSpec.AdditionalVolumes()...)
+	podSpec.ServiceAccountName = spec.ServiceAccount
+	podSpec.InitContainers = append(podSpec.InitContainers, basePDMSSpec.InitContainers()...)
+	if podSpec.ServiceAccountName == "" {
+		podSpec.ServiceAccountName = tc.Spec.ServiceAccount
+	}
+
+	updateStrategy := apps.StatefulSetUpdateStrategy{}
+	if basePDMSSpec.StatefulSetUpdateStrategy() == apps.OnDeleteStatefulSetStrategyType {
+		updateStrategy.Type = apps.OnDeleteStatefulSetStrategyType
+	} else {
+		updateStrategy.Type = apps.RollingUpdateStatefulSetStrategyType
+		updateStrategy.RollingUpdate = &apps.RollingUpdateStatefulSetStrategy{
+			Partition: pointer.Int32Ptr(tc.PDMSStsDesiredReplicas(name)),
+		}
+	}
+
+	pdmsSts := &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            stsName,
+			Namespace:       ns,
+			Labels:          stsLabels.Labels(),
+			Annotations:     stsAnnotations,
+			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
+		},
+		Spec: apps.StatefulSetSpec{
+			Replicas: pointer.Int32Ptr(tc.PDMSStsDesiredReplicas(name)),
+			Selector: stsLabels.LabelSelector(),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: podAnnotations,
+				},
+				Spec: podSpec,
+			},
+			ServiceName:         headlessSvcName,
+			PodManagementPolicy: basePDMSSpec.PodManagementPolicy(),
+			UpdateStrategy:      updateStrategy,
+		},
+	}
+	return pdmsSts, nil
+}
+
+func (m *pdmsMemberManager) statefulSetIsUpgradingFn(podLister corelisters.PodLister, set *apps.StatefulSet, tc *v1alpha1.TidbCluster) (bool, error) {
+	if mngerutils.StatefulSetIsUpgrading(set) {
+		return true, nil
+	}
+	selector, err := labelPDMS(tc, m.name).Selector()
+	if err != nil {
+		return false, err
+	}
+	pods, err := podLister.Pods(tc.GetNamespace()).List(selector)
+	if err != nil {
+		return false, fmt.Errorf("pdmsStatefulSetIsUpgrading: failed to list pods for cluster %s/%s, selector %s, error: %s", tc.GetNamespace(), tc.GetName(), selector, err)
+	}
+	for _, pod := range pods {
+		revisionHash, exist := pod.Labels[apps.ControllerRevisionHashLabelKey]
+		if !exist {
+			return false, nil
+		}
+		if revisionHash != tc.GetPDMSStatus(m.name).StatefulSet.UpdateRevision {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// pdmsMemberRegistered returns whether the member of the PD microservice running in the pod is registered in PD
+func pdmsMemberRegistered(members []string, podName string) bool {
+	for _, member := range members {
+		if strings.Contains(member, "://"+podName+".") {
+			return true
+		}
+	}
+	return false
+}
+
+type FakePDMSMemberManager struct {
+	err error
+}
+
+func NewFakePDMSMemberManager() *FakePDMSMemberManager {
+	return &FakePDMSMemberManager{}
+}
+
+func (m *FakePDMSMemberManager) SetSyncError(err error) {
+	m.err = err
+}
+
+func (m *FakePDMSMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
+	if m.err != nil {
+		return m.err
+	}
+	return nil
+}
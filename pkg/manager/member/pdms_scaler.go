@@ -0,0 +1,88 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+type pdmsScaler struct {
+	generalScaler
+	name string
+}
+
+// NewPDMSScaler returns a Scaler of the PD microservice
+func NewPDMSScaler(deps *controller.Dependencies, name string) Scaler {
+	return &pdmsScaler{generalScaler: generalScaler{deps: deps}, name: name}
+}
+
+func (s *pdmsScaler) Scale(meta metav1.Object, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	scaling, _, _, _ := scaleOne(oldSet, newSet)
+	if scaling > 0 {
+		return s.ScaleOut(meta, oldSet, newSet)
+	} else if scaling < 0 {
+		return s.ScaleIn(meta, oldSet, newSet)
+	}
+	return nil
+}
+
+func (s *pdmsScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	tc, ok := meta.(*v1alpha1.TidbCluster)
+	if !ok {
+		return nil
+	}
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	if !tc.GetPDMSStatus(s.name).Synced {
+		return fmt.Errorf("TidbCluster: %s/%s's pd microservice %s status sync failed, can't scale out now", ns, tcName, s.name)
+	}
+
+	_, ordinal, replicas, deleteSlots := scaleOne(oldSet, newSet)
+	resetReplicas(newSet, oldSet)
+
+	klog.Infof("scaling out pd microservice %s statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", s.name, oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.MemberType(s.name), v1alpha1.EventReasonScaleOut, ordinal, replicas)
+
+	setReplicasAndDeleteSlots(newSet, replicas, deleteSlots)
+	return nil
+}
+
+// ScaleIn removes one member at a time, the member deregisters itself from PD when it exits
+func (s *pdmsScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	tc, ok := meta.(*v1alpha1.TidbCluster)
+	if !ok {
+		return nil
+	}
+
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	_, ordinal, replicas, deleteSlots := scaleOne(oldSet, newSet)
+	resetReplicas(newSet, oldSet)
+
+	if !tc.GetPDMSStatus(s.name).Synced {
+		return fmt.Errorf("TidbCluster: %s/%s's pd microservice %s status sync failed, can't scale in now", ns, tcName, s.name)
+	}
+
+	klog.Infof("scaling in pd microservice %s statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", s.name, oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.MemberType(s.name), v1alpha1.EventReasonScaleIn, ordinal, replicas)
+
+	setReplicasAndDeleteSlots(newSet, replicas, deleteSlots)
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+
+	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+
+	apps "k8s.io/api/apps/v1"
+	"k8s.io/klog/v2"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+)
+
+type pdmsUpgrader struct {
+	deps *controller.Dependencies
+	name string
+}
+
+// NewPDMSUpgrader returns an Upgrader of the PD microservice
+func NewPDMSUpgrader(deps *controller.Dependencies, name string) Upgrader {
+	return &pdmsUpgrader{
+		deps: deps,
+		name: name,
+	}
+}
+
+func (u *pdmsUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSet, newSet *apps.StatefulSet) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	status := tc.GetPDMSStatus(u.name)
+	if !status.Synced {
+		return fmt.Errorf("tidbcluster: [%s/%s]'s pd microservice %s status sync failed, can not to be upgraded", ns, tcName, u.name)
+	}
+
+	// PD is upgraded first, and then the microservices in order: tso, scheduling
+	var blockedReason string
+	switch {
+	case tc.PDUpgrading() || tc.PDScaling():
+		blockedReason = fmt.Sprintf("pd status is %s", tc.Status.PD.Phase)
+	case status.Phase == v1alpha1.ScalePhase:
+		blockedReason = fmt.Sprintf("%s status is %s", u.name, status.Phase)
+	case u.name == label.PDMSSchedulingLabelVal && tc.GetPDMSSpec(label.PDMSTSOLabelVal) != nil &&
+		tc.GetPDMSStatus(label.PDMSTSOLabelVal).Phase != v1alpha1.NormalPhase:
+		blockedReason = fmt.Sprintf("tso status is %s", tc.GetPDMSStatus(label.PDMSTSOLabelVal).Phase)
+	}
+	if blockedReason != "" {
+		klog.Infof("TidbCluster: [%s/%s]'s %s, can not upgrade pd microservice %s", ns, tcName, blockedReason, u.name)
+		mngerutils.RecordBlocked(u.deps.Recorder, tc, status, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked,
+			fmt.Sprintf("%s, can not upgrade %s", blockedReason, u.name))
+		_, podSpec, err := GetLastAppliedConfig(oldSet)
+		if err != nil {
+			return err
+		}
+		newSet.Spec.Template.Spec = *podSpec
+		return nil
+	}
+	mngerutils.ClearBlocked(status, v1alpha1.ComponentConditionUpgradeBlocked)
+
+	status.Phase = v1alpha1.UpgradePhase
+	if !templateEqual(newSet, oldSet) {
+		return nil
+	}
+
+	if status.StatefulSet.UpdateRevision == status.StatefulSet.CurrentRevision {
+		return nil
+	}
+
+	mngerutils.SetUpgradePartition(newSet, *oldSet.Spec.UpdateStrategy.RollingUpdate.Partition)
+	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
+	for _i := len(podOrdinals) - 1; _i >= 0; _i-- {
+		i := podOrdinals[_i]
+		podName := fmt.Sprintf("%s-%d", controller.PDMSMemberName(tcName, u.name), i)
+		pod, err := u.deps.PodLister.Pods(ns).Get(podName)
+		if err != nil {
+			return fmt.Errorf("gracefulUpgrade: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
+		}
+
+		revision, exist := pod.Labels[apps.ControllerRevisionHashLabelKey]
+		if !exist {
+			return controller.RequeueErrorf("tidbcluster: [%s/%s]'s pd microservice %s pod: [%s] has no label: %s", ns, tcName, u.name, podName, apps.ControllerRevisionHashLabelKey)
+		}
+
+		if revision == status.StatefulSet.UpdateRevision {
+			if !podutil.IsPodReady(pod) {
+				return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded pd microservice %s pod: [%s] is not ready", ns, tcName, u.name, podName)
+			}
+			if !pdmsMemberRegistered(status.Members, podName) {
+				return controller.RequeueErrorf("tidbcluster: [%s/%s]'s upgraded pd microservice %s pod: [%s] is not registered in pd", ns, tcName, u.name, podName)
+			}
+			continue
+		}
+
+		recordUpgradePod(u.deps.Recorder, tc, newSet, v1alpha1.MemberType(u.name), i)
+		mngerutils.SetUpgradePartition(newSet, i)
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+
+	. "github.com/onsi/gomega"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestPDMSUpgrader_Upgrade(t *testing.T) {
+	type testcase struct {
+		name        string
+		pdmsName    string
+		changeFn    func(tc *v1alpha1.TidbCluster)
+		errorExpect bool
+		expectFn    func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet)
+	}
+
+	tests := []testcase{
+		{
+			name:     "normal",
+			pdmsName: label.PDMSTSOLabelVal,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(tc.Status.PDMS["tso"].Phase).To(Equal(v1alpha1.UpgradePhase))
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(0)))
+			},
+		},
+		{
+			name:     "upgraded member is not registered in pd",
+			pdmsName: label.PDMSTSOLabelVal,
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PDMS["tso"].Members = nil
+			},
+			errorExpect: true,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(1)))
+			},
+		},
+		{
+			name:     "status is not synced",
+			pdmsName: label.PDMSTSOLabelVal,
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PDMS["tso"].Synced = false
+			},
+			errorExpect: true,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(tc.Status.PDMS["tso"].Phase).To(Equal(v1alpha1.NormalPhase))
+			},
+		},
+		{
+			name:     "pd is upgrading",
+			pdmsName: label.PDMSTSOLabelVal,
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PD.Phase = v1alpha1.UpgradePhase
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(tc.Status.PDMS["tso"].Phase).To(Equal(v1alpha1.NormalPhase))
+				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(1)))
+				g.Expect(tc.Status.PDMS["tso"].Conditions).To(HaveLen(1))
+			},
+		},
+		{
+			name:     "scheduling waits for tso upgrading",
+			pdmsName: label.PDMSSchedulingLabelVal,
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.PDMS["tso"].Phase = v1alpha1.UpgradePhase
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(tc.Status.PDMS["scheduling"].Phase).To(Equal(v1alpha1.NormalPhase))
+				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(1)))
+			},
+		},
+		{
+			name:     "scheduling",
+			pdmsName: label.PDMSSchedulingLabelVal,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(tc.Status.PDMS["scheduling"].Phase).To(Equal(v1alpha1.UpgradePhase))
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(0)))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			deps := controller.NewFakeDependencies()
+			upgrader := NewPDMSUpgrader(deps, test.pdmsName)
+			tc := newTidbClusterForPDMSUpgrader()
+			if test.changeFn != nil {
+				test.changeFn(tc)
+			}
+			podInformer := deps.KubeInformerFactory.Core().V1().Pods()
+			for _, pod := range getPDMSPods(test.pdmsName) {
+				podInformer.Informer().GetIndexer().Add(pod)
+			}
+
+			oldSet := newStatefulSetForPDMSUpgrader(test.pdmsName)
+			newSet := oldSet.DeepCopy()
+			mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)
+
+			err := upgrader.Upgrade(tc, oldSet, newSet)
+			if test.errorExpect {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			test.expectFn(g, tc, newSet)
+		})
+	}
+}
+
+func newStatefulSetForPDMSUpgrader(name string) *apps.StatefulSet {
+	return &apps.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controller.PDMSMemberName(upgradeTcName, name),
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: apps.StatefulSetSpec{
+			Replicas: pointer.Int32Ptr(2),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  name,
+							Image: "pd-test-image",
+						},
+					},
+				},
+			},
+			UpdateStrategy: apps.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &apps.RollingUpdateStatefulSetStrategy{
+					Partition: pointer.Int32Ptr(1),
+				},
+			},
+		},
+	}
+}
+
+func newTidbClusterForPDMSUpgrader() *v1alpha1.TidbCluster {
+	newStatus := func(name string) *v1alpha1.PDMSStatus {
+		return &v1alpha1.PDMSStatus{
+			Name:   name,
+			Synced: true,
+			Phase:  v1alpha1.NormalPhase,
+			StatefulSet: &apps.StatefulSetStatus{
+				CurrentRevision: "1",
+				UpdateRevision:  "2",
+				Replicas:        2,
+			},
+			Members: []string{
+				fmt.Sprintf("http://%s-1.%s.default.svc:2379", controller.PDMSMemberName(upgradeTcName, name), controller.PDMSPeerMemberName(upgradeTcName, name)),
+			},
+		}
+	}
+	return &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      upgradeTcName,
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: v1alpha1.TidbClusterSpec{
+			PD:   &v1alpha1.PDSpec{Replicas: 3, Mode: v1alpha1.PDModeMS},
+			PDMS: []v1alpha1.PDMSSpec{{Name: "tso", Replicas: 2}, {Name: "scheduling", Replicas: 2}},
+		},
+		Status: v1alpha1.TidbClusterStatus{
+			PD: v1alpha1.PDStatus{Phase: v1alpha1.NormalPhase},
+			PDMS: map[string]*v1alpha1.PDMSStatus{
+				"tso":        newStatus("tso"),
+				"scheduling": newStatus("scheduling"),
+			},
+		},
+	}
+}
+
+func getPDMSPods(name string) []*corev1.Pod {
+	var pods []*corev1.Pod
+	for i, revision := range []string{"1", "2"} {
+		l := label.New().Instance(upgradeInstanceName).PDMS(name).Labels()
+		l[apps.ControllerRevisionHashLabelKey] = revision
+		pods = append(pods, &corev1.Pod{
+			TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%d", controller.PDMSMemberName(upgradeTcName, name), i),
+				Namespace: corev1.NamespaceDefault,
+				Labels:    l,
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		})
+	}
+	return pods
+}
@@ -455,7 +455,7 @@ func getNewPumpStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*app
 				ContainerPort: 8250,
 			}},
 			Resources:    controller.ContainerResource(tc.Spec.Pump.ResourceRequirements),
-			Env:          util.AppendEnv(envs, spec.Env()),
+			Env:          util.AppendEnv(append(envs, tlsCertHashEnv(tc, util.ClusterTLSSecretName(tc.Name, label.PumpLabelVal))...), spec.Env()),
 			EnvFrom:      spec.EnvFrom(),
 			VolumeMounts: volumeMounts,
 			ReadinessProbe: &corev1.Probe{
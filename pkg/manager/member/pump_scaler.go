@@ -61,6 +61,7 @@ func (s *pumpScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, newS
 	}
 
 	klog.Infof("scaling out pump statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.PumpMemberType, v1alpha1.EventReasonScaleOut, ordinal, replicas)
 	var pvcName string
 	switch meta.(type) {
 	case *v1alpha1.TidbCluster:
@@ -135,6 +136,7 @@ func (s *pumpScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSe
 	}
 
 	klog.Infof("scaling in pump statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.PumpMemberType, v1alpha1.EventReasonScaleIn, ordinal, replicas)
 	var podName string
 
 	switch meta.(type) {
@@ -79,6 +79,9 @@ var (
 	ticdcRequirement   = util.MustNewRequirement(label.ComponentLabelKey, selection.Equals, []string{label.TiCDCLabelVal})
 	pumpRequirement    = util.MustNewRequirement(label.ComponentLabelKey, selection.Equals, []string{label.PumpLabelVal})
 
+	// exclude TiFlash compute nodes whose volumes are not described by spec.tiflash
+	tiflashWriteRequirement = util.MustNewRequirement(label.TiFlashRoleLabelKey, selection.DoesNotExist, nil)
+
 	dmMasterRequirement = util.MustNewRequirement(label.ComponentLabelKey, selection.Equals, []string{label.DMMasterLabelVal})
 	dmWorkerRequirement = util.MustNewRequirement(label.ComponentLabelKey, selection.Equals, []string{label.DMWorkerLabelVal})
 )
@@ -218,7 +221,7 @@ func (p *pvcResizer) buildContextForTC(tc *v1alpha1.TidbCluster, status v1alpha1
 		}
 		storageVolumes = tc.Spec.TiKV.StorageVolumes
 	case v1alpha1.TiFlashMemberType:
-		ctx.selector = selector.Add(*tiflashRequirement)
+		ctx.selector = selector.Add(*tiflashRequirement, *tiflashWriteRequirement)
 		if tc.Status.TiFlash.Volumes == nil {
 			tc.Status.TiFlash.Volumes = map[v1alpha1.StorageVolumeName]*v1alpha1.StorageVolumeStatus{}
 		}
@@ -241,6 +244,13 @@ func (p *pvcResizer) buildContextForTC(tc *v1alpha1.TidbCluster, status v1alpha1
 		if quantity, ok := tc.Spec.Pump.Requests[corev1.ResourceStorage]; ok {
 			ctx.desiredVolumeQuantity[v1alpha1.GetStorageVolumeName("", v1alpha1.PumpMemberType)] = quantity
 		}
+	case v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType:
+		// the PD microservices are stateless, only the volume status is maintained
+		ctx.selector = selector.Add(*util.MustNewRequirement(label.ComponentLabelKey, selection.Equals, []string{comp.String()}))
+		pdmsStatus := status.(*v1alpha1.PDMSStatus)
+		if pdmsStatus.Volumes == nil {
+			pdmsStatus.Volumes = map[v1alpha1.StorageVolumeName]*v1alpha1.StorageVolumeStatus{}
+		}
 	default:
 		return nil, fmt.Errorf("unsupported member type %s", comp)
 	}
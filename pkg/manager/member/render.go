@@ -0,0 +1,103 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// RenderStatefulSet renders the desired StatefulSet of a component of the tidb
+// cluster with the same builders as its member manager, nothing is created or
+// updated. oldSet is the live StatefulSet which may be nil, and cmLister is
+// used to resolve the name of the ConfigMap in use.
+func RenderStatefulSet(tc *v1alpha1.TidbCluster, memberType v1alpha1.MemberType, oldSet *apps.StatefulSet, cmLister corelisters.ConfigMapLister) (*apps.StatefulSet, error) {
+	var (
+		cm       *corev1.ConfigMap
+		err      error
+		cmPrefix string
+		strategy v1alpha1.ConfigUpdateStrategy
+		build    func(*v1alpha1.TidbCluster, *corev1.ConfigMap) (*apps.StatefulSet, error)
+	)
+
+	switch memberType {
+	case v1alpha1.PDMemberType:
+		cm, err = getPDConfigMap(tc)
+		cmPrefix, strategy, build = controller.PDMemberName(tc.Name), tc.BasePDSpec().ConfigUpdateStrategy(), getNewPDSetForTidbCluster
+	case v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType:
+		name := memberType.String()
+		if tc.GetPDMSSpec(name) == nil {
+			return nil, fmt.Errorf("pd microservice %s is not specified", name)
+		}
+		cm, err = getPDMSConfigMap(tc, name)
+		// keep consistent with pdmsMemberManager.SyncConfigMap
+		cmPrefix, strategy = controller.PDMSMemberName(tc.Name, name), v1alpha1.ConfigUpdateStrategyInPlace
+		build = func(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.StatefulSet, error) {
+			return getNewPDMSStatefulSet(tc, cm, name)
+		}
+	case v1alpha1.TiProxyMemberType:
+		cm, err = getTiProxyConfigMap(tc)
+		cmPrefix, strategy, build = controller.TiProxyMemberName(tc.Name), v1alpha1.ConfigUpdateStrategyInPlace, getNewTiProxyStatefulSet
+	case v1alpha1.TiFlashMemberType:
+		cm, err = getTiFlashConfigMap(tc)
+		cmPrefix, strategy, build = tiflashMemberName(tc), tc.BaseTiFlashSpec().ConfigUpdateStrategy(), getNewStatefulSet
+	case v1alpha1.TiKVMemberType:
+		cm, err = getTikVConfigMap(tc)
+		cmPrefix, strategy, build = tikvMemberName(tc), tc.BaseTiKVSpec().ConfigUpdateStrategy(), getNewTiKVSetForTidbCluster
+	case v1alpha1.PumpMemberType:
+		cm, err = getNewPumpConfigMap(tc)
+		cmPrefix, strategy, build = controller.PumpMemberName(tc.Name), tc.BasePumpSpec().ConfigUpdateStrategy(), getNewPumpStatefulSet
+	case v1alpha1.TiDBMemberType:
+		cm, err = getTiDBConfigMap(tc)
+		cmPrefix, strategy, build = controller.TiDBMemberName(tc.Name), tc.BaseTiDBSpec().ConfigUpdateStrategy(), getNewTiDBSetForTidbCluster
+	case v1alpha1.TiCDCMemberType:
+		// keep consistent with ticdcMemberManager.SyncConfigMap
+		if tc.Spec.TiCDC.Config != nil && !tc.Spec.TiCDC.Config.OnlyOldItems() {
+			cm, err = getTiCDCConfigMap(tc)
+		}
+		cmPrefix, strategy, build = controller.TiCDCMemberName(tc.Name), tc.BaseTiCDCSpec().ConfigUpdateStrategy(), getNewTiCDCStatefulSet
+	default:
+		return nil, fmt.Errorf("rendering statefulset of %s is not supported", memberType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cm != nil {
+		var inUseName string
+		if oldSet != nil {
+			inUseName = mngerutils.FindConfigMapVolume(&oldSet.Spec.Template.Spec, func(name string) bool {
+				return strings.HasPrefix(name, cmPrefix)
+			})
+		}
+		if err := mngerutils.UpdateConfigMapIfNeed(cmLister, strategy, inUseName, cm); err != nil {
+			return nil, err
+		}
+	}
+	return build(tc, cm)
+}
+
+// TemplateEqual returns whether the pod template of the new StatefulSet is the
+// same as the one last applied to the old StatefulSet. Pods are restarted by
+// the member managers if they are not equal.
+func TemplateEqual(new *apps.StatefulSet, old *apps.StatefulSet) bool {
+	return templateEqual(new, old)
+}
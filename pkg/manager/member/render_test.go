@@ -0,0 +1,86 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestRenderStatefulSet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cmLister := corelisters.NewConfigMapLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+	tc := newTidbClusterForPD()
+	tc.Spec.PD.Config = v1alpha1.NewPDConfig()
+
+	cm, err := getPDConfigMap(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	expected, err := getNewPDSetForTidbCluster(tc, cm)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	set, err := RenderStatefulSet(tc, v1alpha1.PDMemberType, nil, cmLister)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(set).To(Equal(expected))
+	g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(set)).To(Succeed())
+	g.Expect(TemplateEqual(expected, set)).To(BeTrue())
+
+	// the config map in use is kept for the in-place config update strategy
+	inUse := set.DeepCopy()
+	for i := range inUse.Spec.Template.Spec.Volumes {
+		if cmSource := inUse.Spec.Template.Spec.Volumes[i].ConfigMap; cmSource != nil {
+			cmSource.Name = "test-pd-in-use"
+		}
+	}
+	g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(inUse)).To(Succeed())
+	set, err = RenderStatefulSet(tc, v1alpha1.PDMemberType, inUse, cmLister)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(TemplateEqual(set, inUse)).To(BeTrue())
+
+	tc.Spec.PD.Image = "pd-test-image:v2"
+	set, err = RenderStatefulSet(tc, v1alpha1.PDMemberType, inUse, cmLister)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(TemplateEqual(set, inUse)).To(BeFalse())
+
+	_, err = RenderStatefulSet(tc, v1alpha1.DMMasterMemberType, nil, cmLister)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestRenderStatefulSetPDMS(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cmLister := corelisters.NewConfigMapLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+	tc := newTidbClusterForPDMSUpgrader()
+
+	for _, mt := range []v1alpha1.MemberType{v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType} {
+		cm, err := getPDMSConfigMap(tc, mt.String())
+		g.Expect(err).NotTo(HaveOccurred())
+		expected, err := getNewPDMSStatefulSet(tc, cm, mt.String())
+		g.Expect(err).NotTo(HaveOccurred())
+
+		set, err := RenderStatefulSet(tc, mt, nil, cmLister)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(set).To(Equal(expected))
+	}
+
+	// the microservice is not specified
+	tc.Spec.PDMS = tc.Spec.PDMS[:1]
+	_, err := RenderStatefulSet(tc, v1alpha1.PDMSSchedulingMemberType, nil, cmLister)
+	g.Expect(err).To(HaveOccurred())
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/features"
 	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -51,6 +52,19 @@ type generalScaler struct {
 	deps *controller.Dependencies
 }
 
+// recordScaling emits an event for the members of the component which begin to scale out or in.
+func (s *generalScaler) recordScaling(meta metav1.Object, memberType v1alpha1.MemberType, reason string, ordinals interface{}, replicas int32) {
+	obj, ok := meta.(runtime.Object)
+	if !ok {
+		return
+	}
+	action := "scaling out"
+	if reason == v1alpha1.EventReasonScaleIn {
+		action = "scaling in"
+	}
+	s.deps.Recorder.Eventf(obj, corev1.EventTypeNormal, reason, "%s %s, ordinals: %v (replicas: %d)", action, memberType, ordinals, replicas)
+}
+
 // TODO: change skipReason to event recorder as in TestPDFailoverFailover
 func (s *generalScaler) deleteDeferDeletingPVC(controller runtime.Object, memberType v1alpha1.MemberType, ordinal int32) (map[string]string, error) {
 	meta := controller.(metav1.Object)
@@ -73,6 +87,9 @@ func (s *generalScaler) deleteDeferDeletingPVC(controller runtime.Object, member
 	if len(pvcs) == 0 {
 		klog.Infof("%s %s/%s list pvc not found, selector: %s", kind, ns, meta.GetName(), selector)
 		podName := ordinalPodName(memberType, meta.GetName(), ordinal)
+		if tc, ok := controller.(*v1alpha1.TidbCluster); ok {
+			podName = tcOrdinalPodName(memberType, tc, ordinal)
+		}
 		skipReason[podName] = skipReasonScalerPVCNotFound
 		return skipReason, nil
 	}
@@ -103,7 +120,7 @@ func (s *generalScaler) deleteDeferDeletingPVC(controller runtime.Object, member
 func (s *generalScaler) updateDeferDeletingPVC(tc *v1alpha1.TidbCluster,
 	memberType v1alpha1.MemberType, ordinal int32) error {
 	ns := tc.GetNamespace()
-	podName := ordinalPodName(memberType, tc.Name, ordinal)
+	podName := tcOrdinalPodName(memberType, tc, ordinal)
 
 	l := label.New().Instance(tc.GetInstanceName())
 	l[label.AnnPodNameKey] = podName
@@ -171,6 +188,17 @@ func ordinalPodName(memberType v1alpha1.MemberType, tcName string, ordinal int32
 	return fmt.Sprintf("%s-%s-%d", tcName, memberType, ordinal)
 }
 
+// tcOrdinalPodName is the same as ordinalPodName except that it takes TiKV groups and TiFlash compute nodes into account
+func tcOrdinalPodName(memberType v1alpha1.MemberType, tc *v1alpha1.TidbCluster, ordinal int32) string {
+	switch memberType {
+	case v1alpha1.TiKVMemberType:
+		return tikvPodName(tc, ordinal)
+	case v1alpha1.TiFlashMemberType:
+		return tiflashPodName(tc, ordinal)
+	}
+	return ordinalPodName(memberType, tc.Name, ordinal)
+}
+
 // scaleOne calculates desired replicas and delete slots from actual/desired
 // stateful sets by allowing only one pod to be deleted or created
 // it returns following values:
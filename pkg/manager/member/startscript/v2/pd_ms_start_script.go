@@ -0,0 +1,108 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+// PDMSStartScriptModel contain fields for rendering PD microservice start script
+type PDMSStartScriptModel struct {
+	PDMSDomain          string
+	ListenAddr          string
+	AdvertiseListenAddr string
+	DiscoveryAddr       string
+	// PDMSName is the name of the microservice, e.g. tso or scheduling
+	PDMSName string
+}
+
+// RenderPDMSStartScript renders the start script of the PD microservice from TidbCluster
+func RenderPDMSStartScript(tc *v1alpha1.TidbCluster, name string) (string, error) {
+	m := &PDMSStartScriptModel{}
+	tcName := tc.Name
+	tcNS := tc.Namespace
+	peerServiceName := controller.PDMSPeerMemberName(tcName, name)
+
+	m.PDMSDomain = fmt.Sprintf("${PDMS_POD_NAME}.%s.%s.svc", peerServiceName, tcNS)
+	if tc.Spec.ClusterDomain != "" {
+		m.PDMSDomain = m.PDMSDomain + "." + tc.Spec.ClusterDomain
+	}
+
+	m.ListenAddr = fmt.Sprintf("%s://0.0.0.0:2379", tc.Scheme())
+
+	m.AdvertiseListenAddr = fmt.Sprintf("%s://${PDMS_DOMAIN}:2379", tc.Scheme())
+
+	m.DiscoveryAddr = fmt.Sprintf("%s-discovery.%s:10261", tcName, tcNS)
+
+	m.PDMSName = name
+
+	return renderTemplateFunc(pdmsStartScriptTpl, m)
+}
+
+// pdmsStartScript is the template of the start script of the PD microservice.
+const pdmsStartScript = `
+PDMS_POD_NAME=${POD_NAME:-$HOSTNAME}
+PDMS_DOMAIN={{ .PDMSDomain }}
+
+elapseTime=0
+period=1
+threshold=30
+while true; do
+    sleep ${period}
+    elapseTime=$(( elapseTime+period ))
+
+    if [[ ${elapseTime} -ge ${threshold} ]]; then
+        echo "waiting for pd microservice ready timeout" >&2
+        exit 1
+    fi
+
+    digRes=$(dig ${PDMS_DOMAIN} A ${PDMS_DOMAIN} AAAA +search +short)
+    if [ $? -ne 0  ]; then
+        echo "domain resolve ${PDMS_DOMAIN} failed"
+        echo "$digRes"
+        continue
+    fi
+
+    if [ -z "${digRes}" ]
+    then
+        echo "domain resolve ${PDMS_DOMAIN} no record return"
+    else
+        echo "domain resolve ${PDMS_DOMAIN} success"
+        echo "$digRes"
+        break
+    fi
+done
+
+ARGS="--listen-addr={{ .ListenAddr }} \
+--advertise-listen-addr={{ .AdvertiseListenAddr }} \
+--config=/etc/pd/pd.toml"
+
+encoded_domain_url=$(echo ${PDMS_DOMAIN}:2379 | base64 | tr "\n" " " | sed "s/ //g")
+
+until result=$(wget -qO- -T 3 http://{{ .DiscoveryAddr }}/new/${encoded_domain_url}/pdms 2>/dev/null); do
+    echo "waiting for discovery service to return start args ..."
+    sleep $((RANDOM % 5))
+done
+ARGS="${ARGS} ${result}"
+
+echo "starting pd microservice {{ .PDMSName }} ..."
+echo "/pd-server services {{ .PDMSName }} ${ARGS}"
+exec /pd-server services {{ .PDMSName }} ${ARGS}
+`
+
+var pdmsStartScriptTpl = template.Must(template.New("pdms-start-script").Parse(componentCommonScript + pdmsStartScript))
@@ -0,0 +1,91 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+
+	"github.com/onsi/gomega"
+)
+
+func TestRenderPDMSStartScript(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	type testcase struct {
+		name string
+
+		pdmsName     string
+		modifyTC     func(tc *v1alpha1.TidbCluster)
+		expectedArgs []string
+	}
+
+	cases := []testcase{
+		{
+			name:     "tso",
+			pdmsName: "tso",
+			expectedArgs: []string{
+				"PDMS_DOMAIN=${PDMS_POD_NAME}.start-script-test-tso-peer.start-script-test-ns.svc\n",
+				`ARGS="--listen-addr=http://0.0.0.0:2379 \
+--advertise-listen-addr=http://${PDMS_DOMAIN}:2379 \
+--config=/etc/pd/pd.toml"`,
+				"http://start-script-test-discovery.start-script-test-ns:10261/new/${encoded_domain_url}/pdms",
+				"exec /pd-server services tso ${ARGS}\n",
+			},
+		},
+		{
+			name:     "scheduling with cluster domain and tls",
+			pdmsName: "scheduling",
+			modifyTC: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.ClusterDomain = "cluster.local"
+				tc.Spec.TLSCluster = &v1alpha1.TLSCluster{Enabled: true}
+			},
+			expectedArgs: []string{
+				"PDMS_DOMAIN=${PDMS_POD_NAME}.start-script-test-scheduling-peer.start-script-test-ns.svc.cluster.local\n",
+				`ARGS="--listen-addr=https://0.0.0.0:2379 \
+--advertise-listen-addr=https://${PDMS_DOMAIN}:2379 \
+--config=/etc/pd/pd.toml"`,
+				"exec /pd-server services scheduling ${ARGS}\n",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Logf("test case: %s", c.name)
+
+		tc := &v1alpha1.TidbCluster{
+			Spec: v1alpha1.TidbClusterSpec{
+				PD:   &v1alpha1.PDSpec{Mode: v1alpha1.PDModeMS},
+				PDMS: []v1alpha1.PDMSSpec{{Name: c.pdmsName}},
+			},
+		}
+		tc.Name = "start-script-test"
+		tc.Namespace = "start-script-test-ns"
+
+		if c.modifyTC != nil {
+			c.modifyTC(tc)
+		}
+
+		script, err := RenderPDMSStartScript(tc, c.pdmsName)
+		g.Expect(err).Should(gomega.Succeed())
+		for _, arg := range c.expectedArgs {
+			if !strings.Contains(script, arg) {
+				t.Errorf("expected %q in script:\n%s", arg, script)
+			}
+		}
+		g.Expect(validateScript(script)).Should(gomega.Succeed())
+	}
+}
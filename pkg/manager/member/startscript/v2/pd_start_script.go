@@ -34,6 +34,8 @@ type PDStartScriptModel struct {
 	AdvertiseClientURL string
 	DiscoveryAddr      string
 	ExtraArgs          string
+	// PDStartCommand is the command to start PD, PD only serves the API service in ms mode
+	PDStartCommand string
 }
 
 // RenderPDStartScript renders PD start script from TidbCluster
@@ -65,6 +67,11 @@ func RenderPDStartScript(tc *v1alpha1.TidbCluster) (string, error) {
 
 	m.DiscoveryAddr = fmt.Sprintf("%s-discovery.%s:10261", tcName, tcNS)
 
+	m.PDStartCommand = "/pd-server"
+	if tc.Spec.PD.Mode == v1alpha1.PDModeMS {
+		m.PDStartCommand = "/pd-server services api"
+	}
+
 	return renderTemplateFunc(pdStartScriptTpl, m)
 }
 
@@ -133,8 +140,8 @@ fi
 
 echo "starting pd-server ..."
 sleep $((RANDOM % 10))
-echo "/pd-server ${ARGS}"
-exec /pd-server ${ARGS}
+echo "{{ .PDStartCommand }} ${ARGS}"
+exec {{ .PDStartCommand }} ${ARGS}
 `
 )
 
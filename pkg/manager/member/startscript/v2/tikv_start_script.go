@@ -43,6 +43,9 @@ func RenderTiKVStartScript(tc *v1alpha1.TidbCluster) (string, error) {
 	tcName := tc.Name
 	tcNS := tc.Namespace
 	peerServiceName := controller.TiKVPeerMemberName(tcName)
+	if group := tc.TiKVGroupName(); group != "" {
+		peerServiceName = controller.TiKVGroupPeerMemberName(tcName, group)
+	}
 
 	m.PDAddr = fmt.Sprintf("%s:2379", controller.PDMemberName(tcName))
 	if tc.AcrossK8s() {
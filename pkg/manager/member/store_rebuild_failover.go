@@ -0,0 +1,261 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// hdReasonNodeDeleted is the reason for host down being true when the node is deleted
+	hdReasonNodeDeleted = "NodeDeleted"
+)
+
+// rebuildFailureStores handles the failure stores in the RebuildLocalVolume mode.
+// The failure recovery of such a store follows this timeline:
+// If HostDown is not set then detect node failure for the pod and set HostDown
+// If HostDown is set then a spare replica is added, see TidbCluster.TiKVStsDesiredReplicas
+// If HostDown is set and Store is Down then delete Store from PD
+// If Store has been removed or become Tombstone then force delete the pod, delete PVCs and PVs and set StoreDeleted,
+// so the pod is recreated with new volumes on a healthy node and the spare replica is removed
+// The store is not deleted and the volumes are kept if the pod is restarted recently, see canRebuildNow
+// If StoreDeleted is set and a new store of the pod is Up then record the store is rebuilt
+func (sf *commonStoreFailover) rebuildFailureStores(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+	memberType := sf.storeAccess.GetMemberType()
+
+	for storeID, failureStore := range sf.storeAccess.GetFailureStores(tc) {
+		if failureStore.Mode != v1alpha1.FailoverModeRebuildLocalVolume {
+			continue
+		}
+
+		if failureStore.StoreDeleted {
+			sf.checkRebuiltStore(tc, storeID, failureStore)
+			continue
+		}
+
+		if !failureStore.HostDown {
+			reason, err := sf.detectHostDown(tc, failureStore)
+			if err != nil {
+				return err
+			}
+			if reason == "" {
+				continue
+			}
+			failureStore.HostDown = true
+			addFailoverRecord(&failureStore, v1alpha1.FailoverStepHostDown, fmt.Sprintf("node %s of pod %s is down, reason: %s", failureStore.NodeName, failureStore.PodName, reason))
+			sf.storeAccess.SetFailureStore(tc, storeID, failureStore)
+			return controller.IgnoreErrorf("Marked host down true for pod %s of tc %s/%s. Host down reason: %s", failureStore.PodName, ns, tcName, reason)
+		}
+
+		store, storeExists := sf.storeAccess.GetStore(tc, failureStore.StoreID)
+		if storeExists && store.State != v1alpha1.TiKVStateTombstone {
+			if store.State != v1alpha1.TiKVStateDown || hasFailoverRecord(failureStore, v1alpha1.FailoverStepStoreDeleted) {
+				// wait for PD to move the regions away from the store
+				continue
+			}
+			if !sf.canRebuildNow(tc, failureStore) {
+				continue
+			}
+			storeUintID, err := strconv.ParseUint(failureStore.StoreID, 10, 64)
+			if err != nil {
+				return err
+			}
+			if err := controller.GetPDClient(sf.deps.PDControl, tc).DeleteStore(storeUintID); err != nil {
+				return err
+			}
+			msg := fmt.Sprintf("Invoked delete on %s store '%s' in cluster %s/%s to rebuild it", memberType, failureStore.StoreID, ns, tcName)
+			addFailoverRecord(&failureStore, v1alpha1.FailoverStepStoreDeleted, msg)
+			sf.storeAccess.SetFailureStore(tc, storeID, failureStore)
+			sf.deps.Recorder.Event(tc, corev1.EventTypeWarning, recoveryEventReason, msg)
+			return controller.RequeueErrorf(msg)
+		}
+
+		if !sf.canRebuildNow(tc, failureStore) {
+			continue
+		}
+		pvNames, err := sf.deletePodAndVolumes(tc, failureStore)
+		if err != nil {
+			return err
+		}
+		failureStore.StoreDeleted = true
+		failureStore.PVNames = pvNames
+		msg := fmt.Sprintf("Deleted pod %s and its volumes %v of %s store '%s' in cluster %s/%s to rebuild it", failureStore.PodName, pvNames, memberType, failureStore.StoreID, ns, tcName)
+		addFailoverRecord(&failureStore, v1alpha1.FailoverStepVolumesDeleted, msg)
+		sf.storeAccess.SetFailureStore(tc, storeID, failureStore)
+		sf.deps.Recorder.Event(tc, corev1.EventTypeWarning, recoveryEventReason, msg)
+		klog.Info(msg)
+	}
+	return nil
+}
+
+// detectHostDown returns the reason if the node the failure store ran on is down, otherwise an empty string
+func (sf *commonStoreFailover) detectHostDown(tc *v1alpha1.TidbCluster, failureStore v1alpha1.TiKVFailureStore) (string, error) {
+	ns := tc.GetNamespace()
+	pod, err := sf.deps.PodLister.Pods(ns).Get(failureStore.PodName)
+	if err != nil && !errors.IsNotFound(err) {
+		return "", fmt.Errorf("%s failover [detectHostDown]: failed to get pod %s/%s, error: %s", sf.storeAccess.GetMemberType(), ns, failureStore.PodName, err)
+	}
+	if pod != nil && pod.Spec.NodeName != "" && (failureStore.NodeName == "" || failureStore.NodeName == pod.Spec.NodeName) {
+		// the node may have been deleted, which is checked below
+		status, err := sf.failureRecovery.getNodeAvailabilityStatus(pod)
+		if err == nil && status.NodeUnavailable {
+			return hdReasonNodeFailure, nil
+		}
+	}
+
+	if failureStore.NodeName == "" || sf.deps.NodeLister == nil {
+		return "", nil
+	}
+	// The node being NotReady alone is not treated as host down, because the rebuild irreversibly deletes the
+	// store and the local volumes while the node may be back soon, e.g. after a restart of the kubelet.
+	_, err = sf.deps.NodeLister.Get(failureStore.NodeName)
+	if errors.IsNotFound(err) {
+		return hdReasonNodeDeleted, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("%s failover [detectHostDown]: failed to get node %s, error: %s", sf.storeAccess.GetMemberType(), failureStore.NodeName, err)
+	}
+	return "", nil
+}
+
+// deletePodAndVolumes force deletes the pod of the failure store because the kubelet of the down node can't
+// confirm the deletion, then deletes the PVCs of the failure store and the PVs bound to them. It returns the
+// names of the deleted PVs.
+func (sf *commonStoreFailover) deletePodAndVolumes(tc *v1alpha1.TidbCluster, failureStore v1alpha1.TiKVFailureStore) ([]string, error) {
+	ns := tc.GetNamespace()
+	memberType := sf.storeAccess.GetMemberType()
+
+	pod, err := sf.deps.PodLister.Pods(ns).Get(failureStore.PodName)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("%s failover [deletePodAndVolumes]: failed to get pod %s/%s, error: %s", memberType, ns, failureStore.PodName, err)
+	}
+	if pod != nil {
+		if err := sf.deps.PodControl.ForceDeletePod(tc, pod); err != nil {
+			return nil, err
+		}
+	}
+
+	pvcs, err := sf.failureRecovery.getPodPvcs(tc, failureStore.PodName)
+	if err != nil {
+		return nil, err
+	}
+	var pvNames []string
+	for _, pvc := range pvcs {
+		if _, ok := failureStore.PVCUIDSet[pvc.UID]; !ok {
+			// the PVC is created for the recreated pod
+			continue
+		}
+		if pvc.DeletionTimestamp == nil {
+			if err := sf.deps.PVCControl.DeletePVC(tc, pvc); err != nil {
+				return nil, err
+			}
+		}
+		if pvc.Spec.VolumeName == "" {
+			continue
+		}
+		pv, err := sf.deps.PVLister.Get(pvc.Spec.VolumeName)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s failover [deletePodAndVolumes]: failed to get pv %s, error: %s", memberType, pvc.Spec.VolumeName, err)
+		}
+		if pv.DeletionTimestamp == nil {
+			if err := sf.deps.PVControl.DeletePV(tc, pv); err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		pvNames = append(pvNames, pv.Name)
+	}
+	return pvNames, nil
+}
+
+// canRebuildNow checks if it is ok to delete the failure store or its volumes now. Like canDoCleanUpNow, if the pod
+// was restarted after the host was marked down, some time is given for it to come up before the store is rebuilt.
+// Unlike canDoCleanUpNow, the pod is not required to be restarted since it can't be on a node which is down.
+func (sf *commonStoreFailover) canRebuildNow(tc *v1alpha1.TidbCluster, failureStore v1alpha1.TiKVFailureStore) bool {
+	ns := tc.GetNamespace()
+	pod, err := sf.deps.PodLister.Pods(ns).Get(failureStore.PodName)
+	if errors.IsNotFound(err) {
+		return true
+	}
+	if err != nil {
+		klog.Errorf("%s failover [canRebuildNow]: failed to get pod %s/%s, error: %s", sf.storeAccess.GetMemberType(), ns, failureStore.PodName, err)
+		return false
+	}
+	for _, record := range failureStore.History {
+		if record.Step != v1alpha1.FailoverStepHostDown {
+			continue
+		}
+		if pod.CreationTimestamp.After(record.Time.Time) && pod.CreationTimestamp.Add(restartToDeleteStoreGap).After(time.Now()) {
+			klog.Infof("%s failover [canRebuildNow]: pod %s/%s is restarted after the host is down, wait for it to come up", sf.storeAccess.GetMemberType(), ns, failureStore.PodName)
+			return false
+		}
+	}
+	return true
+}
+
+// checkRebuiltStore records the failure store is rebuilt if a new store of its pod is Up
+func (sf *commonStoreFailover) checkRebuiltStore(tc *v1alpha1.TidbCluster, storeID string, failureStore v1alpha1.TiKVFailureStore) {
+	if hasFailoverRecord(failureStore, v1alpha1.FailoverStepRebuilt) {
+		return
+	}
+	for _, store := range sf.storeAccess.GetStores(tc) {
+		if store.PodName == failureStore.PodName && store.ID != failureStore.StoreID && store.State == v1alpha1.TiKVStateUp {
+			msg := fmt.Sprintf("%s store '%s' is rebuilt as store '%s' on node %s", sf.storeAccess.GetMemberType(), failureStore.StoreID, store.ID, sf.podNodeName(tc, store.PodName))
+			addFailoverRecord(&failureStore, v1alpha1.FailoverStepRebuilt, msg)
+			sf.storeAccess.SetFailureStore(tc, storeID, failureStore)
+			sf.deps.Recorder.Event(tc, corev1.EventTypeNormal, recoveryEventReason, msg)
+			return
+		}
+	}
+}
+
+func (sf *commonStoreFailover) podNodeName(tc *v1alpha1.TidbCluster, podName string) string {
+	pod, err := sf.deps.PodLister.Pods(tc.GetNamespace()).Get(podName)
+	if err != nil {
+		return ""
+	}
+	return pod.Spec.NodeName
+}
+
+// addFailoverRecord appends a step to the history of the failure store
+func addFailoverRecord(failureStore *v1alpha1.TiKVFailureStore, step v1alpha1.FailoverStep, msg string) {
+	failureStore.History = append(failureStore.History, v1alpha1.FailoverRecord{
+		Step:    step,
+		Time:    metav1.Now(),
+		Message: msg,
+	})
+}
+
+func hasFailoverRecord(failureStore v1alpha1.TiKVFailureStore, step v1alpha1.FailoverStep) bool {
+	for _, record := range failureStore.History {
+		if record.Step == step {
+			return true
+		}
+	}
+	return false
+}
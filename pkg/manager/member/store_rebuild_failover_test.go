@@ -0,0 +1,253 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+)
+
+func failoverSteps(failureStore v1alpha1.TiKVFailureStore) []v1alpha1.FailoverStep {
+	steps := []v1alpha1.FailoverStep{}
+	for _, record := range failureStore.History {
+		steps = append(steps, record.Step)
+	}
+	return steps
+}
+
+func TestTiKVFailoverRebuildLocalVolume(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	tc.Spec.TiKV.MaxFailoverCount = pointer.Int32Ptr(1)
+	tc.Spec.TiKV.Failover = &v1alpha1.Failover{Mode: v1alpha1.FailoverModeRebuildLocalVolume}
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+		"1": {ID: "1", PodName: "test-tikv-1", State: v1alpha1.TiKVStateDown, LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))},
+		"2": {ID: "2", PodName: "test-tikv-2", State: v1alpha1.TiKVStateUp, LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))},
+	}
+
+	fakeDeps, pvcIndexer, podIndexer, nodeIndexer := newFakeDependenciesForFailover(false)
+	recorder := record.NewFakeRecorder(100)
+	fakeDeps.Recorder = recorder
+	pvIndexer := fakeDeps.KubeInformerFactory.Core().V1().PersistentVolumes().Informer().GetIndexer()
+
+	pod, pvcs := getTestTiKVPodAndPvcs(pvcIndexer, podIndexer, tc, testPodPvcParams{})
+	pod.Spec.NodeName = "node-1"
+	pod.Status.Phase = corev1.PodRunning
+	pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionFalse}}
+	pv := &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: pvcs[0].Spec.VolumeName}}
+	pvIndexer.Add(pv)
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	nodeIndexer.Add(node)
+
+	var deletedStore uint64
+	pdClient := controller.NewFakePDClient(fakeDeps.PDControl.(*pdapi.FakePDControl), tc)
+	pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+		deletedStore = action.ID
+		return nil, nil
+	})
+
+	failover := NewTiKVFailover(fakeDeps)
+
+	// the store is marked as failure within the budget, no replica is added for it
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores).To(HaveLen(1))
+	failureStore := tc.Status.TiKV.FailureStores["1"]
+	g.Expect(failureStore.PodName).To(Equal("test-tikv-1"))
+	g.Expect(failureStore.Mode).To(Equal(v1alpha1.FailoverModeRebuildLocalVolume))
+	g.Expect(failureStore.NodeName).To(Equal("node-1"))
+	g.Expect(tc.TiKVStsDesiredReplicas()).To(Equal(tc.Spec.TiKV.Replicas))
+
+	// the node is ready, nothing to do
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores["1"].HostDown).To(BeFalse())
+
+	// the node is down
+	node.Status.Conditions[0].Status = corev1.ConditionUnknown
+	nodeIndexer.Update(node)
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores["1"].HostDown).To(BeTrue())
+	// a spare replica is added so that the regions can be moved away from the store
+	g.Expect(tc.TiKVStsDesiredReplicas()).To(Equal(tc.Spec.TiKV.Replicas + 1))
+
+	// the store is deleted from PD
+	err := failover.Failover(tc)
+	g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+	g.Expect(deletedStore).To(Equal(uint64(1)))
+
+	// wait for the store to become tombstone
+	deletedStore = 0
+	store := tc.Status.TiKV.Stores["1"]
+	store.State = v1alpha1.TiKVStateOffline
+	tc.Status.TiKV.Stores["1"] = store
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(deletedStore).To(BeZero())
+	g.Expect(podIndexer.ListKeys()).To(ContainElement("default/test-tikv-1"))
+
+	// the pod, PVC and PV are deleted after the store becomes tombstone
+	delete(tc.Status.TiKV.Stores, "1")
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	failureStore = tc.Status.TiKV.FailureStores["1"]
+	g.Expect(failureStore.StoreDeleted).To(BeTrue())
+	g.Expect(failureStore.PVNames).To(Equal([]string{pv.Name}))
+	g.Expect(podIndexer.ListKeys()).NotTo(ContainElement("default/test-tikv-1"))
+	g.Expect(pvcIndexer.ListKeys()).NotTo(ContainElement("default/" + pvcs[0].Name))
+	g.Expect(pvIndexer.ListKeys()).NotTo(ContainElement(pv.Name))
+	g.Expect(tc.TiKVStsDesiredReplicas()).To(Equal(tc.Spec.TiKV.Replicas))
+
+	// a new store is up for the recreated pod
+	tc.Status.TiKV.Stores["3"] = v1alpha1.TiKVStore{ID: "3", PodName: "test-tikv-1", State: v1alpha1.TiKVStateUp, LastTransitionTime: metav1.Now()}
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	failureStore = tc.Status.TiKV.FailureStores["1"]
+	g.Expect(failoverSteps(failureStore)).To(Equal([]v1alpha1.FailoverStep{
+		v1alpha1.FailoverStepMarkedFailure,
+		v1alpha1.FailoverStepHostDown,
+		v1alpha1.FailoverStepStoreDeleted,
+		v1alpha1.FailoverStepVolumesDeleted,
+		v1alpha1.FailoverStepRebuilt,
+	}))
+
+	events := collectEvents(recorder.Events)
+	g.Expect(events).To(ContainElement(ContainSubstring("is rebuilt as store '3'")))
+
+	// the budget is used up, another down store is not marked as failure
+	store = tc.Status.TiKV.Stores["2"]
+	store.State = v1alpha1.TiKVStateDown
+	tc.Status.TiKV.Stores["2"] = store
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores).To(HaveLen(1))
+}
+
+func TestTiKVFailoverRebuildLocalVolumeNodeNotReady(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	tc.Spec.TiKV.MaxFailoverCount = pointer.Int32Ptr(1)
+	tc.Spec.TiKV.Failover = &v1alpha1.Failover{Mode: v1alpha1.FailoverModeRebuildLocalVolume}
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+		"1": {ID: "1", PodName: "test-tikv-1", State: v1alpha1.TiKVStateDown, LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))},
+	}
+
+	fakeDeps, pvcIndexer, podIndexer, nodeIndexer := newFakeDependenciesForFailover(false)
+	fakeDeps.Recorder = record.NewFakeRecorder(100)
+
+	pod, pvcs := getTestTiKVPodAndPvcs(pvcIndexer, podIndexer, tc, testPodPvcParams{})
+	pod.Spec.NodeName = "node-1"
+	pod.Status.Phase = corev1.PodRunning
+	// the pod status is not updated yet, e.g. the kubelet is restarting
+	pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionUnknown}},
+		},
+	}
+	nodeIndexer.Add(node)
+
+	var deletedStore uint64
+	pdClient := controller.NewFakePDClient(fakeDeps.PDControl.(*pdapi.FakePDControl), tc)
+	pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+		deletedStore = action.ID
+		return nil, nil
+	})
+
+	failover := NewTiKVFailover(fakeDeps)
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores).To(HaveLen(1))
+
+	// the node is NotReady but the pod is not found unavailable, the store is not rebuilt
+	for i := 0; i < 3; i++ {
+		g.Expect(failover.Failover(tc)).To(Succeed())
+	}
+	failureStore := tc.Status.TiKV.FailureStores["1"]
+	g.Expect(failureStore.HostDown).To(BeFalse())
+	g.Expect(failureStore.StoreDeleted).To(BeFalse())
+	g.Expect(deletedStore).To(BeZero())
+	g.Expect(podIndexer.ListKeys()).To(ContainElement("default/test-tikv-1"))
+	g.Expect(pvcIndexer.ListKeys()).To(ContainElement("default/" + pvcs[0].Name))
+
+	// the pod is gone, the NotReady node still doesn't trigger the rebuild
+	podIndexer.Delete(pod)
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores["1"].HostDown).To(BeFalse())
+	g.Expect(deletedStore).To(BeZero())
+
+	// the node is deleted
+	nodeIndexer.Delete(node)
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores["1"].HostDown).To(BeTrue())
+}
+
+func TestTiKVFailoverRebuildLocalVolumePodRestarted(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	tc.Spec.TiKV.MaxFailoverCount = pointer.Int32Ptr(1)
+	tc.Spec.TiKV.Failover = &v1alpha1.Failover{Mode: v1alpha1.FailoverModeRebuildLocalVolume}
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+		"1": {ID: "1", PodName: "test-tikv-1", State: v1alpha1.TiKVStateDown, LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour))},
+	}
+
+	fakeDeps, pvcIndexer, podIndexer, _ := newFakeDependenciesForFailover(false)
+	fakeDeps.Recorder = record.NewFakeRecorder(100)
+
+	pod, pvcs := getTestTiKVPodAndPvcs(pvcIndexer, podIndexer, tc, testPodPvcParams{})
+	pod.Spec.NodeName = "node-1"
+
+	var deletedStore uint64
+	pdClient := controller.NewFakePDClient(fakeDeps.PDControl.(*pdapi.FakePDControl), tc)
+	pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+		deletedStore = action.ID
+		return nil, nil
+	})
+
+	failover := NewTiKVFailover(fakeDeps)
+
+	// the node is not found, the host is marked down
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores["1"].HostDown).To(BeTrue())
+
+	// the pod is recreated after the host is down, the store is not deleted until the pod has time to come up
+	pod.CreationTimestamp = metav1.NewTime(time.Now().Add(time.Minute))
+	podIndexer.Update(pod)
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(deletedStore).To(BeZero())
+
+	// the store is removed, the volumes are kept as well
+	delete(tc.Status.TiKV.Stores, "1")
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores["1"].StoreDeleted).To(BeFalse())
+	g.Expect(pvcIndexer.ListKeys()).To(ContainElement("default/" + pvcs[0].Name))
+
+	// the pod doesn't come up in time
+	pod.CreationTimestamp = metav1.NewTime(time.Now().Add(-restartToDeleteStoreGap))
+	podIndexer.Update(pod)
+	g.Expect(failover.Failover(tc)).To(Succeed())
+	g.Expect(tc.Status.TiKV.FailureStores["1"].StoreDeleted).To(BeTrue())
+	g.Expect(pvcIndexer.ListKeys()).NotTo(ContainElement("default/" + pvcs[0].Name))
+}
@@ -45,16 +45,16 @@ const (
 	ticdcCertVolumeMount = "ticdc-tls"
 )
 
-// ticdcMemberManager implements manager.Manager.
+// ticdcMemberManager implements manager.Manager, it describes ticdc for the componentMemberManager.
 type ticdcMemberManager struct {
+	*componentMemberManager
 	deps                     *controller.Dependencies
-	scaler                   Scaler
-	ticdcUpgrader            Upgrader
-	suspender                suspender.Suspender
 	podVolumeModifier        volumes.PodVolumeModifier
 	statefulSetIsUpgradingFn func(corelisters.PodLister, pdapi.PDControlInterface, *apps.StatefulSet, *v1alpha1.TidbCluster) (bool, error)
 }
 
+var _ ComponentDescriptor = &ticdcMemberManager{}
+
 func getTiCDCConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 	if tc.Spec.TiCDC.Config == nil {
 		return nil, nil
@@ -92,142 +92,77 @@ func getTiCDCConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 func NewTiCDCMemberManager(deps *controller.Dependencies, scaler Scaler, ticdcUpgrader Upgrader, spder suspender.Suspender, pvm volumes.PodVolumeModifier) manager.Manager {
 	m := &ticdcMemberManager{
 		deps:              deps,
-		scaler:            scaler,
-		ticdcUpgrader:     ticdcUpgrader,
-		suspender:         spder,
 		podVolumeModifier: pvm,
 	}
+	m.componentMemberManager = newComponentMemberManager(deps, m, scaler, ticdcUpgrader, spder)
 	m.statefulSetIsUpgradingFn = ticdcStatefulSetIsUpgrading
 	return m
 }
 
-func (m *ticdcMemberManager) syncTiCDCConfigMap(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) (*corev1.ConfigMap, error) {
-	if tc.Spec.TiCDC.Config == nil || tc.Spec.TiCDC.Config.OnlyOldItems() {
-		return nil, nil
-	}
-
-	newCm, err := getTiCDCConfigMap(tc)
-	if err != nil {
-		return nil, err
-	}
+func (m *ticdcMemberManager) MemberType() v1alpha1.MemberType {
+	return v1alpha1.TiCDCMemberType
+}
 
-	var inUseName string
-	if set != nil {
-		inUseName = mngerutils.FindConfigMapVolume(&set.Spec.Template.Spec, func(name string) bool {
-			return strings.HasPrefix(name, controller.TiCDCMemberName(tc.Name))
-		})
-	}
+func (m *ticdcMemberManager) Name() string {
+	return "TiCDC"
+}
 
-	klog.V(3).Info("get ticdc in use config map name: ", inUseName)
+func (m *ticdcMemberManager) Enabled(tc *v1alpha1.TidbCluster) bool {
+	return tc.Spec.TiCDC != nil
+}
 
-	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, tc.BaseTiCDCSpec().ConfigUpdateStrategy(), inUseName, newCm)
-	if err != nil {
-		return nil, err
-	}
-	return m.deps.TypedControl.CreateOrUpdateConfigMap(tc, newCm)
+func (m *ticdcMemberManager) StatefulSetName(tc *v1alpha1.TidbCluster) string {
+	return controller.TiCDCMemberName(tc.GetName())
 }
 
-// Sync fulfills the manager.Manager interface
-func (m *ticdcMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
-	if tc.Spec.TiCDC == nil {
-		return nil
-	}
+func (m *ticdcMemberManager) Phase(tc *v1alpha1.TidbCluster) v1alpha1.MemberPhase {
+	return tc.Status.TiCDC.Phase
+}
 
+// Precheck blocks all TiCDC operations, e.g. creation, scale, upgrade, if PD or TiKV is not available.
+func (m *ticdcMemberManager) Precheck(tc *v1alpha1.TidbCluster) error {
 	ns := tc.GetNamespace()
 	tcName := tc.GetName()
-
-	// skip sync if ticdc is suspended
-	component := v1alpha1.TiCDCMemberType
-	needSuspend, err := m.suspender.SuspendComponent(tc, component)
-	if err != nil {
-		return fmt.Errorf("suspend %s failed: %v", component, err)
-	}
-	if needSuspend {
-		klog.Infof("component %s for cluster %s/%s is suspended, skip syncing", component, ns, tcName)
-		return nil
-	}
-
-	// NB: All TiCDC operations, e.g. creation, scale, upgrade will be blocked.
-	//     if PD or TiKV is not available.
 	if tc.Spec.PD != nil && !tc.PDIsAvailable() {
 		return controller.RequeueErrorf("TidbCluster: [%s/%s], TiCDC is waiting for PD cluster running", ns, tcName)
 	}
 	if tc.Spec.TiKV != nil && !tc.TiKVIsAvailable() {
 		return controller.RequeueErrorf("TidbCluster: [%s/%s], TiCDC is waiting for TiKV cluster running", ns, tcName)
 	}
-
-	// Sync CDC Headless Service
-	if err := m.syncCDCHeadlessService(tc); err != nil {
-		return err
-	}
-
-	return m.syncStatefulSet(tc)
+	return nil
 }
 
-func (m *ticdcMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
-	ns := tc.GetNamespace()
-	tcName := tc.GetName()
-
-	oldStsTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiCDCMemberName(tcName))
-	if err != nil && !errors.IsNotFound(err) {
-		return fmt.Errorf("syncStatefulSet: failed to get sts %s for cluster %s/%s, error: %s", controller.TiCDCMemberName(tcName), ns, tcName, err)
-	}
-
-	stsNotExist := errors.IsNotFound(err)
-	oldSts := oldStsTmp.DeepCopy()
-
-	// failed to sync ticdc status will not affect subsequent logic, just print the errors.
-	if err := m.syncTiCDCStatus(tc, oldSts); err != nil {
-		klog.Errorf("failed to sync TidbCluster: [%s/%s]'s ticdc status, error: %v",
-			ns, tcName, err)
-	}
-
-	if tc.Spec.Paused {
-		klog.Infof("TidbCluster %s/%s is paused, skip syncing ticdc statefulset", tc.GetNamespace(), tc.GetName())
-		return nil
-	}
+func (m *ticdcMemberManager) NewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.StatefulSet, error) {
+	return getNewTiCDCStatefulSet(tc, cm)
+}
 
-	cm, err := m.syncTiCDCConfigMap(tc, oldSts)
-	if err != nil {
-		return err
+func (m *ticdcMemberManager) SyncConfigMap(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) (*corev1.ConfigMap, error) {
+	if tc.Spec.TiCDC.Config == nil || tc.Spec.TiCDC.Config.OnlyOldItems() {
+		return nil, nil
 	}
 
-	newSts, err := getNewTiCDCStatefulSet(tc, cm)
+	newCm, err := getTiCDCConfigMap(tc)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if stsNotExist {
-		err = mngerutils.SetStatefulSetLastAppliedConfigAnnotation(newSts)
-		if err != nil {
-			return err
-		}
-		err = m.deps.StatefulSetControl.CreateStatefulSet(tc, newSts)
-		if err != nil {
-			return err
-		}
-		return nil
+	var inUseName string
+	if set != nil {
+		inUseName = mngerutils.FindConfigMapVolume(&set.Spec.Template.Spec, func(name string) bool {
+			return strings.HasPrefix(name, controller.TiCDCMemberName(tc.Name))
+		})
 	}
 
-	// Scaling takes precedence over upgrading because:
-	// - if a pod fails in the upgrading, users may want to delete it or add
-	//   new replicas
-	// - it's ok to scale in the middle of upgrading (in statefulset controller
-	//   scaling takes precedence over upgrading too)
-	if err := m.scaler.Scale(tc, oldSts, newSts); err != nil {
-		return err
-	}
+	klog.V(3).Info("get ticdc in use config map name: ", inUseName)
 
-	if !templateEqual(newSts, oldSts) || tc.Status.TiCDC.Phase == v1alpha1.UpgradePhase {
-		if err := m.ticdcUpgrader.Upgrade(tc, oldSts, newSts); err != nil {
-			return err
-		}
+	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, tc.BaseTiCDCSpec().ConfigUpdateStrategy(), inUseName, newCm)
+	if err != nil {
+		return nil, err
 	}
-
-	return mngerutils.UpdateStatefulSetWithPrecheck(m.deps, tc, "FailedUpdateTiCDCSTS", newSts, oldSts)
+	return m.deps.TypedControl.CreateOrUpdateConfigMap(tc, newCm)
 }
 
-func (m *ticdcMemberManager) syncTiCDCStatus(tc *v1alpha1.TidbCluster, sts *apps.StatefulSet) error {
+func (m *ticdcMemberManager) SyncStatus(tc *v1alpha1.TidbCluster, sts *apps.StatefulSet) error {
 	if sts == nil {
 		// skip if not created yet
 		return nil
@@ -288,12 +223,8 @@ func (m *ticdcMemberManager) syncTiCDCStatus(tc *v1alpha1.TidbCluster, sts *apps
 	return nil
 }
 
-func (m *ticdcMemberManager) syncCDCHeadlessService(tc *v1alpha1.TidbCluster) error {
-	if tc.Spec.Paused {
-		klog.Infof("TidbCluster %s/%s is paused, skip syncing ticdc service", tc.GetNamespace(), tc.GetName())
-		return nil
-	}
-
+// SyncServices syncs the headless Service of TiCDC
+func (m *ticdcMemberManager) SyncServices(tc *v1alpha1.TidbCluster) error {
 	ns := tc.GetNamespace()
 	tcName := tc.GetName()
 
@@ -307,7 +238,7 @@ func (m *ticdcMemberManager) syncCDCHeadlessService(tc *v1alpha1.TidbCluster) er
 		return m.deps.ServiceControl.CreateService(tc, newSvc)
 	}
 	if err != nil {
-		return fmt.Errorf("syncCDCHeadlessService: failed to get svc %s for cluster %s/%s, error: %s", controller.TiCDCPeerMemberName(tcName), ns, tcName, err)
+		return fmt.Errorf("SyncServices: failed to get svc %s for cluster %s/%s, error: %s", controller.TiCDCPeerMemberName(tcName), ns, tcName, err)
 	}
 
 	oldSvc := oldSvcTmp.DeepCopy()
@@ -463,7 +394,7 @@ func getNewTiCDCStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*ap
 		},
 		VolumeMounts: volMounts,
 		Resources:    controller.ContainerResource(tc.Spec.TiCDC.ResourceRequirements),
-		Env:          util.AppendEnv(envs, baseTiCDCSpec.Env()),
+		Env:          util.AppendEnv(append(envs, tlsCertHashEnv(tc, util.ClusterTLSSecretName(tc.Name, label.TiCDCLabelVal))...), baseTiCDCSpec.Env()),
 		EnvFrom:      baseTiCDCSpec.EnvFrom(),
 	}
 	if cm != nil {
@@ -350,7 +350,7 @@ func TestTiCDCMemberManagerSyncTidbClusterStatus(t *testing.T) {
 			test.beforeSyncStatus(tc, pmm, indexers)
 		}
 
-		err := pmm.syncTiCDCStatus(tc, set)
+		err := pmm.SyncStatus(tc, set)
 		if test.errExpectFn != nil {
 			test.errExpectFn(g, err)
 		}
@@ -593,13 +593,7 @@ func TestGetNewTiCDCStatefulSet(t *testing.T) {
 
 func newFakeTiCDCMemberManager() (*ticdcMemberManager, *controller.FakeStatefulSetControl, *controller.FakeTiDBControl, *fakeIndexers) {
 	fakeDeps := controller.NewFakeDependencies()
-	tmm := &ticdcMemberManager{
-		deps:              fakeDeps,
-		scaler:            NewTiCDCScaler(fakeDeps),
-		suspender:         suspender.NewFakeSuspender(),
-		podVolumeModifier: &volumes.FakePodVolumeModifier{},
-	}
-	tmm.statefulSetIsUpgradingFn = ticdcStatefulSetIsUpgrading
+	tmm := NewTiCDCMemberManager(fakeDeps, NewTiCDCScaler(fakeDeps), NewTiCDCUpgrader(fakeDeps), suspender.NewFakeSuspender(), &volumes.FakePodVolumeModifier{}).(*ticdcMemberManager)
 	indexers := &fakeIndexers{
 		pod:    fakeDeps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer(),
 		tc:     fakeDeps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer(),
@@ -62,6 +62,7 @@ func (s *ticdcScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, new
 		return nil
 	}
 	klog.Infof("scaling out ticdc statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.TiCDCMemberType, v1alpha1.EventReasonScaleOut, ordinal, replicas)
 	skipReason, err := s.deleteDeferDeletingPVC(obj, v1alpha1.TiCDCMemberType, ordinal)
 	if err != nil {
 		return err
@@ -82,6 +83,7 @@ func (s *ticdcScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newS
 	resetReplicas(newSet, oldSet)
 
 	klog.Infof("scaling in ticdc statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.TiCDCMemberType, v1alpha1.EventReasonScaleIn, ordinal, replicas)
 	// We need to remove member from cluster before reducing statefulset replicas
 	var podName string
 	switch meta.(type) {
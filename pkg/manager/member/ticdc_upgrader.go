@@ -58,6 +58,9 @@ func (u *ticdcUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulS
 			ns, tcName,
 			tc.Status.PD.Phase, tc.Status.TiKV.Phase, tc.Status.TiFlash.Phase,
 			tc.Status.Pump.Phase, tc.Status.TiDB.Phase)
+		mngerutils.RecordBlocked(u.deps.Recorder, tc, &tc.Status.TiCDC, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked,
+			fmt.Sprintf("pd status is %s, tikv status is %s, tiflash status is %s, pump status is %s, tidb status is %s, can not upgrade ticdc",
+				tc.Status.PD.Phase, tc.Status.TiKV.Phase, tc.Status.TiFlash.Phase, tc.Status.Pump.Phase, tc.Status.TiDB.Phase))
 		_, podSpec, err := GetLastAppliedConfig(oldSet)
 		if err != nil {
 			return err
@@ -65,6 +68,7 @@ func (u *ticdcUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulS
 		newSet.Spec.Template.Spec = *podSpec
 		return nil
 	}
+	mngerutils.ClearBlocked(&tc.Status.TiCDC, v1alpha1.ComponentConditionUpgradeBlocked)
 
 	tc.Status.TiCDC.Phase = v1alpha1.UpgradePhase
 	if !templateEqual(newSet, oldSet) {
@@ -136,6 +140,7 @@ func (u *ticdcUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulS
 			klog.Infof("ticdcUpgrade.Upgrade: %s graceful shutdown complete in cluster %s/%s", podName, tc.GetNamespace(), tc.GetName())
 		}
 
+		recordUpgradePod(u.deps.Recorder, tc, newSet, v1alpha1.TiCDCMemberType, ordinal)
 		mngerutils.SetUpgradePartition(newSet, ordinal)
 		return nil
 	}
@@ -19,6 +19,7 @@ import (
 	"strconv"
 
 	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -83,8 +84,17 @@ func (m *realTidbDiscoveryManager) Reconcile(obj client.Object) error {
 	}
 
 	meta, _ := getDiscoveryMeta(metaObj, controller.DiscoveryMemberName)
+	stateMeta, _ := getDiscoveryMeta(metaObj, controller.DiscoveryStateName)
+	// Ensure the ConfigMap to persist the peer knowledge, its data is owned by discovery
+	_, err := m.deps.GenericControl.CreateOrUpdate(obj, &corev1.ConfigMap{ObjectMeta: stateMeta}, func(existing, desired client.Object) error {
+		existing.SetLabels(desired.GetLabels())
+		return nil
+	}, true)
+	if err != nil {
+		return controller.RequeueErrorf("error creating or updating discovery state configmap: %v", err)
+	}
 	// Ensure RBAC
-	_, err := m.deps.TypedControl.CreateOrUpdateRole(obj, &rbacv1.Role{
+	_, err = m.deps.TypedControl.CreateOrUpdateRole(obj, &rbacv1.Role{
 		ObjectMeta: meta,
 		Rules: []rbacv1.PolicyRule{
 			clusterPolicyRule,
@@ -93,6 +103,24 @@ func (m *realTidbDiscoveryManager) Reconcile(obj client.Object) error {
 				Resources: []string{"secrets"},
 				Verbs:     []string{"get", "list", "watch"},
 			},
+			{
+				APIGroups:     []string{corev1.GroupName},
+				Resources:     []string{"configmaps"},
+				ResourceNames: []string{stateMeta.Name},
+				Verbs:         []string{"get", "update"},
+			},
+			// leader election of multiple discovery pods
+			{
+				APIGroups:     []string{coordinationv1.GroupName},
+				Resources:     []string{"leases"},
+				ResourceNames: []string{meta.Name},
+				Verbs:         []string{"get", "update"},
+			},
+			{
+				APIGroups: []string{coordinationv1.GroupName},
+				Resources: []string{"leases"},
+				Verbs:     []string{"create"},
+			},
 		},
 	})
 	if err != nil {
@@ -166,6 +194,7 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 		timezone  string
 		baseSpec  v1alpha1.ComponentAccessor
 		podSpec   corev1.PodSpec
+		replicas  int32 = 1
 	)
 
 	switch cluster := obj.(type) {
@@ -174,6 +203,7 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 		timezone = cluster.Timezone()
 		baseSpec = cluster.BaseDiscoverySpec()
 		podSpec = baseSpec.BuildPodSpec()
+		replicas = cluster.DiscoveryReplicas()
 	case *v1alpha1.DMCluster:
 		resources = cluster.Spec.Discovery.ResourceRequirements
 		timezone = cluster.Timezone()
@@ -184,6 +214,15 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 	}
 
 	meta, l := getDiscoveryMeta(obj, controller.DiscoveryMemberName)
+	stateMeta, _ := getDiscoveryMeta(obj, controller.DiscoveryStateName)
+
+	// NOTE: the args and the readiness probe are added with the persisted
+	// state, so the deployments created by an older operator are rolled once
+	// when the operator is upgraded
+	args := []string{fmt.Sprintf("--state-configmap=%s", stateMeta.Name)}
+	if replicas > 1 {
+		args = append(args, "--leader-elect", fmt.Sprintf("--lease-name=%s", meta.Name))
+	}
 
 	envs := []corev1.EnvVar{
 		{
@@ -212,6 +251,7 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 		Command: []string{
 			"/usr/local/bin/tidb-discovery",
 		},
+		Args:            args,
 		Image:           m.deps.CLIConfig.TiDBDiscoveryImage,
 		ImagePullPolicy: baseSpec.ImagePullPolicy(),
 		Env:             envs,
@@ -229,6 +269,17 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 				ContainerPort: 10262,
 			},
 		},
+		// only the pod serving the discovery requests is ready, which is the
+		// leader if there are multiple replicas
+		ReadinessProbe: &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: "/ready",
+					Port: intstr.FromInt(10261),
+				},
+			},
+			PeriodSeconds: 5,
+		},
 	})
 
 	var err error
@@ -260,6 +311,7 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 			Name:  "TC_TLS_ENABLED",
 			Value: strconv.FormatBool(true),
 		})
+		podSpec.Containers[0].Env = append(podSpec.Containers[0].Env, tlsCertHashEnv(tc, util.ClusterTLSSecretName(tc.Name, label.PDLabelVal))...)
 	}
 
 	podLabels := util.CombineStringMap(l.Labels(), baseSpec.Labels())
@@ -267,8 +319,9 @@ func (m *realTidbDiscoveryManager) getTidbDiscoveryDeployment(obj metav1.Object)
 	d := &appsv1.Deployment{
 		ObjectMeta: meta,
 		Spec: appsv1.DeploymentSpec{
+			// Recreate as only the leader becomes ready, a rolling update never finishes
 			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
-			Replicas: pointer.Int32Ptr(1),
+			Replicas: pointer.Int32Ptr(replicas),
 			Selector: l.LabelSelector(),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
@@ -24,10 +24,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestTidbDiscoveryManager_Reconcile(t *testing.T) {
 	g := NewGomegaWithT(t)
+	var stateCtrl *controller.FakeGenericControl
 	type testcase struct {
 		name                string
 		prepare             func(tc *v1alpha1.TidbCluster, ctrl *controller.FakeGenericControl)
@@ -58,6 +61,8 @@ func TestTidbDiscoveryManager_Reconcile(t *testing.T) {
 				g.Expect(err).To(Succeed())
 				g.Expect(deploys).To(HaveLen(1))
 				g.Expect(deploys[0].Name).To((Equal("test-discovery")))
+				g.Expect(*deploys[0].Spec.Replicas).To(Equal(int32(1)))
+				g.Expect(deploys[0].Spec.Template.Spec.Containers[0].Args).To(Equal([]string{"--state-configmap=test-discovery-state"}))
 			},
 			errOnCreateOrUpdate: false,
 		},
@@ -96,6 +101,29 @@ func TestTidbDiscoveryManager_Reconcile(t *testing.T) {
 			},
 			errOnCreateOrUpdate: false,
 		},
+		{
+			name: "Multiple replicas",
+			prepare: func(tc *v1alpha1.TidbCluster, ctrl *controller.FakeGenericControl) {
+				tc.Spec.Discovery.Replicas = pointer.Int32Ptr(3)
+				stateCtrl = ctrl
+			},
+			expect: func(deploys []appsv1.Deployment, tc *v1alpha1.TidbCluster, err error) {
+				g.Expect(err).To(Succeed())
+				g.Expect(deploys).To(HaveLen(1))
+				g.Expect(*deploys[0].Spec.Replicas).To(Equal(int32(3)))
+				g.Expect(deploys[0].Spec.Strategy.Type).To(Equal(appsv1.RecreateDeploymentStrategyType))
+				g.Expect(deploys[0].Spec.Template.Spec.Containers[0].Args).To(Equal([]string{
+					"--state-configmap=test-discovery-state",
+					"--leader-elect",
+					"--lease-name=test-discovery",
+				}))
+				g.Expect(deploys[0].Spec.Template.Spec.Containers[0].ReadinessProbe.HTTPGet.Path).To(Equal("/ready"))
+
+				cm := &corev1.ConfigMap{}
+				g.Expect(stateCtrl.FakeCli.Get(context.TODO(), client.ObjectKey{Namespace: tc.Namespace, Name: "test-discovery-state"}, cm)).To(Succeed())
+				g.Expect(cm.OwnerReferences).To(HaveLen(1))
+			},
+		},
 		{
 			name: "Create or update resource error",
 			expect: func(deploys []appsv1.Deployment, tc *v1alpha1.TidbCluster, err error) {
@@ -142,6 +170,7 @@ func TestTidbDiscoveryManager_ReconcileDM(t *testing.T) {
 				g.Expect(err).To(Succeed())
 				g.Expect(deploys).To(HaveLen(1))
 				g.Expect(deploys[0].Name).To(Equal("test-dm-discovery"))
+				g.Expect(deploys[0].Spec.Template.Spec.Containers[0].Args).To(Equal([]string{"--state-configmap=test-dm-discovery-state"}))
 			},
 			errOnCreateOrUpdate: false,
 		},
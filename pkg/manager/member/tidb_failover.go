@@ -19,6 +19,7 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
@@ -68,7 +69,9 @@ func (f *tidbFailover) Failover(tc *v1alpha1.TidbCluster) error {
 		deadline := tidbMember.LastTransitionTime.Add(f.deps.CLIConfig.TiDBFailoverPeriod)
 		if time.Now().After(deadline) {
 			if len(tc.Status.TiDB.FailureMembers) >= int(maxFailoverCount) {
-				klog.Warningf("the failover count reaches the limit (%d), no more failover pods will be created", maxFailoverCount)
+				msg := fmt.Sprintf("the failover count reaches the limit (%d), no more failover pods will be created for tidb[%s]", maxFailoverCount, tidbMember.Name)
+				klog.Warning(msg)
+				mngerutils.RecordBlocked(f.deps.Recorder, tc, &tc.Status.TiDB, v1alpha1.ComponentConditionFailoverBlocked, v1alpha1.EventReasonFailoverLimitReached, msg)
 				break
 			}
 
@@ -91,6 +94,7 @@ func (f *tidbFailover) Failover(tc *v1alpha1.TidbCluster) error {
 			}
 			msg := fmt.Sprintf("tidb[%s] is unhealthy", tidbMember.Name)
 			f.deps.Recorder.Event(tc, corev1.EventTypeWarning, unHealthEventReason, fmt.Sprintf(unHealthEventMsgPattern, "tidb", tidbMember.Name, msg))
+			mngerutils.ClearBlocked(&tc.Status.TiDB, v1alpha1.ComponentConditionFailoverBlocked)
 			break
 		}
 	}
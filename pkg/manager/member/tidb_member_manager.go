@@ -965,7 +965,7 @@ func getNewTiDBSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap)
 		},
 		VolumeMounts: volMounts,
 		Resources:    controller.ContainerResource(tc.Spec.TiDB.ResourceRequirements),
-		Env:          util.AppendEnv(envs, baseTiDBSpec.Env()),
+		Env:          util.AppendEnv(append(envs, tlsCertHashEnv(tc, util.ClusterTLSSecretName(tc.Name, label.TiDBLabelVal))...), baseTiDBSpec.Env()),
 		EnvFrom:      baseTiDBSpec.EnvFrom(),
 		ReadinessProbe: &corev1.Probe{
 			Handler:             buildTiDBReadinessProbHandler(tc),
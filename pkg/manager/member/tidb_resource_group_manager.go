@@ -0,0 +1,184 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager"
+	corev1 "k8s.io/api/core/v1"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+// defaultResourceGroup is the builtin resource group of TiDB, it can be altered but not dropped
+const defaultResourceGroup = "default"
+
+type resourceGroupManager struct {
+	deps *controller.Dependencies
+}
+
+// NewResourceGroupManager returns a manager which makes the resource groups in TiDB match `spec.resourceGroups`
+func NewResourceGroupManager(deps *controller.Dependencies) manager.Manager {
+	return &resourceGroupManager{
+		deps: deps,
+	}
+}
+
+// Sync makes the resource groups in TiDB match the spec. The resource groups are a user-level SQL feature,
+// so failures are recorded as Warning events instead of being returned to block the sync of the components.
+func (m *resourceGroupManager) Sync(tc *v1alpha1.TidbCluster) error {
+	if err := m.syncResourceGroups(tc); err != nil {
+		klog.Errorf("tidb cluster %s/%s: failed to sync resource groups, err: %v", tc.GetNamespace(), tc.GetName(), err)
+		m.deps.Recorder.Eventf(tc, corev1.EventTypeWarning, v1alpha1.EventReasonFailedSyncResourceGroup, "failed to sync resource groups: %v", err)
+	}
+	return nil
+}
+
+func (m *resourceGroupManager) syncResourceGroups(tc *v1alpha1.TidbCluster) error {
+	ns := tc.GetNamespace()
+	tcName := tc.GetName()
+
+	if len(tc.Spec.ResourceGroups) == 0 && len(tc.Status.ResourceGroups) == 0 {
+		return nil
+	}
+	if tc.Spec.Paused {
+		klog.V(4).Infof("tidb cluster %s/%s is paused, skip syncing resource groups", ns, tcName)
+		return nil
+	}
+	if tc.Spec.TiDB == nil || !tidbAvailable(tc) {
+		klog.V(4).Infof("tidb cluster %s/%s has no healthy tidb member, skip syncing resource groups", ns, tcName)
+		return nil
+	}
+
+	groups, err := m.deps.TiDBSQLControl.GetResourceGroups(tc)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]v1alpha1.ResourceGroupSpec, len(groups))
+	for _, group := range groups {
+		current[group.Name] = group
+	}
+
+	if tc.Status.ResourceGroups == nil {
+		tc.Status.ResourceGroups = map[string]v1alpha1.ResourceGroupStatus{}
+	}
+
+	var errs []error
+	desired := map[string]bool{}
+	for i := range tc.Spec.ResourceGroups {
+		group := tc.Spec.ResourceGroups[i]
+		if group.Priority == "" {
+			group.Priority = v1alpha1.ResourceGroupPriorityMedium
+		}
+		desired[group.Name] = true
+
+		if err := m.syncResourceGroup(tc, &group, current); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		status := tc.Status.ResourceGroups[group.Name]
+		status.RUPerSec = group.RUPerSec
+		status.Priority = group.Priority
+		status.Burstable = group.Burstable
+		tc.Status.ResourceGroups[group.Name] = status
+	}
+
+	// drop the resource groups which are created by the operator but removed from the spec
+	for name := range tc.Status.ResourceGroups {
+		if desired[name] {
+			continue
+		}
+		if name != defaultResourceGroup {
+			if _, ok := current[name]; ok {
+				if err := m.deps.TiDBSQLControl.DropResourceGroup(tc, name); err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				klog.Infof("tidb cluster %s/%s: drop resource group %s", ns, tcName, name)
+			}
+		}
+		delete(tc.Status.ResourceGroups, name)
+	}
+
+	m.syncResourceGroupConsumption(tc)
+
+	return errorutils.NewAggregate(errs)
+}
+
+func (m *resourceGroupManager) syncResourceGroup(tc *v1alpha1.TidbCluster, group *v1alpha1.ResourceGroupSpec, current map[string]v1alpha1.ResourceGroupSpec) error {
+	existing, ok := current[group.Name]
+	if !ok {
+		if err := m.deps.TiDBSQLControl.CreateResourceGroup(tc, group); err != nil {
+			return err
+		}
+		klog.Infof("tidb cluster %s/%s: create resource group %s", tc.GetNamespace(), tc.GetName(), group.Name)
+		return nil
+	}
+	if existing == *group {
+		return nil
+	}
+	if err := m.deps.TiDBSQLControl.AlterResourceGroup(tc, group); err != nil {
+		return err
+	}
+	klog.Infof("tidb cluster %s/%s: alter resource group %s", tc.GetNamespace(), tc.GetName(), group.Name)
+	return nil
+}
+
+// syncResourceGroupConsumption fills the consumed RU of the resource groups from PD,
+// failures are ignored because the consumption is only informational.
+func (m *resourceGroupManager) syncResourceGroupConsumption(tc *v1alpha1.TidbCluster) {
+	if len(tc.Status.ResourceGroups) == 0 {
+		return
+	}
+	pdGroups, err := controller.GetPDClient(m.deps.PDControl, tc).GetResourceGroups()
+	if err != nil {
+		klog.Warningf("tidb cluster %s/%s: failed to get resource groups from pd, err: %v", tc.GetNamespace(), tc.GetName(), err)
+		return
+	}
+	for _, pdGroup := range pdGroups {
+		status, ok := tc.Status.ResourceGroups[pdGroup.Name]
+		if !ok || pdGroup.RUConsumption == nil {
+			continue
+		}
+		status.ConsumedReadRU = int64(pdGroup.RUConsumption.RRU)
+		status.ConsumedWriteRU = int64(pdGroup.RUConsumption.WRU)
+		tc.Status.ResourceGroups[pdGroup.Name] = status
+	}
+}
+
+func tidbAvailable(tc *v1alpha1.TidbCluster) bool {
+	for _, member := range tc.Status.TiDB.Members {
+		if member.Health {
+			return true
+		}
+	}
+	return false
+}
+
+type FakeResourceGroupManager struct {
+	err error
+}
+
+func NewFakeResourceGroupManager() *FakeResourceGroupManager {
+	return &FakeResourceGroupManager{}
+}
+
+func (m *FakeResourceGroupManager) SetSyncError(err error) {
+	m.err = err
+}
+
+func (m *FakeResourceGroupManager) Sync(tc *v1alpha1.TidbCluster) error {
+	return m.err
+}
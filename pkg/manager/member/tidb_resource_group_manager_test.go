@@ -0,0 +1,193 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestResourceGroupManagerSync(t *testing.T) {
+	type testcase struct {
+		name          string
+		groups        []v1alpha1.ResourceGroupSpec
+		status        map[string]v1alpha1.ResourceGroupStatus
+		existing      map[string]v1alpha1.ResourceGroupSpec
+		tidbUnhealthy bool
+		sqlErr        bool
+		pdErr         bool
+		expectFn      func(*GomegaWithT, *v1alpha1.TidbCluster, *controller.FakeTiDBSQLControl, []string, error)
+	}
+
+	tests := []testcase{
+		{
+			name: "no resource groups",
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(BeEmpty())
+				g.Expect(tc.Status.ResourceGroups).To(BeNil())
+			},
+		},
+		{
+			name: "create resource groups",
+			groups: []v1alpha1.ResourceGroupSpec{
+				{Name: "rg1", RUPerSec: 1000},
+				{Name: "rg2", RUPerSec: 2000, Priority: v1alpha1.ResourceGroupPriorityHigh, Burstable: true},
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(ConsistOf("CREATE RESOURCE GROUP rg1", "CREATE RESOURCE GROUP rg2"))
+				g.Expect(sqlControl.ResourceGroups["rg1"].Priority).To(Equal(v1alpha1.ResourceGroupPriorityMedium))
+				g.Expect(tc.Status.ResourceGroups).To(Equal(map[string]v1alpha1.ResourceGroupStatus{
+					"rg1": {RUPerSec: 1000, Priority: v1alpha1.ResourceGroupPriorityMedium, ConsumedReadRU: 10, ConsumedWriteRU: 20},
+					"rg2": {RUPerSec: 2000, Priority: v1alpha1.ResourceGroupPriorityHigh, Burstable: true},
+				}))
+			},
+		},
+		{
+			name: "alter changed resource groups only",
+			groups: []v1alpha1.ResourceGroupSpec{
+				{Name: "rg1", RUPerSec: 1000},
+				{Name: "rg2", RUPerSec: 3000},
+			},
+			existing: map[string]v1alpha1.ResourceGroupSpec{
+				"rg1": {Name: "rg1", RUPerSec: 1000, Priority: v1alpha1.ResourceGroupPriorityMedium},
+				"rg2": {Name: "rg2", RUPerSec: 2000, Priority: v1alpha1.ResourceGroupPriorityMedium, Burstable: true},
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"ALTER RESOURCE GROUP rg2"}))
+				g.Expect(sqlControl.ResourceGroups["rg2"].Burstable).To(BeFalse())
+				g.Expect(tc.Status.ResourceGroups["rg2"].RUPerSec).To(Equal(int64(3000)))
+			},
+		},
+		{
+			name:   "drop resource groups removed from spec",
+			groups: []v1alpha1.ResourceGroupSpec{{Name: "rg1", RUPerSec: 1000}},
+			status: map[string]v1alpha1.ResourceGroupStatus{
+				"rg1":     {RUPerSec: 1000, Priority: v1alpha1.ResourceGroupPriorityMedium},
+				"rg2":     {RUPerSec: 2000, Priority: v1alpha1.ResourceGroupPriorityMedium},
+				"default": {RUPerSec: 5000, Priority: v1alpha1.ResourceGroupPriorityMedium},
+			},
+			existing: map[string]v1alpha1.ResourceGroupSpec{
+				"rg1":     {Name: "rg1", RUPerSec: 1000, Priority: v1alpha1.ResourceGroupPriorityMedium},
+				"rg2":     {Name: "rg2", RUPerSec: 2000, Priority: v1alpha1.ResourceGroupPriorityMedium},
+				"rg3":     {Name: "rg3", RUPerSec: 2000, Priority: v1alpha1.ResourceGroupPriorityMedium},
+				"default": {Name: "default", RUPerSec: 5000, Priority: v1alpha1.ResourceGroupPriorityMedium},
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"DROP RESOURCE GROUP rg2"}))
+				g.Expect(sqlControl.ResourceGroups).To(HaveKey("rg3"))
+				g.Expect(sqlControl.ResourceGroups).To(HaveKey("default"))
+				g.Expect(tc.Status.ResourceGroups).To(HaveLen(1))
+				g.Expect(tc.Status.ResourceGroups).To(HaveKey("rg1"))
+			},
+		},
+		{
+			name:          "tidb is not available",
+			groups:        []v1alpha1.ResourceGroupSpec{{Name: "rg1", RUPerSec: 1000}},
+			tidbUnhealthy: true,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(BeEmpty())
+			},
+		},
+		{
+			name:   "failed to execute sql",
+			groups: []v1alpha1.ResourceGroupSpec{{Name: "rg1", RUPerSec: 1000}},
+			sqlErr: true,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				// the failure is recorded as an event and doesn't block the sync of the components
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(tc.Status.ResourceGroups).To(BeEmpty())
+				g.Expect(events).To(ConsistOf(ContainSubstring("Warning FailedSyncResourceGroup")))
+			},
+		},
+		{
+			name:   "failed to get resource groups from pd",
+			groups: []v1alpha1.ResourceGroupSpec{{Name: "rg1", RUPerSec: 1000}},
+			pdErr:  true,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(tc.Status.ResourceGroups["rg1"]).To(Equal(v1alpha1.ResourceGroupStatus{RUPerSec: 1000, Priority: v1alpha1.ResourceGroupPriorityMedium}))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			deps := controller.NewFakeDependencies()
+			m := NewResourceGroupManager(deps)
+
+			tc := newTidbClusterForResourceGroup()
+			tc.Spec.ResourceGroups = test.groups
+			tc.Status.ResourceGroups = test.status
+			if test.tidbUnhealthy {
+				tc.Status.TiDB.Members["test-tidb-0"] = v1alpha1.TiDBMember{Name: "test-tidb-0", Health: false}
+			}
+
+			sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+			for name, group := range test.existing {
+				sqlControl.ResourceGroups[name] = group
+			}
+			if test.sqlErr {
+				sqlControl.SetError(fmt.Errorf("mock sql error"))
+			}
+
+			pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+			pdClient.AddReaction(pdapi.GetResourceGroupsActionType, func(action *pdapi.Action) (interface{}, error) {
+				if test.pdErr {
+					return nil, fmt.Errorf("mock pd error")
+				}
+				return []*pdapi.ResourceGroup{
+					{Name: "rg1", RUConsumption: &pdapi.ResourceGroupConsumption{RRU: 10.5, WRU: 20}},
+					{Name: "default", RUConsumption: &pdapi.ResourceGroupConsumption{RRU: 100, WRU: 200}},
+				}, nil
+			})
+
+			err := m.Sync(tc)
+			test.expectFn(g, tc, sqlControl, collectEvents(deps.Recorder.(*record.FakeRecorder).Events), err)
+		})
+	}
+}
+
+func newTidbClusterForResourceGroup() *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: v1alpha1.TidbClusterSpec{
+			TiDB:      &v1alpha1.TiDBSpec{},
+			SQLAccess: &v1alpha1.TiDBSQLAccessConfig{SecretName: "test-secret"},
+		},
+		Status: v1alpha1.TidbClusterStatus{
+			TiDB: v1alpha1.TiDBStatus{
+				Members: map[string]v1alpha1.TiDBMember{
+					"test-tidb-0": {Name: "test-tidb-0", Health: true},
+				},
+			},
+		},
+	}
+}
@@ -57,6 +57,7 @@ func (s *tidbScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, newS
 		return nil
 	}
 	klog.Infof("scaling out tidb statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.TiDBMemberType, v1alpha1.EventReasonScaleOut, ordinal, replicas)
 	skipReason, err := s.deleteDeferDeletingPVC(obj, v1alpha1.TiDBMemberType, ordinal)
 	if err != nil {
 		return err
@@ -77,6 +78,7 @@ func (s *tidbScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSe
 	resetReplicas(newSet, oldSet)
 
 	klog.Infof("scaling in tidb statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.TiDBMemberType, v1alpha1.EventReasonScaleIn, ordinal, replicas)
 	// We need to remove member from cluster before reducing statefulset replicas
 	var podName string
 	switch meta.(type) {
@@ -57,8 +57,11 @@ func (u *tidbUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSe
 	tcName := tc.GetName()
 
 	if tc.Status.PD.Phase == v1alpha1.UpgradePhase || tc.Status.PD.Phase == v1alpha1.ScalePhase ||
+		tc.PDMSUpgrading() || tc.PDMSScaling() ||
 		tc.Status.TiKV.Phase == v1alpha1.UpgradePhase || tc.Status.TiKV.Phase == v1alpha1.ScalePhase ||
+		tc.TiKVGroupsUpgradingOrScaling() ||
 		tc.Status.TiFlash.Phase == v1alpha1.UpgradePhase || tc.Status.TiFlash.Phase == v1alpha1.ScalePhase ||
+		tc.TiFlashComputeUpgradingOrScaling() ||
 		tc.Status.Pump.Phase == v1alpha1.UpgradePhase || tc.Status.Pump.Phase == v1alpha1.ScalePhase ||
 		tc.TiDBScaling() {
 		klog.Infof("TidbCluster: [%s/%s]'s pd status is %s, "+
@@ -67,6 +70,9 @@ func (u *tidbUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSe
 			ns, tcName,
 			tc.Status.PD.Phase, tc.Status.TiKV.Phase, tc.Status.TiFlash.Phase,
 			tc.Status.Pump.Phase, tc.Status.TiDB.Phase)
+		mngerutils.RecordBlocked(u.deps.Recorder, tc, &tc.Status.TiDB, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked,
+			fmt.Sprintf("pd status is %s, tikv status is %s, tiflash status is %s, pump status is %s, tidb status is %s, can not upgrade tidb",
+				tc.Status.PD.Phase, tc.Status.TiKV.Phase, tc.Status.TiFlash.Phase, tc.Status.Pump.Phase, tc.Status.TiDB.Phase))
 		_, podSpec, err := GetLastAppliedConfig(oldSet)
 		if err != nil {
 			return err
@@ -74,6 +80,7 @@ func (u *tidbUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSe
 		newSet.Spec.Template.Spec = *podSpec
 		return nil
 	}
+	mngerutils.ClearBlocked(&tc.Status.TiDB, v1alpha1.ComponentConditionUpgradeBlocked)
 
 	tc.Status.TiDB.Phase = v1alpha1.UpgradePhase
 	if !templateEqual(newSet, oldSet) {
@@ -140,6 +147,7 @@ func (u *tidbUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.StatefulSe
 }
 
 func (u *tidbUpgrader) upgradeTiDBPod(tc *v1alpha1.TidbCluster, ordinal int32, newSet *apps.StatefulSet) error {
+	recordUpgradePod(u.deps.Recorder, tc, newSet, v1alpha1.TiDBMemberType, ordinal)
 	mngerutils.SetUpgradePartition(newSet, ordinal)
 	return nil
 }
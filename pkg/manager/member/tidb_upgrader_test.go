@@ -175,6 +175,27 @@ func TestTiDBUpgrader_Upgrade(t *testing.T) {
 				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(1)))
 			},
 		},
+		{
+			name: "tiflash compute is upgrading",
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.TiFlashCompute.Phase = v1alpha1.UpgradePhase
+			},
+			getLastAppliedConfigErr: false,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(1)))
+			},
+		},
+		{
+			name: "tikv group is upgrading",
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{{Name: "g1"}}
+				tc.Status.TiKVGroups = map[string]v1alpha1.TiKVStatus{"g1": {Phase: v1alpha1.UpgradePhase}}
+			},
+			getLastAppliedConfigErr: false,
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet) {
+				g.Expect(newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(pointer.Int32Ptr(1)))
+			},
+		},
 		{
 			name: "pump is upgrading",
 			changeFn: func(tc *v1alpha1.TidbCluster) {
@@ -39,6 +39,10 @@ func (tsa *tiflashStoreAccess) GetFailoverPeriod(cliConfig *controller.CLIConfig
 	return cliConfig.TiFlashFailoverPeriod
 }
 
+func (tsa *tiflashStoreAccess) GetFailoverMode(_ *v1alpha1.TidbCluster) v1alpha1.FailoverMode {
+	return v1alpha1.FailoverModeAddReplica
+}
+
 func (tsa *tiflashStoreAccess) GetMemberType() v1alpha1.MemberType {
 	return v1alpha1.TiFlashMemberType
 }
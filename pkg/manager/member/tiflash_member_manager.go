@@ -21,6 +21,7 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/manager"
 	"github.com/pingcap/tidb-operator/pkg/manager/member/startscript"
@@ -44,9 +45,9 @@ import (
 
 const (
 	// find a better way to manage store only managed by tiflash in Operator
-	tiflashStoreLimitPattern = `%s-tiflash-\d+\.%s-tiflash-peer\.%s\.svc%s:\d+`
-	tiflashCertPath          = "/var/lib/tiflash-tls"
-	tiflashCertVolumeName    = "tiflash-tls"
+	tiflashStorePatternFormat = `%s-\d+\.%s\.%s\.svc%s:\d+`
+	tiflashCertPath           = "/var/lib/tiflash-tls"
+	tiflashCertVolumeName     = "tiflash-tls"
 )
 
 // tiflashMemberManager implements manager.Manager.
@@ -106,7 +107,28 @@ func (m *tiflashMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
 		return err
 	}
 
-	return m.syncStatefulSet(tc)
+	if err = m.syncStatefulSet(tc); err != nil {
+		return err
+	}
+
+	// compute nodes are synced after write nodes, they are not upgraded at the same time, see tiflashUpgrader
+	return m.syncCompute(tc)
+}
+
+// syncCompute syncs TiFlash compute nodes in the disaggregated architecture in the same way as write nodes
+func (m *tiflashMemberManager) syncCompute(tc *v1alpha1.TidbCluster) error {
+	view := tc.TiFlashComputeView()
+	if view == nil {
+		tc.Status.TiFlashCompute = v1alpha1.TiFlashStatus{}
+		return nil
+	}
+
+	if err := m.syncHeadlessService(view); err != nil {
+		return err
+	}
+	err := m.syncStatefulSet(view)
+	tc.Status.TiFlashCompute = view.Status.TiFlash
+	return err
 }
 
 func (m *tiflashMemberManager) syncRecoveryForTiFlash(tc *v1alpha1.TidbCluster) error {
@@ -170,7 +192,7 @@ func (m *tiflashMemberManager) syncHeadlessService(tc *v1alpha1.TidbCluster) err
 	tcName := tc.GetName()
 
 	newSvc := getNewHeadlessService(tc)
-	oldSvcTmp, err := m.deps.ServiceLister.Services(ns).Get(controller.TiFlashPeerMemberName(tcName))
+	oldSvcTmp, err := m.deps.ServiceLister.Services(ns).Get(tiflashPeerMemberName(tc))
 	if errors.IsNotFound(err) {
 		err = controller.SetServiceLastAppliedConfigAnnotation(newSvc)
 		if err != nil {
@@ -179,7 +201,7 @@ func (m *tiflashMemberManager) syncHeadlessService(tc *v1alpha1.TidbCluster) err
 		return m.deps.ServiceControl.CreateService(tc, newSvc)
 	}
 	if err != nil {
-		return fmt.Errorf("syncHeadlessService: failed to get svc %s for cluster %s/%s, error: %s", controller.TiFlashPeerMemberName(tcName), ns, tcName, err)
+		return fmt.Errorf("syncHeadlessService: failed to get svc %s for cluster %s/%s, error: %s", tiflashPeerMemberName(tc), ns, tcName, err)
 	}
 
 	oldSvc := oldSvcTmp.DeepCopy()
@@ -206,9 +228,9 @@ func (m *tiflashMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
 	ns := tc.GetNamespace()
 	tcName := tc.GetName()
 
-	oldSetTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiFlashMemberName(tcName))
+	oldSetTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(tiflashMemberName(tc))
 	if err != nil && !errors.IsNotFound(err) {
-		return fmt.Errorf("syncStatefulSet: fail to get sts %s for cluster %s/%s, error: %s", controller.TiFlashMemberName(tcName), ns, tcName, err)
+		return fmt.Errorf("syncStatefulSet: fail to get sts %s for cluster %s/%s, error: %s", tiflashMemberName(tc), ns, tcName, err)
 	}
 	setNotExist := errors.IsNotFound(err)
 
@@ -216,9 +238,9 @@ func (m *tiflashMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
 	// to avoid some fileds (e.g storage request) reused and cause unexpected behavior (e.g scale down).
 	if oldSetTmp != nil && *oldSetTmp.Spec.Replicas == 0 && oldSetTmp.Status.UpdatedReplicas == 0 && tc.Spec.TiFlash.Replicas > 0 {
 		if err := m.deps.StatefulSetControl.DeleteStatefulSet(tc, oldSetTmp); err != nil && !errors.IsNotFound(err) {
-			return fmt.Errorf("syncStatefulSet: fail to delete sts %s for cluster %s/%s, error: %s", controller.TiFlashMemberName(tcName), ns, tcName, err)
+			return fmt.Errorf("syncStatefulSet: fail to delete sts %s for cluster %s/%s, error: %s", tiflashMemberName(tc), ns, tcName, err)
 		}
-		return controller.RequeueErrorf("wait for previous sts %s for cluster %s/%s to be deleted", controller.TiFlashMemberName(tcName), ns, tcName)
+		return controller.RequeueErrorf("wait for previous sts %s for cluster %s/%s to be deleted", tiflashMemberName(tc), ns, tcName)
 	}
 
 	oldSet := oldSetTmp.DeepCopy()
@@ -277,11 +299,15 @@ func (m *tiflashMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
 	//   new replicas
 	// - it's ok to scale in the middle of upgrading (in statefulset controller
 	//   scaling takes precedence over upgrading too)
-	if err := m.scaler.Scale(tc, oldSet, newSet); err != nil {
-		return err
+	// Compute nodes hold no data, so they are scaled by the statefulset controller
+	// directly without deleting stores from PD.
+	if !tc.IsTiFlashCompute() {
+		if err := m.scaler.Scale(tc, oldSet, newSet); err != nil {
+			return err
+		}
 	}
 
-	if m.deps.CLIConfig.AutoFailover && tc.Spec.TiFlash.MaxFailoverCount != nil {
+	if m.deps.CLIConfig.AutoFailover && tc.Spec.TiFlash.MaxFailoverCount != nil && !tc.IsTiFlashCompute() {
 		if tc.TiFlashAllPodsStarted() && !tc.TiFlashAllStoresReady() {
 			if err := m.failover.Failover(tc); err != nil {
 				return err
@@ -307,7 +333,7 @@ func (m *tiflashMemberManager) syncConfigMap(tc *v1alpha1.TidbCluster, set *apps
 	var inUseName string
 	if set != nil {
 		inUseName = mngerutils.FindConfigMapVolume(&set.Spec.Template.Spec, func(name string) bool {
-			return strings.HasPrefix(name, controller.TiFlashMemberName(tc.Name))
+			return strings.HasPrefix(name, tiflashMemberName(tc))
 		})
 	}
 
@@ -320,10 +346,8 @@ func (m *tiflashMemberManager) syncConfigMap(tc *v1alpha1.TidbCluster, set *apps
 
 func getNewHeadlessService(tc *v1alpha1.TidbCluster) *corev1.Service {
 	ns := tc.Namespace
-	tcName := tc.Name
-	instanceName := tc.GetInstanceName()
-	svcName := controller.TiFlashPeerMemberName(tcName)
-	svcLabel := label.New().Instance(instanceName).TiFlash().Labels()
+	svcName := tiflashPeerMemberName(tc)
+	svcLabel := labelTiFlash(tc).Labels()
 
 	svc := corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
@@ -380,7 +404,7 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 	}
 
 	// This should not happen as we have validaton for this field
-	if len(spec.StorageClaims) < 1 {
+	if len(spec.StorageClaims) < 1 && !tc.IsTiFlashCompute() {
 		return nil, fmt.Errorf("storageClaims should be configured at least one item for tiflash, tidbcluster %s/%s", tc.Namespace, tc.Name)
 	}
 	pvcs, err := flashVolumeClaimTemplate(tc.Spec.TiFlash.StorageClaims)
@@ -413,6 +437,13 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 			}},
 		},
 	}
+	// compute nodes without local cache still need a volume for the config files and temporary data
+	if len(spec.StorageClaims) == 0 {
+		volMounts = append(volMounts, corev1.VolumeMount{Name: "data0", MountPath: "/data0"})
+		vols = append(vols, corev1.Volume{
+			Name: "data0", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+	}
 
 	if tc.IsTLSClusterEnabled() {
 		vols = append(vols, corev1.Volume{
@@ -502,13 +533,13 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 	initContainers = append(initContainers, initializer)
 
 	stsLabels := labelTiFlash(tc)
-	setName := controller.TiFlashMemberName(tcName)
+	setName := tiflashMemberName(tc)
 	podLabels := util.CombineStringMap(stsLabels, baseTiFlashSpec.Labels())
 	podAnnotations := util.CombineStringMap(baseTiFlashSpec.Annotations(), controller.AnnProm(8234, "/metrics"))
 	podAnnotations = util.CombineStringMap(controller.AnnAdditionalProm("tiflash.proxy", 20292), podAnnotations)
 	stsAnnotations := getStsAnnotations(tc.Annotations, label.TiFlashLabelVal)
 	capacity := controller.TiKVCapacity(tc.Spec.TiFlash.Limits)
-	headlessSvcName := controller.TiFlashPeerMemberName(tcName)
+	headlessSvcName := tiflashPeerMemberName(tc)
 
 	deleteSlotsNumber, err := util.GetDeleteSlotsNumber(stsAnnotations)
 	if err != nil {
@@ -541,6 +572,7 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 			Value: tc.Timezone(),
 		},
 	}
+	env = append(env, tiflashSharedStorageEnv(tc)...)
 
 	startScript, err := startscript.RenderTiFlashStartScript(tc)
 	if err != nil {
@@ -601,6 +633,7 @@ func getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.St
 			},
 		})
 	}
+	env = append(env, tlsCertHashEnv(tc, util.ClusterTLSSecretName(tc.Name, label.TiFlashLabelVal))...)
 	tiflashContainer.Env = util.AppendEnv(env, baseTiFlashSpec.Env())
 	tiflashContainer.EnvFrom = baseTiFlashSpec.EnvFrom()
 	podSpec.Volumes = append(vols, baseTiFlashSpec.AdditionalVolumes()...)
@@ -692,11 +725,10 @@ func getTiFlashConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 		return nil, err
 	}
 
-	instanceName := tc.GetInstanceName()
-	tiflashLabel := label.New().Instance(instanceName).TiFlash().Labels()
+	tiflashLabel := labelTiFlash(tc).Labels()
 	cm := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:            controller.TiFlashMemberName(tc.Name),
+			Name:            tiflashMemberName(tc),
 			Namespace:       tc.Namespace,
 			Labels:          tiflashLabel,
 			OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
@@ -712,7 +744,42 @@ func getTiFlashConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 
 func labelTiFlash(tc *v1alpha1.TidbCluster) label.Label {
 	instanceName := tc.GetInstanceName()
-	return label.New().Instance(instanceName).TiFlash()
+	l := label.New().Instance(instanceName).TiFlash()
+	if tc.IsTiFlashCompute() {
+		l = l.TiFlashCompute()
+	}
+	return l
+}
+
+// tiflashSharedStorageEnv returns the credentials of the shared storage in the disaggregated architecture
+func tiflashSharedStorageEnv(tc *v1alpha1.TidbCluster) []corev1.EnvVar {
+	if !tc.TiFlashDisaggregated() {
+		return nil
+	}
+	s3 := tc.Spec.TiFlash.Disaggregated.S3
+	if s3 == nil || s3.SecretName == "" {
+		return nil
+	}
+	return []corev1.EnvVar{
+		{
+			Name: "S3_ACCESS_KEY_ID",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: s3.SecretName},
+					Key:                  constants.S3AccessKey,
+				},
+			},
+		},
+		{
+			Name: "S3_SECRET_ACCESS_KEY",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: s3.SecretName},
+					Key:                  constants.S3SecretKey,
+				},
+			},
+		},
+	}
 }
 
 func (m *tiflashMemberManager) syncTidbClusterStatus(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) error {
@@ -749,7 +816,7 @@ func (m *tiflashMemberManager) syncTidbClusterStatus(tc *v1alpha1.TidbCluster, s
 		return err
 	}
 
-	pattern, err := regexp.Compile(fmt.Sprintf(tiflashStoreLimitPattern, tc.Name, tc.Name, tc.Namespace, controller.FormatClusterDomainForRegex(tc.Spec.ClusterDomain)))
+	pattern, err := tiflashStorePattern(tc)
 	if err != nil {
 		return err
 	}
@@ -820,6 +887,14 @@ func (m *tiflashMemberManager) syncTidbClusterStatus(tc *v1alpha1.TidbCluster, s
 	return nil
 }
 
+// tiflashStorePattern returns the pattern of addresses of stores managed by the TiFlash StatefulSet,
+// tc may be a view of TiFlash compute nodes
+func tiflashStorePattern(tc *v1alpha1.TidbCluster) (*regexp.Regexp, error) {
+	memberName := regexp.QuoteMeta(tiflashMemberName(tc))
+	peerMemberName := regexp.QuoteMeta(tiflashPeerMemberName(tc))
+	return regexp.Compile(fmt.Sprintf(tiflashStorePatternFormat, memberName, peerMemberName, tc.Namespace, controller.FormatClusterDomainForRegex(tc.Spec.ClusterDomain)))
+}
+
 func (m *tiflashMemberManager) getTiFlashStore(store *pdapi.StoreInfo) *v1alpha1.TiKVStore {
 	if store.Store == nil || store.Status == nil {
 		return nil
@@ -863,7 +938,7 @@ func (m *tiflashMemberManager) setStoreLabelsForTiFlash(tc *v1alpha1.TidbCluster
 		return setCount, nil
 	}
 
-	pattern, err := regexp.Compile(fmt.Sprintf(tiflashStoreLimitPattern, tc.Name, tc.Name, tc.Namespace, controller.FormatClusterDomainForRegex(tc.Spec.ClusterDomain)))
+	pattern, err := tiflashStorePattern(tc)
 	if err != nil {
 		return -1, err
 	}
@@ -926,7 +1001,7 @@ func tiflashStatefulSetIsUpgrading(podLister corelisters.PodLister, pdControl pd
 		return true, nil
 	}
 	instanceName := tc.GetInstanceName()
-	selector, err := label.New().Instance(instanceName).TiFlash().Selector()
+	selector, err := tiflashPodSelector(tc)
 	if err != nil {
 		return false, err
 	}
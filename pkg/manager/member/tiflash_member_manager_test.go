@@ -1327,6 +1327,74 @@ func TestTiFlashMemberManagerSyncTidbClusterStatus(t *testing.T) {
 	}
 }
 
+func TestTiFlashMemberManagerSyncCreateCompute(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiflash()
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{
+		"pd-0": {Name: "pd-0", Health: true},
+		"pd-1": {Name: "pd-1", Health: true},
+		"pd-2": {Name: "pd-2", Health: true},
+	}
+	tc.Status.PD.StatefulSet = &apps.StatefulSetStatus{ReadyReplicas: 3}
+	tc.Spec.TiFlash.Disaggregated = &v1alpha1.TiFlashDisaggregatedSpec{
+		StorageProvider: v1alpha1.StorageProvider{
+			S3: &v1alpha1.S3StorageProvider{Bucket: "tiflash", SecretName: "s3-secret"},
+		},
+		Compute: v1alpha1.TiFlashComputeSpec{Replicas: 2},
+	}
+	ns := tc.Namespace
+	tcName := tc.Name
+
+	tfmm, _, _, _, _, _ := newFakeTiFlashMemberManager(tc)
+	err := tfmm.Sync(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	set, err := tfmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiFlashMemberName(tcName))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(set.Spec.Selector.MatchLabels).NotTo(HaveKey(label.TiFlashRoleLabelKey))
+
+	set, err = tfmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiFlashComputeMemberName(tcName))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*set.Spec.Replicas).To(Equal(int32(2)))
+	g.Expect(set.Spec.ServiceName).To(Equal(controller.TiFlashComputePeerMemberName(tcName)))
+	g.Expect(set.Spec.Selector.MatchLabels).To(HaveKeyWithValue(label.TiFlashRoleLabelKey, label.TiFlashComputeRoleVal))
+	g.Expect(set.Spec.VolumeClaimTemplates).To(BeEmpty())
+	g.Expect(set.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+		Name: "data0", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	}))
+	var envNames []string
+	for _, env := range set.Spec.Template.Spec.Containers[0].Env {
+		envNames = append(envNames, env.Name)
+	}
+	g.Expect(envNames).To(ContainElements("S3_ACCESS_KEY_ID", "S3_SECRET_ACCESS_KEY"))
+	svc, err := tfmm.deps.ServiceLister.Services(ns).Get(controller.TiFlashComputePeerMemberName(tcName))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(svc.Spec.Selector).To(HaveKeyWithValue(label.TiFlashRoleLabelKey, label.TiFlashComputeRoleVal))
+
+	g.Expect(tc.Status.TiFlash.StatefulSet).NotTo(BeNil())
+	g.Expect(tc.Status.TiFlashCompute.StatefulSet).NotTo(BeNil())
+	g.Expect(tc.Labels).NotTo(HaveKey(label.TiFlashRoleLabelKey))
+}
+
+func TestTiFlashStorePattern(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiflash()
+	tc.Spec.TiFlash.Disaggregated = &v1alpha1.TiFlashDisaggregatedSpec{}
+	view := tc.TiFlashComputeView()
+
+	pattern, err := tiflashStorePattern(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pattern.MatchString("test-tiflash-1.test-tiflash-peer.default.svc:3930")).To(BeTrue())
+	g.Expect(pattern.MatchString("test-tiflash-compute-1.test-tiflash-compute-peer.default.svc:3930")).To(BeFalse())
+
+	pattern, err = tiflashStorePattern(view)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pattern.MatchString("test-tiflash-1.test-tiflash-peer.default.svc:3930")).To(BeFalse())
+	g.Expect(pattern.MatchString("test-tiflash-compute-1.test-tiflash-compute-peer.default.svc:3930")).To(BeTrue())
+}
+
 func newTidbClusterForTiflash() *v1alpha1.TidbCluster {
 	tc := newTidbClusterForPD()
 	tc.Spec.TiFlash = &v1alpha1.TiFlashSpec{
@@ -60,6 +60,7 @@ func (s *tiflashScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, n
 	_, ordinals, replicas, deleteSlots := scaleMulti(oldSet, newSet, scaleOutParallelism)
 	klog.Infof("scaling out tiflash statefulset %s/%s, ordinal: %v (replicas: %d, scale out parallelism: %d, delete slots: %v)",
 		oldSet.Namespace, oldSet.Name, ordinals, replicas, scaleOutParallelism, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.TiFlashMemberType, v1alpha1.EventReasonScaleOut, ordinals, replicas)
 
 	var (
 		errs                         []error
@@ -101,6 +102,7 @@ func (s *tiflashScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, ne
 	scaleInParallelism := tc.Spec.TiFlash.GetScaleInParallelism()
 	_, ordinals, replicas, deleteSlots := scaleMulti(oldSet, newSet, scaleInParallelism)
 	klog.Infof("scaling in tiflash statefulset %s/%s, ordinal: %v (replicas: %d, delete slots: %v), scaleInParallelism: %v", oldSet.Namespace, oldSet.Name, ordinals, replicas, deleteSlots.List(), scaleInParallelism)
+	s.recordScaling(meta, v1alpha1.TiFlashMemberType, v1alpha1.EventReasonScaleIn, ordinals, replicas)
 
 	var (
 		errs                         []error
@@ -131,7 +133,7 @@ func (s *tiflashScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, ne
 func (s *tiflashScaler) scaleInOne(tc *v1alpha1.TidbCluster, ordinal int32) error {
 	ns := tc.GetNamespace()
 	tcName := tc.GetName()
-	podName := ordinalPodName(v1alpha1.TiFlashMemberType, tcName, ordinal)
+	podName := tiflashPodName(tc, ordinal)
 	pod, err := s.deps.PodLister.Pods(ns).Get(podName)
 	if err != nil {
 		return fmt.Errorf("tiflashScaler.ScaleIn: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
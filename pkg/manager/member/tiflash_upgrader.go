@@ -59,10 +59,16 @@ func (u *tiflashUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 	tcName := tc.GetName()
 
 	if tc.Status.PD.Phase == v1alpha1.UpgradePhase || tc.Status.PD.Phase == v1alpha1.ScalePhase ||
+		tc.PDMSUpgrading() || tc.PDMSScaling() ||
+		tc.TiKVGroupsUpgradingOrScaling() ||
+		tc.TiFlashComputeUpgradingOrScaling() ||
 		tc.TiFlashScaling() {
-		klog.Infof("TidbCluster: [%s/%s]'s pd status is %s, tiflash status is %s, can not upgrade tiflash",
+		klog.Infof("TidbCluster: [%s/%s]'s pd status is %s, tiflash status is %s, the other tiflash role status is %s, can not upgrade tiflash",
 			ns, tcName,
-			tc.Status.PD.Phase, tc.Status.TiFlash.Phase)
+			tc.Status.PD.Phase, tc.Status.TiFlash.Phase, tc.Status.TiFlashCompute.Phase)
+		mngerutils.RecordBlocked(u.deps.Recorder, tc, &tc.Status.TiFlash, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked,
+			fmt.Sprintf("pd status is %s, tiflash status is %s, the other tiflash role status is %s, can not upgrade tiflash",
+				tc.Status.PD.Phase, tc.Status.TiFlash.Phase, tc.Status.TiFlashCompute.Phase))
 		_, podSpec, err := GetLastAppliedConfig(oldSet)
 		if err != nil {
 			return err
@@ -70,6 +76,7 @@ func (u *tiflashUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 		newSet.Spec.Template.Spec = *podSpec
 		return nil
 	}
+	mngerutils.ClearBlocked(&tc.Status.TiFlash, v1alpha1.ComponentConditionUpgradeBlocked)
 
 	if !tc.Status.TiFlash.Synced {
 		return fmt.Errorf("cluster: [%s/%s]'s TiFlash status is not synced, can not upgrade", ns, tcName)
@@ -109,12 +116,12 @@ func (u *tiflashUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
 	for _i := len(podOrdinals) - 1; _i >= 0; _i-- {
 		i := podOrdinals[_i]
-		store := getTiFlashStoreByOrdinal(tc.GetName(), tc.Status.TiFlash, i)
+		store := getTiFlashStoreByOrdinal(tc, i)
 		if store == nil {
 			mngerutils.SetUpgradePartition(newSet, i)
 			continue
 		}
-		podName := TiFlashPodName(tcName, i)
+		podName := tiflashPodName(tc, i)
 		pod, err := u.deps.PodLister.Pods(ns).Get(podName)
 		if err != nil {
 			return fmt.Errorf("TiFlashUpgrader.Upgrade: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
@@ -151,6 +158,7 @@ func (u *tiflashUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 			continue
 		}
 
+		recordUpgradePod(u.deps.Recorder, tc, newSet, v1alpha1.TiFlashMemberType, i)
 		mngerutils.SetUpgradePartition(newSet, i)
 		return nil
 	}
@@ -158,9 +166,9 @@ func (u *tiflashUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 	return nil
 }
 
-func getTiFlashStoreByOrdinal(name string, status v1alpha1.TiFlashStatus, ordinal int32) *v1alpha1.TiKVStore {
-	podName := TiFlashPodName(name, ordinal)
-	for _, store := range status.Stores {
+func getTiFlashStoreByOrdinal(tc *v1alpha1.TidbCluster, ordinal int32) *v1alpha1.TiKVStore {
+	podName := tiflashPodName(tc, ordinal)
+	for _, store := range tc.Status.TiFlash.Stores {
 		if store.PodName == podName {
 			return &store
 		}
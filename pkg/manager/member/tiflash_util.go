@@ -184,8 +184,8 @@ func getTiFlashConfigV2(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper
 		common.SetIfNil("flash.service_addr", listenHost+":3930")
 		common.SetIfNil("flash.flash_cluster.log", defaultClusterLog)
 		common.SetIfNil("flash.proxy.addr", listenHost+":20170")
-		common.SetIfNil("flash.proxy.advertise-addr", fmt.Sprintf("%s-POD_NUM.%s.%s.svc%s:20170", controller.TiFlashMemberName(name),
-			controller.TiFlashPeerMemberName(name), ns, controller.FormatClusterDomain(clusterDomain)))
+		common.SetIfNil("flash.proxy.advertise-addr", fmt.Sprintf("%s-POD_NUM.%s.%s.svc%s:20170", tiflashMemberName(tc),
+			tiflashPeerMemberName(tc), ns, controller.FormatClusterDomain(clusterDomain)))
 		common.SetIfNil("flash.proxy.data-dir", "/data0/proxy")
 		common.SetIfNil("flash.proxy.config", "/data0/proxy.toml")
 
@@ -211,9 +211,14 @@ func getTiFlashConfigV2(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper
 	// proxy
 	{
 		proxy.SetIfNil("log-level", "info")
-		proxy.SetIfNil("server.engine-addr", fmt.Sprintf("%s-POD_NUM.%s.%s.svc%s:3930", controller.TiFlashMemberName(name), controller.TiFlashPeerMemberName(name), ns, controller.FormatClusterDomain(clusterDomain)))
+		proxy.SetIfNil("server.engine-addr", fmt.Sprintf("%s-POD_NUM.%s.%s.svc%s:3930", tiflashMemberName(tc), tiflashPeerMemberName(tc), ns, controller.FormatClusterDomain(clusterDomain)))
 		proxy.SetIfNil("server.status-addr", listenHost+":20292")
-		proxy.SetIfNil("server.advertise-status-addr", fmt.Sprintf("%s-POD_NUM.%s.%s.svc%s:20292", controller.TiFlashMemberName(name), controller.TiFlashPeerMemberName(name), ns, controller.FormatClusterDomain(clusterDomain)))
+		proxy.SetIfNil("server.advertise-status-addr", fmt.Sprintf("%s-POD_NUM.%s.%s.svc%s:20292", tiflashMemberName(tc), tiflashPeerMemberName(tc), ns, controller.FormatClusterDomain(clusterDomain)))
+	}
+
+	// disaggregated architecture
+	if tc.TiFlashDisaggregated() {
+		setTiFlashDisaggregatedConfig(tc, common)
 	}
 
 	// Note the config of tiflash use "_" by convention, others(proxy) use "-".
@@ -238,6 +243,39 @@ func getTiFlashConfigV2(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper
 	return config
 }
 
+// setTiFlashDisaggregatedConfig sets the role of the node and the shared storage in the disaggregated architecture,
+// tc may be a view of TiFlash compute nodes
+func setTiFlashDisaggregatedConfig(tc *v1alpha1.TidbCluster, common *v1alpha1.TiFlashCommonConfigWraper) {
+	mode := "tiflash_write"
+	if tc.IsTiFlashCompute() {
+		mode = "tiflash_compute"
+	}
+	common.SetIfNil("flash.disaggregated_mode", mode)
+
+	if s3 := tc.Spec.TiFlash.Disaggregated.S3; s3 != nil {
+		if s3.Endpoint != "" {
+			common.SetIfNil("storage.s3.endpoint", s3.Endpoint)
+		}
+		common.SetIfNil("storage.s3.bucket", s3.Bucket)
+		root := s3.Prefix
+		if root == "" {
+			root = s3.Path
+		}
+		common.SetIfNil("storage.s3.root", "/"+strings.Trim(root, "/"))
+	}
+
+	if tc.IsTiFlashCompute() {
+		common.SetIfNil("flash.use_autoscaler", false)
+		// use the first storage claim as the local cache of data on the shared storage
+		if claims := tc.Spec.TiFlash.StorageClaims; len(claims) > 0 {
+			common.SetIfNil("storage.remote.cache.dir", "/data0/remote_cache")
+			if quantity, ok := claims[0].Resources.Requests[corev1.ResourceStorage]; ok {
+				common.SetIfNil("storage.remote.cache.capacity", quantity.Value())
+			}
+		}
+	}
+}
+
 func getTiFlashConfig(tc *v1alpha1.TidbCluster) *v1alpha1.TiFlashConfigWraper {
 	config := tc.Spec.TiFlash.Config.DeepCopy()
 	if config == nil {
@@ -1843,6 +1843,114 @@ func TestTestGetTiFlashConfig(t *testing.T) {
 					engine-addr = "test-tiflash-POD_NUM.test-tiflash-peer.default.svc:3930"
 					status-addr = "0.0.0.0:20292"`,
 			},
+			{
+				name: "disaggregated write node",
+				setTC: func(tc *v1alpha1.TidbCluster) {
+					tc.Spec.TiFlash.Config = nil
+					tc.Spec.TiFlash.Disaggregated = &v1alpha1.TiFlashDisaggregatedSpec{
+						StorageProvider: v1alpha1.StorageProvider{
+							S3: &v1alpha1.S3StorageProvider{Endpoint: "http://minio:9000", Bucket: "tiflash", Prefix: "test/"},
+						},
+					}
+				},
+				expectCommonCfg: `
+					http_port = 8123
+					tcp_port = 9000
+					tmp_path = "/data0/tmp"
+					[flash]
+					  disaggregated_mode = "tiflash_write"
+					  service_addr = "0.0.0.0:3930"
+					  tidb_status_addr = "test-tidb.default.svc:10080"
+					  [flash.flash_cluster]
+						log = "/data0/logs/flash_cluster_manager.log"
+					  [flash.proxy]
+						addr = "0.0.0.0:20170"
+						advertise-addr = "test-tiflash-POD_NUM.test-tiflash-peer.default.svc:20170"
+						config = "/data0/proxy.toml"
+						data-dir = "/data0/proxy"
+					[logger]
+					  errorlog = "/data0/logs/error.log"
+					  log = "/data0/logs/server.log"
+					[raft]
+					  pd_addr = "test-pd.default.svc:2379"
+					[storage]
+					  [storage.main]
+						dir = ["/data0/db"]
+					  [storage.raft]
+						dir = ["/data0/kvstore"]
+					  [storage.s3]
+						bucket = "tiflash"
+						endpoint = "http://minio:9000"
+						root = "/test"`,
+				expectProxyCfg: `
+					log-level = "info"
+
+					[server]
+					advertise-status-addr = "test-tiflash-POD_NUM.test-tiflash-peer.default.svc:20292"
+					engine-addr = "test-tiflash-POD_NUM.test-tiflash-peer.default.svc:3930"
+					status-addr = "0.0.0.0:20292"`,
+			},
+			{
+				name: "disaggregated compute node",
+				setTC: func(tc *v1alpha1.TidbCluster) {
+					tc.Spec.TiFlash.Config = nil
+					tc.Spec.TiFlash.Disaggregated = &v1alpha1.TiFlashDisaggregatedSpec{
+						StorageProvider: v1alpha1.StorageProvider{
+							S3: &v1alpha1.S3StorageProvider{Bucket: "tiflash"},
+						},
+						Compute: v1alpha1.TiFlashComputeSpec{
+							StorageClaims: []v1alpha1.StorageClaim{
+								{
+									Resources: corev1.ResourceRequirements{
+										Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+									},
+								},
+							},
+						},
+					}
+					*tc = *tc.TiFlashComputeView()
+				},
+				expectCommonCfg: `
+					http_port = 8123
+					tcp_port = 9000
+					tmp_path = "/data0/tmp"
+					[flash]
+					  disaggregated_mode = "tiflash_compute"
+					  service_addr = "0.0.0.0:3930"
+					  tidb_status_addr = "test-tidb.default.svc:10080"
+					  use_autoscaler = false
+					  [flash.flash_cluster]
+						log = "/data0/logs/flash_cluster_manager.log"
+					  [flash.proxy]
+						addr = "0.0.0.0:20170"
+						advertise-addr = "test-tiflash-compute-POD_NUM.test-tiflash-compute-peer.default.svc:20170"
+						config = "/data0/proxy.toml"
+						data-dir = "/data0/proxy"
+					[logger]
+					  errorlog = "/data0/logs/error.log"
+					  log = "/data0/logs/server.log"
+					[raft]
+					  pd_addr = "test-pd.default.svc:2379"
+					[storage]
+					  [storage.main]
+						dir = ["/data0/db"]
+					  [storage.raft]
+						dir = ["/data0/kvstore"]
+					  [storage.remote]
+						[storage.remote.cache]
+						  capacity = 10737418240
+						  dir = "/data0/remote_cache"
+					  [storage.s3]
+						bucket = "tiflash"
+						root = "/"`,
+				expectProxyCfg: `
+					log-level = "info"
+
+					[server]
+					advertise-status-addr = "test-tiflash-compute-POD_NUM.test-tiflash-compute-peer.default.svc:20292"
+					engine-addr = "test-tiflash-compute-POD_NUM.test-tiflash-compute-peer.default.svc:3930"
+					status-addr = "0.0.0.0:20292"`,
+			},
 		}
 
 		for _, testcase := range cases {
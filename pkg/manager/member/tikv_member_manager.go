@@ -39,6 +39,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
@@ -50,7 +51,7 @@ const (
 	tikvClusterCertPath = "/var/lib/tikv-tls"
 
 	// find a better way to manage store only managed by tikv in Operator
-	tikvStoreLimitPattern = `%s-tikv-\d+\.%s-tikv-peer\.%s\.svc%s\:\d+`
+	tikvStorePatternFormat = `%s-\d+\.%s\.%s\.svc%s\:\d+`
 )
 
 // tikvMemberManager implements manager.Manager.
@@ -117,13 +118,28 @@ func (m *tikvMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
 		return err
 	}
 
+	if err := m.syncTiKV(tc); err != nil {
+		return err
+	}
+	return m.syncTiKVGroups(tc)
+}
+
+// syncTiKV syncs the peer service and the StatefulSet of TiKV, tc may be a view of a TiKV group
+func (m *tikvMemberManager) syncTiKV(tc *v1alpha1.TidbCluster) error {
+	svcLabel := func(l label.Label) label.Label { return l.TiKV() }
+	memberName := controller.TiKVPeerMemberName
+	if group := tc.TiKVGroupName(); group != "" {
+		svcLabel = func(l label.Label) label.Label { return l.TiKV().TiKVGroup(group) }
+		memberName = func(clusterName string) string { return controller.TiKVGroupPeerMemberName(clusterName, group) }
+	}
+
 	svcList := []SvcConfig{
 		{
 			Name:       "peer",
 			Port:       20160,
 			Headless:   true,
-			SvcLabel:   func(l label.Label) label.Label { return l.TiKV() },
-			MemberName: controller.TiKVPeerMemberName,
+			SvcLabel:   svcLabel,
+			MemberName: memberName,
 		},
 	}
 	for _, svc := range svcList {
@@ -134,6 +150,29 @@ func (m *tikvMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
 	return m.syncStatefulSetForTidbCluster(tc)
 }
 
+// syncTiKVGroups syncs the TiKV groups one by one in the same way as `spec.tikv`.
+// Only one of `spec.tikv` and the groups is upgraded at a time, see isTiKVReadyToUpgrade.
+func (m *tikvMemberManager) syncTiKVGroups(tc *v1alpha1.TidbCluster) error {
+	groups := sets.NewString()
+	for _, group := range tc.Spec.TiKVGroups {
+		groups.Insert(group.Name)
+		view := tc.TiKVGroupView(group.Name)
+		err := m.syncTiKV(view)
+		tc.SetTiKVGroupStatus(view)
+		if err != nil {
+			return err
+		}
+	}
+
+	// status of the removed groups are useless
+	for name := range tc.Status.TiKVGroups {
+		if !groups.Has(name) {
+			delete(tc.Status.TiKVGroups, name)
+		}
+	}
+	return nil
+}
+
 func (m *tikvMemberManager) checkRecoveryForTidbCluster(tc *v1alpha1.TidbCluster) error {
 	// Check whether the cluster is in recovery mode
 	// and whether the volumes have been restored for TiKV
@@ -200,9 +239,9 @@ func (m *tikvMemberManager) syncStatefulSetForTidbCluster(tc *v1alpha1.TidbClust
 	ns := tc.GetNamespace()
 	tcName := tc.GetName()
 
-	oldSetTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiKVMemberName(tcName))
+	oldSetTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(tikvMemberName(tc))
 	if err != nil && !errors.IsNotFound(err) {
-		return fmt.Errorf("syncStatefulSetForTidbCluster: failed to get sts %s for cluster %s/%s, error: %s", controller.TiKVMemberName(tcName), ns, tcName, err)
+		return fmt.Errorf("syncStatefulSetForTidbCluster: failed to get sts %s for cluster %s/%s, error: %s", tikvMemberName(tc), ns, tcName, err)
 	}
 	setNotExist := errors.IsNotFound(err)
 
@@ -295,7 +334,7 @@ func (m *tikvMemberManager) syncTiKVConfigMap(tc *v1alpha1.TidbCluster, set *app
 	var inUseName string
 	if set != nil {
 		inUseName = mngerutils.FindConfigMapVolume(&set.Spec.Template.Spec, func(name string) bool {
-			return strings.HasPrefix(name, controller.TiKVMemberName(tc.Name))
+			return strings.HasPrefix(name, tikvMemberName(tc))
 		})
 	}
 
@@ -353,6 +392,9 @@ func getNewTiKVSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap)
 	baseTiKVSpec := tc.BaseTiKVSpec()
 
 	tikvConfigMap := controller.MemberConfigMapName(tc, v1alpha1.TiKVMemberType)
+	if tc.TiKVGroupName() != "" {
+		tikvConfigMap = tikvMemberName(tc)
+	}
 	if cm != nil {
 		tikvConfigMap = cm.Name
 	}
@@ -467,11 +509,11 @@ func getNewTiKVSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap)
 
 	stsLabels := labelTiKV(tc)
 	podLabels := util.CombineStringMap(stsLabels.Labels(), baseTiKVSpec.Labels())
-	setName := controller.TiKVMemberName(tcName)
+	setName := tikvMemberName(tc)
 	podAnnotations := util.CombineStringMap(baseTiKVSpec.Annotations(), controller.AnnProm(20180, "/metrics"))
 	stsAnnotations := getStsAnnotations(tc.Annotations, label.TiKVLabelVal)
 	capacity := controller.TiKVCapacity(tc.Spec.TiKV.Limits)
-	headlessSvcName := controller.TiKVPeerMemberName(tcName)
+	headlessSvcName := tikvPeerMemberName(tc)
 
 	deleteSlotsNumber, err := util.GetDeleteSlotsNumber(stsAnnotations)
 	if err != nil {
@@ -660,6 +702,7 @@ func getNewTiKVSetForTidbCluster(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap)
 			},
 		})
 	}
+	env = append(env, tlsCertHashEnv(tc, util.ClusterTLSSecretName(tc.Name, label.TiKVLabelVal))...)
 	tikvContainer.Env = util.AppendEnv(env, baseTiKVSpec.Env())
 	tikvContainer.EnvFrom = baseTiKVSpec.EnvFrom()
 	containers = append(containers, tikvContainer)
@@ -769,10 +812,9 @@ func getTikVConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 	if err != nil {
 		return nil, err
 	}
-	instanceName := tc.GetInstanceName()
-	tikvLabel := label.New().Instance(instanceName).TiKV().Labels()
+	tikvLabel := labelTiKV(tc).Labels()
 	cm.ObjectMeta = metav1.ObjectMeta{
-		Name:            controller.TiKVMemberName(tc.Name),
+		Name:            tikvMemberName(tc),
 		Namespace:       tc.Namespace,
 		Labels:          tikvLabel,
 		OwnerReferences: []metav1.OwnerReference{controller.GetOwnerRef(tc)},
@@ -783,7 +825,11 @@ func getTikVConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 
 func labelTiKV(tc *v1alpha1.TidbCluster) label.Label {
 	instanceName := tc.GetInstanceName()
-	return label.New().Instance(instanceName).TiKV()
+	l := label.New().Instance(instanceName).TiKV()
+	if group := tc.TiKVGroupName(); group != "" {
+		l = l.TiKVGroup(group)
+	}
+	return l
 }
 
 func (m *tikvMemberManager) syncTiKVClusterStatus(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) error {
@@ -841,7 +887,7 @@ func (m *tikvMemberManager) syncTiKVClusterStatus(tc *v1alpha1.TidbCluster, set
 		return err
 	}
 
-	pattern, err := regexp.Compile(fmt.Sprintf(tikvStoreLimitPattern, tc.Name, tc.Name, tc.Namespace, controller.FormatClusterDomainForRegex(tc.Spec.ClusterDomain)))
+	pattern, err := tikvStorePattern(tc)
 	if err != nil {
 		return err
 	}
@@ -918,6 +964,14 @@ func (m *tikvMemberManager) syncTiKVClusterStatus(tc *v1alpha1.TidbCluster, set
 	return nil
 }
 
+// tikvStorePattern returns the pattern of addresses of stores managed by the TiKV StatefulSet,
+// tc may be a view of a TiKV group
+func tikvStorePattern(tc *v1alpha1.TidbCluster) (*regexp.Regexp, error) {
+	memberName := regexp.QuoteMeta(tikvMemberName(tc))
+	peerMemberName := regexp.QuoteMeta(tikvPeerMemberName(tc))
+	return regexp.Compile(fmt.Sprintf(tikvStorePatternFormat, memberName, peerMemberName, tc.Namespace, controller.FormatClusterDomainForRegex(tc.Spec.ClusterDomain)))
+}
+
 func getTiKVStore(store *pdapi.StoreInfo) *v1alpha1.TiKVStore {
 	if store.Store == nil || store.Status == nil {
 		return nil
@@ -966,7 +1020,7 @@ func (m *tikvMemberManager) setStoreLabelsForTiKV(tc *v1alpha1.TidbCluster) (int
 		return setCount, nil
 	}
 
-	pattern, err := regexp.Compile(fmt.Sprintf(tikvStoreLimitPattern, tc.Name, tc.Name, tc.Namespace, controller.FormatClusterDomainForRegex(tc.Spec.ClusterDomain)))
+	pattern, err := tikvStorePattern(tc)
 	if err != nil {
 		return -1, err
 	}
@@ -1031,7 +1085,7 @@ func tikvStatefulSetIsUpgrading(podLister corelisters.PodLister, pdControl pdapi
 		return true, nil
 	}
 	instanceName := tc.GetInstanceName()
-	selector, err := label.New().Instance(instanceName).TiKV().Selector()
+	selector, err := tikvPodSelector(tc)
 	if err != nil {
 		return false, err
 	}
@@ -219,6 +219,74 @@ func TestTiKVMemberManagerSyncCreate(t *testing.T) {
 	}
 }
 
+func TestTiKVMemberManagerSyncCreateTiKVGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForPD()
+	tc.Status.PD.Members = map[string]v1alpha1.PDMember{
+		"pd-0": {Name: "pd-0", Health: true},
+		"pd-1": {Name: "pd-1", Health: true},
+		"pd-2": {Name: "pd-2", Health: true},
+	}
+	tc.Status.PD.StatefulSet = &apps.StatefulSetStatus{ReadyReplicas: 3}
+	tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{
+		{Name: "g1", TiKVSpec: *tc.Spec.TiKV.DeepCopy()},
+	}
+	tc.Status.TiKVGroups = map[string]v1alpha1.TiKVStatus{
+		"removed": {Phase: v1alpha1.NormalPhase},
+	}
+	ns := tc.Namespace
+	tcName := tc.Name
+
+	tkmm, _, _, pdClient, _, _ := newFakeTiKVMemberManager(tc)
+	pdClient.AddReaction(pdapi.GetStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{Count: 0, Stores: []*pdapi.StoreInfo{}}, nil
+	})
+	pdClient.AddReaction(pdapi.GetTombStoneStoresActionType, func(action *pdapi.Action) (interface{}, error) {
+		return &pdapi.StoresInfo{Count: 0, Stores: []*pdapi.StoreInfo{}}, nil
+	})
+
+	err := tkmm.Sync(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = tkmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiKVMemberName(tcName))
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = tkmm.deps.ServiceLister.Services(ns).Get(controller.TiKVPeerMemberName(tcName))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	set, err := tkmm.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiKVGroupMemberName(tcName, "g1"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(set.Spec.ServiceName).To(Equal(controller.TiKVGroupPeerMemberName(tcName, "g1")))
+	g.Expect(set.Spec.Selector.MatchLabels).To(HaveKeyWithValue(label.TiKVGroupLabelKey, "g1"))
+	g.Expect(set.Spec.Template.Labels).To(HaveKeyWithValue(label.TiKVGroupLabelKey, "g1"))
+	svc, err := tkmm.deps.ServiceLister.Services(ns).Get(controller.TiKVGroupPeerMemberName(tcName, "g1"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(svc.Spec.Selector).To(HaveKeyWithValue(label.TiKVGroupLabelKey, "g1"))
+
+	g.Expect(tc.Status.TiKV.StatefulSet).NotTo(BeNil())
+	g.Expect(tc.Status.TiKVGroups).To(HaveLen(1))
+	g.Expect(tc.Status.TiKVGroups["g1"].StatefulSet).NotTo(BeNil())
+	g.Expect(tc.Labels).NotTo(HaveKey(label.TiKVGroupLabelKey))
+}
+
+func TestTiKVStorePattern(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbClusterForTiKV()
+	tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{{Name: "g1"}}
+	view := tc.TiKVGroupView("g1")
+
+	pattern, err := tikvStorePattern(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pattern.MatchString("test-tikv-1.test-tikv-peer.default.svc:20160")).To(BeTrue())
+	g.Expect(pattern.MatchString("test-tikv-g1-1.test-tikv-g1-peer.default.svc:20160")).To(BeFalse())
+
+	pattern, err = tikvStorePattern(view)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pattern.MatchString("test-tikv-1.test-tikv-peer.default.svc:20160")).To(BeFalse())
+	g.Expect(pattern.MatchString("test-tikv-g1-1.test-tikv-g1-peer.default.svc:20160")).To(BeTrue())
+}
+
 func TestTiKVMemberManagerSyncUpdate(t *testing.T) {
 	g := NewGomegaWithT(t)
 	type testcase struct {
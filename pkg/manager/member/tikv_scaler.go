@@ -21,9 +21,9 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	apps "k8s.io/api/apps/v1"
-	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	errorutils "k8s.io/apimachinery/pkg/util/errors"
@@ -63,6 +63,7 @@ func (s *tikvScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, newS
 	_, ordinals, replicas, deleteSlots := scaleMulti(oldSet, newSet, scaleOutParallelism)
 	klog.Infof("scaling out tikv statefulset %s/%s, ordinal: %v (replicas: %d, scale out parallelism: %d, delete slots: %v)",
 		oldSet.Namespace, oldSet.Name, ordinals, replicas, scaleOutParallelism, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.TiKVMemberType, v1alpha1.EventReasonScaleOut, ordinals, replicas)
 
 	var (
 		errs                         []error
@@ -115,6 +116,7 @@ func (s *tikvScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSe
 
 	klog.Infof("scaling in tikv statefulset %s/%s, ordinals: %v (replicas: %d, delete slots: %v), scaleInParallelism: %v, scaleInTime: %v",
 		oldSet.Namespace, oldSet.Name, ordinals, replicas, deleteSlots.List(), scaleInParallelism, scaleInTime)
+	s.recordScaling(meta, v1alpha1.TiKVMemberType, v1alpha1.EventReasonScaleIn, ordinals, replicas)
 
 	var (
 		upTikvStoreCount    int
@@ -177,7 +179,7 @@ func (s *tikvScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, newSe
 func (s *tikvScaler) scaleInOne(tc *v1alpha1.TidbCluster, skipPreCheck bool, upTikvStoreCount, deletedUpStoreCount, maxReplicas int, ordinal int32, scaleInTime string) (deletedUpStore int, err error) {
 	tcName := tc.GetName()
 	ns := tc.GetNamespace()
-	podName := ordinalPodName(v1alpha1.TiKVMemberType, tcName, ordinal)
+	podName := tikvPodName(tc, ordinal)
 	pod, err := s.deps.PodLister.Pods(ns).Get(podName)
 	if err != nil {
 		return deletedUpStore, fmt.Errorf("tikvScaler.ScaleIn: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
@@ -301,17 +303,18 @@ func (s *tikvScaler) preCheckUpStores(tc *v1alpha1.TidbCluster, podName string,
 	if upNumber < maxReplicas {
 		errMsg := fmt.Sprintf("the number of stores in Up state of TidbCluster [%s/%s] is %d, less than MaxReplicas in PD configuration(%d), can't scale in TiKV, podname %s ", tc.GetNamespace(), tc.GetName(), upNumber, maxReplicas, podName)
 		klog.Error(errMsg)
-		s.deps.Recorder.Event(tc, v1.EventTypeWarning, "FailedScaleIn", errMsg)
+		mngerutils.RecordBlocked(s.deps.Recorder, tc, &tc.Status.TiKV, v1alpha1.ComponentConditionScaleBlocked, v1alpha1.EventReasonFailedScaleIn, errMsg)
 		return false
 	} else if upNumber == maxReplicas {
 		if storeState == v1alpha1.TiKVStateUp {
 			errMsg := fmt.Sprintf("can't scale in TiKV of TidbCluster [%s/%s], cause the number of up stores is equal to MaxReplicas in PD configuration(%d), and the store in Pod %s which is going to be deleted is up too. MaxReplicas can be update online using pd-ctl or SQL statements, refer to https://docs.pingcap.com/tidb/stable/dynamic-config", tc.GetNamespace(), tc.GetName(), maxReplicas, podName)
 			klog.Error(errMsg)
-			s.deps.Recorder.Event(tc, v1.EventTypeWarning, "FailedScaleIn", errMsg)
+			mngerutils.RecordBlocked(s.deps.Recorder, tc, &tc.Status.TiKV, v1alpha1.ComponentConditionScaleBlocked, v1alpha1.EventReasonFailedScaleIn, errMsg)
 			return false
 		}
 	}
 
+	mngerutils.ClearBlocked(&tc.Status.TiKV, v1alpha1.ComponentConditionScaleBlocked)
 	return true
 }
 
@@ -25,6 +25,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/features"
 	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
 
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -72,6 +73,8 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 	case *v1alpha1.TidbCluster:
 		if ready, reason := isTiKVReadyToUpgrade(meta); !ready {
 			klog.Infof("TidbCluster: [%s/%s], can not upgrade tikv because: %s", ns, tcName, reason)
+			mngerutils.RecordBlocked(u.deps.Recorder, meta, &meta.Status.TiKV, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked,
+				fmt.Sprintf("can not upgrade tikv because: %s", reason))
 			_, podSpec, err := GetLastAppliedConfig(oldSet)
 			if err != nil {
 				return err
@@ -79,6 +82,7 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 			newSet.Spec.Template.Spec = *podSpec
 			return nil
 		}
+		mngerutils.ClearBlocked(&meta.Status.TiKV, v1alpha1.ComponentConditionUpgradeBlocked)
 		status = &meta.Status.TiKV
 	default:
 		return fmt.Errorf("cluster[%s/%s] failed to upgrading tikv due to converting", meta.GetNamespace(), meta.GetName())
@@ -133,12 +137,12 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 	podOrdinals := helper.GetPodOrdinals(*oldSet.Spec.Replicas, oldSet).List()
 	for _i := len(podOrdinals) - 1; _i >= 0; _i-- {
 		i := podOrdinals[_i]
-		store := getStoreByOrdinal(meta.GetName(), *status, i)
+		store := getStoreByOrdinal(tc, i)
 		if store == nil {
 			mngerutils.SetUpgradePartition(newSet, i)
 			continue
 		}
-		podName := TikvPodName(tcName, i)
+		podName := tikvPodName(tc, i)
 		pod, err := u.deps.PodLister.Pods(ns).Get(podName)
 		if err != nil {
 			return fmt.Errorf("tikvUpgrader.Upgrade: failed to get pods %s for cluster %s/%s, error: %s", podName, ns, tcName, err)
@@ -183,7 +187,7 @@ func (u *tikvUpgrader) Upgrade(meta metav1.Object, oldSet *apps.StatefulSet, new
 func (u *tikvUpgrader) upgradeTiKVPod(tc *v1alpha1.TidbCluster, ordinal int32, newSet *apps.StatefulSet) error {
 	ns := tc.GetNamespace()
 	tcName := tc.GetName()
-	upgradePodName := TikvPodName(tcName, ordinal)
+	upgradePodName := tikvPodName(tc, ordinal)
 	upgradePod, err := u.deps.PodLister.Pods(ns).Get(upgradePodName)
 	if err != nil {
 		return fmt.Errorf("upgradeTiKVPod: failed to get pod %s for tc %s/%s, error: %s", upgradePodName, ns, tcName, err)
@@ -207,10 +211,22 @@ func (u *tikvUpgrader) upgradeTiKVPod(tc *v1alpha1.TidbCluster, ordinal int32, n
 		}
 	}
 
+	// observe only when the partition is changed to the pod for the first time
+	if beginTime, err := time.Parse(time.RFC3339, upgradePod.Annotations[annoKeyEvictLeaderBeginTime]); err == nil && isPartitionAbove(newSet, ordinal) {
+		metrics.ObserveClusterHistogram(metrics.ClusterUpgradeStepDuration, time.Since(beginTime).Seconds(),
+			ns, tcName, v1alpha1.TiKVMemberType.String(), "evict_leader")
+	}
+
+	recordUpgradePod(u.deps.Recorder, tc, newSet, v1alpha1.TiKVMemberType, ordinal)
 	mngerutils.SetUpgradePartition(newSet, ordinal)
 	return nil
 }
 
+func isPartitionAbove(set *apps.StatefulSet, ordinal int32) bool {
+	strategy := set.Spec.UpdateStrategy.RollingUpdate
+	return strategy != nil && strategy.Partition != nil && *strategy.Partition > ordinal
+}
+
 func (u *tikvUpgrader) evictLeaderBeforeUpgrade(tc *v1alpha1.TidbCluster, upgradePod *corev1.Pod) (bool, error) {
 	logPrefix := fmt.Sprintf("evictLeaderBeforeUpgrade: for tikv pod %s/%s", upgradePod.Namespace, upgradePod.Name)
 
@@ -333,6 +349,10 @@ func (u *tikvUpgrader) endEvictLeaderAfterUpgrade(tc *v1alpha1.TidbCluster, pod
 	if store.LeaderCountBeforeUpgrade != nil {
 		done := isLeaderTransferBackOrTimeout()
 		if done {
+			if endTime, err := time.Parse(time.RFC3339, pod.Annotations[annoKeyEvictLeaderEndTime]); err == nil {
+				metrics.ObserveClusterHistogram(metrics.ClusterUpgradeStepDuration, time.Since(endTime).Seconds(),
+					tc.Namespace, tc.Name, v1alpha1.TiKVMemberType.String(), "transfer_leader_back")
+			}
 			store.LeaderCountBeforeUpgrade = nil
 			tc.Status.TiKV.Stores[store.ID] = store
 		}
@@ -452,7 +472,7 @@ func endEvictLeaderForAllStore(deps *controller.Dependencies, tc *v1alpha1.TidbC
 }
 
 func endEvictLeader(deps *controller.Dependencies, tc *v1alpha1.TidbCluster, ordinal int32) error {
-	store := getStoreByOrdinal(tc.GetName(), tc.Status.TiKV, ordinal)
+	store := getStoreByOrdinal(tc, ordinal)
 	if store == nil {
 		klog.Errorf("endEvictLeader: no store found for TiKV ordinal %v of %s/%s", ordinal, tc.Namespace, tc.Name)
 		return nil
@@ -481,9 +501,9 @@ func endEvictLeaderbyStoreID(deps *controller.Dependencies, tc *v1alpha1.TidbClu
 	return nil
 }
 
-func getStoreByOrdinal(name string, status v1alpha1.TiKVStatus, ordinal int32) *v1alpha1.TiKVStore {
-	podName := TikvPodName(name, ordinal)
-	for _, store := range status.Stores {
+func getStoreByOrdinal(tc *v1alpha1.TidbCluster, ordinal int32) *v1alpha1.TiKVStore {
+	podName := tikvPodName(tc, ordinal)
+	for _, store := range tc.Status.TiKV.Stores {
 		if store.PodName == podName {
 			return &store
 		}
@@ -495,12 +515,24 @@ func isTiKVReadyToUpgrade(tc *v1alpha1.TidbCluster) (bool, string) {
 	if tc.Status.TiFlash.Phase == v1alpha1.UpgradePhase || tc.Status.TiFlash.Phase == v1alpha1.ScalePhase {
 		return false, fmt.Sprintf("tiflash status is %s", tc.Status.TiFlash.Phase)
 	}
+	if tc.TiFlashComputeUpgradingOrScaling() {
+		return false, fmt.Sprintf("tiflash compute status is %s", tc.Status.TiFlashCompute.Phase)
+	}
 	if tc.Status.PD.Phase == v1alpha1.UpgradePhase || tc.Status.PD.Phase == v1alpha1.ScalePhase {
 		return false, fmt.Sprintf("pd status is %s", tc.Status.PD.Phase)
 	}
+	if tc.PDMSUpgrading() || tc.PDMSScaling() {
+		return false, "pd microservices are upgrading or scaling"
+	}
 	if tc.TiKVScaling() {
 		return false, fmt.Sprintf("tikv status is %s", tc.Status.TiKV.Phase)
 	}
+	if tc.TiKVGroupsUpgradingOrScaling() {
+		if tc.TiKVGroupName() != "" {
+			return false, "tikv or other tikv groups are upgrading or scaling"
+		}
+		return false, "tikv groups are upgrading or scaling"
+	}
 
 	return true, ""
 }
@@ -31,6 +31,7 @@ import (
 	. "github.com/onsi/gomega"
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	podinformers "k8s.io/client-go/informers/core/v1"
@@ -329,6 +330,29 @@ func TestTiKVUpgraderUpgrade(t *testing.T) {
 				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(3)))
 			},
 		},
+		{
+			name: "tikv can not upgrade when pd microservice is upgrading",
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.PDMS = []v1alpha1.PDMSSpec{{Name: "tso", Replicas: 1}}
+				tc.Status.PDMS = map[string]*v1alpha1.PDMSStatus{"tso": {Name: "tso", Phase: v1alpha1.UpgradePhase}}
+				tc.Status.TiKV.Phase = v1alpha1.NormalPhase
+				tc.Status.TiKV.Synced = true
+			},
+			changeOldSet: func(oldSet *apps.StatefulSet) {
+				mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)
+			},
+			changePods:          nil,
+			beginEvictLeaderErr: false,
+			endEvictLeaderErr:   false,
+			updatePodErr:        false,
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet, pods map[string]*corev1.Pod) {
+				g.Expect(tc.Status.TiKV.Phase).To(Equal(v1alpha1.NormalPhase))
+				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(3)))
+			},
+		},
 		{
 			name: "tikv can not upgrade when tiflash is upgrading",
 			changeFn: func(tc *v1alpha1.TidbCluster) {
@@ -351,6 +375,28 @@ func TestTiKVUpgraderUpgrade(t *testing.T) {
 				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(3)))
 			},
 		},
+		{
+			name: "tikv can not upgrade when tiflash compute is upgrading",
+			changeFn: func(tc *v1alpha1.TidbCluster) {
+				tc.Status.TiFlashCompute.Phase = v1alpha1.UpgradePhase
+				tc.Status.TiKV.Phase = v1alpha1.NormalPhase
+				tc.Status.TiKV.Synced = true
+			},
+			changeOldSet: func(oldSet *apps.StatefulSet) {
+				mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)
+			},
+			changePods:          nil,
+			beginEvictLeaderErr: false,
+			endEvictLeaderErr:   false,
+			updatePodErr:        false,
+			errExpectFn: func(g *GomegaWithT, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+			},
+			expectFn: func(g *GomegaWithT, tc *v1alpha1.TidbCluster, newSet *apps.StatefulSet, pods map[string]*corev1.Pod) {
+				g.Expect(tc.Status.TiKV.Phase).To(Equal(v1alpha1.NormalPhase))
+				g.Expect(*newSet.Spec.UpdateStrategy.RollingUpdate.Partition).To(Equal(int32(3)))
+			},
+		},
 		{
 			name: "tikv can not upgrade when it is scaling",
 			changeFn: func(tc *v1alpha1.TidbCluster) {
@@ -1007,6 +1053,64 @@ func TestTiKVUpgraderUpgrade(t *testing.T) {
 	}
 }
 
+func TestTiKVUpgraderUpgradeTiKVGroups(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	upgrader, _, _, _, _, _ := newTiKVUpgrader()
+	tc := newTidbClusterForTiKVUpgrader()
+	tc.Status.TiKV.Phase = v1alpha1.NormalPhase
+	tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{{Name: "g1"}, {Name: "g2"}}
+	tc.Status.TiKVGroups = map[string]v1alpha1.TiKVStatus{
+		"g1": *tc.Status.TiKV.DeepCopy(),
+		"g2": *tc.Status.TiKV.DeepCopy(),
+	}
+
+	// upgrade returns the phase of tc and whether the new StatefulSet is kept
+	upgrade := func(tc *v1alpha1.TidbCluster) (v1alpha1.MemberPhase, bool) {
+		oldSet := oldStatefulSetForTiKVUpgrader()
+		g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(oldSet)).To(Succeed())
+		newSet := newStatefulSetForTiKVUpgrader()
+		newSet.Spec.Template.Spec.Containers[0].Image = "tikv-test-image:v2"
+		g.Expect(upgrader.Upgrade(tc, oldSet, newSet)).To(Succeed())
+		return tc.Status.TiKV.Phase, newSet.Spec.Template.Spec.Containers[0].Image == "tikv-test-image:v2"
+	}
+
+	// g1 starts upgrading
+	view := tc.TiKVGroupView("g1")
+	phase, upgraded := upgrade(view)
+	g.Expect(phase).To(Equal(v1alpha1.UpgradePhase))
+	g.Expect(upgraded).To(BeTrue())
+	tc.SetTiKVGroupStatus(view)
+
+	// g2 and spec.tikv wait for g1
+	view = tc.TiKVGroupView("g2")
+	phase, upgraded = upgrade(view)
+	g.Expect(phase).To(Equal(v1alpha1.NormalPhase))
+	g.Expect(upgraded).To(BeFalse())
+	g.Expect(meta.IsStatusConditionTrue(view.Status.TiKV.Conditions, v1alpha1.ComponentConditionUpgradeBlocked)).To(BeTrue())
+	tc.SetTiKVGroupStatus(view)
+	phase, upgraded = upgrade(tc)
+	g.Expect(phase).To(Equal(v1alpha1.NormalPhase))
+	g.Expect(upgraded).To(BeFalse())
+
+	// g2 starts upgrading after g1 is upgraded
+	status := tc.Status.TiKVGroups["g1"]
+	status.Phase = v1alpha1.NormalPhase
+	tc.Status.TiKVGroups["g1"] = status
+	view = tc.TiKVGroupView("g2")
+	phase, upgraded = upgrade(view)
+	g.Expect(phase).To(Equal(v1alpha1.UpgradePhase))
+	g.Expect(upgraded).To(BeTrue())
+	tc.SetTiKVGroupStatus(view)
+
+	// g1 waits for spec.tikv
+	tc.Status.TiKVGroups["g2"] = status
+	tc.Status.TiKV.Phase = v1alpha1.UpgradePhase
+	phase, upgraded = upgrade(tc.TiKVGroupView("g1"))
+	g.Expect(phase).To(Equal(v1alpha1.NormalPhase))
+	g.Expect(upgraded).To(BeFalse())
+}
+
 func newTiKVUpgrader() (TiKVUpgrader, *pdapi.FakePDControl, *controller.FakePodControl, podinformers.PodInformer, *tikvapi.FakeTiKVControl, *volumes.FakePodVolumeModifier) {
 	fakeDeps := controller.NewFakeDependencies()
 	pdControl := fakeDeps.PDControl.(*pdapi.FakePDControl)
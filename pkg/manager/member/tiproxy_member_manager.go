@@ -48,61 +48,82 @@ func labelTiProxy(tc *v1alpha1.TidbCluster) label.Label {
 	return label.New().Instance(instanceName).TiProxy()
 }
 
-// tiproxyMemberManager implements manager.Manager.
+// tiproxyMemberManager implements manager.Manager, it describes tiproxy for the componentMemberManager.
 type tiproxyMemberManager struct {
-	deps      *controller.Dependencies
-	scaler    Scaler
-	upgrader  Upgrader
-	suspender suspender.Suspender
+	*componentMemberManager
+	deps *controller.Dependencies
 }
 
+var _ ComponentDescriptor = &tiproxyMemberManager{}
+
 // NewTiProxyMemberManager returns a *tiproxyMemberManager
 func NewTiProxyMemberManager(deps *controller.Dependencies, scaler Scaler, upgrader Upgrader, spder suspender.Suspender) manager.Manager {
 	m := &tiproxyMemberManager{
-		deps:      deps,
-		scaler:    scaler,
-		upgrader:  upgrader,
-		suspender: spder,
+		deps: deps,
 	}
+	m.componentMemberManager = newComponentMemberManager(deps, m, scaler, upgrader, spder)
 	return m
 }
 
-// Sync fulfills the manager.Manager interface
-func (m *tiproxyMemberManager) Sync(tc *v1alpha1.TidbCluster) error {
-	if tc.Spec.TiProxy == nil {
-		return nil
-	}
+func (m *tiproxyMemberManager) MemberType() v1alpha1.MemberType {
+	return v1alpha1.TiProxyMemberType
+}
 
-	ns := tc.GetNamespace()
-	tcName := tc.GetName()
+func (m *tiproxyMemberManager) Name() string {
+	return "TiProxy"
+}
 
-	if tc.Spec.Paused {
-		klog.Infof("TidbCluster %s/%s is paused, skip syncing tiproxy service", ns, tcName)
-		return nil
+func (m *tiproxyMemberManager) Enabled(tc *v1alpha1.TidbCluster) bool {
+	return tc.Spec.TiProxy != nil
+}
+
+func (m *tiproxyMemberManager) StatefulSetName(tc *v1alpha1.TidbCluster) string {
+	return controller.TiProxyMemberName(tc.GetName())
+}
+
+func (m *tiproxyMemberManager) Phase(tc *v1alpha1.TidbCluster) v1alpha1.MemberPhase {
+	return tc.Status.TiProxy.Phase
+}
+
+func (m *tiproxyMemberManager) Precheck(tc *v1alpha1.TidbCluster) error {
+	return nil
+}
+
+func (m *tiproxyMemberManager) SyncServices(tc *v1alpha1.TidbCluster) error {
+	if err := m.syncProxyService(tc, false); err != nil {
+		return err
 	}
+	return m.syncProxyService(tc, true)
+}
 
-	// skip sync if tiproxy is suspended
-	component := v1alpha1.TiProxyMemberType
-	needSuspend, err := m.suspender.SuspendComponent(tc, component)
+func (m *tiproxyMemberManager) NewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.StatefulSet, error) {
+	return getNewTiProxyStatefulSet(tc, cm)
+}
+
+func (m *tiproxyMemberManager) SyncConfigMap(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) (*corev1.ConfigMap, error) {
+	newCm, err := getTiProxyConfigMap(tc)
 	if err != nil {
-		return fmt.Errorf("suspend %s failed: %v", component, err)
-	}
-	if needSuspend {
-		klog.Infof("component %s for cluster %s/%s is suspended, skip syncing", component, ns, tcName)
-		return nil
+		return nil, err
 	}
 
-	if err := m.syncProxyService(tc, false); err != nil {
-		return err
+	var inUseName string
+	if set != nil {
+		inUseName = mngerutils.FindConfigMapVolume(&set.Spec.Template.Spec, func(name string) bool {
+			return strings.HasPrefix(name, controller.TiProxyMemberName(tc.Name))
+		})
 	}
-	if err := m.syncProxyService(tc, true); err != nil {
-		return err
+
+	klog.V(4).Info("get tiproxy in use config map name: ", inUseName)
+
+	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, v1alpha1.ConfigUpdateStrategyInPlace, inUseName, newCm)
+	if err != nil {
+		return nil, err
 	}
 
-	return m.syncStatefulSet(tc)
+	return m.deps.TypedControl.CreateOrUpdateConfigMap(tc, newCm)
 }
 
-func (m *tiproxyMemberManager) syncConfigMap(tc *v1alpha1.TidbCluster, set *apps.StatefulSet) (*corev1.ConfigMap, error) {
+func getTiProxyConfigMap(tc *v1alpha1.TidbCluster) (*corev1.ConfigMap, error) {
 	PDAddr := fmt.Sprintf("%s:2379", controller.PDMemberName(tc.Name))
 	// TODO: support it
 	if tc.AcrossK8s() {
@@ -157,7 +178,7 @@ func (m *tiproxyMemberManager) syncConfigMap(tc *v1alpha1.TidbCluster, set *apps
 		return nil, fmt.Errorf("render start-script for tc %s/%s failed: %v", tc.Namespace, tc.Name, err)
 	}
 
-	newCm := &corev1.ConfigMap{
+	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            controller.TiProxyMemberName(tc.Name),
 			Namespace:       tc.Namespace,
@@ -168,84 +189,10 @@ func (m *tiproxyMemberManager) syncConfigMap(tc *v1alpha1.TidbCluster, set *apps
 			"config-file":    string(cfgBytes),
 			"startup-script": startScript,
 		},
-	}
-
-	var inUseName string
-	if set != nil {
-		inUseName = mngerutils.FindConfigMapVolume(&set.Spec.Template.Spec, func(name string) bool {
-			return strings.HasPrefix(name, controller.TiProxyMemberName(tc.Name))
-		})
-	}
-
-	klog.V(4).Info("get tiproxy in use config map name: ", inUseName)
-
-	err = mngerutils.UpdateConfigMapIfNeed(m.deps.ConfigMapLister, v1alpha1.ConfigUpdateStrategyInPlace, inUseName, newCm)
-	if err != nil {
-		return nil, err
-	}
-
-	return m.deps.TypedControl.CreateOrUpdateConfigMap(tc, newCm)
-}
-
-func (m *tiproxyMemberManager) syncStatefulSet(tc *v1alpha1.TidbCluster) error {
-	ns := tc.GetNamespace()
-	tcName := tc.GetName()
-
-	oldStsTmp, err := m.deps.StatefulSetLister.StatefulSets(ns).Get(controller.TiProxyMemberName(tcName))
-	if err != nil && !errors.IsNotFound(err) {
-		return fmt.Errorf("syncStatefulSet: failed to get sts %s for cluster %s/%s, error: %s", controller.TiProxyMemberName(tcName), ns, tcName, err)
-	}
-
-	stsNotExist := errors.IsNotFound(err)
-	oldStatefulSet := oldStsTmp.DeepCopy()
-
-	// failed to sync tiproxy status will not affect subsequent logic, just print the errors.
-	if err := m.syncStatus(tc, oldStatefulSet); err != nil {
-		klog.Errorf("failed to sync TidbCluster: [%s/%s]'s tiproxy status, error: %v",
-			ns, tcName, err)
-	}
-
-	cm, err := m.syncConfigMap(tc, oldStatefulSet)
-	if err != nil {
-		return err
-	}
-
-	newSts, err := m.getNewStatefulSet(tc, cm)
-	if err != nil {
-		return err
-	}
-
-	if stsNotExist {
-		err = mngerutils.SetStatefulSetLastAppliedConfigAnnotation(newSts)
-		if err != nil {
-			return err
-		}
-		err = m.deps.StatefulSetControl.CreateStatefulSet(tc, newSts)
-		if err != nil {
-			return err
-		}
-		return nil
-	}
-
-	// Scaling takes precedence over upgrading because:
-	// - if a pod fails in the upgrading, users may want to delete it or add
-	//   new replicas
-	// - it's ok to scale in the middle of upgrading (in statefulset controller
-	//   scaling takes precedence over upgrading too)
-	if err := m.scaler.Scale(tc, oldStatefulSet, newSts); err != nil {
-		return err
-	}
-
-	if !templateEqual(newSts, oldStatefulSet) || tc.Status.TiProxy.Phase == v1alpha1.UpgradePhase {
-		if err := m.upgrader.Upgrade(tc, oldStatefulSet, newSts); err != nil {
-			return err
-		}
-	}
-
-	return mngerutils.UpdateStatefulSetWithPrecheck(m.deps, tc, "FailedUpdateTiProxySTS", newSts, oldStatefulSet)
+	}, nil
 }
 
-func (m *tiproxyMemberManager) syncStatus(tc *v1alpha1.TidbCluster, sts *apps.StatefulSet) error {
+func (m *tiproxyMemberManager) SyncStatus(tc *v1alpha1.TidbCluster, sts *apps.StatefulSet) error {
 	if sts == nil {
 		// skip if not created yet
 		return nil
@@ -378,7 +325,7 @@ func (m *tiproxyMemberManager) syncProxyService(tc *v1alpha1.TidbCluster, peer b
 }
 
 // Only Use config file if cm is not nil
-func (m *tiproxyMemberManager) getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.StatefulSet, error) {
+func getNewTiProxyStatefulSet(tc *v1alpha1.TidbCluster, cm *corev1.ConfigMap) (*apps.StatefulSet, error) {
 	var err error
 
 	ns := tc.GetNamespace()
@@ -508,7 +455,7 @@ func (m *tiproxyMemberManager) getNewStatefulSet(tc *v1alpha1.TidbCluster, cm *c
 		},
 		VolumeMounts: volMounts,
 		Resources:    controller.ContainerResource(tc.Spec.TiProxy.ResourceRequirements),
-		Env:          util.AppendEnv(envs, baseTiProxySpec.Env()),
+		Env:          util.AppendEnv(append(envs, tlsCertHashEnv(tc, util.ClusterClientTLSSecretName(tc.Name))...), baseTiProxySpec.Env()),
 		EnvFrom:      baseTiProxySpec.EnvFrom(),
 	}
 
@@ -57,6 +57,7 @@ func (s *tiproxyScaler) ScaleOut(meta metav1.Object, oldSet *apps.StatefulSet, n
 	resetReplicas(newSet, oldSet)
 
 	klog.Infof("scaling out tiproxy statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.TiProxyMemberType, v1alpha1.EventReasonScaleOut, ordinal, replicas)
 
 	setReplicasAndDeleteSlots(newSet, replicas, deleteSlots)
 	return nil
@@ -80,6 +81,7 @@ func (s *tiproxyScaler) ScaleIn(meta metav1.Object, oldSet *apps.StatefulSet, ne
 	}
 
 	klog.Infof("scaling in tiproxy statefulset %s/%s, ordinal: %d (replicas: %d, delete slots: %v)", oldSet.Namespace, oldSet.Name, ordinal, replicas, deleteSlots.List())
+	s.recordScaling(meta, v1alpha1.TiProxyMemberType, v1alpha1.EventReasonScaleIn, ordinal, replicas)
 
 	setReplicasAndDeleteSlots(newSet, replicas, deleteSlots)
 	return nil
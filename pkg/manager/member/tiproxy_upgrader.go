@@ -46,6 +46,8 @@ func (u *tiproxyUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 	if tc.Status.TiProxy.Phase == v1alpha1.ScalePhase {
 		klog.Infof("TidbCluster: [%s/%s]'s tiproxy status is %v, can not upgrade tiproxy",
 			ns, tcName, tc.Status.TiProxy.Phase)
+		mngerutils.RecordBlocked(u.deps.Recorder, tc, &tc.Status.TiProxy, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked,
+			fmt.Sprintf("tiproxy status is %s, can not upgrade tiproxy", tc.Status.TiProxy.Phase))
 		_, podSpec, err := GetLastAppliedConfig(oldSet)
 		if err != nil {
 			return err
@@ -53,6 +55,7 @@ func (u *tiproxyUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 		newSet.Spec.Template.Spec = *podSpec
 		return nil
 	}
+	mngerutils.ClearBlocked(&tc.Status.TiProxy, v1alpha1.ComponentConditionUpgradeBlocked)
 
 	tc.Status.TiProxy.Phase = v1alpha1.UpgradePhase
 	if !templateEqual(newSet, oldSet) {
@@ -85,6 +88,7 @@ func (u *tiproxyUpgrader) Upgrade(tc *v1alpha1.TidbCluster, oldSet *apps.Statefu
 			continue
 		}
 
+		recordUpgradePod(u.deps.Recorder, tc, newSet, v1alpha1.TiProxyMemberType, i)
 		mngerutils.SetUpgradePartition(newSet, i)
 		return nil
 	}
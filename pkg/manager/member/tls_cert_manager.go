@@ -0,0 +1,541 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager"
+	"github.com/pingcap/tidb-operator/pkg/metrics"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/crypto"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	errorutils "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	tlsCACertKey = "ca.crt"
+	tlsCAKeyKey  = "ca.key"
+
+	// internalCADuration is the validity of the CA managed by the operator
+	internalCADuration = 10 * 365 * 24 * time.Hour
+	// a new CA is created when the current one expires in internalCARenewBefore, it's added to the
+	// trust bundle of all the components before it signs any certificate, so the rotation of the CA
+	// does not break the connections between the components
+	internalCARenewBefore = 2 * 365 * 24 * time.Hour
+
+	// tlsCertCommonName is the common name of the certificates, it matches the examples
+	// of `security.cert-allowed-cn` in the documents
+	tlsCertCommonName = "TiDB"
+
+	tlsCertComponentCA     = "ca"
+	tlsCertComponentClient = "client"
+
+	tlsCertRenewedReason       = "CertificateRenewed"
+	tlsCertNotManagedReason    = "CertificateNotManaged"
+	tlsCertIssueFailedReason   = "CertificateIssueFailed"
+	tlsCertHashEnvName         = "TLS_CERT_HASH"
+	certManagerAPIVersion      = "cert-manager.io/v1"
+	certManagerCertificateKind = "Certificate"
+)
+
+var certManagerCertificateGVK = schema.FromAPIVersionAndKind(certManagerAPIVersion, certManagerCertificateKind)
+
+// tlsCertificate is a certificate issued for a component of the cluster
+type tlsCertificate struct {
+	component  string
+	secretName string
+	dnsNames   []string
+	ipList     []string
+}
+
+type tlsCertManager struct {
+	deps *controller.Dependencies
+}
+
+// NewTLSCertManager returns a manager which issues and renews the certificates of the cluster
+// if `spec.tlsCluster.issuer` is set
+func NewTLSCertManager(deps *controller.Dependencies) manager.Manager {
+	return &tlsCertManager{
+		deps: deps,
+	}
+}
+
+func (m *tlsCertManager) Sync(tc *v1alpha1.TidbCluster) error {
+	issuer := tc.TLSIssuer()
+	if issuer == nil {
+		for secretName, status := range tc.Status.TLSCertificates {
+			metrics.ClusterCertificateExpiry.DeleteLabelValues(tc.Namespace, tc.Name, status.Component, secretName)
+		}
+		tc.Status.TLSCertificates = nil
+		return nil
+	}
+
+	certs := desiredTLSCertificates(tc)
+	var err error
+	switch issuer.Type {
+	case v1alpha1.TLSIssuerTypeInternal:
+		err = m.syncInternal(tc, issuer, certs)
+	case v1alpha1.TLSIssuerTypeCertManager:
+		err = m.syncCertManager(tc, issuer, certs)
+	default:
+		err = fmt.Errorf("unsupported tls issuer type %q", issuer.Type)
+	}
+	if err != nil {
+		m.deps.Recorder.Eventf(tc, corev1.EventTypeWarning, tlsCertIssueFailedReason, "failed to issue certificates: %v", err)
+		return err
+	}
+	return m.syncStatus(tc, issuer, certs)
+}
+
+// syncInternal issues the certificates with the CA managed by the operator
+func (m *tlsCertManager) syncInternal(tc *v1alpha1.TidbCluster, issuer *v1alpha1.TLSIssuer, certs []tlsCertificate) error {
+	cas, err := m.syncCA(tc)
+	if err != nil {
+		return err
+	}
+	bundle, _ := crypto.EncodeCertificateAuthorities(cas)
+	roots := x509.NewCertPool()
+	for _, ca := range cas {
+		roots.AddCert(ca.Cert)
+	}
+	signer := tlsSigner(cas, issuer.GetDuration())
+
+	var errs []error
+	for _, cert := range certs {
+		if err := m.syncInternalCertificate(tc, issuer, cert, signer, roots, bundle); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errorutils.NewAggregate(errs)
+}
+
+// syncCA returns the CAs sorted by the expiry, the expired CAs are removed and a new CA is created
+// if all of them are going to expire
+func (m *tlsCertManager) syncCA(tc *v1alpha1.TidbCluster) ([]*crypto.CertificateAuthority, error) {
+	ns := tc.GetNamespace()
+	secretName := util.ClusterCASecretName(tc.GetName())
+
+	var cas []*crypto.CertificateAuthority
+	secret, err := m.deps.SecretLister.Secrets(ns).Get(secretName)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if secret != nil {
+		cas, err = crypto.ParseCertificateAuthorities(secret.Data[tlsCACertKey], secret.Data[tlsCAKeyKey])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CA in secret %s/%s: %v", ns, secretName, err)
+		}
+	}
+
+	now := time.Now()
+	valid := make([]*crypto.CertificateAuthority, 0, len(cas)+1)
+	for _, ca := range cas {
+		if ca.Cert.NotAfter.After(now) {
+			valid = append(valid, ca)
+		}
+	}
+	sort.SliceStable(valid, func(i, j int) bool {
+		return valid[i].Cert.NotAfter.Before(valid[j].Cert.NotAfter)
+	})
+	if len(valid) == 0 || valid[len(valid)-1].Cert.NotAfter.Sub(now) < internalCARenewBefore {
+		ca, err := crypto.NewCA(fmt.Sprintf("%s-%s-ca", ns, tc.GetName()), internalCADuration)
+		if err != nil {
+			return nil, err
+		}
+		valid = append(valid, ca)
+		if len(cas) > 0 {
+			m.deps.Recorder.Eventf(tc, corev1.EventTypeNormal, tlsCertRenewedReason, "new CA %s is created", ca.Cert.SerialNumber)
+		}
+	}
+	if secret != nil && len(valid) == len(cas) && tlsSameCAs(valid, cas) {
+		return valid, nil
+	}
+
+	certs, keys := crypto.EncodeCertificateAuthorities(valid)
+	_, err = m.deps.TypedControl.CreateOrUpdateSecret(tc, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: ns,
+			Labels:    label.New().Instance(tc.GetInstanceName()).Component(tlsCertComponentCA),
+		},
+		Data: map[string][]byte{
+			tlsCACertKey: certs,
+			tlsCAKeyKey:  keys,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	klog.Infof("tidb cluster %s/%s: CA secret %s is updated, %d CA(s) are trusted", ns, tc.GetName(), secretName, len(valid))
+	return valid, nil
+}
+
+func (m *tlsCertManager) syncInternalCertificate(tc *v1alpha1.TidbCluster, issuer *v1alpha1.TLSIssuer, cert tlsCertificate,
+	signer *crypto.CertificateAuthority, roots *x509.CertPool, bundle []byte) error {
+	ns := tc.GetNamespace()
+	secret, err := m.deps.SecretLister.Secrets(ns).Get(cert.secretName)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if secret != nil && secret.Labels[label.ManagedByLabelKey] != label.TiDBOperator {
+		// never overwrite the certificates provided by users
+		m.deps.Recorder.Eventf(tc, corev1.EventTypeWarning, tlsCertNotManagedReason,
+			"secret %s is not managed by tidb-operator, skip issuing the certificate", cert.secretName)
+		return nil
+	}
+
+	data := map[string][]byte{}
+	reason := "it's not issued"
+	if secret != nil {
+		for k, v := range secret.Data {
+			data[k] = v
+		}
+		reason = tlsCertRenewReason(secret.Data, cert, roots, issuer.GetRenewBefore())
+	}
+	if reason != "" {
+		certPEM, keyPEM, err := signer.Issue(tlsCertCommonName, cert.dnsNames, cert.ipList, issuer.GetDuration())
+		if err != nil {
+			return err
+		}
+		data[corev1.TLSCertKey] = certPEM
+		data[corev1.TLSPrivateKeyKey] = keyPEM
+		if secret != nil {
+			m.deps.Recorder.Eventf(tc, corev1.EventTypeNormal, tlsCertRenewedReason, "certificate in secret %s is renewed because %s", cert.secretName, reason)
+		}
+		klog.Infof("tidb cluster %s/%s: issue certificate in secret %s because %s", ns, tc.GetName(), cert.secretName, reason)
+	}
+	data[tlsCACertKey] = bundle
+	if secret != nil && apiequality.Semantic.DeepEqual(secret.Data, data) {
+		return nil
+	}
+
+	_, err = m.deps.TypedControl.CreateOrUpdateSecret(tc, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cert.secretName,
+			Namespace: ns,
+			Labels:    label.New().Instance(tc.GetInstanceName()).Component(cert.component),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	})
+	return err
+}
+
+// syncCertManager issues the certificates by cert-manager, a Certificate is created for each Secret
+func (m *tlsCertManager) syncCertManager(tc *v1alpha1.TidbCluster, issuer *v1alpha1.TLSIssuer, certs []tlsCertificate) error {
+	var errs []error
+	for _, cert := range certs {
+		if err := m.syncCertManagerCertificate(tc, issuer, cert); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errorutils.NewAggregate(errs)
+}
+
+func (m *tlsCertManager) syncCertManagerCertificate(tc *v1alpha1.TidbCluster, issuer *v1alpha1.TLSIssuer, cert tlsCertificate) error {
+	desired := newCertManagerCertificate(tc, issuer, cert)
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(certManagerCertificateGVK)
+	err := m.deps.GenericClient.Get(context.TODO(), types.NamespacedName{Namespace: desired.GetNamespace(), Name: desired.GetName()}, existing)
+	if errors.IsNotFound(err) {
+		klog.Infof("tidb cluster %s/%s: create cert-manager Certificate %s", tc.GetNamespace(), tc.GetName(), desired.GetName())
+		return m.deps.GenericClient.Create(context.TODO(), desired)
+	}
+	if err != nil {
+		return err
+	}
+
+	if apiequality.Semantic.DeepEqual(existing.Object["spec"], desired.Object["spec"]) {
+		return nil
+	}
+	existing.Object["spec"] = desired.Object["spec"]
+	klog.Infof("tidb cluster %s/%s: update cert-manager Certificate %s", tc.GetNamespace(), tc.GetName(), desired.GetName())
+	return m.deps.GenericClient.Update(context.TODO(), existing)
+}
+
+// syncStatus records the certificates in the status, the hash of the certificates is used to rolling restart
+// the components when the certificates are renewed
+func (m *tlsCertManager) syncStatus(tc *v1alpha1.TidbCluster, issuer *v1alpha1.TLSIssuer, certs []tlsCertificate) error {
+	ns := tc.GetNamespace()
+	secrets := make([]tlsCertificate, 0, len(certs)+1)
+	if issuer.Type == v1alpha1.TLSIssuerTypeInternal {
+		secrets = append(secrets, tlsCertificate{component: tlsCertComponentCA, secretName: util.ClusterCASecretName(tc.GetName())})
+	}
+	secrets = append(secrets, certs...)
+
+	status := make(map[string]v1alpha1.TLSCertificateStatus, len(secrets))
+	for _, cert := range secrets {
+		secret, err := m.deps.SecretLister.Secrets(ns).Get(cert.secretName)
+		if errors.IsNotFound(err) {
+			// the Secret is not created by cert-manager yet or the lister is not synced yet
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		certKey := corev1.TLSCertKey
+		if cert.component == tlsCertComponentCA {
+			certKey = tlsCACertKey
+		}
+		parsed, err := crypto.ParseCertificates(secret.Data[certKey])
+		if err != nil {
+			klog.Warningf("tidb cluster %s/%s: failed to parse certificate in secret %s: %v", ns, tc.GetName(), cert.secretName, err)
+			continue
+		}
+		// the CA bundle expires with the CA which expires last
+		notBefore, notAfter := parsed[0].NotBefore, parsed[0].NotAfter
+		for _, c := range parsed[1:] {
+			if c.NotAfter.After(notAfter) {
+				notBefore, notAfter = c.NotBefore, c.NotAfter
+			}
+		}
+		status[cert.secretName] = v1alpha1.TLSCertificateStatus{
+			Component: cert.component,
+			NotBefore: metav1.NewTime(notBefore),
+			NotAfter:  metav1.NewTime(notAfter),
+			Hash:      tlsCertHash(secret.Data),
+		}
+		metrics.ClusterCertificateExpiry.WithLabelValues(ns, tc.GetName(), cert.component, cert.secretName).Set(float64(notAfter.Unix()))
+	}
+	for secretName, old := range tc.Status.TLSCertificates {
+		if _, ok := status[secretName]; !ok {
+			metrics.ClusterCertificateExpiry.DeleteLabelValues(ns, tc.GetName(), old.Component, secretName)
+		}
+	}
+	tc.Status.TLSCertificates = status
+	return nil
+}
+
+// desiredTLSCertificates returns the certificates of the components in the cluster,
+// the client certificate is always issued because it's used by the operator and the tools
+func desiredTLSCertificates(tc *v1alpha1.TidbCluster) []tlsCertificate {
+	tcName := tc.GetName()
+	var certs []tlsCertificate
+	add := func(component string, services ...string) {
+		certs = append(certs, tlsCertificate{
+			component:  component,
+			secretName: util.ClusterTLSSecretName(tcName, component),
+			dnsNames:   tlsDNSNames(tc, services...),
+			ipList:     []string{"127.0.0.1", "::1"},
+		})
+	}
+
+	if tc.Spec.PD != nil {
+		add(label.PDLabelVal, controller.PDMemberName(tcName), controller.PDPeerMemberName(tcName))
+	}
+	if tc.Spec.TiKV != nil || len(tc.Spec.TiKVGroups) > 0 {
+		services := []string{controller.TiKVMemberName(tcName), controller.TiKVPeerMemberName(tcName)}
+		for _, group := range tc.Spec.TiKVGroups {
+			services = append(services, controller.TiKVGroupMemberName(tcName, group.Name), controller.TiKVGroupPeerMemberName(tcName, group.Name))
+		}
+		add(label.TiKVLabelVal, services...)
+	}
+	if tc.Spec.TiDB != nil {
+		add(label.TiDBLabelVal, controller.TiDBMemberName(tcName), controller.TiDBPeerMemberName(tcName))
+	}
+	if tc.Spec.TiFlash != nil {
+		services := []string{controller.TiFlashMemberName(tcName), controller.TiFlashPeerMemberName(tcName)}
+		if tc.TiFlashDisaggregated() {
+			services = append(services, controller.TiFlashComputeMemberName(tcName), controller.TiFlashComputePeerMemberName(tcName))
+		}
+		add(label.TiFlashLabelVal, services...)
+	}
+	if tc.Spec.TiCDC != nil {
+		add(label.TiCDCLabelVal, controller.TiCDCMemberName(tcName), controller.TiCDCPeerMemberName(tcName))
+	}
+	if tc.Spec.Pump != nil {
+		add(label.PumpLabelVal, controller.PumpMemberName(tcName), controller.PumpPeerMemberName(tcName))
+	}
+
+	// TiProxy uses the client certificate to connect to the cluster
+	var clientServices []string
+	if tc.Spec.TiProxy != nil {
+		clientServices = append(clientServices, controller.TiProxyMemberName(tcName), controller.TiProxyPeerMemberName(tcName))
+	}
+	certs = append(certs, tlsCertificate{
+		component:  tlsCertComponentClient,
+		secretName: util.ClusterClientTLSSecretName(tcName),
+		dnsNames:   tlsDNSNames(tc, clientServices...),
+		ipList:     []string{"127.0.0.1", "::1"},
+	})
+	return certs
+}
+
+// tlsDNSNames returns the DNS names of the services and the pods behind the services
+func tlsDNSNames(tc *v1alpha1.TidbCluster, services ...string) []string {
+	ns := tc.GetNamespace()
+	names := []string{"localhost"}
+	for _, svc := range services {
+		for _, name := range []string{svc, "*." + svc} {
+			names = append(names, name, fmt.Sprintf("%s.%s", name, ns), fmt.Sprintf("%s.%s.svc", name, ns))
+			if tc.Spec.ClusterDomain != "" {
+				names = append(names, fmt.Sprintf("%s.%s.svc.%s", name, ns, tc.Spec.ClusterDomain))
+			}
+		}
+	}
+	return names
+}
+
+// tlsSigner returns the CA which signs the certificates. The oldest CA which outlives the certificate is
+// preferred, so a new CA is not used before it's trusted by all the components.
+func tlsSigner(cas []*crypto.CertificateAuthority, duration time.Duration) *crypto.CertificateAuthority {
+	now := time.Now()
+	for _, ca := range cas {
+		if ca.Cert.NotAfter.Sub(now) >= duration {
+			return ca
+		}
+	}
+	return cas[len(cas)-1]
+}
+
+// tlsCertRenewReason returns why the certificate should be renewed, it's empty if the certificate is still valid
+func tlsCertRenewReason(data map[string][]byte, cert tlsCertificate, roots *x509.CertPool, renewBefore time.Duration) string {
+	certs, err := crypto.ParseCertificates(data[corev1.TLSCertKey])
+	if err != nil || len(data[corev1.TLSPrivateKeyKey]) == 0 {
+		return "it can not be parsed"
+	}
+	leaf := certs[0]
+	if time.Until(leaf.NotAfter) < renewBefore {
+		return fmt.Sprintf("it expires at %s", leaf.NotAfter.Format(time.RFC3339))
+	}
+	if !sets.NewString(leaf.DNSNames...).Equal(sets.NewString(cert.dnsNames...)) {
+		return "the DNS names are changed"
+	}
+	ips := sets.NewString()
+	for _, ip := range leaf.IPAddresses {
+		ips.Insert(ip.String())
+	}
+	desiredIPs := sets.NewString()
+	for _, ip := range cert.ipList {
+		desiredIPs.Insert(net.ParseIP(ip).String())
+	}
+	if !ips.Equal(desiredIPs) {
+		return "the IP addresses are changed"
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); err != nil {
+		return "it's not signed by a trusted CA"
+	}
+	return ""
+}
+
+func tlsSameCAs(a, b []*crypto.CertificateAuthority) bool {
+	for i := range a {
+		if !a[i].Cert.Equal(b[i].Cert) {
+			return false
+		}
+	}
+	return true
+}
+
+// tlsCertHash returns the hash of the certificate and the trusted CAs
+func tlsCertHash(data map[string][]byte) string {
+	h := sha256.Sum256(bytes.Join([][]byte{data[corev1.TLSCertKey], data[tlsCACertKey]}, nil))
+	return hex.EncodeToString(h[:])[:16]
+}
+
+func newCertManagerCertificate(tc *v1alpha1.TidbCluster, issuer *v1alpha1.TLSIssuer, cert tlsCertificate) *unstructured.Unstructured {
+	ref := issuer.IssuerRef
+	kind, group := ref.Kind, ref.Group
+	if kind == "" {
+		kind = "Issuer"
+	}
+	if group == "" {
+		group = "cert-manager.io"
+	}
+	dnsNames := make([]interface{}, 0, len(cert.dnsNames))
+	for _, name := range cert.dnsNames {
+		dnsNames = append(dnsNames, name)
+	}
+	ipList := make([]interface{}, 0, len(cert.ipList))
+	for _, ip := range cert.ipList {
+		ipList = append(ipList, ip)
+	}
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"secretName":  cert.secretName,
+			"commonName":  tlsCertCommonName,
+			"dnsNames":    dnsNames,
+			"ipAddresses": ipList,
+			"duration":    issuer.GetDuration().String(),
+			"renewBefore": issuer.GetRenewBefore().String(),
+			"usages":      []interface{}{"server auth", "client auth"},
+			"secretTemplate": map[string]interface{}{
+				"labels": map[string]interface{}{
+					label.ManagedByLabelKey: label.TiDBOperator,
+					label.InstanceLabelKey:  tc.GetInstanceName(),
+					label.ComponentLabelKey: cert.component,
+				},
+			},
+			"issuerRef": map[string]interface{}{
+				"name":  ref.Name,
+				"kind":  kind,
+				"group": group,
+			},
+		},
+	}}
+	obj.SetGroupVersionKind(certManagerCertificateGVK)
+	obj.SetNamespace(tc.GetNamespace())
+	obj.SetName(cert.secretName)
+	obj.SetLabels(label.New().Instance(tc.GetInstanceName()).Component(cert.component))
+	obj.SetOwnerReferences([]metav1.OwnerReference{controller.GetOwnerRef(tc)})
+	return obj
+}
+
+// tlsCertHashEnv returns the env which carries the hash of the certificate if it's issued by the operator,
+// so the pods are rolling restarted to load the certificate when it's renewed
+func tlsCertHashEnv(tc *v1alpha1.TidbCluster, secretName string) []corev1.EnvVar {
+	hash := tc.TLSCertHash(secretName)
+	if hash == "" {
+		return nil
+	}
+	return []corev1.EnvVar{{Name: tlsCertHashEnvName, Value: hash}}
+}
+
+type FakeTLSCertManager struct {
+	err error
+}
+
+func NewFakeTLSCertManager() *FakeTLSCertManager {
+	return &FakeTLSCertManager{}
+}
+
+func (m *FakeTLSCertManager) SetSyncError(err error) {
+	m.err = err
+}
+
+func (m *FakeTLSCertManager) Sync(_ *v1alpha1.TidbCluster) error {
+	return m.err
+}
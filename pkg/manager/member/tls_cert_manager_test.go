@@ -0,0 +1,237 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package member
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/crypto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func newTidbClusterForTLSCert(issuer *v1alpha1.TLSIssuer) *v1alpha1.TidbCluster {
+	tc := newTidbClusterForPD()
+	tc.Spec.TLSCluster = &v1alpha1.TLSCluster{Enabled: true, Issuer: issuer}
+	tc.Spec.TiKV = &v1alpha1.TiKVSpec{}
+	tc.Spec.TiDB = &v1alpha1.TiDBSpec{}
+	tc.Spec.TiFlash = nil
+	return tc
+}
+
+// secretClient returns the client which the secrets are written by
+func secretClient(deps *controller.Dependencies) client.Client {
+	return deps.GenericControl.(*controller.FakeGenericControl).FakeCli
+}
+
+// syncSecretsToLister makes the secrets written by the manager visible to the lister
+func syncSecretsToLister(g *GomegaWithT, deps *controller.Dependencies) {
+	list := &corev1.SecretList{}
+	g.Expect(secretClient(deps).List(context.TODO(), list)).Should(Succeed())
+	indexer := deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer()
+	for i := range list.Items {
+		g.Expect(indexer.Update(&list.Items[i])).Should(Succeed())
+	}
+}
+
+func getSecretFromLister(g *GomegaWithT, deps *controller.Dependencies, tc *v1alpha1.TidbCluster, name string) *corev1.Secret {
+	secret, err := deps.SecretLister.Secrets(tc.Namespace).Get(name)
+	g.Expect(err).Should(Succeed())
+	return secret
+}
+
+func TestTLSCertManagerSyncInternal(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	m := NewTLSCertManager(deps)
+	tc := newTidbClusterForTLSCert(&v1alpha1.TLSIssuer{Type: v1alpha1.TLSIssuerTypeInternal})
+
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	syncSecretsToLister(g, deps)
+	g.Expect(m.Sync(tc)).Should(Succeed())
+
+	caSecretName := util.ClusterCASecretName(tc.Name)
+	pdSecretName := util.ClusterTLSSecretName(tc.Name, "pd")
+	g.Expect(tc.Status.TLSCertificates).Should(HaveKey(caSecretName))
+	g.Expect(tc.Status.TLSCertificates).Should(HaveKey(pdSecretName))
+	g.Expect(tc.Status.TLSCertificates).Should(HaveKey(util.ClusterTLSSecretName(tc.Name, "tikv")))
+	g.Expect(tc.Status.TLSCertificates).Should(HaveKey(util.ClusterTLSSecretName(tc.Name, "tidb")))
+	g.Expect(tc.Status.TLSCertificates).Should(HaveKey(util.ClusterClientTLSSecretName(tc.Name)))
+	g.Expect(tc.Status.TLSCertificates).ShouldNot(HaveKey(util.ClusterTLSSecretName(tc.Name, "tiflash")))
+	g.Expect(tc.Status.TLSCertificates[pdSecretName].Component).Should(Equal("pd"))
+	g.Expect(tc.Status.TLSCertificates[pdSecretName].NotAfter.Sub(time.Now())).Should(BeNumerically("~", 90*24*time.Hour, time.Hour))
+
+	hash := tc.TLSCertHash(pdSecretName)
+	g.Expect(hash).ShouldNot(BeEmpty())
+	g.Expect(tlsCertHashEnv(tc, pdSecretName)).Should(Equal([]corev1.EnvVar{{Name: tlsCertHashEnvName, Value: hash}}))
+
+	// the certificate is signed by the CA and valid for the pods
+	pdSecret := getSecretFromLister(g, deps, tc, pdSecretName)
+	caSecret := getSecretFromLister(g, deps, tc, caSecretName)
+	g.Expect(pdSecret.Data[tlsCACertKey]).Should(Equal(caSecret.Data[tlsCACertKey]))
+	certs, err := crypto.ParseCertificates(pdSecret.Data[corev1.TLSCertKey])
+	g.Expect(err).Should(Succeed())
+	g.Expect(certs[0].Subject.CommonName).Should(Equal(tlsCertCommonName))
+	g.Expect(certs[0].VerifyHostname("test-pd-0.test-pd-peer.default.svc")).Should(Succeed())
+
+	// nothing is changed if the certificates are valid
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	syncSecretsToLister(g, deps)
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	g.Expect(tc.TLSCertHash(pdSecretName)).Should(Equal(hash))
+
+	// the certificates are renewed if they expire in renewBefore
+	tc.Spec.TLSCluster.Issuer.RenewBefore = &metav1.Duration{Duration: 100 * 24 * time.Hour}
+	tc.Spec.TLSCluster.Issuer.Duration = &metav1.Duration{Duration: 200 * 24 * time.Hour}
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	syncSecretsToLister(g, deps)
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	g.Expect(tc.TLSCertHash(pdSecretName)).ShouldNot(Equal(hash))
+	g.Expect(tc.Status.TLSCertificates[pdSecretName].NotAfter.Sub(time.Now())).Should(BeNumerically("~", 200*24*time.Hour, time.Hour))
+
+	// the certificates are renewed if the DNS names are changed
+	hash = tc.TLSCertHash(pdSecretName)
+	tc.Spec.ClusterDomain = "cluster.local"
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	syncSecretsToLister(g, deps)
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	g.Expect(tc.TLSCertHash(pdSecretName)).ShouldNot(Equal(hash))
+
+	// the status is cleaned if the certificates are not managed by the operator anymore
+	tc.Spec.TLSCluster.Issuer = nil
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	g.Expect(tc.Status.TLSCertificates).Should(BeNil())
+	g.Expect(tlsCertHashEnv(tc, pdSecretName)).Should(BeNil())
+}
+
+func TestTLSCertManagerRotateCA(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	m := NewTLSCertManager(deps)
+	tc := newTidbClusterForTLSCert(&v1alpha1.TLSIssuer{Type: v1alpha1.TLSIssuerTypeInternal})
+
+	// the CA is going to expire
+	oldCA, err := crypto.NewCA("old", 365*24*time.Hour)
+	g.Expect(err).Should(Succeed())
+	expiredCA, err := crypto.NewCA("expired", -time.Hour)
+	g.Expect(err).Should(Succeed())
+	certs, keys := crypto.EncodeCertificateAuthorities([]*crypto.CertificateAuthority{expiredCA, oldCA})
+	indexer := deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer()
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: util.ClusterCASecretName(tc.Name), Namespace: tc.Namespace},
+		Data:       map[string][]byte{tlsCACertKey: certs, tlsCAKeyKey: keys},
+	}
+	g.Expect(indexer.Add(caSecret)).Should(Succeed())
+	g.Expect(secretClient(deps).Create(context.TODO(), caSecret.DeepCopy())).Should(Succeed())
+
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	syncSecretsToLister(g, deps)
+
+	// the expired CA is removed and a new CA is added
+	secret := getSecretFromLister(g, deps, tc, util.ClusterCASecretName(tc.Name))
+	cas, err := crypto.ParseCertificateAuthorities(secret.Data[tlsCACertKey], secret.Data[tlsCAKeyKey])
+	g.Expect(err).Should(Succeed())
+	g.Expect(cas).Should(HaveLen(2))
+	g.Expect(cas[0].Cert.Equal(oldCA.Cert)).Should(BeTrue())
+	g.Expect(cas[1].Cert.NotAfter.Sub(time.Now())).Should(BeNumerically(">", internalCARenewBefore))
+
+	// the certificates are signed by the old CA until the new CA is trusted by all the components
+	secret = getSecretFromLister(g, deps, tc, util.ClusterTLSSecretName(tc.Name, "pd"))
+	g.Expect(secret.Data[tlsCACertKey]).Should(Equal(getSecretFromLister(g, deps, tc, util.ClusterCASecretName(tc.Name)).Data[tlsCACertKey]))
+	leaf, err := crypto.ParseCertificates(secret.Data[corev1.TLSCertKey])
+	g.Expect(err).Should(Succeed())
+	g.Expect(leaf[0].Issuer.CommonName).Should(Equal("old"))
+
+	g.Expect(tlsSigner(cas, 400*24*time.Hour)).Should(Equal(cas[1]))
+}
+
+func TestTLSCertManagerSkipUserSecrets(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	m := NewTLSCertManager(deps)
+	tc := newTidbClusterForTLSCert(&v1alpha1.TLSIssuer{Type: v1alpha1.TLSIssuerTypeInternal})
+
+	userSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: util.ClusterTLSSecretName(tc.Name, "pd"), Namespace: tc.Namespace},
+		Data:       map[string][]byte{corev1.TLSCertKey: []byte("user")},
+	}
+	indexer := deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer()
+	g.Expect(indexer.Add(userSecret)).Should(Succeed())
+
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	secret := &corev1.Secret{}
+	err := secretClient(deps).Get(context.TODO(), types.NamespacedName{Namespace: tc.Namespace, Name: userSecret.Name}, secret)
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(tc.Status.TLSCertificates).ShouldNot(HaveKey(userSecret.Name))
+}
+
+func TestTLSCertManagerSyncCertManager(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	m := NewTLSCertManager(deps)
+	tc := newTidbClusterForTLSCert(&v1alpha1.TLSIssuer{
+		Type:      v1alpha1.TLSIssuerTypeCertManager,
+		IssuerRef: &v1alpha1.CertManagerIssuerRef{Name: "tidb-issuer", Kind: "ClusterIssuer"},
+	})
+
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	// the secrets are not issued by cert-manager yet
+	g.Expect(tc.Status.TLSCertificates).Should(BeEmpty())
+
+	pdSecretName := util.ClusterTLSSecretName(tc.Name, "pd")
+	getCertificate := func() *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(certManagerCertificateGVK)
+		g.Expect(deps.GenericClient.Get(context.TODO(), types.NamespacedName{Namespace: tc.Namespace, Name: pdSecretName}, obj)).Should(Succeed())
+		return obj
+	}
+	cert := getCertificate()
+	g.Expect(cert.GetOwnerReferences()).Should(HaveLen(1))
+	secretName, _, _ := unstructured.NestedString(cert.Object, "spec", "secretName")
+	g.Expect(secretName).Should(Equal(pdSecretName))
+	issuerRef, _, _ := unstructured.NestedStringMap(cert.Object, "spec", "issuerRef")
+	g.Expect(issuerRef).Should(Equal(map[string]string{"name": "tidb-issuer", "kind": "ClusterIssuer", "group": "cert-manager.io"}))
+	duration, _, _ := unstructured.NestedString(cert.Object, "spec", "duration")
+	g.Expect(duration).Should(Equal((90 * 24 * time.Hour).String()))
+
+	// the Certificate is updated if the issuer is changed
+	tc.Spec.TLSCluster.Issuer.Duration = &metav1.Duration{Duration: 60 * 24 * time.Hour}
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	duration, _, _ = unstructured.NestedString(getCertificate().Object, "spec", "duration")
+	g.Expect(duration).Should(Equal((60 * 24 * time.Hour).String()))
+
+	// the status is recorded once the secret is issued by cert-manager
+	ca, err := crypto.NewCA("cert-manager", 24*time.Hour)
+	g.Expect(err).Should(Succeed())
+	certPEM, keyPEM, err := ca.Issue("TiDB", nil, nil, time.Hour)
+	g.Expect(err).Should(Succeed())
+	caPEM, _ := crypto.EncodeCertificateAuthorities([]*crypto.CertificateAuthority{ca})
+	indexer := deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer()
+	g.Expect(indexer.Add(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: pdSecretName, Namespace: tc.Namespace},
+		Data:       map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM, tlsCACertKey: caPEM},
+	})).Should(Succeed())
+	g.Expect(m.Sync(tc)).Should(Succeed())
+	g.Expect(tc.Status.TLSCertificates).Should(HaveLen(1))
+	g.Expect(tc.TLSCertHash(pdSecretName)).ShouldNot(BeEmpty())
+}
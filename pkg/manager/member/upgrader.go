@@ -14,8 +14,13 @@
 package member
 
 import (
+	"fmt"
+
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	apps "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 )
 
 // Upgrader implements the logic for upgrading the tidb cluster.
@@ -28,3 +33,13 @@ type Upgrader interface {
 type DMUpgrader interface {
 	Upgrade(*v1alpha1.DMCluster, *apps.StatefulSet, *apps.StatefulSet) error
 }
+
+// recordUpgradePod emits an event when the partition of the statefulset is moved to the pod,
+// so that the event is emitted only once for each pod.
+func recordUpgradePod(recorder record.EventRecorder, obj runtime.Object, set *apps.StatefulSet, memberType v1alpha1.MemberType, ordinal int32) {
+	if !isPartitionAbove(set, ordinal) {
+		return
+	}
+	recorder.Event(obj, corev1.EventTypeNormal, v1alpha1.EventReasonUpgradePod,
+		fmt.Sprintf("upgrading %s pod %s-%d", memberType, set.GetName(), ordinal))
+}
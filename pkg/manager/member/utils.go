@@ -38,6 +38,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
 	corelisters "k8s.io/client-go/listers/core/v1"
@@ -121,10 +122,84 @@ func TiFlashPodName(tcName string, ordinal int32) string {
 	return fmt.Sprintf("%s-%d", controller.TiFlashMemberName(tcName), ordinal)
 }
 
+// tiflashMemberName returns the name of the TiFlash StatefulSet, tc may be a view of TiFlash compute nodes
+func tiflashMemberName(tc *v1alpha1.TidbCluster) string {
+	if tc.IsTiFlashCompute() {
+		return controller.TiFlashComputeMemberName(tc.Name)
+	}
+	return controller.TiFlashMemberName(tc.Name)
+}
+
+// tiflashPeerMemberName returns the name of the TiFlash peer service, tc may be a view of TiFlash compute nodes
+func tiflashPeerMemberName(tc *v1alpha1.TidbCluster) string {
+	if tc.IsTiFlashCompute() {
+		return controller.TiFlashComputePeerMemberName(tc.Name)
+	}
+	return controller.TiFlashPeerMemberName(tc.Name)
+}
+
+func tiflashPodName(tc *v1alpha1.TidbCluster, ordinal int32) string {
+	return fmt.Sprintf("%s-%d", tiflashMemberName(tc), ordinal)
+}
+
+// tiflashPodSelector returns the selector of TiFlash pods managed by the StatefulSet of tc,
+// tc may be a view of TiFlash compute nodes. Compute nodes are excluded from `spec.tiflash`.
+func tiflashPodSelector(tc *v1alpha1.TidbCluster) (labels.Selector, error) {
+	selector, err := labelTiFlash(tc).Selector()
+	if err != nil {
+		return nil, err
+	}
+	if tc.IsTiFlashCompute() {
+		return selector, nil
+	}
+	r, err := labels.NewRequirement(label.TiFlashRoleLabelKey, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+	return selector.Add(*r), nil
+}
+
 func TikvPodName(tcName string, ordinal int32) string {
 	return fmt.Sprintf("%s-%d", controller.TiKVMemberName(tcName), ordinal)
 }
 
+// tikvMemberName returns the name of the TiKV StatefulSet, tc may be a view of a TiKV group
+func tikvMemberName(tc *v1alpha1.TidbCluster) string {
+	if group := tc.TiKVGroupName(); group != "" {
+		return controller.TiKVGroupMemberName(tc.Name, group)
+	}
+	return controller.TiKVMemberName(tc.Name)
+}
+
+// tikvPeerMemberName returns the name of the TiKV peer service, tc may be a view of a TiKV group
+func tikvPeerMemberName(tc *v1alpha1.TidbCluster) string {
+	if group := tc.TiKVGroupName(); group != "" {
+		return controller.TiKVGroupPeerMemberName(tc.Name, group)
+	}
+	return controller.TiKVPeerMemberName(tc.Name)
+}
+
+func tikvPodName(tc *v1alpha1.TidbCluster, ordinal int32) string {
+	return fmt.Sprintf("%s-%d", tikvMemberName(tc), ordinal)
+}
+
+// tikvPodSelector returns the selector of TiKV pods managed by the StatefulSet of tc,
+// tc may be a view of a TiKV group. Pods of TiKV groups are excluded from `spec.tikv`.
+func tikvPodSelector(tc *v1alpha1.TidbCluster) (labels.Selector, error) {
+	selector, err := labelTiKV(tc).Selector()
+	if err != nil {
+		return nil, err
+	}
+	if tc.TiKVGroupName() != "" {
+		return selector, nil
+	}
+	r, err := labels.NewRequirement(label.TiKVGroupLabelKey, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, err
+	}
+	return selector.Add(*r), nil
+}
+
 func PdPodName(tcName string, ordinal int32) string {
 	return fmt.Sprintf("%s-%d", controller.PDMemberName(tcName), ordinal)
 }
@@ -141,6 +216,35 @@ func DMMasterPodName(dcName string, ordinal int32) string {
 	return fmt.Sprintf("%s-%d", controller.DMMasterMemberName(dcName), ordinal)
 }
 
+func DMWorkerPodName(dcName string, ordinal int32) string {
+	return fmt.Sprintf("%s-%d", controller.DMWorkerMemberName(dcName), ordinal)
+}
+
+// dmTLSClientVolumes returns the volumes and mounts of the client certificates of
+// the upstream and downstream databases, mounted at the paths in DMCluster status.
+func dmTLSClientVolumes(dc *v1alpha1.DMCluster) ([]corev1.Volume, []corev1.VolumeMount) {
+	var vols []corev1.Volume
+	var volMounts []corev1.VolumeMount
+	add := func(prefix, mountPath string, secrets []v1alpha1.DMTLSClientSecret) {
+		for _, secret := range secrets {
+			volName := fmt.Sprintf("%s-%s", prefix, secret.Name)
+			volMounts = append(volMounts, corev1.VolumeMount{
+				Name: volName, ReadOnly: true, MountPath: path.Join(mountPath, secret.Name),
+			})
+			vols = append(vols, corev1.Volume{
+				Name: volName, VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: secret.SecretName,
+					},
+				},
+			})
+		}
+	}
+	add("upstream-tls", v1alpha1.DMUpstreamTLSMountPath, dc.Spec.TLSUpstreams)
+	add("downstream-tls", v1alpha1.DMDownstreamTLSMountPath, dc.Spec.TLSDownstreams)
+	return vols, volMounts
+}
+
 // PdName should match the start arg `--name` of pd-server
 // See the start script of PD in pkg/manager/member/startscript/v1.pdStartScriptTpl
 // and pkg/manager/member/startscript/v2.RenderPDStartScript
@@ -280,12 +384,12 @@ func shouldRecover(tc *v1alpha1.TidbCluster, component string, podLister corelis
 		stores = tc.Status.TiKV.Stores
 		failureStores = tc.Status.TiKV.FailureStores
 		ordinals = tc.TiKVStsDesiredOrdinals(true)
-		podPrefix = controller.TiKVMemberName(tc.Name)
+		podPrefix = tikvMemberName(tc)
 	case label.TiFlashLabelVal:
 		stores = tc.Status.TiFlash.Stores
 		failureStores = tc.Status.TiFlash.FailureStores
 		ordinals = tc.TiFlashStsDesiredOrdinals(true)
-		podPrefix = controller.TiFlashMemberName(tc.Name)
+		podPrefix = tiflashMemberName(tc)
 	default:
 		klog.Warningf("Unexpected component %s for %s/%s in shouldRecover", component, tc.Namespace, tc.Name)
 		return false
@@ -445,7 +549,7 @@ func GetPVCSelectorForPod(controller runtime.Object, memberType v1alpha1.MemberT
 	var l label.Label
 	switch controller.(type) {
 	case *v1alpha1.TidbCluster:
-		podName = ordinalPodName(memberType, meta.GetName(), ordinal)
+		podName = tcOrdinalPodName(memberType, controller.(*v1alpha1.TidbCluster), ordinal)
 		l = label.New().Instance(meta.GetName())
 		l[label.AnnPodNameKey] = podName
 	case *v1alpha1.DMCluster:
@@ -17,13 +17,16 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	errutil "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/klog/v2"
 
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
 	"github.com/pingcap/tidb-operator/pkg/controller"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
 )
 
 var (
@@ -33,6 +36,8 @@ var (
 		v1alpha1.TiCDCMemberType,
 		v1alpha1.TiKVMemberType,
 		v1alpha1.PumpMemberType,
+		v1alpha1.PDMSSchedulingMemberType,
+		v1alpha1.PDMSTSOMemberType,
 		v1alpha1.PDMemberType,
 	}
 	suspendOrderForDM = []v1alpha1.MemberType{
@@ -102,8 +107,13 @@ func (s *suspender) SuspendComponent(cluster v1alpha1.Cluster, comp v1alpha1.Mem
 	if !suspending {
 		if can, reason := canSuspendComponent(ctx.cluster, ctx.component); !can {
 			klog.Warningf("component %s can not be suspended now because: %s", ctx.ComponentID(), reason)
+			if obj, ok := ctx.cluster.(runtime.Object); ok {
+				mngerutils.RecordBlocked(s.deps.Recorder, obj, ctx.status, v1alpha1.ComponentConditionSuspendBlocked,
+					v1alpha1.EventReasonSuspendBlocked, fmt.Sprintf("%s can not be suspended now because: %s", ctx.component, reason))
+			}
 			return false, nil
 		}
+		mngerutils.ClearBlocked(ctx.status, v1alpha1.ComponentConditionSuspendBlocked)
 
 		err := s.begin(ctx)
 		return true, err
@@ -174,6 +184,8 @@ func (s *suspender) suspendSts(ctx *suspendComponentCtx) error {
 			ctx.status.(*v1alpha1.PumpStatus).Members = nil
 		case v1alpha1.TiCDCMemberType:
 			ctx.status.(*v1alpha1.TiCDCStatus).Captures = nil
+		case v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType:
+			ctx.status.(*v1alpha1.PDMSStatus).Members = nil
 		case v1alpha1.DMMasterMemberType:
 			ctx.status.(*v1alpha1.MasterStatus).Members = nil
 			ctx.status.(*v1alpha1.MasterStatus).Leader = v1alpha1.MasterMember{}
@@ -191,6 +203,7 @@ func (s *suspender) begin(ctx *suspendComponentCtx) error {
 	klog.Infof("begin to suspend component %s and transfer phase from %s to %s",
 		ctx.ComponentID(), status.GetPhase(), phase)
 	ctx.status.SetPhase(phase)
+	s.recordEvent(ctx, v1alpha1.EventReasonSuspend, fmt.Sprintf("begin to suspend %s", ctx.component))
 	return nil
 }
 
@@ -200,9 +213,16 @@ func (s *suspender) end(ctx *suspendComponentCtx) error {
 	klog.Infof("end to suspend component %s and transfer phase from %s to %s",
 		ctx.ComponentID(), status.GetPhase(), phase)
 	ctx.status.SetPhase(phase)
+	s.recordEvent(ctx, v1alpha1.EventReasonResume, fmt.Sprintf("end to suspend %s", ctx.component))
 	return nil
 }
 
+func (s *suspender) recordEvent(ctx *suspendComponentCtx, reason, message string) {
+	if obj, ok := ctx.cluster.(runtime.Object); ok {
+		s.deps.Recorder.Event(obj, corev1.EventTypeNormal, reason, message)
+	}
+}
+
 // needsSuspendComponent returns whether suspender needs to to suspend the component
 func needsSuspendComponent(cluster v1alpha1.Cluster, comp v1alpha1.MemberType) bool {
 	spec := cluster.ComponentSpec(comp)
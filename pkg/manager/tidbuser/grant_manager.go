@@ -0,0 +1,186 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// GrantManager keeps the privileges in TiDB in sync with the TiDBGrant
+type GrantManager struct {
+	deps *controller.Dependencies
+}
+
+// NewGrantManager returns a GrantManager
+func NewGrantManager(deps *controller.Dependencies) *GrantManager {
+	return &GrantManager{
+		deps: deps,
+	}
+}
+
+func (m *GrantManager) Sync(grant *v1alpha1.TiDBGrant, user *v1alpha1.TiDBUser, tc *v1alpha1.TidbCluster) error {
+	drifts, err := m.sync(grant, user, tc)
+	if err != nil {
+		setCondition(&grant.Status.Conditions, grant.Generation, v1alpha1.TiDBAccountSynced, false, reasonSyncFailed, err.Error())
+		return err
+	}
+	grant.Status.ObservedGeneration = grant.Generation
+	setCondition(&grant.Status.Conditions, grant.Generation, v1alpha1.TiDBAccountSynced, true, reasonSynced, "")
+	setDriftCondition(&grant.Status.Conditions, grant.Generation, drifts)
+	if len(drifts) > 0 {
+		m.deps.Recorder.Eventf(grant, corev1.EventTypeWarning, reasonDriftDetected, "out-of-band changes are reverted: %s", strings.Join(drifts, "; "))
+	}
+	return nil
+}
+
+func (m *GrantManager) sync(grant *v1alpha1.TiDBGrant, user *v1alpha1.TiDBUser, tc *v1alpha1.TidbCluster) ([]string, error) {
+	if user.Status.UserName == "" {
+		return nil, controller.RequeueErrorf("TiDBUser %s/%s is not created in TiDB yet", user.Namespace, user.Name)
+	}
+	desired := &v1alpha1.TiDBGrantApplied{
+		Cluster:         v1alpha1.TidbClusterRef{Namespace: tc.Namespace, Name: tc.Name},
+		UserName:        user.Status.UserName,
+		Host:            user.Status.Host,
+		Database:        grant.GetDatabase(),
+		Table:           grant.GetTable(),
+		Privileges:      normalizePrivileges(grant.Spec.Privileges),
+		WithGrantOption: grant.Spec.WithGrantOption,
+	}
+
+	// revoke the privileges from the previous user or object
+	applied := grant.Status.Applied
+	if applied != nil && !sameTarget(applied, desired) {
+		if err := m.revokeApplied(grant, applied); err != nil {
+			return nil, err
+		}
+		grant.Status.Applied = nil
+		applied = nil
+	}
+
+	account := controller.TiDBAccount{User: desired.UserName, Host: desired.Host}
+	lines, err := m.deps.TiDBSQLControl.ShowGrants(tc, account)
+	if err != nil {
+		return nil, err
+	}
+	current := parseGrants(lines)
+	currentPrivileges := current.privilegesOn(desired.Database, desired.Table)
+	currentGrantOption := current.grantOptionOn(desired.Database, desired.Table)
+
+	var drifts []string
+	appliedPrivileges := sets.NewString()
+	if applied != nil {
+		appliedPrivileges.Insert(applied.Privileges...)
+		for _, privilege := range applied.Privileges {
+			if !currentPrivileges.Has(privilege) {
+				drifts = append(drifts, fmt.Sprintf("%s on %s.%s was revoked from %s", privilege, desired.Database, desired.Table, account))
+			}
+		}
+		if applied.WithGrantOption && !currentGrantOption {
+			drifts = append(drifts, fmt.Sprintf("grant option on %s.%s was revoked from %s", desired.Database, desired.Table, account))
+		}
+	}
+
+	toGrant := sets.NewString(desired.Privileges...).Difference(currentPrivileges)
+	if desired.WithGrantOption && !currentGrantOption {
+		// grant option is applied to all the listed privileges
+		toGrant = sets.NewString(desired.Privileges...)
+	}
+	if toGrant.Len() > 0 {
+		if err := m.deps.TiDBSQLControl.Grant(tc, account, toGrant.List(), desired.Database, desired.Table, desired.WithGrantOption); err != nil {
+			return nil, err
+		}
+		klog.Infof("TiDBGrant %s/%s: grant %v on %s.%s to %s", grant.Namespace, grant.Name, toGrant.List(), desired.Database, desired.Table, account)
+	}
+
+	toRevoke := appliedPrivileges.Difference(sets.NewString(desired.Privileges...)).Intersection(currentPrivileges)
+	if applied != nil && applied.WithGrantOption && !desired.WithGrantOption && currentGrantOption {
+		toRevoke.Insert(grantOption)
+	}
+	if toRevoke.Len() > 0 {
+		if err := m.deps.TiDBSQLControl.Revoke(tc, account, toRevoke.List(), desired.Database, desired.Table); err != nil {
+			return nil, err
+		}
+		klog.Infof("TiDBGrant %s/%s: revoke %v on %s.%s from %s", grant.Namespace, grant.Name, toRevoke.List(), desired.Database, desired.Table, account)
+	}
+
+	grant.Status.Applied = desired
+	return drifts, nil
+}
+
+func (m *GrantManager) Clean(grant *v1alpha1.TiDBGrant) error {
+	if grant.Status.Applied == nil {
+		return nil
+	}
+	return m.revokeApplied(grant, grant.Status.Applied)
+}
+
+// revokeApplied revokes the applied privileges, it's skipped if the cluster or the user does not exist anymore
+func (m *GrantManager) revokeApplied(grant *v1alpha1.TiDBGrant, applied *v1alpha1.TiDBGrantApplied) error {
+	tc, err := m.deps.TiDBClusterLister.TidbClusters(applied.Cluster.Namespace).Get(applied.Cluster.Name)
+	if err != nil {
+		klog.Warningf("TiDBGrant %s/%s: skip revoking privileges because cluster %s/%s can not be got, err: %v",
+			grant.Namespace, grant.Name, applied.Cluster.Namespace, applied.Cluster.Name, err)
+		return nil
+	}
+	account := controller.TiDBAccount{User: applied.UserName, Host: applied.Host}
+	info, err := m.deps.TiDBSQLControl.GetUser(tc, account)
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return nil
+	}
+	privileges := append([]string(nil), applied.Privileges...)
+	if applied.WithGrantOption {
+		privileges = append(privileges, grantOption)
+	}
+	if err := m.deps.TiDBSQLControl.Revoke(tc, account, privileges, applied.Database, applied.Table); err != nil {
+		return err
+	}
+	klog.Infof("TiDBGrant %s/%s: revoke %v on %s.%s from %s", grant.Namespace, grant.Name, privileges, applied.Database, applied.Table, account)
+	return nil
+}
+
+func sameTarget(a, b *v1alpha1.TiDBGrantApplied) bool {
+	return apiequality.Semantic.DeepEqual(a.Cluster, b.Cluster) && a.UserName == b.UserName && a.Host == b.Host &&
+		normalizeObject(a.Database+"."+a.Table) == normalizeObject(b.Database+"."+b.Table)
+}
+
+type FakeGrantManager struct {
+	err error
+}
+
+func NewFakeGrantManager() *FakeGrantManager {
+	return &FakeGrantManager{}
+}
+
+func (m *FakeGrantManager) SetSyncError(err error) {
+	m.err = err
+}
+
+func (m *FakeGrantManager) Sync(grant *v1alpha1.TiDBGrant, user *v1alpha1.TiDBUser, tc *v1alpha1.TidbCluster) error {
+	return m.err
+}
+
+func (m *FakeGrantManager) Clean(grant *v1alpha1.TiDBGrant) error {
+	return m.err
+}
@@ -0,0 +1,235 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestGrantManagerSync(t *testing.T) {
+	account := controller.TiDBAccount{User: "app", Host: "%"}
+
+	type testcase struct {
+		name       string
+		update     func(*v1alpha1.TiDBGrant)
+		privileges map[string][]string
+		option     map[string]bool
+		notCreated bool
+		expectFn   func(*GomegaWithT, *v1alpha1.TiDBGrant, *controller.FakeTiDBSQLControl, []string, error)
+	}
+
+	tests := []testcase{
+		{
+			name: "grant privileges",
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"GRANT INSERT,SELECT ON `db1`.* TO 'app'@'%'"}))
+				g.Expect(grant.Status.Applied).To(Equal(&v1alpha1.TiDBGrantApplied{
+					Cluster:    v1alpha1.TidbClusterRef{Namespace: corev1.NamespaceDefault, Name: "basic"},
+					UserName:   "app",
+					Host:       "%",
+					Database:   "db1",
+					Table:      "*",
+					Privileges: []string{"INSERT", "SELECT"},
+				}))
+				g.Expect(meta.IsStatusConditionTrue(grant.Status.Conditions, v1alpha1.TiDBAccountSynced)).To(BeTrue())
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+		{
+			name: "user is not created",
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(controller.IsRequeueError(err)).To(BeTrue())
+				g.Expect(sqlControl.Statements).To(BeEmpty())
+			},
+			notCreated: true,
+		},
+		{
+			name: "nothing changed",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Status.Applied = newApplied("INSERT", "SELECT")
+			},
+			privileges: map[string][]string{"`db1`.*": {"INSERT", "SELECT"}},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(BeEmpty())
+				g.Expect(meta.IsStatusConditionFalse(grant.Status.Conditions, v1alpha1.TiDBAccountDrifted)).To(BeTrue())
+			},
+		},
+		{
+			name: "revoke privileges removed from spec",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Spec.Privileges = []string{"select"}
+				grant.Status.Applied = newApplied("INSERT", "SELECT")
+			},
+			privileges: map[string][]string{"`db1`.*": {"INSERT", "SELECT", "UPDATE"}},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				// UPDATE is not granted by the TiDBGrant, so it's kept
+				g.Expect(sqlControl.Statements).To(Equal([]string{"REVOKE INSERT ON `db1`.* FROM 'app'@'%'"}))
+				g.Expect(sqlControl.Users[account].Privileges["`db1`.*"]).To(Equal([]string{"SELECT", "UPDATE"}))
+				g.Expect(grant.Status.Applied.Privileges).To(Equal([]string{"SELECT"}))
+			},
+		},
+		{
+			name: "revert privileges revoked out-of-band",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Status.Applied = newApplied("INSERT", "SELECT")
+			},
+			privileges: map[string][]string{"`db1`.*": {"SELECT"}},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"GRANT INSERT ON `db1`.* TO 'app'@'%'"}))
+				g.Expect(meta.IsStatusConditionTrue(grant.Status.Conditions, v1alpha1.TiDBAccountDrifted)).To(BeTrue())
+				g.Expect(events).To(HaveLen(1))
+				g.Expect(events[0]).To(ContainSubstring(reasonDriftDetected))
+			},
+		},
+		{
+			name: "grant with grant option",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Spec.WithGrantOption = true
+				grant.Status.Applied = newApplied("INSERT", "SELECT")
+			},
+			privileges: map[string][]string{"`db1`.*": {"INSERT", "SELECT"}},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"GRANT INSERT,SELECT ON `db1`.* TO 'app'@'%'"}))
+				g.Expect(sqlControl.Users[account].GrantOption["`db1`.*"]).To(BeTrue())
+				g.Expect(grant.Status.Applied.WithGrantOption).To(BeTrue())
+			},
+		},
+		{
+			name: "revoke grant option",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Status.Applied = newApplied("INSERT", "SELECT")
+				grant.Status.Applied.WithGrantOption = true
+			},
+			privileges: map[string][]string{"`db1`.*": {"INSERT", "SELECT"}},
+			option:     map[string]bool{"`db1`.*": true},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"REVOKE GRANT OPTION ON `db1`.* FROM 'app'@'%'"}))
+				g.Expect(sqlControl.Users[account].GrantOption["`db1`.*"]).To(BeFalse())
+				g.Expect(sqlControl.Users[account].Privileges["`db1`.*"]).To(Equal([]string{"INSERT", "SELECT"}))
+			},
+		},
+		{
+			name: "move privileges to another database",
+			update: func(grant *v1alpha1.TiDBGrant) {
+				grant.Spec.Database = "db2"
+				grant.Status.Applied = newApplied("INSERT", "SELECT")
+			},
+			privileges: map[string][]string{"`db1`.*": {"INSERT", "SELECT"}},
+			expectFn: func(g *GomegaWithT, grant *v1alpha1.TiDBGrant, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{
+					"REVOKE INSERT,SELECT ON `db1`.* FROM 'app'@'%'",
+					"GRANT INSERT,SELECT ON `db2`.* TO 'app'@'%'",
+				}))
+				g.Expect(grant.Status.Applied.Database).To(Equal("db2"))
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			deps := controller.NewFakeDependencies()
+			sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+			sqlControl.Users[account] = &controller.FakeTiDBUser{Password: "pw1", Privileges: test.privileges, GrantOption: test.option}
+			if sqlControl.Users[account].Privileges == nil {
+				sqlControl.Users[account].Privileges = map[string][]string{}
+			}
+			if sqlControl.Users[account].GrantOption == nil {
+				sqlControl.Users[account].GrantOption = map[string]bool{}
+			}
+			tc := newTidbCluster()
+			g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(tc)).To(Succeed())
+
+			user := newTiDBUser()
+			if !test.notCreated {
+				setApplied(user)
+			}
+			grant := newTiDBGrant()
+			if test.update != nil {
+				test.update(grant)
+			}
+			err := NewGrantManager(deps).Sync(grant, user, tc)
+			test.expectFn(g, grant, sqlControl, collectEvents(deps.Recorder.(*record.FakeRecorder)), err)
+		})
+	}
+}
+
+func TestGrantManagerClean(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+	m := NewGrantManager(deps)
+
+	// the cluster does not exist
+	grant := newTiDBGrant()
+	grant.Status.Applied = newApplied("SELECT")
+	g.Expect(m.Clean(grant)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(BeEmpty())
+
+	// the user does not exist
+	g.Expect(deps.InformerFactory.Pingcap().V1alpha1().TidbClusters().Informer().GetIndexer().Add(newTidbCluster())).To(Succeed())
+	g.Expect(m.Clean(grant)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(BeEmpty())
+
+	account := controller.TiDBAccount{User: "app", Host: "%"}
+	sqlControl.Users[account] = &controller.FakeTiDBUser{
+		Privileges:  map[string][]string{"`db1`.*": {"SELECT"}},
+		GrantOption: map[string]bool{"`db1`.*": true},
+	}
+	grant.Status.Applied.WithGrantOption = true
+	g.Expect(m.Clean(grant)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(Equal([]string{"REVOKE SELECT,GRANT OPTION ON `db1`.* FROM 'app'@'%'"}))
+	g.Expect(sqlControl.Users[account].Privileges).To(BeEmpty())
+}
+
+func newApplied(privileges ...string) *v1alpha1.TiDBGrantApplied {
+	return &v1alpha1.TiDBGrantApplied{
+		Cluster:    v1alpha1.TidbClusterRef{Namespace: corev1.NamespaceDefault, Name: "basic"},
+		UserName:   "app",
+		Host:       "%",
+		Database:   "db1",
+		Table:      "*",
+		Privileges: privileges,
+	}
+}
+
+func newTiDBGrant() *v1alpha1.TiDBGrant {
+	return &v1alpha1.TiDBGrant{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app-db1",
+			Namespace: corev1.NamespaceDefault,
+		},
+		Spec: v1alpha1.TiDBGrantSpec{
+			User:       "app",
+			Privileges: []string{"SELECT", "insert"},
+			Database:   "db1",
+		},
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	allPrivileges   = "ALL PRIVILEGES"
+	grantOption     = "GRANT OPTION"
+	usagePrivilege  = "USAGE"
+	withGrantOption = " WITH GRANT OPTION"
+)
+
+var (
+	// e.g. GRANT SELECT,INSERT ON `db`.* TO 'u'@'%' WITH GRANT OPTION
+	grantPrivilegesRegexp = regexp.MustCompile(`^GRANT (.+) ON (\S+) TO `)
+	// e.g. GRANT 'r1'@'%','r2'@'%' TO 'u'@'%'
+	grantRolesRegexp = regexp.MustCompile(`^GRANT ('.*) TO `)
+	accountRegexp    = regexp.MustCompile(`'((?:[^']|'')*)'@'((?:[^']|'')*)'`)
+)
+
+// grants is the parsed result of `SHOW GRANTS`
+type grants struct {
+	// key: object in the format of `db.table` without quotes
+	privileges  map[string]sets.String
+	grantOption map[string]bool
+	roles       map[controller.TiDBAccount]bool
+}
+
+// parseGrants parses the result of `SHOW GRANTS`, column privileges are ignored
+func parseGrants(lines []string) *grants {
+	g := &grants{
+		privileges:  map[string]sets.String{},
+		grantOption: map[string]bool{},
+		roles:       map[controller.TiDBAccount]bool{},
+	}
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if m := grantPrivilegesRegexp.FindStringSubmatch(line); m != nil {
+			object := normalizeObject(m[2])
+			for _, privilege := range strings.Split(m[1], ",") {
+				privilege = normalizePrivilege(privilege)
+				if privilege == usagePrivilege || strings.Contains(privilege, "(") {
+					continue
+				}
+				if g.privileges[object] == nil {
+					g.privileges[object] = sets.NewString()
+				}
+				g.privileges[object].Insert(privilege)
+			}
+			if strings.HasSuffix(line, withGrantOption) {
+				g.grantOption[object] = true
+			}
+			continue
+		}
+		if m := grantRolesRegexp.FindStringSubmatch(line); m != nil {
+			for _, account := range accountRegexp.FindAllStringSubmatch(m[1], -1) {
+				g.roles[controller.TiDBAccount{
+					User: strings.ReplaceAll(account[1], "''", "'"),
+					Host: strings.ReplaceAll(account[2], "''", "'"),
+				}] = true
+			}
+		}
+	}
+	return g
+}
+
+// privilegesOn returns the privileges granted on `db.table`
+func (g *grants) privilegesOn(db, table string) sets.String {
+	if privileges, ok := g.privileges[normalizeObject(db+"."+table)]; ok {
+		return privileges
+	}
+	return sets.NewString()
+}
+
+// grantOptionOn returns whether the privileges on `db.table` are granted with grant option
+func (g *grants) grantOptionOn(db, table string) bool {
+	return g.grantOption[normalizeObject(db+"."+table)]
+}
+
+// normalizePrivilege returns the privilege in the format of `SHOW GRANTS`, e.g. ALL is converted to ALL PRIVILEGES
+func normalizePrivilege(privilege string) string {
+	privilege = strings.ToUpper(strings.Join(strings.Fields(privilege), " "))
+	if privilege == "ALL" {
+		return allPrivileges
+	}
+	return privilege
+}
+
+// normalizePrivileges normalizes and sorts the privileges
+func normalizePrivileges(privileges []string) []string {
+	result := sets.NewString()
+	for _, privilege := range privileges {
+		result.Insert(normalizePrivilege(privilege))
+	}
+	return result.List()
+}
+
+func normalizeObject(object string) string {
+	return strings.ToLower(strings.ReplaceAll(object, "`", ""))
+}
+
+func sortedAccounts(accounts []controller.TiDBAccount) []controller.TiDBAccount {
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].String() < accounts[j].String()
+	})
+	return accounts
+}
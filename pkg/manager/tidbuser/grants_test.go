@@ -0,0 +1,50 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+func TestParseGrants(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	grants := parseGrants([]string{
+		"GRANT USAGE ON *.* TO 'u1'@'%'",
+		"GRANT Select,INSERT ON `Db1`.* TO 'u1'@'%' WITH GRANT OPTION",
+		"GRANT ALL PRIVILEGES ON `db2`.`t1` TO 'u1'@'%'",
+		"GRANT SELECT(c1),UPDATE ON `db3`.`t1` TO 'u1'@'%'",
+		"GRANT 'r1'@'%','r''2'@'localhost' TO 'u1'@'%'",
+	})
+
+	g.Expect(grants.privilegesOn("*", "*").List()).To(BeEmpty())
+	g.Expect(grants.privilegesOn("db1", "*").List()).To(Equal([]string{"INSERT", "SELECT"}))
+	g.Expect(grants.grantOptionOn("db1", "*")).To(BeTrue())
+	g.Expect(grants.privilegesOn("db2", "t1").List()).To(Equal([]string{allPrivileges}))
+	g.Expect(grants.grantOptionOn("db2", "t1")).To(BeFalse())
+	g.Expect(grants.privilegesOn("db3", "t1").List()).To(Equal([]string{"UPDATE"}))
+	g.Expect(grants.roles).To(Equal(map[controller.TiDBAccount]bool{
+		{User: "r1", Host: "%"}:          true,
+		{User: "r'2", Host: "localhost"}: true,
+	}))
+}
+
+func TestNormalizePrivileges(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(normalizePrivileges([]string{"select", "all", " create  view ", "SELECT"})).To(Equal([]string{allPrivileges, "CREATE VIEW", "SELECT"}))
+}
@@ -0,0 +1,238 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	reasonSynced          = "Synced"
+	reasonSyncFailed      = "SyncFailed"
+	reasonImmutable       = "ImmutableFieldChanged"
+	reasonNoDrift         = "NoDrift"
+	reasonDriftDetected   = "DriftDetected"
+	reasonPasswordRotated = "PasswordRotated"
+
+	defaultRoleHost = "%"
+)
+
+// UserManager creates the user in TiDB and keeps its password and roles in sync with the TiDBUser
+type UserManager struct {
+	deps *controller.Dependencies
+}
+
+// NewUserManager returns a UserManager
+func NewUserManager(deps *controller.Dependencies) *UserManager {
+	return &UserManager{
+		deps: deps,
+	}
+}
+
+func (m *UserManager) Sync(user *v1alpha1.TiDBUser, tc *v1alpha1.TidbCluster) error {
+	drifts, err := m.sync(user, tc)
+	if err != nil {
+		setCondition(&user.Status.Conditions, user.Generation, v1alpha1.TiDBAccountSynced, false, reasonSyncFailed, err.Error())
+		return err
+	}
+	user.Status.ObservedGeneration = user.Generation
+	setCondition(&user.Status.Conditions, user.Generation, v1alpha1.TiDBAccountSynced, true, reasonSynced, "")
+	setDriftCondition(&user.Status.Conditions, user.Generation, drifts)
+	if len(drifts) > 0 {
+		m.deps.Recorder.Eventf(user, corev1.EventTypeWarning, reasonDriftDetected, "out-of-band changes are reverted: %s", strings.Join(drifts, "; "))
+	}
+	return nil
+}
+
+func (m *UserManager) sync(user *v1alpha1.TiDBUser, tc *v1alpha1.TidbCluster) ([]string, error) {
+	ns := user.GetNamespace()
+	name := user.GetName()
+	account := controller.TiDBAccount{User: user.GetUserName(), Host: user.GetHost()}
+	status := &user.Status
+	if status.UserName != "" && (status.UserName != account.User || status.Host != account.Host) {
+		// renaming an account would break the clients and the grants, so it's not supported
+		return nil, fmt.Errorf("%s: userName and host can not be changed from %s", reasonImmutable,
+			controller.TiDBAccount{User: status.UserName, Host: status.Host})
+	}
+
+	var password, secretVersion string
+	if !user.Spec.Role {
+		secret, err := m.deps.SecretLister.Secrets(ns).Get(user.Spec.PasswordSecret.Name)
+		if err != nil {
+			return nil, fmt.Errorf("get password secret %s/%s failed, err: %v", ns, user.Spec.PasswordSecret.Name, err)
+		}
+		data, ok := secret.Data[user.Spec.PasswordSecret.Key]
+		if !ok {
+			return nil, fmt.Errorf("key %s does not exist in secret %s/%s", user.Spec.PasswordSecret.Key, ns, secret.Name)
+		}
+		password, secretVersion = string(data), secret.ResourceVersion
+	}
+
+	var drifts []string
+	info, err := m.deps.TiDBSQLControl.GetUser(tc, account)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case info == nil:
+		if status.UserName != "" {
+			drifts = append(drifts, fmt.Sprintf("%s was dropped", account))
+		}
+		if user.Spec.Role {
+			err = m.deps.TiDBSQLControl.CreateRole(tc, account)
+		} else {
+			err = m.deps.TiDBSQLControl.CreateUser(tc, account, password)
+		}
+		if err != nil {
+			return nil, err
+		}
+		klog.Infof("TiDBUser %s/%s: create %s in cluster %s/%s", ns, name, account, tc.Namespace, tc.Name)
+		// the roles are dropped together with the account
+		status.Roles = nil
+	case user.Spec.Role:
+	case status.PasswordSecretVersion != secretVersion:
+		if err := m.deps.TiDBSQLControl.SetPassword(tc, account, password); err != nil {
+			return nil, err
+		}
+		if status.PasswordSecretVersion != "" {
+			m.deps.Recorder.Eventf(user, corev1.EventTypeNormal, reasonPasswordRotated, "password of %s is updated from secret %s", account, user.Spec.PasswordSecret.Name)
+		}
+	case info.Plugin == controller.NativePasswordPlugin && info.AuthenticationString != controller.NativePasswordHash(password):
+		drifts = append(drifts, fmt.Sprintf("password of %s was changed", account))
+		if err := m.deps.TiDBSQLControl.SetPassword(tc, account, password); err != nil {
+			return nil, err
+		}
+	}
+	status.UserName = account.User
+	status.Host = account.Host
+	status.PasswordSecretVersion = secretVersion
+
+	roleDrifts, err := m.syncRoles(user, tc, account)
+	if err != nil {
+		return nil, err
+	}
+	return append(drifts, roleDrifts...), nil
+}
+
+func (m *UserManager) syncRoles(user *v1alpha1.TiDBUser, tc *v1alpha1.TidbCluster, account controller.TiDBAccount) ([]string, error) {
+	if len(user.Spec.Roles) == 0 && len(user.Status.Roles) == 0 {
+		return nil, nil
+	}
+	lines, err := m.deps.TiDBSQLControl.ShowGrants(tc, account)
+	if err != nil {
+		return nil, err
+	}
+	current := parseGrants(lines)
+
+	var drifts []string
+	applied := map[string]bool{}
+	for _, role := range user.Status.Roles {
+		applied[role] = true
+	}
+	desired := map[string]bool{}
+	var toGrant []controller.TiDBAccount
+	for _, role := range user.Spec.Roles {
+		desired[role] = true
+		roleAccount := controller.TiDBAccount{User: role, Host: defaultRoleHost}
+		if current.roles[roleAccount] {
+			continue
+		}
+		if applied[role] {
+			drifts = append(drifts, fmt.Sprintf("role %s was revoked", roleAccount))
+		}
+		toGrant = append(toGrant, roleAccount)
+	}
+	var toRevoke []controller.TiDBAccount
+	for _, role := range user.Status.Roles {
+		roleAccount := controller.TiDBAccount{User: role, Host: defaultRoleHost}
+		if !desired[role] && current.roles[roleAccount] {
+			toRevoke = append(toRevoke, roleAccount)
+		}
+	}
+
+	if len(toGrant) > 0 {
+		if err := m.deps.TiDBSQLControl.GrantRoles(tc, account, sortedAccounts(toGrant)); err != nil {
+			return nil, err
+		}
+	}
+	if len(toRevoke) > 0 {
+		if err := m.deps.TiDBSQLControl.RevokeRoles(tc, account, sortedAccounts(toRevoke)); err != nil {
+			return nil, err
+		}
+	}
+	user.Status.Roles = append([]string(nil), user.Spec.Roles...)
+	return drifts, nil
+}
+
+func (m *UserManager) Clean(user *v1alpha1.TiDBUser, tc *v1alpha1.TidbCluster) error {
+	if user.Status.UserName == "" {
+		return nil
+	}
+	account := controller.TiDBAccount{User: user.Status.UserName, Host: user.Status.Host}
+	if err := m.deps.TiDBSQLControl.DropUser(tc, account, user.Spec.Role); err != nil {
+		return err
+	}
+	klog.Infof("TiDBUser %s/%s: drop %s in cluster %s/%s", user.Namespace, user.Name, account, tc.Namespace, tc.Name)
+	return nil
+}
+
+func setCondition(conditions *[]metav1.Condition, generation int64, conditionType string, status bool, reason, message string) {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	}
+	if status {
+		condition.Status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(conditions, condition)
+}
+
+func setDriftCondition(conditions *[]metav1.Condition, generation int64, drifts []string) {
+	if len(drifts) > 0 {
+		setCondition(conditions, generation, v1alpha1.TiDBAccountDrifted, true, reasonDriftDetected, strings.Join(drifts, "; "))
+		return
+	}
+	setCondition(conditions, generation, v1alpha1.TiDBAccountDrifted, false, reasonNoDrift, "")
+}
+
+type FakeUserManager struct {
+	err error
+}
+
+func NewFakeUserManager() *FakeUserManager {
+	return &FakeUserManager{}
+}
+
+func (m *FakeUserManager) SetSyncError(err error) {
+	m.err = err
+}
+
+func (m *FakeUserManager) Sync(user *v1alpha1.TiDBUser, tc *v1alpha1.TidbCluster) error {
+	return m.err
+}
+
+func (m *FakeUserManager) Clean(user *v1alpha1.TiDBUser, tc *v1alpha1.TidbCluster) error {
+	return m.err
+}
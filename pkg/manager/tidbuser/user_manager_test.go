@@ -0,0 +1,289 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tidbuser
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestUserManagerSync(t *testing.T) {
+	account := controller.TiDBAccount{User: "app", Host: "%"}
+
+	type testcase struct {
+		name     string
+		update   func(*v1alpha1.TiDBUser)
+		existing map[controller.TiDBAccount]*controller.FakeTiDBUser
+		sqlErr   bool
+		expectFn func(*GomegaWithT, *v1alpha1.TiDBUser, *controller.FakeTiDBSQLControl, []string, error)
+	}
+
+	tests := []testcase{
+		{
+			name: "create user",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.Roles = []string{"reader"}
+			},
+			existing: map[controller.TiDBAccount]*controller.FakeTiDBUser{
+				{User: "reader", Host: "%"}: {Role: true},
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{
+					"CREATE USER 'app'@'%'",
+					"GRANT 'reader'@'%' TO 'app'@'%'",
+				}))
+				g.Expect(sqlControl.Users[account].Password).To(Equal("pw1"))
+				g.Expect(user.Status.UserName).To(Equal("app"))
+				g.Expect(user.Status.Host).To(Equal("%"))
+				g.Expect(user.Status.PasswordSecretVersion).To(Equal("1"))
+				g.Expect(user.Status.Roles).To(Equal([]string{"reader"}))
+				g.Expect(user.Status.ObservedGeneration).To(Equal(int64(2)))
+				g.Expect(meta.IsStatusConditionTrue(user.Status.Conditions, v1alpha1.TiDBAccountSynced)).To(BeTrue())
+				g.Expect(meta.IsStatusConditionFalse(user.Status.Conditions, v1alpha1.TiDBAccountDrifted)).To(BeTrue())
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+		{
+			name: "create role",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.Role = true
+				user.Spec.PasswordSecret = nil
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"CREATE ROLE 'app'@'%'"}))
+				g.Expect(sqlControl.Users[account].Role).To(BeTrue())
+				g.Expect(user.Status.PasswordSecretVersion).To(BeEmpty())
+			},
+		},
+		{
+			name: "rotate password",
+			update: func(user *v1alpha1.TiDBUser) {
+				setApplied(user)
+				user.Status.PasswordSecretVersion = "0"
+			},
+			existing: map[controller.TiDBAccount]*controller.FakeTiDBUser{
+				account: {Password: "pw0"},
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"ALTER USER 'app'@'%'"}))
+				g.Expect(sqlControl.Users[account].Password).To(Equal("pw1"))
+				g.Expect(user.Status.PasswordSecretVersion).To(Equal("1"))
+				g.Expect(events).To(HaveLen(1))
+				g.Expect(events[0]).To(ContainSubstring(reasonPasswordRotated))
+			},
+		},
+		{
+			name:   "nothing changed",
+			update: setApplied,
+			existing: map[controller.TiDBAccount]*controller.FakeTiDBUser{
+				account: {Password: "pw1"},
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(BeEmpty())
+				g.Expect(events).To(BeEmpty())
+			},
+		},
+		{
+			name:   "revert password changed out-of-band",
+			update: setApplied,
+			existing: map[controller.TiDBAccount]*controller.FakeTiDBUser{
+				account: {Password: "changed"},
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"ALTER USER 'app'@'%'"}))
+				g.Expect(sqlControl.Users[account].Password).To(Equal("pw1"))
+				g.Expect(meta.IsStatusConditionTrue(user.Status.Conditions, v1alpha1.TiDBAccountDrifted)).To(BeTrue())
+				g.Expect(events).To(HaveLen(1))
+				g.Expect(events[0]).To(ContainSubstring(reasonDriftDetected))
+			},
+		},
+		{
+			name:   "recreate user dropped out-of-band",
+			update: setApplied,
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{"CREATE USER 'app'@'%'"}))
+				g.Expect(meta.IsStatusConditionTrue(user.Status.Conditions, v1alpha1.TiDBAccountDrifted)).To(BeTrue())
+				g.Expect(events).To(HaveLen(1))
+			},
+		},
+		{
+			name: "sync roles",
+			update: func(user *v1alpha1.TiDBUser) {
+				setApplied(user)
+				user.Spec.Roles = []string{"reader", "writer"}
+				user.Status.Roles = []string{"reader", "admin"}
+			},
+			existing: map[controller.TiDBAccount]*controller.FakeTiDBUser{
+				account: {Password: "pw1", Roles: []controller.TiDBAccount{{User: "admin", Host: "%"}}},
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(Equal([]string{
+					"GRANT 'reader'@'%', 'writer'@'%' TO 'app'@'%'",
+					"REVOKE 'admin'@'%' FROM 'app'@'%'",
+				}))
+				g.Expect(user.Status.Roles).To(Equal([]string{"reader", "writer"}))
+				// reader was revoked out-of-band
+				g.Expect(meta.IsStatusConditionTrue(user.Status.Conditions, v1alpha1.TiDBAccountDrifted)).To(BeTrue())
+			},
+		},
+		{
+			name: "user name can not be changed",
+			update: func(user *v1alpha1.TiDBUser) {
+				setApplied(user)
+				user.Spec.UserName = "app2"
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(reasonImmutable))
+				g.Expect(sqlControl.Statements).To(BeEmpty())
+				g.Expect(meta.IsStatusConditionFalse(user.Status.Conditions, v1alpha1.TiDBAccountSynced)).To(BeTrue())
+			},
+		},
+		{
+			name: "password secret does not exist",
+			update: func(user *v1alpha1.TiDBUser) {
+				user.Spec.PasswordSecret.Name = "not-exist"
+			},
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(sqlControl.Statements).To(BeEmpty())
+				g.Expect(user.Status.UserName).To(BeEmpty())
+			},
+		},
+		{
+			name:   "sql error",
+			sqlErr: true,
+			expectFn: func(g *GomegaWithT, user *v1alpha1.TiDBUser, sqlControl *controller.FakeTiDBSQLControl, events []string, err error) {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(meta.IsStatusConditionFalse(user.Status.Conditions, v1alpha1.TiDBAccountSynced)).To(BeTrue())
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			deps := controller.NewFakeDependencies()
+			sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+			for account, user := range test.existing {
+				sqlControl.Users[account] = user
+			}
+			if test.sqlErr {
+				sqlControl.SetError(fmt.Errorf("sql error"))
+			}
+			secretIndexer := deps.KubeInformerFactory.Core().V1().Secrets().Informer().GetIndexer()
+			g.Expect(secretIndexer.Add(newSecret())).To(Succeed())
+
+			user := newTiDBUser()
+			if test.update != nil {
+				test.update(user)
+			}
+			err := NewUserManager(deps).Sync(user, newTidbCluster())
+			test.expectFn(g, user, sqlControl, collectEvents(deps.Recorder.(*record.FakeRecorder)), err)
+		})
+	}
+}
+
+func TestUserManagerClean(t *testing.T) {
+	g := NewGomegaWithT(t)
+	deps := controller.NewFakeDependencies()
+	sqlControl := deps.TiDBSQLControl.(*controller.FakeTiDBSQLControl)
+	m := NewUserManager(deps)
+	tc := newTidbCluster()
+
+	// the user is not created yet
+	user := newTiDBUser()
+	g.Expect(m.Clean(user, tc)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(BeEmpty())
+
+	account := controller.TiDBAccount{User: "app", Host: "%"}
+	sqlControl.Users[account] = &controller.FakeTiDBUser{Password: "pw1"}
+	setApplied(user)
+	g.Expect(m.Clean(user, tc)).To(Succeed())
+	g.Expect(sqlControl.Statements).To(Equal([]string{"DROP USER 'app'@'%'"}))
+	g.Expect(sqlControl.Users).NotTo(HaveKey(account))
+}
+
+func setApplied(user *v1alpha1.TiDBUser) {
+	user.Status.UserName = user.GetUserName()
+	user.Status.Host = user.GetHost()
+	user.Status.PasswordSecretVersion = "1"
+	user.Status.Roles = user.Spec.Roles
+}
+
+func collectEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+func newTidbCluster() *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: corev1.NamespaceDefault,
+		},
+	}
+}
+
+func newSecret() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "app-password",
+			Namespace:       corev1.NamespaceDefault,
+			ResourceVersion: "1",
+		},
+		Data: map[string][]byte{
+			"password": []byte("pw1"),
+		},
+	}
+}
+
+func newTiDBUser() *v1alpha1.TiDBUser {
+	return &v1alpha1.TiDBUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "app",
+			Namespace:  corev1.NamespaceDefault,
+			Generation: 2,
+		},
+		Spec: v1alpha1.TiDBUserSpec{
+			Cluster: v1alpha1.TidbClusterRef{Name: "basic"},
+			PasswordSecret: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "app-password"},
+				Key:                  "password",
+			},
+		},
+	}
+}
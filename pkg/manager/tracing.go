@@ -0,0 +1,51 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manager
+
+import (
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/tracing"
+)
+
+type tracedManager struct {
+	name string
+	m    Manager
+}
+
+// WithTracing returns a Manager tracing each Sync of m in a span.
+func WithTracing(name string, m Manager) Manager {
+	return &tracedManager{name: name, m: m}
+}
+
+func (t *tracedManager) Sync(tc *v1alpha1.TidbCluster) error {
+	return tracing.Trace(tracing.TidbClusterKey(tc.Namespace, tc.Name), t.name+".Sync", func() error {
+		return t.m.Sync(tc)
+	})
+}
+
+type tracedDMManager struct {
+	name string
+	m    DMManager
+}
+
+// WithDMTracing returns a DMManager tracing each SyncDM of m in a span.
+func WithDMTracing(name string, m DMManager) DMManager {
+	return &tracedDMManager{name: name, m: m}
+}
+
+func (t *tracedDMManager) SyncDM(dc *v1alpha1.DMCluster) error {
+	return tracing.Trace(tracing.DMClusterKey(dc.Namespace, dc.Name), t.name+".Sync", func() error {
+		return t.m.SyncDM(dc)
+	})
+}
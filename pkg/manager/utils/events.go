@@ -0,0 +1,56 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// RecordBlocked mirrors an operation of the component which is blocked into the condition
+// of the component. A Warning event is emitted only when the condition turns True, so that
+// an operation blocked for a long time does not emit an event in every reconciliation.
+// The event is always emitted if the component has no status.
+func RecordBlocked(recorder record.EventRecorder, obj runtime.Object, status v1alpha1.ComponentStatus, conditionType, reason, message string) {
+	if status == nil {
+		recorder.Event(obj, corev1.EventTypeWarning, reason, message)
+		return
+	}
+	if !meta.IsStatusConditionTrue(status.GetConditions(), conditionType) {
+		recorder.Event(obj, corev1.EventTypeWarning, reason, message)
+	}
+	status.SetCondition(metav1.Condition{
+		Type:    conditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+// ClearBlocked turns the condition of the component to False if it's True.
+func ClearBlocked(status v1alpha1.ComponentStatus, conditionType string) {
+	if status == nil || !meta.IsStatusConditionTrue(status.GetConditions(), conditionType) {
+		return
+	}
+	status.SetCondition(metav1.Condition{
+		Type:   conditionType,
+		Status: metav1.ConditionFalse,
+		Reason: v1alpha1.ConditionReasonUnblocked,
+	})
+}
@@ -0,0 +1,63 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+)
+
+func TestRecordAndClearBlocked(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &v1alpha1.TidbCluster{}
+	recorder := record.NewFakeRecorder(10)
+
+	// clearing a condition which is not present is a no-op
+	ClearBlocked(&tc.Status.TiKV, v1alpha1.ComponentConditionUpgradeBlocked)
+	g.Expect(tc.Status.TiKV.Conditions).To(BeEmpty())
+
+	RecordBlocked(recorder, tc, &tc.Status.TiKV, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked, "pd status is Upgrade")
+	g.Expect(<-recorder.Events).To(Equal("Warning UpgradeBlocked pd status is Upgrade"))
+	cond := meta.FindStatusCondition(tc.Status.TiKV.Conditions, v1alpha1.ComponentConditionUpgradeBlocked)
+	g.Expect(cond).NotTo(BeNil())
+	g.Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(cond.Reason).To(Equal(v1alpha1.EventReasonUpgradeBlocked))
+	g.Expect(cond.Message).To(Equal("pd status is Upgrade"))
+
+	// no event while the condition is kept True, but the condition is updated
+	RecordBlocked(recorder, tc, &tc.Status.TiKV, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked, "tidb status is Upgrade")
+	g.Expect(recorder.Events).To(BeEmpty())
+	cond = meta.FindStatusCondition(tc.Status.TiKV.Conditions, v1alpha1.ComponentConditionUpgradeBlocked)
+	g.Expect(cond.Message).To(Equal("tidb status is Upgrade"))
+
+	ClearBlocked(&tc.Status.TiKV, v1alpha1.ComponentConditionUpgradeBlocked)
+	cond = meta.FindStatusCondition(tc.Status.TiKV.Conditions, v1alpha1.ComponentConditionUpgradeBlocked)
+	g.Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(cond.Reason).To(Equal(v1alpha1.ConditionReasonUnblocked))
+
+	// the event is emitted again once it's blocked after being unblocked
+	RecordBlocked(recorder, tc, &tc.Status.TiKV, v1alpha1.ComponentConditionUpgradeBlocked, v1alpha1.EventReasonUpgradeBlocked, "pd status is Upgrade")
+	g.Expect(<-recorder.Events).To(Equal("Warning UpgradeBlocked pd status is Upgrade"))
+
+	// the event is still emitted if the component has no status
+	RecordBlocked(recorder, tc, nil, v1alpha1.ComponentConditionSuspendBlocked, v1alpha1.EventReasonSuspendBlocked, "wait")
+	g.Expect(<-recorder.Events).To(Equal("Warning SuspendBlocked wait"))
+}
@@ -0,0 +1,242 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	klog "k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation"
+)
+
+// Performance of Ultra Disks and Premium SSD v2 can be adjusted up to four times within 24 hours.
+// See https://learn.microsoft.com/en-us/azure/virtual-machines/disks-enable-ultra-ssd#adjust-the-performance-of-an-ultra-disk
+var defaultWaitDuration = time.Hour * 6
+
+const (
+	// parameters of the azure disk csi driver are case insensitive
+	paramKeySKU        = "skuname"
+	paramKeyIOPS       = "diskiopsreadwrite"
+	paramKeyThroughput = "diskmbpsreadwrite"
+
+	provisioningStateSucceeded = "Succeeded"
+	provisioningStateFailed    = "Failed"
+
+	skuUltraSSD    = "UltraSSD_LRS"
+	skuPremiumV2   = "PremiumV2_LRS"
+	defaultDiskSKU = "StandardSSD_LRS"
+
+	// See https://learn.microsoft.com/en-us/azure/virtual-machines/disks-types
+	maxSize = 65536
+	minSize = 1
+)
+
+// DiskAPI is the subset of the Azure managed disks API used to modify a disk.
+type DiskAPI interface {
+	GetDisk(ctx context.Context, id string) (*Disk, error)
+	// UpdateDisk patches the disk, nil or empty fields of the update are not changed.
+	UpdateDisk(ctx context.Context, id string, update *Disk) error
+}
+
+// DiskModifier modifies Azure Managed Disks provisioned by the Azure Disk CSI driver.
+type DiskModifier struct {
+	c DiskAPI
+}
+
+type Disk struct {
+	// ID is the CSI volume handle, i.e. the resource id of the managed disk
+	ID     string
+	SizeGB *int32
+	SKU    string
+	IOPS   *int64
+	// Throughput is in MB/s
+	Throughput *int64
+
+	ProvisioningState string
+}
+
+func NewDiskModifier() delegation.VolumeModifier {
+	return &DiskModifier{
+		c: newRESTDiskAPI(),
+	}
+}
+
+func (m *DiskModifier) Name() string {
+	return "disk.csi.azure.com"
+}
+
+func (m *DiskModifier) MinWaitDuration() time.Duration {
+	return defaultWaitDuration
+}
+
+func (m *DiskModifier) Validate(spvc, dpvc *corev1.PersistentVolumeClaim, ssc, dsc *storagev1.StorageClass) error {
+	if ssc.Provisioner != dsc.Provisioner {
+		return fmt.Errorf("provisioner should not be changed, now from %s to %s", ssc.Provisioner, dsc.Provisioner)
+	}
+	ssku, dsku := getParam(ssc.Parameters, paramKeySKU), getParam(dsc.Parameters, paramKeySKU)
+	if ssku == "" {
+		ssku = defaultDiskSKU
+	}
+	if dsku == "" {
+		dsku = defaultDiskSKU
+	}
+	// ultra disks and premium ssd v2 can't be converted from or to other sku
+	if ssku != dsku && (isPerformanceConfigurable(ssku) || isPerformanceConfigurable(dsku)) {
+		return fmt.Errorf("sku should not be changed, now from %s to %s", ssku, dsku)
+	}
+	if !isPerformanceConfigurable(dsku) {
+		if getParam(dsc.Parameters, paramKeyIOPS) != "" || getParam(dsc.Parameters, paramKeyThroughput) != "" {
+			return fmt.Errorf("iops and throughput can not be provisioned for sku %s", dsku)
+		}
+	}
+
+	return nil
+}
+
+func (m *DiskModifier) ModifyVolume(ctx context.Context, pvc *corev1.PersistentVolumeClaim, pv *corev1.PersistentVolume, sc *storagev1.StorageClass) ( /*wait*/ bool, error) {
+	if pv == nil {
+		klog.V(4).Infof("Persistent volume is nil, skip modifying PV for %s. This may be caused by no relevant permissions", pvc.Spec.VolumeName)
+		return false, nil
+	}
+
+	desired, err := m.getExpectedDisk(pvc, pv, sc)
+	if err != nil {
+		return false, err
+	}
+
+	actual, err := m.c.GetDisk(ctx, desired.ID)
+	if err != nil {
+		return false, err
+	}
+
+	// last modification is not finished, a failed one will be retried
+	if actual.ProvisioningState != provisioningStateSucceeded && actual.ProvisioningState != provisioningStateFailed {
+		return true, nil
+	}
+
+	update := Disk{}
+	changed := false
+	// disk size can only be increased, shrinking is rejected by the validation of pvc
+	if desired.SizeGB != nil && actual.SizeGB != nil && *desired.SizeGB > *actual.SizeGB {
+		update.SizeGB = desired.SizeGB
+		changed = true
+	}
+	if desired.SKU != "" && !strings.EqualFold(desired.SKU, actual.SKU) {
+		update.SKU = desired.SKU
+		changed = true
+	}
+	if diffInt64(actual.IOPS, desired.IOPS) {
+		update.IOPS = desired.IOPS
+		changed = true
+	}
+	if diffInt64(actual.Throughput, desired.Throughput) {
+		update.Throughput = desired.Throughput
+		changed = true
+	}
+	if !changed && actual.ProvisioningState == provisioningStateSucceeded {
+		return false, nil
+	}
+
+	klog.V(2).Infof("call azure api to update disk for pvc %s/%s", pvc.Namespace, pvc.Name)
+	if err := m.c.UpdateDisk(ctx, desired.ID, &update); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *DiskModifier) getExpectedDisk(pvc *corev1.PersistentVolumeClaim, pv *corev1.PersistentVolume, sc *storagev1.StorageClass) (*Disk, error) {
+	if pv.Spec.CSI == nil {
+		return nil, fmt.Errorf("pv %s is not provisioned by csi driver", pv.Name)
+	}
+	d := Disk{
+		ID: pv.Spec.CSI.VolumeHandle,
+	}
+
+	size, err := getSizeFromPVC(pvc)
+	if err != nil {
+		return nil, err
+	}
+	d.SizeGB = pointer.Int32Ptr(int32(size))
+
+	if sc == nil {
+		return &d, nil
+	}
+	d.SKU = getParam(sc.Parameters, paramKeySKU)
+	if d.IOPS, err = getParamInt64(sc.Parameters, paramKeyIOPS); err != nil {
+		return nil, err
+	}
+	if d.Throughput, err = getParamInt64(sc.Parameters, paramKeyThroughput); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// isPerformanceConfigurable returns whether iops or throughput of the sku can be provisioned.
+func isPerformanceConfigurable(sku string) bool {
+	return strings.EqualFold(sku, skuUltraSSD) || strings.EqualFold(sku, skuPremiumV2)
+}
+
+// If some params are not set, assume they are equal.
+func diffInt64(a, b *int64) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	return *a != *b
+}
+
+func getSizeFromPVC(pvc *corev1.PersistentVolumeClaim) (int64, error) {
+	quantity := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	sizeBytes := quantity.ScaledValue(0)
+	// round up to GiB as the csi driver does
+	size := (sizeBytes + 1<<30 - 1) >> 30
+
+	if size < minSize || size > maxSize {
+		return 0, fmt.Errorf("invalid storage size: %v", &quantity)
+	}
+	return size, nil
+}
+
+// getParam gets the value of a storage class parameter by case insensitive key.
+func getParam(params map[string]string, key string) string {
+	for k, v := range params {
+		if strings.EqualFold(k, key) {
+			return v
+		}
+	}
+	return ""
+}
+
+func getParamInt64(params map[string]string, key string) (*int64, error) {
+	str := getParam(params, key)
+	if str == "" {
+		return nil, nil
+	}
+	param, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse %v param in storage class: %v", key, err)
+	}
+
+	return pointer.Int64Ptr(param), nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+)
+
+func newTestPVC(size string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse(size),
+				},
+			},
+		},
+	}
+}
+
+func newTestPV(volId string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{
+					VolumeHandle: volId,
+				},
+			},
+		},
+	}
+}
+
+func newTestStorageClass(sku, iops, throughput string) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		Provisioner: "disk.csi.azure.com",
+		Parameters: map[string]string{
+			"skuName":           sku,
+			"DiskIOPSReadWrite": iops,
+			"DiskMBpsReadWrite": throughput,
+		},
+	}
+}
+
+func TestModifyVolume(t *testing.T) {
+	id := "/subscriptions/s/resourceGroups/g/providers/Microsoft.Compute/disks/d"
+
+	cases := []struct {
+		desc  string
+		pvc   *corev1.PersistentVolumeClaim
+		sc    *storagev1.StorageClass
+		state string
+
+		wait     bool
+		hasErr   bool
+		expected Disk
+	}{
+		{
+			desc:     "disk is not changed",
+			pvc:      newTestPVC("10Gi"),
+			sc:       newTestStorageClass("PremiumV2_LRS", "3000", "125"),
+			expected: Disk{ID: id, SizeGB: pointer.Int32Ptr(10), SKU: skuPremiumV2, IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(125)},
+		},
+		{
+			desc:     "disk is updating",
+			pvc:      newTestPVC("20Gi"),
+			sc:       newTestStorageClass("PremiumV2_LRS", "3000", "125"),
+			state:    "Updating",
+			wait:     true,
+			expected: Disk{ID: id, SizeGB: pointer.Int32Ptr(10), SKU: skuPremiumV2, IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(125)},
+		},
+		{
+			desc:     "disk size, iops and throughput are changed",
+			pvc:      newTestPVC("20Gi"),
+			sc:       newTestStorageClass("PremiumV2_LRS", "5000", "200"),
+			wait:     true,
+			expected: Disk{ID: id, SizeGB: pointer.Int32Ptr(20), SKU: skuPremiumV2, IOPS: pointer.Int64Ptr(5000), Throughput: pointer.Int64Ptr(200)},
+		},
+		{
+			desc:     "last update is failed, retry",
+			pvc:      newTestPVC("10Gi"),
+			sc:       newTestStorageClass("PremiumV2_LRS", "3000", "125"),
+			state:    provisioningStateFailed,
+			wait:     true,
+			expected: Disk{ID: id, SizeGB: pointer.Int32Ptr(10), SKU: skuPremiumV2, IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(125)},
+		},
+		{
+			desc:     "invalid throughput",
+			pvc:      newTestPVC("10Gi"),
+			sc:       newTestStorageClass("PremiumV2_LRS", "3000", "xxx"),
+			hasErr:   true,
+			expected: Disk{ID: id, SizeGB: pointer.Int32Ptr(10), SKU: skuPremiumV2, IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(125)},
+		},
+	}
+
+	g := NewGomegaWithT(t)
+	for _, c := range cases {
+		api := NewFakeDiskAPI(func(string) string {
+			if c.state != "" {
+				return c.state
+			}
+			return provisioningStateSucceeded
+		})
+		api.Disks[id] = &Disk{ID: id, SizeGB: pointer.Int32Ptr(10), SKU: skuPremiumV2, IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(125)}
+		m := &DiskModifier{c: api}
+
+		wait, err := m.ModifyVolume(context.TODO(), c.pvc, newTestPV(id), c.sc)
+		if c.hasErr {
+			g.Expect(err).Should(HaveOccurred(), c.desc)
+		} else {
+			g.Expect(err).Should(Succeed(), c.desc)
+		}
+		g.Expect(wait).Should(Equal(c.wait), c.desc)
+		g.Expect(*api.Disks[id]).Should(Equal(c.expected), c.desc)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+	m := NewFakeDiskModifier(NewFakeDiskAPI(nil))
+	pvc := newTestPVC("10Gi")
+
+	g.Expect(m.Validate(pvc, pvc, newTestStorageClass("Premium_LRS", "", ""), newTestStorageClass("StandardSSD_LRS", "", ""))).Should(Succeed())
+	g.Expect(m.Validate(pvc, pvc, newTestStorageClass("Premium_LRS", "", ""), newTestStorageClass("PremiumV2_LRS", "", ""))).ShouldNot(Succeed())
+	g.Expect(m.Validate(pvc, pvc, newTestStorageClass("Premium_LRS", "", ""), newTestStorageClass("Premium_LRS", "3000", ""))).ShouldNot(Succeed())
+}
@@ -0,0 +1,80 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation"
+)
+
+func NewFakeDiskModifier(api *FakeDiskAPI) delegation.VolumeModifier {
+	return &DiskModifier{
+		c: api,
+	}
+}
+
+// GetProvisioningStateFunc returns the provisioning state of the disk, nil means the disk is always Succeeded.
+type GetProvisioningStateFunc func(id string) string
+
+type FakeDiskAPI struct {
+	Disks map[string]*Disk
+	f     GetProvisioningStateFunc
+}
+
+func NewFakeDiskAPI(f GetProvisioningStateFunc) *FakeDiskAPI {
+	return &FakeDiskAPI{
+		Disks: map[string]*Disk{},
+		f:     f,
+	}
+}
+
+func (a *FakeDiskAPI) GetDisk(ctx context.Context, id string) (*Disk, error) {
+	d, ok := a.Disks[id]
+	if !ok {
+		return nil, fmt.Errorf("disk %s is not found", id)
+	}
+	cp := *d
+	cp.ProvisioningState = provisioningStateSucceeded
+	if a.f != nil {
+		cp.ProvisioningState = a.f(id)
+	}
+
+	return &cp, nil
+}
+
+func (a *FakeDiskAPI) UpdateDisk(ctx context.Context, id string, update *Disk) error {
+	d, ok := a.Disks[id]
+	if !ok {
+		return fmt.Errorf("disk %s is not found", id)
+	}
+	if update.SizeGB != nil {
+		if d.SizeGB != nil && *d.SizeGB > *update.SizeGB {
+			return fmt.Errorf("disk %s can't be shrunk from %d to %d", id, *d.SizeGB, *update.SizeGB)
+		}
+		d.SizeGB = update.SizeGB
+	}
+	if update.SKU != "" {
+		d.SKU = update.SKU
+	}
+	if update.IOPS != nil {
+		d.IOPS = update.IOPS
+	}
+	if update.Throughput != nil {
+		d.Throughput = update.Throughput
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+)
+
+const apiVersion = "2022-07-02"
+
+// restDiskAPI calls the Azure Resource Manager REST API with the credentials from environment.
+type restDiskAPI struct {
+	mu         sync.Mutex
+	endpoint   string
+	authorizer autorest.Authorizer
+}
+
+type restDisk struct {
+	SKU        *restDiskSKU        `json:"sku,omitempty"`
+	Properties *restDiskProperties `json:"properties,omitempty"`
+}
+
+type restDiskSKU struct {
+	Name string `json:"name,omitempty"`
+}
+
+type restDiskProperties struct {
+	DiskSizeGB        *int32 `json:"diskSizeGB,omitempty"`
+	DiskIOPSReadWrite *int64 `json:"diskIOPSReadWrite,omitempty"`
+	DiskMBpsReadWrite *int64 `json:"diskMBpsReadWrite,omitempty"`
+	ProvisioningState string `json:"provisioningState,omitempty"`
+}
+
+func newRESTDiskAPI() DiskAPI {
+	return &restDiskAPI{}
+}
+
+// getAuthorizer initializes the authorizer lazily, the initialization is retried by the next call if it fails.
+func (a *restDiskAPI) getAuthorizer() (string, autorest.Authorizer, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.authorizer != nil {
+		return a.endpoint, a.authorizer, nil
+	}
+	settings, err := auth.GetSettingsFromEnvironment()
+	if err != nil {
+		return "", nil, err
+	}
+	authorizer, err := settings.GetAuthorizer()
+	if err != nil {
+		return "", nil, err
+	}
+	a.endpoint = strings.TrimSuffix(settings.Environment.ResourceManagerEndpoint, "/")
+	a.authorizer = authorizer
+	return a.endpoint, a.authorizer, nil
+}
+
+func (a *restDiskAPI) GetDisk(ctx context.Context, id string) (*Disk, error) {
+	rd := restDisk{}
+	if err := a.do(ctx, http.MethodGet, id, nil, &rd); err != nil {
+		return nil, err
+	}
+
+	d := Disk{
+		ID: id,
+	}
+	if rd.SKU != nil {
+		d.SKU = rd.SKU.Name
+	}
+	if rd.Properties != nil {
+		d.SizeGB = rd.Properties.DiskSizeGB
+		d.IOPS = rd.Properties.DiskIOPSReadWrite
+		d.Throughput = rd.Properties.DiskMBpsReadWrite
+		d.ProvisioningState = rd.Properties.ProvisioningState
+	}
+
+	return &d, nil
+}
+
+func (a *restDiskAPI) UpdateDisk(ctx context.Context, id string, update *Disk) error {
+	rd := restDisk{
+		Properties: &restDiskProperties{
+			DiskSizeGB:        update.SizeGB,
+			DiskIOPSReadWrite: update.IOPS,
+			DiskMBpsReadWrite: update.Throughput,
+		},
+	}
+	if update.SKU != "" {
+		rd.SKU = &restDiskSKU{Name: update.SKU}
+	}
+
+	return a.do(ctx, http.MethodPatch, id, &rd, nil)
+}
+
+func (a *restDiskAPI) do(ctx context.Context, method, id string, in, out interface{}) error {
+	endpoint, authorizer, err := a.getAuthorizer()
+	if err != nil {
+		return fmt.Errorf("can't init azure client: %v", err)
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint+"/"+strings.TrimPrefix(id, "/")+"?api-version="+apiVersion, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s %s failed with status %d: %s", method, id, resp.StatusCode, data)
+	}
+	if out == nil {
+		return nil
+	}
+
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csi
+
+import (
+	"context"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+type pvcAttributesAPI struct {
+	kubeCli kubernetes.Interface
+}
+
+type pvcWithAttributes struct {
+	Spec struct {
+		VolumeAttributesClassName *string `json:"volumeAttributesClassName,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		CurrentVolumeAttributesClassName *string `json:"currentVolumeAttributesClassName,omitempty"`
+		ModifyVolumeStatus               *struct {
+			TargetVolumeAttributesClassName string `json:"targetVolumeAttributesClassName,omitempty"`
+			Status                          string `json:"status"`
+		} `json:"modifyVolumeStatus,omitempty"`
+	} `json:"status"`
+}
+
+// GetVolumeAttributes gets the raw pvc because the vendored types don't have the VolumeAttributesClass fields.
+func (a *pvcAttributesAPI) GetVolumeAttributes(ctx context.Context, ns, name string) (*VolumeAttributes, error) {
+	data, err := a.kubeCli.CoreV1().RESTClient().Get().
+		Namespace(ns).
+		Resource("persistentvolumeclaims").
+		Name(name).
+		DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pvc := pvcWithAttributes{}
+	if err := json.Unmarshal(data, &pvc); err != nil {
+		return nil, err
+	}
+
+	attrs := VolumeAttributes{}
+	if pvc.Spec.VolumeAttributesClassName != nil {
+		attrs.Class = *pvc.Spec.VolumeAttributesClassName
+	}
+	if pvc.Status.CurrentVolumeAttributesClassName != nil {
+		attrs.CurrentClass = *pvc.Status.CurrentVolumeAttributesClassName
+	}
+	if s := pvc.Status.ModifyVolumeStatus; s != nil {
+		attrs.TargetClass = s.TargetVolumeAttributesClassName
+		attrs.Status = s.Status
+	}
+
+	return &attrs, nil
+}
+
+func (a *pvcAttributesAPI) SetVolumeAttributesClass(ctx context.Context, ns, name, class string) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"volumeAttributesClassName": class,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = a.kubeCli.CoreV1().RESTClient().Patch(types.MergePatchType).
+		Namespace(ns).
+		Resource("persistentvolumeclaims").
+		Name(name).
+		Body(data).
+		DoRaw(ctx)
+	return err
+}
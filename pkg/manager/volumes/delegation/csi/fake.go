@@ -0,0 +1,70 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csi
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation"
+)
+
+func NewFakeVACModifier(f GetModifyStatusFunc) delegation.VolumeModifier {
+	return &VACModifier{
+		c: NewFakePVCAttributesAPI(f),
+	}
+}
+
+// GetModifyStatusFunc returns the status of modifying the pvc to the class.
+// Empty status means the modification is finished, nil means all modifications are finished immediately.
+type GetModifyStatusFunc func(ns, name, class string) string
+
+type FakePVCAttributesAPI struct {
+	classes map[string]string
+	f       GetModifyStatusFunc
+}
+
+func NewFakePVCAttributesAPI(f GetModifyStatusFunc) *FakePVCAttributesAPI {
+	return &FakePVCAttributesAPI{
+		classes: map[string]string{},
+		f:       f,
+	}
+}
+
+func (a *FakePVCAttributesAPI) GetVolumeAttributes(ctx context.Context, ns, name string) (*VolumeAttributes, error) {
+	class, ok := a.classes[ns+"/"+name]
+	if !ok {
+		return &VolumeAttributes{}, nil
+	}
+	status := ""
+	if a.f != nil {
+		status = a.f(ns, name, class)
+	}
+	if status == "" {
+		return &VolumeAttributes{
+			Class:        class,
+			CurrentClass: class,
+		}, nil
+	}
+
+	return &VolumeAttributes{
+		Class:       class,
+		TargetClass: class,
+		Status:      status,
+	}, nil
+}
+
+func (a *FakePVCAttributesAPI) SetVolumeAttributesClass(ctx context.Context, ns, name, class string) error {
+	a.classes[ns+"/"+name] = class
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/client-go/kubernetes"
+	klog "k8s.io/klog/v2"
+
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation"
+)
+
+const (
+	// AnnVolumeAttributesClass is set on a StorageClass to modify volumes by the named VolumeAttributesClass
+	// instead of calling the cloud provider api directly. It requires the VolumeAttributesClass feature
+	// of Kubernetes and a CSI driver implementing ControllerModifyVolume.
+	AnnVolumeAttributesClass = "pingcap.com/volume-attributes-class"
+
+	// VACModifierName is the name of VACModifier, it's not a provisioner because the modifier works for all csi drivers
+	VACModifierName = "volume-attributes-class"
+
+	modifyVolumeStatusInfeasible = "Infeasible"
+
+	// the external-resizer retries the modification by itself
+	defaultWaitDuration = time.Minute
+)
+
+// VolumeAttributes is the VolumeAttributesClass related spec and status of a pvc.
+type VolumeAttributes struct {
+	// Class is spec.volumeAttributesClassName
+	Class string
+	// CurrentClass is status.currentVolumeAttributesClassName
+	CurrentClass string
+	// TargetClass is status.modifyVolumeStatus.targetVolumeAttributesClassName
+	TargetClass string
+	// Status is status.modifyVolumeStatus.status, one of Pending, InProgress and Infeasible
+	Status string
+}
+
+// PVCAttributesAPI reads and updates VolumeAttributesClass fields of pvcs,
+// which are not known by the vendored client-go.
+type PVCAttributesAPI interface {
+	GetVolumeAttributes(ctx context.Context, ns, name string) (*VolumeAttributes, error)
+	SetVolumeAttributesClass(ctx context.Context, ns, name, class string) error
+}
+
+// VACModifier modifies volumes by setting the VolumeAttributesClass of pvcs.
+type VACModifier struct {
+	c PVCAttributesAPI
+}
+
+func NewVACModifier(kubeCli kubernetes.Interface) delegation.VolumeModifier {
+	return &VACModifier{
+		c: &pvcAttributesAPI{kubeCli: kubeCli},
+	}
+}
+
+// IsVACStorageClass returns whether volumes of the storage class should be modified by VolumeAttributesClass.
+func IsVACStorageClass(sc *storagev1.StorageClass) bool {
+	return sc != nil && sc.Annotations[AnnVolumeAttributesClass] != ""
+}
+
+func (m *VACModifier) Name() string {
+	return VACModifierName
+}
+
+func (m *VACModifier) MinWaitDuration() time.Duration {
+	return defaultWaitDuration
+}
+
+func (m *VACModifier) Validate(spvc, dpvc *corev1.PersistentVolumeClaim, ssc, dsc *storagev1.StorageClass) error {
+	if ssc.Provisioner != dsc.Provisioner {
+		return fmt.Errorf("provisioner should not be changed, now from %s to %s", ssc.Provisioner, dsc.Provisioner)
+	}
+	if !IsVACStorageClass(dsc) {
+		return fmt.Errorf("annotation %s is not set in storage class %s", AnnVolumeAttributesClass, dsc.Name)
+	}
+
+	return nil
+}
+
+// ModifyVolume sets the VolumeAttributesClass of the pvc and waits until the csi driver applies it.
+// PV is not used so it works without the permission of PV.
+func (m *VACModifier) ModifyVolume(ctx context.Context, pvc *corev1.PersistentVolumeClaim, pv *corev1.PersistentVolume, sc *storagev1.StorageClass) ( /*wait*/ bool, error) {
+	if !IsVACStorageClass(sc) {
+		return false, nil
+	}
+	class := sc.Annotations[AnnVolumeAttributesClass]
+
+	attrs, err := m.c.GetVolumeAttributes(ctx, pvc.Namespace, pvc.Name)
+	if err != nil {
+		return false, err
+	}
+
+	if attrs.Class != class {
+		klog.V(2).Infof("set volume attributes class of pvc %s/%s from %q to %q", pvc.Namespace, pvc.Name, attrs.Class, class)
+		if err := m.c.SetVolumeAttributesClass(ctx, pvc.Namespace, pvc.Name, class); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	if attrs.TargetClass == class && attrs.Status == modifyVolumeStatusInfeasible {
+		return false, fmt.Errorf("volume attributes class %s is infeasible for pvc %s/%s", class, pvc.Namespace, pvc.Name)
+	}
+
+	if attrs.CurrentClass != class {
+		return true, nil
+	}
+
+	return false, nil
+}
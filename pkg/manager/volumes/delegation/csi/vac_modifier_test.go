@@ -0,0 +1,105 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csi
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestStorageClass(class string) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "sc",
+			Annotations: map[string]string{
+				AnnVolumeAttributesClass: class,
+			},
+		},
+		Provisioner: "ebs.csi.aws.com",
+	}
+}
+
+func TestModifyVolume(t *testing.T) {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "pvc",
+		},
+	}
+
+	cases := []struct {
+		desc   string
+		status string
+
+		wait   bool
+		hasErr bool
+	}{
+		{
+			desc: "modification is finished",
+		},
+		{
+			desc:   "modification is in progress",
+			status: "InProgress",
+			wait:   true,
+		},
+		{
+			desc:   "modification is infeasible",
+			status: modifyVolumeStatusInfeasible,
+			hasErr: true,
+		},
+	}
+
+	g := NewGomegaWithT(t)
+	for _, c := range cases {
+		api := NewFakePVCAttributesAPI(func(ns, name, class string) string {
+			return c.status
+		})
+		m := &VACModifier{c: api}
+
+		// first call sets the class
+		wait, err := m.ModifyVolume(context.TODO(), pvc, nil, newTestStorageClass("fast"))
+		g.Expect(err).Should(Succeed(), c.desc)
+		g.Expect(wait).Should(BeTrue(), c.desc)
+
+		wait, err = m.ModifyVolume(context.TODO(), pvc, nil, newTestStorageClass("fast"))
+		if c.hasErr {
+			g.Expect(err).Should(HaveOccurred(), c.desc)
+		} else {
+			g.Expect(err).Should(Succeed(), c.desc)
+		}
+		g.Expect(wait).Should(Equal(c.wait), c.desc)
+
+		attrs, err := api.GetVolumeAttributes(context.TODO(), pvc.Namespace, pvc.Name)
+		g.Expect(err).Should(Succeed(), c.desc)
+		g.Expect(attrs.Class).Should(Equal("fast"), c.desc)
+	}
+
+	// storage class without the annotation is skipped
+	wait, err := NewFakeVACModifier(nil).ModifyVolume(context.TODO(), pvc, nil, &storagev1.StorageClass{})
+	g.Expect(err).Should(Succeed())
+	g.Expect(wait).Should(BeFalse())
+}
+
+func TestValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+	m := NewFakeVACModifier(nil)
+
+	g.Expect(m.Validate(nil, nil, newTestStorageClass("slow"), newTestStorageClass("fast"))).Should(Succeed())
+	g.Expect(m.Validate(nil, nil, newTestStorageClass("slow"), newTestStorageClass(""))).ShouldNot(Succeed())
+}
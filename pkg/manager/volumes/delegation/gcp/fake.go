@@ -0,0 +1,84 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation"
+)
+
+func NewFakePDModifier(api *FakeDiskAPI) delegation.VolumeModifier {
+	return &PDModifier{
+		c: api,
+	}
+}
+
+// GetDiskStatusFunc returns the status of the disk, nil means the disk is always READY.
+type GetDiskStatusFunc func(id string) string
+
+type FakeDiskAPI struct {
+	Disks map[string]*Disk
+	f     GetDiskStatusFunc
+}
+
+func NewFakeDiskAPI(f GetDiskStatusFunc) *FakeDiskAPI {
+	return &FakeDiskAPI{
+		Disks: map[string]*Disk{},
+		f:     f,
+	}
+}
+
+func (a *FakeDiskAPI) GetDisk(ctx context.Context, id string) (*Disk, error) {
+	d, ok := a.Disks[id]
+	if !ok {
+		return nil, fmt.Errorf("disk %s is not found", id)
+	}
+	cp := *d
+	cp.Status = diskStatusReady
+	if a.f != nil {
+		cp.Status = a.f(id)
+	}
+
+	return &cp, nil
+}
+
+func (a *FakeDiskAPI) ResizeDisk(ctx context.Context, id string, sizeGb int64) error {
+	d, ok := a.Disks[id]
+	if !ok {
+		return fmt.Errorf("disk %s is not found", id)
+	}
+	if d.SizeGb != nil && *d.SizeGb > sizeGb {
+		return fmt.Errorf("disk %s can't be shrunk from %d to %d", id, *d.SizeGb, sizeGb)
+	}
+	d.SizeGb = &sizeGb
+
+	return nil
+}
+
+func (a *FakeDiskAPI) UpdateDisk(ctx context.Context, id string, iops, throughput *int64) error {
+	d, ok := a.Disks[id]
+	if !ok {
+		return fmt.Errorf("disk %s is not found", id)
+	}
+	if iops != nil {
+		d.IOPS = iops
+	}
+	if throughput != nil {
+		d.Throughput = throughput
+	}
+
+	return nil
+}
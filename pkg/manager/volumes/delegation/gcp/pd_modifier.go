@@ -0,0 +1,233 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	klog "k8s.io/klog/v2"
+	"k8s.io/utils/pointer"
+
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation"
+)
+
+// Provisioned IOPS and throughput of a disk can be changed only once every 4 hours.
+// See https://cloud.google.com/compute/docs/disks/modify-hyperdisks
+var defaultWaitDuration = time.Hour * 4
+
+const (
+	paramKeyType       = "type"
+	paramKeyIOPS       = "provisioned-iops-on-create"
+	paramKeyThroughput = "provisioned-throughput-on-create"
+
+	diskStatusReady = "READY"
+
+	// See https://cloud.google.com/compute/docs/disks#disk-types
+	maxSize = 65536
+	minSize = 1
+)
+
+// DiskAPI is the subset of the Compute Engine disks API used to modify a disk.
+type DiskAPI interface {
+	GetDisk(ctx context.Context, id string) (*Disk, error)
+	ResizeDisk(ctx context.Context, id string, sizeGb int64) error
+	// UpdateDisk updates the provisioned IOPS and throughput of the disk, nil fields are not changed.
+	UpdateDisk(ctx context.Context, id string, iops, throughput *int64) error
+}
+
+// PDModifier modifies GCE Persistent Disks and Hyperdisks provisioned by the GCE PD CSI driver.
+type PDModifier struct {
+	c DiskAPI
+}
+
+type Disk struct {
+	// ID is the CSI volume handle, e.g. projects/{project}/zones/{zone}/disks/{name}
+	ID     string
+	SizeGb *int64
+	IOPS   *int64
+	// Throughput is in MiB/s
+	Throughput *int64
+	Type       string
+
+	Status string
+}
+
+func NewPDModifier() delegation.VolumeModifier {
+	return &PDModifier{
+		c: newRESTDiskAPI(),
+	}
+}
+
+func (m *PDModifier) Name() string {
+	return "pd.csi.storage.gke.io"
+}
+
+func (m *PDModifier) MinWaitDuration() time.Duration {
+	return defaultWaitDuration
+}
+
+func (m *PDModifier) Validate(spvc, dpvc *corev1.PersistentVolumeClaim, ssc, dsc *storagev1.StorageClass) error {
+	if ssc.Provisioner != dsc.Provisioner {
+		return fmt.Errorf("provisioner should not be changed, now from %s to %s", ssc.Provisioner, dsc.Provisioner)
+	}
+	// the type of a persistent disk can only be changed by recreating it from a snapshot
+	styp, dtyp := ssc.Parameters[paramKeyType], dsc.Parameters[paramKeyType]
+	if styp != "" && dtyp != "" && styp != dtyp {
+		return fmt.Errorf("disk type should not be changed, now from %s to %s", styp, dtyp)
+	}
+	if dtyp != "" && !isPerformanceConfigurable(dtyp) {
+		if dsc.Parameters[paramKeyIOPS] != "" || dsc.Parameters[paramKeyThroughput] != "" {
+			return fmt.Errorf("iops and throughput can not be provisioned for disk type %s", dtyp)
+		}
+	}
+
+	return nil
+}
+
+func (m *PDModifier) ModifyVolume(ctx context.Context, pvc *corev1.PersistentVolumeClaim, pv *corev1.PersistentVolume, sc *storagev1.StorageClass) ( /*wait*/ bool, error) {
+	if pv == nil {
+		klog.V(4).Infof("Persistent volume is nil, skip modifying PV for %s. This may be caused by no relevant permissions", pvc.Spec.VolumeName)
+		return false, nil
+	}
+
+	desired, err := m.getExpectedDisk(pvc, pv, sc)
+	if err != nil {
+		return false, err
+	}
+
+	actual, err := m.c.GetDisk(ctx, desired.ID)
+	if err != nil {
+		return false, err
+	}
+
+	// last modification is not finished
+	if actual.Status != diskStatusReady {
+		return true, nil
+	}
+
+	// disk size can only be increased, shrinking is rejected by the validation of pvc
+	if desired.SizeGb != nil && actual.SizeGb != nil && *desired.SizeGb > *actual.SizeGb {
+		klog.V(2).Infof("call gcp api to resize disk for pvc %s/%s", pvc.Namespace, pvc.Name)
+		if err := m.c.ResizeDisk(ctx, desired.ID, *desired.SizeGb); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	var iops, throughput *int64
+	if diffInt64(actual.IOPS, desired.IOPS) {
+		iops = desired.IOPS
+	}
+	if diffInt64(actual.Throughput, desired.Throughput) {
+		throughput = desired.Throughput
+	}
+	if iops == nil && throughput == nil {
+		return false, nil
+	}
+
+	klog.V(2).Infof("call gcp api to update disk for pvc %s/%s", pvc.Namespace, pvc.Name)
+	if err := m.c.UpdateDisk(ctx, desired.ID, iops, throughput); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (m *PDModifier) getExpectedDisk(pvc *corev1.PersistentVolumeClaim, pv *corev1.PersistentVolume, sc *storagev1.StorageClass) (*Disk, error) {
+	if pv.Spec.CSI == nil {
+		return nil, fmt.Errorf("pv %s is not provisioned by csi driver", pv.Name)
+	}
+	d := Disk{
+		ID: pv.Spec.CSI.VolumeHandle,
+	}
+
+	size, err := getSizeFromPVC(pvc)
+	if err != nil {
+		return nil, err
+	}
+	d.SizeGb = pointer.Int64Ptr(size)
+
+	if sc == nil {
+		return &d, nil
+	}
+	d.Type = sc.Parameters[paramKeyType]
+	if d.IOPS, err = getParamInt64(sc.Parameters, paramKeyIOPS); err != nil {
+		return nil, err
+	}
+	if d.Throughput, err = getParamMiB(sc.Parameters, paramKeyThroughput); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// isPerformanceConfigurable returns whether iops or throughput of the disk type can be provisioned.
+func isPerformanceConfigurable(typ string) bool {
+	return typ == "pd-extreme" || strings.HasPrefix(typ, "hyperdisk-")
+}
+
+// If some params are not set, assume they are equal.
+func diffInt64(a, b *int64) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	return *a != *b
+}
+
+func getSizeFromPVC(pvc *corev1.PersistentVolumeClaim) (int64, error) {
+	quantity := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	sizeBytes := quantity.ScaledValue(0)
+	// round up to GiB as the csi driver does
+	size := (sizeBytes + 1<<30 - 1) >> 30
+
+	if size < minSize || size > maxSize {
+		return 0, fmt.Errorf("invalid storage size: %v", &quantity)
+	}
+	return size, nil
+}
+
+func getParamInt64(params map[string]string, key string) (*int64, error) {
+	str := params[key]
+	if str == "" {
+		return nil, nil
+	}
+	param, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse %v param in storage class: %v", key, err)
+	}
+
+	return pointer.Int64Ptr(param), nil
+}
+
+// getParamMiB parses a throughput like "250Mi" into MiB/s.
+func getParamMiB(params map[string]string, key string) (*int64, error) {
+	str := params[key]
+	if str == "" {
+		return nil, nil
+	}
+	q, err := resource.ParseQuantity(str)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse %v param in storage class: %v", key, err)
+	}
+
+	return pointer.Int64Ptr(q.Value() >> 20), nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
+)
+
+func newTestPVC(size string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse(size),
+				},
+			},
+		},
+	}
+}
+
+func newTestPV(volId string) *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				CSI: &corev1.CSIPersistentVolumeSource{
+					VolumeHandle: volId,
+				},
+			},
+		},
+	}
+}
+
+func newTestStorageClass(typ, iops, throughput string) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		Provisioner: "pd.csi.storage.gke.io",
+		Parameters: map[string]string{
+			paramKeyType:       typ,
+			paramKeyIOPS:       iops,
+			paramKeyThroughput: throughput,
+		},
+	}
+}
+
+func TestModifyVolume(t *testing.T) {
+	id := "projects/p/zones/z/disks/d"
+
+	cases := []struct {
+		desc   string
+		pvc    *corev1.PersistentVolumeClaim
+		sc     *storagev1.StorageClass
+		status string
+
+		wait     bool
+		hasErr   bool
+		expected Disk
+	}{
+		{
+			desc:     "disk is not changed",
+			pvc:      newTestPVC("10Gi"),
+			sc:       newTestStorageClass("hyperdisk-balanced", "3000", "140Mi"),
+			expected: Disk{ID: id, SizeGb: pointer.Int64Ptr(10), IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(140)},
+		},
+		{
+			desc:     "disk is not ready",
+			pvc:      newTestPVC("20Gi"),
+			sc:       newTestStorageClass("hyperdisk-balanced", "3000", "140Mi"),
+			status:   "RESTORING",
+			wait:     true,
+			expected: Disk{ID: id, SizeGb: pointer.Int64Ptr(10), IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(140)},
+		},
+		{
+			desc:     "disk size is changed",
+			pvc:      newTestPVC("20Gi"),
+			sc:       newTestStorageClass("hyperdisk-balanced", "4000", "140Mi"),
+			wait:     true,
+			expected: Disk{ID: id, SizeGb: pointer.Int64Ptr(20), IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(140)},
+		},
+		{
+			desc:     "iops and throughput are changed",
+			pvc:      newTestPVC("10Gi"),
+			sc:       newTestStorageClass("hyperdisk-balanced", "4000", "200Mi"),
+			wait:     true,
+			expected: Disk{ID: id, SizeGb: pointer.Int64Ptr(10), IOPS: pointer.Int64Ptr(4000), Throughput: pointer.Int64Ptr(200)},
+		},
+		{
+			desc:     "invalid iops",
+			pvc:      newTestPVC("10Gi"),
+			sc:       newTestStorageClass("hyperdisk-balanced", "xxx", "200Mi"),
+			hasErr:   true,
+			expected: Disk{ID: id, SizeGb: pointer.Int64Ptr(10), IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(140)},
+		},
+	}
+
+	g := NewGomegaWithT(t)
+	for _, c := range cases {
+		api := NewFakeDiskAPI(func(string) string {
+			if c.status != "" {
+				return c.status
+			}
+			return diskStatusReady
+		})
+		api.Disks[id] = &Disk{ID: id, SizeGb: pointer.Int64Ptr(10), IOPS: pointer.Int64Ptr(3000), Throughput: pointer.Int64Ptr(140)}
+		m := &PDModifier{c: api}
+
+		wait, err := m.ModifyVolume(context.TODO(), c.pvc, newTestPV(id), c.sc)
+		if c.hasErr {
+			g.Expect(err).Should(HaveOccurred(), c.desc)
+		} else {
+			g.Expect(err).Should(Succeed(), c.desc)
+		}
+		g.Expect(wait).Should(Equal(c.wait), c.desc)
+		g.Expect(*api.Disks[id]).Should(Equal(c.expected), c.desc)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	g := NewGomegaWithT(t)
+	m := NewFakePDModifier(NewFakeDiskAPI(nil))
+	pvc := newTestPVC("10Gi")
+
+	g.Expect(m.Validate(pvc, pvc, newTestStorageClass("hyperdisk-balanced", "3000", ""), newTestStorageClass("hyperdisk-balanced", "4000", "200Mi"))).Should(Succeed())
+	g.Expect(m.Validate(pvc, pvc, newTestStorageClass("pd-ssd", "", ""), newTestStorageClass("pd-balanced", "", ""))).ShouldNot(Succeed())
+	g.Expect(m.Validate(pvc, pvc, newTestStorageClass("pd-ssd", "", ""), newTestStorageClass("pd-ssd", "3000", ""))).ShouldNot(Succeed())
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/oauth2/google"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	computeEndpoint = "https://compute.googleapis.com/compute/v1/"
+	computeScope    = "https://www.googleapis.com/auth/compute"
+)
+
+// restDiskAPI calls the Compute Engine REST API with the application default credentials.
+type restDiskAPI struct {
+	mu     sync.Mutex
+	client *http.Client
+	// newClientFn returns the client with the application default credentials
+	newClientFn func(ctx context.Context) (*http.Client, error)
+}
+
+// restDisk is the json representation of a disk, int64 fields are encoded as strings.
+type restDisk struct {
+	SizeGb                string `json:"sizeGb,omitempty"`
+	ProvisionedIops       string `json:"provisionedIops,omitempty"`
+	ProvisionedThroughput string `json:"provisionedThroughput,omitempty"`
+	Type                  string `json:"type,omitempty"`
+	Status                string `json:"status,omitempty"`
+}
+
+func newRESTDiskAPI() DiskAPI {
+	return &restDiskAPI{
+		newClientFn: func(ctx context.Context) (*http.Client, error) {
+			return google.DefaultClient(ctx, computeScope)
+		},
+	}
+}
+
+// getClient initializes the client lazily, the initialization is retried by the next call if it fails,
+// e.g. the metadata server is not available for a while.
+func (a *restDiskAPI) getClient() (*http.Client, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.client != nil {
+		return a.client, nil
+	}
+	client, err := a.newClientFn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	a.client = client
+	return client, nil
+}
+
+func (a *restDiskAPI) GetDisk(ctx context.Context, id string) (*Disk, error) {
+	rd := restDisk{}
+	if err := a.do(ctx, http.MethodGet, id, nil, &rd); err != nil {
+		return nil, err
+	}
+
+	d := Disk{
+		ID:     id,
+		Type:   path.Base(rd.Type),
+		Status: rd.Status,
+	}
+	var err error
+	if d.SizeGb, err = parseInt64(rd.SizeGb); err != nil {
+		return nil, err
+	}
+	if d.IOPS, err = parseInt64(rd.ProvisionedIops); err != nil {
+		return nil, err
+	}
+	if d.Throughput, err = parseInt64(rd.ProvisionedThroughput); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+func (a *restDiskAPI) ResizeDisk(ctx context.Context, id string, sizeGb int64) error {
+	return a.do(ctx, http.MethodPost, id+"/resize", &restDisk{
+		SizeGb: strconv.FormatInt(sizeGb, 10),
+	}, nil)
+}
+
+func (a *restDiskAPI) UpdateDisk(ctx context.Context, id string, iops, throughput *int64) error {
+	rd := restDisk{}
+	paths := []string{}
+	if iops != nil {
+		rd.ProvisionedIops = strconv.FormatInt(*iops, 10)
+		paths = append(paths, "provisionedIops")
+	}
+	if throughput != nil {
+		rd.ProvisionedThroughput = strconv.FormatInt(*throughput, 10)
+		paths = append(paths, "provisionedThroughput")
+	}
+
+	return a.do(ctx, http.MethodPatch, id+"?updateMask="+url.QueryEscape(strings.Join(paths, ",")), &rd, nil)
+}
+
+func (a *restDiskAPI) do(ctx context.Context, method, uri string, in, out interface{}) error {
+	client, err := a.getClient()
+	if err != nil {
+		return fmt.Errorf("can't init gcp client: %v", err)
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, computeEndpoint+strings.TrimPrefix(uri, "/"), body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s %s failed with status %d: %s", method, uri, resp.StatusCode, data)
+	}
+	if out == nil {
+		return nil
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+func parseInt64(str string) (*int64, error) {
+	if str == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.Int64Ptr(v), nil
+}
@@ -0,0 +1,53 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestRESTDiskAPIGetClient(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	calls := 0
+	expected := &http.Client{}
+	a := &restDiskAPI{
+		newClientFn: func(ctx context.Context) (*http.Client, error) {
+			calls++
+			if calls == 1 {
+				return nil, fmt.Errorf("metadata server is not available")
+			}
+			return expected, nil
+		},
+	}
+
+	// the failure is not cached
+	_, err := a.getClient()
+	g.Expect(err).To(HaveOccurred())
+
+	client, err := a.getClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(client).To(BeIdenticalTo(expected))
+
+	// the client is initialized only once
+	client, err = a.getClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(client).To(BeIdenticalTo(expected))
+	g.Expect(calls).To(Equal(2))
+}
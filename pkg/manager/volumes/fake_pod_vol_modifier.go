@@ -24,6 +24,7 @@ var _ PodVolumeModifier = &FakePodVolumeModifier{}
 type FakePodVolumeModifier struct {
 	ModifyFunc            func(actual []ActualVolume) error
 	ShouldModifyFunc      func(actual []ActualVolume) bool
+	ShouldReplaceFunc     func(actual []ActualVolume) bool
 	GetDesiredVolumesFunc func(tc *v1alpha1.TidbCluster, mt v1alpha1.MemberType) ([]DesiredVolume, error)
 	GetActualVolumesFunc  func(pod *corev1.Pod, vs []DesiredVolume) ([]ActualVolume, error)
 }
@@ -44,6 +45,13 @@ func (pvm *FakePodVolumeModifier) ShouldModify(actual []ActualVolume) bool {
 	return pvm.ShouldModifyFunc(actual)
 }
 
+func (pvm *FakePodVolumeModifier) ShouldReplace(actual []ActualVolume) bool {
+	if pvm.ShouldReplaceFunc == nil {
+		return false
+	}
+	return pvm.ShouldReplaceFunc(actual)
+}
+
 func (pvm *FakePodVolumeModifier) Modify(actual []ActualVolume) error {
 	if pvm.ModifyFunc == nil {
 		return nil
@@ -91,6 +91,12 @@ func (p *podVolModifier) getVolumePhase(vol *ActualVolume) VolumePhase {
 	return VolumePhasePreparing
 }
 
+// needReplace returns whether the volume can't be modified in place and should be migrated by replacing the store.
+// Changing to the default storage class is not supported.
+func needReplace(vol *ActualVolume) bool {
+	return vol.Phase == VolumePhaseCannotModify && vol.Desired != nil && vol.Desired.StorageClass != nil
+}
+
 func isVolumeExpansionSupported(sc *storagev1.StorageClass) bool {
 	if sc.AllowVolumeExpansion == nil {
 		return false
@@ -106,6 +112,9 @@ func (p *podVolModifier) validate(vol *ActualVolume) error {
 		// TODO: support default storage class
 		return ErrChangeDefaultStorageClass
 	}
+	if vol.StorageClass != nil && vol.StorageClass.Provisioner != vol.Desired.StorageClass.Provisioner {
+		return fmt.Errorf("can't change provisioner from %s to %s", vol.StorageClass.Provisioner, vol.Desired.StorageClass.Provisioner)
+	}
 	desired := vol.Desired.Size
 	actual := getStorageSize(vol.PVC.Spec.Resources.Requests)
 	result := desired.Cmp(actual)
@@ -31,6 +31,9 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation/aws"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation/azure"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation/csi"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation/gcp"
 )
 
 type PodVolumeModifier interface {
@@ -39,6 +42,8 @@ type PodVolumeModifier interface {
 
 	ShouldModify(actual []ActualVolume) bool
 	Modify(actual []ActualVolume) error
+	// ShouldReplace returns whether any volume can't be modified in place and should be replaced.
+	ShouldReplace(actual []ActualVolume) bool
 }
 
 type DesiredVolume struct {
@@ -89,13 +94,19 @@ type podVolModifier struct {
 
 func NewPodVolumeModifier(deps *controller.Dependencies) PodVolumeModifier {
 	return &podVolModifier{
-		deps: deps,
-		modifiers: map[string]delegation.VolumeModifier{
-			"ebs.csi.aws.com": aws.NewEBSModifier(deps.AWSConfig),
-		},
+		deps:      deps,
+		modifiers: newVolumeModifiers(aws.NewEBSModifier(deps.AWSConfig), gcp.NewPDModifier(), azure.NewDiskModifier(), csi.NewVACModifier(deps.KubeClientset)),
 	}
 }
 
+func newVolumeModifiers(ms ...delegation.VolumeModifier) map[string]delegation.VolumeModifier {
+	modifiers := make(map[string]delegation.VolumeModifier, len(ms))
+	for _, m := range ms {
+		modifiers[m.Name()] = m
+	}
+	return modifiers
+}
+
 func (p *podVolModifier) ShouldModify(actual []ActualVolume) bool {
 	for i := range actual {
 		vol := &actual[i]
@@ -108,6 +119,16 @@ func (p *podVolModifier) ShouldModify(actual []ActualVolume) bool {
 	return false
 }
 
+func (p *podVolModifier) ShouldReplace(actual []ActualVolume) bool {
+	for i := range actual {
+		if needReplace(&actual[i]) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (p *podVolModifier) Modify(actual []ActualVolume) error {
 	ctx := context.TODO()
 
@@ -250,6 +271,8 @@ func (p *podVolModifier) GetDesiredVolumes(tc *v1alpha1.TidbCluster, mt v1alpha1
 			StorageClass: sc,
 		}
 		desiredVolumes = append(desiredVolumes, d)
+	case v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType:
+		// the PD microservices have no volumes
 	default:
 		return nil, fmt.Errorf("unsupported member type %s", mt)
 	}
@@ -536,7 +559,12 @@ func (p *podVolModifier) modifyVolume(ctx context.Context, vol *ActualVolume) (b
 	return m.ModifyVolume(ctx, pvc, vol.PV, vol.Desired.StorageClass)
 }
 
+// getVolumeModifier returns the modifier of the storage class. Modifying by VolumeAttributesClass
+// takes precedence over calling the cloud provider api of the provisioner.
 func (p *podVolModifier) getVolumeModifier(sc *storagev1.StorageClass) delegation.VolumeModifier {
+	if csi.IsVACStorageClass(sc) {
+		return p.modifiers[csi.VACModifierName]
+	}
 	return p.modifiers[sc.Provisioner]
 }
 
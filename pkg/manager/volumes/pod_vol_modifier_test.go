@@ -29,6 +29,9 @@ import (
 
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation/azure"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation/csi"
+	"github.com/pingcap/tidb-operator/pkg/manager/volumes/delegation/gcp"
 )
 
 func newTestPVCForModify(sc *string, specSize, statusSize string, anno map[string]string) *corev1.PersistentVolumeClaim {
@@ -231,3 +234,101 @@ func TestModify(t *testing.T) {
 		g.Expect(resultPVC).Should(Equal(c.expectedPVC), c.desc)
 	}
 }
+
+func TestModifyByCloudModifiers(t *testing.T) {
+	size := "10Gi"
+	oldSc := "old"
+	newSc := "new"
+	volId := "vol"
+
+	newSC := func(name, provisioner string, params, anno map[string]string) *storagev1.StorageClass {
+		sc := newTestSCForModify(name, provisioner)
+		sc.Parameters = params
+		sc.Annotations = anno
+		return sc
+	}
+
+	gcpAPI := gcp.NewFakeDiskAPI(nil)
+	gcpAPI.Disks[volId] = &gcp.Disk{ID: volId, SizeGb: pointer.Int64Ptr(10), IOPS: pointer.Int64Ptr(3000)}
+	azureAPI := azure.NewFakeDiskAPI(nil)
+	azureAPI.Disks[volId] = &azure.Disk{ID: volId, SizeGB: pointer.Int32Ptr(10), SKU: "PremiumV2_LRS", IOPS: pointer.Int64Ptr(3000)}
+
+	cases := []struct {
+		desc     string
+		modifier delegation.VolumeModifier
+		oldSC    *storagev1.StorageClass
+		newSC    *storagev1.StorageClass
+		check    func(g *GomegaWithT)
+	}{
+		{
+			desc:     "gcp hyperdisk iops is changed",
+			modifier: gcp.NewFakePDModifier(gcpAPI),
+			oldSC:    newSC(oldSc, "pd.csi.storage.gke.io", map[string]string{"type": "hyperdisk-balanced", "provisioned-iops-on-create": "3000"}, nil),
+			newSC:    newSC(newSc, "pd.csi.storage.gke.io", map[string]string{"type": "hyperdisk-balanced", "provisioned-iops-on-create": "5000"}, nil),
+			check: func(g *GomegaWithT) {
+				g.Expect(*gcpAPI.Disks[volId].IOPS).Should(Equal(int64(5000)))
+			},
+		},
+		{
+			desc:     "azure premium ssd v2 iops is changed",
+			modifier: azure.NewFakeDiskModifier(azureAPI),
+			oldSC:    newSC(oldSc, "disk.csi.azure.com", map[string]string{"skuName": "PremiumV2_LRS", "DiskIOPSReadWrite": "3000"}, nil),
+			newSC:    newSC(newSc, "disk.csi.azure.com", map[string]string{"skuName": "PremiumV2_LRS", "DiskIOPSReadWrite": "5000"}, nil),
+			check: func(g *GomegaWithT) {
+				g.Expect(*azureAPI.Disks[volId].IOPS).Should(Equal(int64(5000)))
+			},
+		},
+		{
+			desc:     "volume attributes class is changed",
+			modifier: csi.NewFakeVACModifier(nil),
+			oldSC:    newSC(oldSc, "ebs.csi.aws.com", nil, map[string]string{csi.AnnVolumeAttributesClass: "slow"}),
+			newSC:    newSC(newSc, "ebs.csi.aws.com", nil, map[string]string{csi.AnnVolumeAttributesClass: "fast"}),
+		},
+	}
+
+	g := NewGomegaWithT(t)
+	for _, c := range cases {
+		pvc := newTestPVCForModify(&oldSc, size, size, nil)
+		pv := newTestPVForModify()
+		pv.Spec.CSI = &corev1.CSIPersistentVolumeSource{VolumeHandle: volId}
+		kc := fake.NewSimpleClientset(pvc, pv, c.oldSC, c.newSC)
+
+		pvm := &podVolModifier{
+			deps: &controller.Dependencies{
+				KubeClientset: kc,
+			},
+			modifiers: newVolumeModifiers(c.modifier),
+		}
+
+		actual := ActualVolume{
+			Desired: &DesiredVolume{
+				Name:         "test",
+				Size:         resource.MustParse(size),
+				StorageClass: c.newSC,
+			},
+			PVC:          pvc,
+			PV:           pv,
+			StorageClass: c.oldSC,
+		}
+		actual.Phase = pvm.getVolumePhase(&actual)
+		g.Expect(actual.Phase).Should(Equal(VolumePhasePreparing), c.desc)
+
+		// the first round calls the cloud api and waits for the modification
+		g.Expect(pvm.Modify([]ActualVolume{actual})).ShouldNot(Succeed(), c.desc)
+
+		modified, err := kc.CoreV1().PersistentVolumeClaims(pvc.Namespace).Get(context.TODO(), pvc.Name, metav1.GetOptions{})
+		g.Expect(err).Should(Succeed(), c.desc)
+		actual.PVC = modified
+		actual.Phase = VolumePhaseModifying
+
+		// the second round finds the volume is modified
+		g.Expect(pvm.Modify([]ActualVolume{actual})).Should(Succeed(), c.desc)
+
+		modified, err = kc.CoreV1().PersistentVolumeClaims(pvc.Namespace).Get(context.TODO(), pvc.Name, metav1.GetOptions{})
+		g.Expect(err).Should(Succeed(), c.desc)
+		g.Expect(modified.Annotations[annoKeyPVCStatusStorageClass]).Should(Equal(newSc), c.desc)
+		if c.check != nil {
+			c.check(g)
+		}
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -147,7 +148,7 @@ func (p *pvcModifier) buildContextForTC(tc *v1alpha1.TidbCluster, status v1alpha
 }
 
 func (p *pvcModifier) getPodsOfComponent(tc *v1alpha1.TidbCluster, mt v1alpha1.MemberType) ([]*corev1.Pod, error) {
-	selector, err := p.sf.NewSelector(tc.GetInstanceName(), mt)
+	selector, err := p.sf.NewSelectorForTidbCluster(tc, mt)
 	if err != nil {
 		return nil, err
 	}
@@ -187,10 +188,18 @@ func (p *pvcModifier) modifyVolumes(ctx *componentVolumeContext) error {
 		return fmt.Errorf("component phase is not Normal")
 	}
 
+	if err := p.checkBlockedVolumes(ctx); err != nil {
+		return err
+	}
+
 	if err := p.tryToRecreateSTS(ctx); err != nil {
 		return err
 	}
 
+	if err := p.tryToReplaceVolumes(ctx); err != nil {
+		return err
+	}
+
 	if err := p.tryToModifyPVC(ctx); err != nil {
 		return err
 	}
@@ -198,6 +207,38 @@ func (p *pvcModifier) modifyVolumes(ctx *componentVolumeContext) error {
 	return nil
 }
 
+// checkBlockedVolumes mirrors the volumes which can neither be modified in place nor be replaced
+// into the condition of the component.
+func (p *pvcModifier) checkBlockedVolumes(ctx *componentVolumeContext) error {
+	canReplace := ctx.status.MemberType() == v1alpha1.TiKVMemberType && ctx.tc.Spec.TiKV.VolumeReplace != nil
+
+	var blocked []string
+	for _, pod := range ctx.pods {
+		actual, err := p.pm.GetActualVolumes(pod, ctx.desiredVolumes)
+		if err != nil {
+			return err
+		}
+		for i := range actual {
+			vol := &actual[i]
+			// changing to the default storage class is ignored
+			if !needReplace(vol) || canReplace {
+				continue
+			}
+			blocked = append(blocked, fmt.Sprintf("%s/%s(storage class: %s, size: %s)", pod.Name, vol.Desired.Name,
+				vol.Desired.GetStorageClassName(), vol.Desired.Size.String()))
+		}
+	}
+
+	if len(blocked) == 0 {
+		utils.ClearBlocked(ctx.status, v1alpha1.ComponentConditionVolumeModifyBlocked)
+		return nil
+	}
+
+	utils.RecordBlocked(p.deps.Recorder, ctx.tc, ctx.status, v1alpha1.ComponentConditionVolumeModifyBlocked, v1alpha1.EventReasonModifyVolumeBlocked,
+		fmt.Sprintf("volumes of %s can not be modified: %s", ctx.status.MemberType(), strings.Join(blocked, ", ")))
+	return nil
+}
+
 func (p *pvcModifier) isStatefulSetSynced(ctx *componentVolumeContext, sts *appsv1.StatefulSet) (bool, error) {
 	for _, volTemplate := range sts.Spec.VolumeClaimTemplates {
 		volName := v1alpha1.StorageVolumeName(volTemplate.Name)
@@ -299,6 +340,10 @@ func (p *pvcModifier) tryToModifyPVC(ctx *componentVolumeContext) error {
 			continue
 		}
 
+		if isModifyStarting(actual) {
+			p.deps.Recorder.Event(ctx.tc, corev1.EventTypeNormal, v1alpha1.EventReasonModifyVolume,
+				fmt.Sprintf("modifying volumes of %s pod %s", ctx.status.MemberType(), pod.Name))
+		}
 		if err := p.pm.Modify(actual); err != nil {
 			return err
 		}
@@ -314,6 +359,15 @@ func (p *pvcModifier) tryToModifyPVC(ctx *componentVolumeContext) error {
 	return nil
 }
 
+func isModifyStarting(actual []ActualVolume) bool {
+	for i := range actual {
+		if actual[i].Phase == VolumePhasePreparing {
+			return true
+		}
+	}
+	return false
+}
+
 func ensureTiKVLeaderEvictionCondition(tc *v1alpha1.TidbCluster, status metav1.ConditionStatus) bool {
 	if meta.IsStatusConditionPresentAndEqual(tc.Status.TiKV.Conditions, v1alpha1.ConditionTypeLeaderEvicting, status) {
 		return false
@@ -0,0 +1,210 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumes
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	klog "k8s.io/klog/v2"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+)
+
+// tryToReplaceVolumes migrates volumes which can't be modified in place, e.g. shrinking the size
+// or changing to a storage class of another provisioner, by replacing stores one pod at a time:
+//  1. mark the volumes as replacing, then spare stores are added by the member manager
+//  2. delete the store of a pod and wait for it to become tombstone after regions are moved out
+//  3. delete the pvcs and the pod, then the pod is recreated with new volumes and joins as a new store
+//  4. unmark the volumes after all pods are replaced, then the spare stores are removed
+//
+// It returns an error to requeue while the replacement is in progress.
+func (p *pvcModifier) tryToReplaceVolumes(ctx *componentVolumeContext) error {
+	if ctx.status.MemberType() != v1alpha1.TiKVMemberType || ctx.tc.Spec.TiKV.VolumeReplace == nil {
+		return nil
+	}
+	tc := ctx.tc
+
+	var (
+		pods      []*corev1.Pod
+		volNames  = map[v1alpha1.StorageVolumeName]struct{}{}
+		podActual = map[string][]ActualVolume{}
+	)
+	for _, pod := range ctx.pods {
+		actual, err := p.pm.GetActualVolumes(pod, ctx.desiredVolumes)
+		if err != nil {
+			return err
+		}
+		if !p.pm.ShouldReplace(actual) {
+			continue
+		}
+		pods = append(pods, pod)
+		podActual[pod.Name] = actual
+		for i := range actual {
+			if needReplace(&actual[i]) {
+				volNames[actual[i].Desired.Name] = struct{}{}
+			}
+		}
+	}
+
+	volumes := ctx.status.GetVolumes()
+	replacingPod := ""
+	for _, v := range volumes {
+		if v.Replacing && v.ReplacingPod != "" {
+			replacingPod = v.ReplacingPod
+		}
+	}
+
+	// the replaced pod is recreated with new volumes, wait for its new store up
+	if replacingPod != "" && podActual[replacingPod] == nil {
+		if !isStoreOfPodUp(tc, replacingPod) {
+			return controller.RequeueErrorf("wait for new store of tikv %s/%s up", tc.Namespace, replacingPod)
+		}
+		klog.Infof("volumes of tikv %s/%s are replaced", tc.Namespace, replacingPod)
+		for _, v := range volumes {
+			if v.ReplacingPod == replacingPod {
+				v.ReplacingPod = ""
+				v.ReplacedCount++
+			}
+		}
+		replacingPod = ""
+	}
+
+	if len(pods) == 0 {
+		if tc.TiKVVolumeReplacing() {
+			klog.Infof("all volumes of %s are replaced, remove the spare stores", ctx.ComponentID())
+			for _, v := range volumes {
+				v.Replacing = false
+				v.ReplacingPod = ""
+			}
+		}
+		return nil
+	}
+
+	if !tc.TiKVVolumeReplacing() {
+		klog.Infof("volumes of %s can't be modified in place, start to replace stores of %d pods", ctx.ComponentID(), len(pods))
+		for name := range volNames {
+			v, ok := volumes[name]
+			if !ok {
+				continue
+			}
+			v.Replacing = true
+			v.ReplacingPod = ""
+			v.ReplacedCount = 0
+		}
+		return controller.RequeueErrorf("wait for spare stores of %s added before replacing volumes", ctx.ComponentID())
+	}
+
+	pod := pods[0]
+	if replacingPod != "" {
+		for _, candidate := range pods {
+			if candidate.Name == replacingPod {
+				pod = candidate
+			}
+		}
+	} else {
+		// all stores including the spare ones should be up before replacing the next one
+		if !areAllStoresUp(tc) {
+			return controller.RequeueErrorf("wait for all tikv stores of %s up before replacing volumes", ctx.ComponentID())
+		}
+		for _, actual := range podActual[pod.Name] {
+			if v, ok := volumes[actual.Desired.Name]; ok && needReplace(&actual) {
+				v.ReplacingPod = pod.Name
+			}
+		}
+		p.deps.Recorder.Event(tc, corev1.EventTypeNormal, v1alpha1.EventReasonReplaceVolume,
+			fmt.Sprintf("replacing store of tikv pod %s to migrate its volumes", pod.Name))
+	}
+
+	return p.replaceStore(tc, pod, podActual[pod.Name])
+}
+
+func (p *pvcModifier) replaceStore(tc *v1alpha1.TidbCluster, pod *corev1.Pod, actual []ActualVolume) error {
+	ns := tc.Namespace
+
+	// the pod may be recreated before the pvcs are deleted and it's pending with the deleting pvcs
+	for i := range actual {
+		if actual[i].PVC.DeletionTimestamp == nil {
+			continue
+		}
+		if pod.DeletionTimestamp == nil {
+			if err := p.deps.PodControl.DeletePod(tc, pod); err != nil {
+				return err
+			}
+		}
+		return controller.RequeueErrorf("wait for pvc %s/%s deleted", ns, actual[i].PVC.Name)
+	}
+
+	for _, store := range tc.Status.TiKV.Stores {
+		if store.PodName != pod.Name {
+			continue
+		}
+		if store.State != v1alpha1.TiKVStateOffline {
+			id, err := strconv.ParseUint(store.ID, 10, 64)
+			if err != nil {
+				return err
+			}
+			if err := controller.GetPDClient(p.deps.PDControl, tc).DeleteStore(id); err != nil {
+				return fmt.Errorf("delete store %d of tikv %s/%s failed: %v", id, ns, pod.Name, err)
+			}
+			klog.Infof("delete store %d of tikv %s/%s to replace volumes", id, ns, pod.Name)
+		}
+		return controller.RequeueErrorf("wait for store %s of tikv %s/%s tombstone, state: %s", store.ID, ns, pod.Name, store.State)
+	}
+
+	if !isStoreOfPodTombstone(tc, pod) {
+		return controller.RequeueErrorf("wait for store of tikv %s/%s synced", ns, pod.Name)
+	}
+
+	for i := range actual {
+		if err := p.deps.PVCControl.DeletePVC(tc, actual[i].PVC); err != nil {
+			return err
+		}
+	}
+	if err := p.deps.PodControl.DeletePod(tc, pod); err != nil {
+		return err
+	}
+	klog.Infof("delete pod %s/%s and its pvcs to recreate them with new volumes", ns, pod.Name)
+
+	return controller.RequeueErrorf("wait for tikv %s/%s recreated with new volumes", ns, pod.Name)
+}
+
+func isStoreOfPodUp(tc *v1alpha1.TidbCluster, podName string) bool {
+	for _, store := range tc.Status.TiKV.Stores {
+		if store.PodName == podName {
+			return store.State == v1alpha1.TiKVStateUp
+		}
+	}
+	return false
+}
+
+// isStoreOfPodTombstone checks the store id in the label of pod to ignore the tombstone stores replaced before.
+func isStoreOfPodTombstone(tc *v1alpha1.TidbCluster, pod *corev1.Pod) bool {
+	store, ok := tc.Status.TiKV.TombstoneStores[pod.Labels[label.StoreIDLabelKey]]
+	return ok && store.PodName == pod.Name
+}
+
+func areAllStoresUp(tc *v1alpha1.TidbCluster) bool {
+	count := int32(0)
+	for _, store := range tc.Status.TiKV.Stores {
+		if store.State != v1alpha1.TiKVStateUp {
+			return false
+		}
+		count++
+	}
+	return count >= tc.TiKVStsDesiredReplicas()
+}
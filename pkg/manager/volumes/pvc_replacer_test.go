@@ -0,0 +1,162 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package volumes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+)
+
+func TestReplaceVolumes(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	deps := controller.NewFakeDependencies()
+	podIndexer := deps.KubeInformerFactory.Core().V1().Pods().Informer().GetIndexer()
+	pvcIndexer := deps.KubeInformerFactory.Core().V1().PersistentVolumeClaims().Informer().GetIndexer()
+
+	tc := &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tc"},
+		Spec: v1alpha1.TidbClusterSpec{
+			TiKV: &v1alpha1.TiKVSpec{
+				Replicas:      3,
+				VolumeReplace: &v1alpha1.VolumeReplaceSpec{},
+			},
+		},
+		Status: v1alpha1.TidbClusterStatus{
+			TiKV: v1alpha1.TiKVStatus{
+				Phase: v1alpha1.NormalPhase,
+				Volumes: map[v1alpha1.StorageVolumeName]*v1alpha1.StorageVolumeStatus{
+					"tikv": {Name: "tikv"},
+				},
+				Stores:          map[string]v1alpha1.TiKVStore{},
+				TombstoneStores: map[string]v1alpha1.TiKVStore{},
+			},
+		},
+	}
+
+	// pods with old volumes
+	old := map[string]bool{"tc-tikv-0": true, "tc-tikv-1": true, "tc-tikv-2": true}
+	addPod := func(ordinal int, storeID string) *corev1.Pod {
+		name := fmt.Sprintf("tc-tikv-%d", ordinal)
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      name,
+			Labels:    map[string]string{label.StoreIDLabelKey: storeID},
+		}}
+		pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tikv-" + name}}
+		g.Expect(podIndexer.Add(pod)).Should(Succeed())
+		g.Expect(pvcIndexer.Add(pvc)).Should(Succeed())
+		tc.Status.TiKV.Stores[storeID] = v1alpha1.TiKVStore{ID: storeID, PodName: name, State: v1alpha1.TiKVStateUp}
+		return pod
+	}
+	pods := []*corev1.Pod{addPod(0, "1"), addPod(1, "2"), addPod(2, "3")}
+
+	pvm := &FakePodVolumeModifier{
+		GetActualVolumesFunc: func(pod *corev1.Pod, vs []DesiredVolume) ([]ActualVolume, error) {
+			vol := ActualVolume{
+				Desired: &vs[0],
+				PVC:     &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "tikv-" + pod.Name}},
+				Phase:   VolumePhaseModified,
+			}
+			if old[pod.Name] {
+				vol.Phase = VolumePhaseCannotModify
+			}
+			return []ActualVolume{vol}, nil
+		},
+		ShouldReplaceFunc: func(actual []ActualVolume) bool {
+			return needReplace(&actual[0])
+		},
+	}
+	p := &pvcModifier{deps: deps, pm: pvm}
+
+	pdClient := controller.NewFakePDClient(deps.PDControl.(*pdapi.FakePDControl), tc)
+	deleted := []uint64{}
+	pdClient.AddReaction(pdapi.DeleteStoreActionType, func(action *pdapi.Action) (interface{}, error) {
+		deleted = append(deleted, action.ID)
+		return nil, nil
+	})
+
+	sync := func() error {
+		ctx := &componentVolumeContext{
+			Context:        context.TODO(),
+			tc:             tc,
+			status:         &tc.Status.TiKV,
+			pods:           pods,
+			desiredVolumes: []DesiredVolume{{Name: "tikv", StorageClass: newTestSCForModify("new", "new")}},
+		}
+		return p.tryToReplaceVolumes(ctx)
+	}
+	vol := tc.Status.TiKV.Volumes["tikv"]
+
+	// start replacing and add a spare store
+	g.Expect(sync()).ShouldNot(Succeed())
+	g.Expect(vol.Replacing).Should(BeTrue())
+	g.Expect(tc.TiKVStsDesiredReplicas()).Should(Equal(int32(4)))
+
+	// wait for the spare store up
+	g.Expect(sync()).ShouldNot(Succeed())
+	g.Expect(vol.ReplacingPod).Should(BeEmpty())
+	pods = append(pods, addPod(3, "4"))
+
+	// delete the store of the first pod
+	g.Expect(sync()).ShouldNot(Succeed())
+	g.Expect(vol.ReplacingPod).Should(Equal("tc-tikv-0"))
+	g.Expect(deleted).Should(Equal([]uint64{1}))
+
+	// the store is offline
+	tc.Status.TiKV.Stores["1"] = v1alpha1.TiKVStore{ID: "1", PodName: "tc-tikv-0", State: v1alpha1.TiKVStateOffline}
+	g.Expect(sync()).ShouldNot(Succeed())
+	g.Expect(deleted).Should(Equal([]uint64{1}))
+
+	// the store is tombstone, delete the pod and pvc
+	tc.Status.TiKV.TombstoneStores["1"] = tc.Status.TiKV.Stores["1"]
+	delete(tc.Status.TiKV.Stores, "1")
+	g.Expect(sync()).ShouldNot(Succeed())
+	_, err := deps.PodLister.Pods("ns").Get("tc-tikv-0")
+	g.Expect(err).Should(HaveOccurred())
+	_, err = deps.PVCLister.PersistentVolumeClaims("ns").Get("tikv-tc-tikv-0")
+	g.Expect(err).Should(HaveOccurred())
+
+	// the pod is recreated with new volumes but the new store is not up
+	old["tc-tikv-0"] = false
+	pods[0] = addPod(0, "5")
+	tc.Status.TiKV.Stores["5"] = v1alpha1.TiKVStore{ID: "5", PodName: "tc-tikv-0", State: v1alpha1.TiKVStateDown}
+	g.Expect(sync()).ShouldNot(Succeed())
+	g.Expect(vol.ReplacedCount).Should(Equal(0))
+
+	// the new store is up, replace the next pod
+	tc.Status.TiKV.Stores["5"] = v1alpha1.TiKVStore{ID: "5", PodName: "tc-tikv-0", State: v1alpha1.TiKVStateUp}
+	g.Expect(sync()).ShouldNot(Succeed())
+	g.Expect(vol.ReplacedCount).Should(Equal(1))
+	g.Expect(vol.ReplacingPod).Should(Equal("tc-tikv-1"))
+	g.Expect(deleted).Should(Equal([]uint64{1, 2}))
+
+	// all pods are replaced, remove the spare store
+	old["tc-tikv-1"] = false
+	old["tc-tikv-2"] = false
+	g.Expect(sync()).Should(Succeed())
+	g.Expect(vol.Replacing).Should(BeFalse())
+	g.Expect(vol.ReplacedCount).Should(Equal(2))
+	g.Expect(tc.TiKVStsDesiredReplicas()).Should(Equal(int32(3)))
+}
@@ -40,6 +40,38 @@ func (sf *selectorFactory) NewSelector(instance string, mt v1alpha1.MemberType)
 	return selector.Add(*r), nil
 }
 
+// NewSelectorForTidbCluster returns the selector of pods of the component, tc may be a view of a TiKV group
+// or of TiFlash compute nodes.
+// Pods of TiKV groups are excluded from `spec.tikv` and TiFlash compute nodes are excluded from `spec.tiflash`.
+func (sf *selectorFactory) NewSelectorForTidbCluster(tc *v1alpha1.TidbCluster, mt v1alpha1.MemberType) (labels.Selector, error) {
+	selector, err := sf.NewSelector(tc.GetInstanceName(), mt)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *labels.Requirement
+	switch mt {
+	case v1alpha1.TiKVMemberType:
+		if group := tc.TiKVGroupName(); group != "" {
+			r, err = labels.NewRequirement(label.TiKVGroupLabelKey, selection.Equals, []string{group})
+		} else {
+			r, err = labels.NewRequirement(label.TiKVGroupLabelKey, selection.DoesNotExist, nil)
+		}
+	case v1alpha1.TiFlashMemberType:
+		if tc.IsTiFlashCompute() {
+			r, err = labels.NewRequirement(label.TiFlashRoleLabelKey, selection.Equals, []string{label.TiFlashComputeRoleVal})
+		} else {
+			r, err = labels.NewRequirement(label.TiFlashRoleLabelKey, selection.DoesNotExist, nil)
+		}
+	default:
+		return selector, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return selector.Add(*r), nil
+}
+
 // pd => pd
 // tidb => tidb
 // tikv => tikv
@@ -53,6 +85,8 @@ func convertMemberTypeToLabelVal(mt v1alpha1.MemberType) string {
 func NewSelectorFactory() (*selectorFactory, error) {
 	mts := []v1alpha1.MemberType{
 		v1alpha1.PDMemberType,
+		v1alpha1.PDMSTSOMemberType,
+		v1alpha1.PDMSSchedulingMemberType,
 		v1alpha1.TiProxyMemberType,
 		v1alpha1.TiDBMemberType,
 		v1alpha1.TiKVMemberType,
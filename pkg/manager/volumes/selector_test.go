@@ -87,3 +87,37 @@ func TestNewSelector(t *testing.T) {
 		g.Expect(s.String()).Should(Equal(c.expected), c.desc)
 	}
 }
+
+func TestNewSelectorForTidbCluster(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &v1alpha1.TidbCluster{
+		Spec: v1alpha1.TidbClusterSpec{
+			TiKV:       &v1alpha1.TiKVSpec{},
+			TiKVGroups: []v1alpha1.TiKVGroupSpec{{Name: "g1"}},
+		},
+	}
+	tc.Name = "aaa"
+	sf := MustNewSelectorFactory()
+
+	s, err := sf.NewSelectorForTidbCluster(tc, v1alpha1.PDMemberType)
+	g.Expect(err).Should(Succeed())
+	g.Expect(s.String()).Should(Equal("app.kubernetes.io/component=pd,app.kubernetes.io/instance=aaa,app.kubernetes.io/managed-by=tidb-operator,app.kubernetes.io/name=tidb-cluster"))
+
+	s, err = sf.NewSelectorForTidbCluster(tc, v1alpha1.TiKVMemberType)
+	g.Expect(err).Should(Succeed())
+	g.Expect(s.String()).Should(Equal("app.kubernetes.io/component=tikv,app.kubernetes.io/instance=aaa,app.kubernetes.io/managed-by=tidb-operator,app.kubernetes.io/name=tidb-cluster,!tidb.pingcap.com/tikv-group"))
+
+	s, err = sf.NewSelectorForTidbCluster(tc.TiKVGroupView("g1"), v1alpha1.TiKVMemberType)
+	g.Expect(err).Should(Succeed())
+	g.Expect(s.String()).Should(Equal("app.kubernetes.io/component=tikv,app.kubernetes.io/instance=aaa,app.kubernetes.io/managed-by=tidb-operator,app.kubernetes.io/name=tidb-cluster,tidb.pingcap.com/tikv-group=g1"))
+
+	tc.Spec.TiFlash = &v1alpha1.TiFlashSpec{Disaggregated: &v1alpha1.TiFlashDisaggregatedSpec{}}
+	s, err = sf.NewSelectorForTidbCluster(tc, v1alpha1.TiFlashMemberType)
+	g.Expect(err).Should(Succeed())
+	g.Expect(s.String()).Should(Equal("app.kubernetes.io/component=tiflash,app.kubernetes.io/instance=aaa,app.kubernetes.io/managed-by=tidb-operator,app.kubernetes.io/name=tidb-cluster,!tidb.pingcap.com/tiflash-role"))
+
+	s, err = sf.NewSelectorForTidbCluster(tc.TiFlashComputeView(), v1alpha1.TiFlashMemberType)
+	g.Expect(err).Should(Succeed())
+	g.Expect(s.String()).Should(Equal("app.kubernetes.io/component=tiflash,app.kubernetes.io/instance=aaa,app.kubernetes.io/managed-by=tidb-operator,app.kubernetes.io/name=tidb-cluster,tidb.pingcap.com/tiflash-role=compute"))
+}
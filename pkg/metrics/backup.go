@@ -0,0 +1,52 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	BackupDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "backup",
+			Name:      "duration_seconds",
+			Help:      "Time spent by the completed Backup",
+		}, []string{LabelNamespace, LabelName, LabelMode})
+
+	BackupSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "backup",
+			Name:      "size_bytes",
+			Help:      "Data size of the completed Backup",
+		}, []string{LabelNamespace, LabelName, LabelMode})
+
+	RestoreDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "restore",
+			Name:      "duration_seconds",
+			Help:      "Time spent by the completed Restore",
+		}, []string{LabelNamespace, LabelName, LabelMode})
+
+	LogBackupCheckpointLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "backup",
+			Name:      "log_checkpoint_lag_seconds",
+			Help:      "Lag between now and the checkpoint ts of the running log Backup",
+		}, []string{LabelNamespace, LabelName})
+)
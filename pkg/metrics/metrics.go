@@ -20,6 +20,17 @@ import (
 // RegisterMetrics registers all metrics of tidb-operator.
 func RegisterMetrics() {
 	prometheus.MustRegister(ClusterSpecReplicas)
+	prometheus.MustRegister(ClusterCertificateExpiry)
+	prometheus.MustRegister(ClusterComponentPhase)
+	prometheus.MustRegister(ClusterComponentPhaseDuration)
+	prometheus.MustRegister(ClusterFailureMembers)
+	prometheus.MustRegister(ClusterUpgradeStepDuration)
+	prometheus.MustRegister(ClusterLeaderEvictionDuration)
+	prometheus.MustRegister(ClusterVolumeCount)
+	prometheus.MustRegister(BackupDuration)
+	prometheus.MustRegister(BackupSize)
+	prometheus.MustRegister(RestoreDuration)
+	prometheus.MustRegister(LogBackupCheckpointLag)
 }
 
 // Label constants.
@@ -27,6 +38,12 @@ const (
 	LabelNamespace = "namespace"
 	LabelName      = "name"
 	LabelComponent = "component"
+	LabelSecret    = "secret"
+	LabelPhase     = "phase"
+	LabelStep      = "step"
+	LabelVolume    = "volume"
+	LabelState     = "state"
+	LabelMode      = "mode"
 )
 
 var (
@@ -14,6 +14,10 @@
 package metrics
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -25,4 +29,167 @@ var (
 			Name:      "spec_replicas",
 			Help:      "Desired replicas of each component in TidbCluster",
 		}, []string{LabelNamespace, LabelName, LabelComponent})
+
+	ClusterCertificateExpiry = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "certificate_expiry_timestamp_seconds",
+			Help:      "Expiry time of the certificates issued by tidb-operator for TidbCluster",
+		}, []string{LabelNamespace, LabelName, LabelComponent, LabelSecret})
+
+	ClusterComponentPhase = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "component_phase",
+			Help:      "Phase of each component in TidbCluster, 1 for the current phase and 0 for others",
+		}, []string{LabelNamespace, LabelName, LabelComponent, LabelPhase})
+
+	ClusterComponentPhaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "component_phase_duration_seconds",
+			Help:      "Time spent by each component in TidbCluster in a phase before transitioning to another one",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 18),
+		}, []string{LabelNamespace, LabelName, LabelComponent, LabelPhase})
+
+	ClusterFailureMembers = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "failure_members",
+			Help:      "Count of failure members or stores of each component in TidbCluster",
+		}, []string{LabelNamespace, LabelName, LabelComponent})
+
+	ClusterUpgradeStepDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "upgrade_step_duration_seconds",
+			Help:      "Time spent in each step of upgrading a pod of TidbCluster",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 16),
+		}, []string{LabelNamespace, LabelName, LabelComponent, LabelStep})
+
+	ClusterLeaderEvictionDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "leader_eviction_duration_seconds",
+			Help:      "Time spent to evict leaders from a store of TidbCluster",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 16),
+		}, []string{LabelNamespace, LabelName, LabelComponent})
+
+	ClusterVolumeCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "tidb_operator",
+			Subsystem: "cluster",
+			Name:      "volume_count",
+			Help:      "Count of volumes of each component in TidbCluster by state, one of bound, modified and replaced",
+		}, []string{LabelNamespace, LabelName, LabelComponent, LabelVolume, LabelState})
 )
+
+// clusterGauges records the label values of gauges set for each TidbCluster,
+// so that they can be deleted after the TidbCluster is deleted.
+// labelDeleter is implemented by all metric vectors.
+type labelDeleter interface {
+	DeleteLabelValues(lvs ...string) bool
+}
+
+var clusterSeries = struct {
+	sync.Mutex
+	series map[string]map[labelDeleter]map[string][]string
+}{series: map[string]map[labelDeleter]map[string][]string{}}
+
+// SetClusterGauge sets the gauge of a TidbCluster, the first two label values must be the namespace and name.
+func SetClusterGauge(g *prometheus.GaugeVec, value float64, lvs ...string) {
+	g.WithLabelValues(lvs...).Set(value)
+	trackClusterSeries(g, lvs)
+}
+
+// ObserveClusterHistogram observes the histogram of a TidbCluster, the first two label values must be the namespace and name.
+func ObserveClusterHistogram(h *prometheus.HistogramVec, value float64, lvs ...string) {
+	h.WithLabelValues(lvs...).Observe(value)
+	trackClusterSeries(h, lvs)
+}
+
+func trackClusterSeries(m labelDeleter, lvs []string) {
+	key := lvs[0] + "/" + lvs[1]
+	clusterSeries.Lock()
+	defer clusterSeries.Unlock()
+	if clusterSeries.series[key] == nil {
+		clusterSeries.series[key] = map[labelDeleter]map[string][]string{}
+	}
+	if clusterSeries.series[key][m] == nil {
+		clusterSeries.series[key][m] = map[string][]string{}
+	}
+	clusterSeries.series[key][m][fmt.Sprint(lvs)] = lvs
+}
+
+// DeleteClusterMetrics deletes the series recorded for a deleted TidbCluster.
+func DeleteClusterMetrics(ns, name string) {
+	key := ns + "/" + name
+	clusterSeries.Lock()
+	defer clusterSeries.Unlock()
+	for m, series := range clusterSeries.series[key] {
+		for _, lvs := range series {
+			m.DeleteLabelValues(lvs...)
+		}
+	}
+	delete(clusterSeries.series, key)
+	phaseTracker.forget(key)
+}
+
+// phaseTracker records the last observed phase of each component and when it's entered.
+var phaseTracker = &tracker{records: map[string]map[string]phaseRecord{}}
+
+type tracker struct {
+	sync.Mutex
+	records map[string]map[string]phaseRecord
+}
+
+type phaseRecord struct {
+	phase string
+	since time.Time
+}
+
+// observe returns the last phase and how long it lasts if the phase of the component is changed.
+func (t *tracker) observe(key, component, phase string, now time.Time) (string, time.Duration, bool) {
+	t.Lock()
+	defer t.Unlock()
+	if t.records[key] == nil {
+		t.records[key] = map[string]phaseRecord{}
+	}
+	last, ok := t.records[key][component]
+	if ok && last.phase == phase {
+		return "", 0, false
+	}
+	t.records[key][component] = phaseRecord{phase: phase, since: now}
+	if !ok {
+		return "", 0, false
+	}
+	return last.phase, now.Sub(last.since), true
+}
+
+func (t *tracker) forget(key string) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.records, key)
+}
+
+// ObserveComponentPhase sets the phase of a component and observes the duration of the last phase
+// if it's changed. The duration of the first observed phase is unknown after the operator restarts, so it's ignored.
+func ObserveComponentPhase(ns, name, component, phase string, allPhases []string) {
+	for _, p := range allPhases {
+		value := 0.0
+		if p == phase {
+			value = 1
+		}
+		SetClusterGauge(ClusterComponentPhase, value, ns, name, component, p)
+	}
+
+	if last, d, changed := phaseTracker.observe(ns+"/"+name, component, phase, time.Now()); changed {
+		ObserveClusterHistogram(ClusterComponentPhaseDuration, d.Seconds(), ns, name, component, last)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestPhaseTracker(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tr := &tracker{records: map[string]map[string]phaseRecord{}}
+	now := time.Now()
+
+	_, _, changed := tr.observe("ns/tc", "tikv", "Normal", now)
+	g.Expect(changed).To(BeFalse())
+	_, _, changed = tr.observe("ns/tc", "tikv", "Normal", now.Add(time.Minute))
+	g.Expect(changed).To(BeFalse())
+
+	last, d, changed := tr.observe("ns/tc", "tikv", "Upgrade", now.Add(2*time.Minute))
+	g.Expect(changed).To(BeTrue())
+	g.Expect(last).To(Equal("Normal"))
+	g.Expect(d).To(Equal(2 * time.Minute))
+
+	tr.forget("ns/tc")
+	_, _, changed = tr.observe("ns/tc", "tikv", "Normal", now)
+	g.Expect(changed).To(BeFalse())
+}
+
+func TestDeleteClusterMetrics(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	phases := []string{"Normal", "Upgrade"}
+	ObserveComponentPhase("ns", "tc1", "pd", "Normal", phases)
+	ObserveComponentPhase("ns", "tc1", "pd", "Upgrade", phases)
+	ObserveComponentPhase("ns", "tc2", "pd", "Normal", phases)
+	SetClusterGauge(ClusterFailureMembers, 1, "ns", "tc1", "tikv")
+	g.Expect(testutil.ToFloat64(ClusterComponentPhase.WithLabelValues("ns", "tc1", "pd", "Upgrade"))).To(Equal(1.0))
+	g.Expect(testutil.ToFloat64(ClusterComponentPhase.WithLabelValues("ns", "tc1", "pd", "Normal"))).To(Equal(0.0))
+	g.Expect(testutil.CollectAndCount(ClusterComponentPhaseDuration)).To(Equal(1))
+
+	DeleteClusterMetrics("ns", "tc1")
+	g.Expect(testutil.CollectAndCount(ClusterComponentPhase)).To(Equal(2))
+	g.Expect(testutil.CollectAndCount(ClusterFailureMembers)).To(Equal(0))
+	g.Expect(testutil.CollectAndCount(ClusterComponentPhaseDuration)).To(Equal(0))
+}
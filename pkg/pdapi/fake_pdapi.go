@@ -44,6 +44,17 @@ const (
 	TransferPDLeaderActionType                  ActionType = "TransferPDLeader"
 	GetAutoscalingPlansActionType               ActionType = "GetAutoscalingPlans"
 	GetRecoveringMarkActionType                 ActionType = "GetRecoveringMark"
+	GetResourceGroupsActionType                 ActionType = "GetResourceGroups"
+	GetRegionsActionType                        ActionType = "GetRegions"
+	GetRegionActionType                         ActionType = "GetRegion"
+	GetHotRegionsActionType                     ActionType = "GetHotRegions"
+	GetSchedulersActionType                     ActionType = "GetSchedulers"
+	AddSchedulerActionType                      ActionType = "AddScheduler"
+	RemoveSchedulerActionType                   ActionType = "RemoveScheduler"
+	SetConfigActionType                         ActionType = "SetConfig"
+	GetRegionStatsActionType                    ActionType = "GetRegionStats"
+	GetHotStoresActionType                      ActionType = "GetHotStores"
+	GetMSMembersActionType                      ActionType = "GetMSMembers"
 )
 
 type NotFoundReaction struct {
@@ -59,6 +70,8 @@ type Action struct {
 	Name        string
 	Labels      map[string]string
 	Replication PDReplicationConfig
+	Limit       int
+	Config      map[string]interface{}
 }
 
 type Reaction func(action *Action) (interface{}, error)
@@ -281,3 +294,93 @@ func (c *FakePDClient) GetRecoveringMark() (bool, error) {
 
 	return true, nil
 }
+
+func (c *FakePDClient) GetResourceGroups() ([]*ResourceGroup, error) {
+	action := &Action{}
+	result, err := c.fakeAPI(GetResourceGroupsActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.([]*ResourceGroup), nil
+}
+
+func (c *FakePDClient) GetRegions(limit int) (*RegionsInfo, error) {
+	action := &Action{Limit: limit}
+	result, err := c.fakeAPI(GetRegionsActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RegionsInfo), nil
+}
+
+func (c *FakePDClient) GetRegion(regionID uint64) (*RegionInfo, error) {
+	action := &Action{ID: regionID}
+	result, err := c.fakeAPI(GetRegionActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RegionInfo), nil
+}
+
+func (c *FakePDClient) GetHotRegions(typ string) (*StoreHotPeersInfos, error) {
+	action := &Action{Name: typ}
+	result, err := c.fakeAPI(GetHotRegionsActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*StoreHotPeersInfos), nil
+}
+
+func (c *FakePDClient) GetSchedulers() ([]string, error) {
+	action := &Action{}
+	result, err := c.fakeAPI(GetSchedulersActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.([]string), nil
+}
+
+func (c *FakePDClient) AddScheduler(name string, storeID uint64) error {
+	action := &Action{Name: name, ID: storeID}
+	_, err := c.fakeAPI(AddSchedulerActionType, action)
+	return err
+}
+
+func (c *FakePDClient) RemoveScheduler(name string) error {
+	action := &Action{Name: name}
+	_, err := c.fakeAPI(RemoveSchedulerActionType, action)
+	return err
+}
+
+func (c *FakePDClient) SetConfig(config map[string]interface{}) error {
+	action := &Action{Config: config}
+	_, err := c.fakeAPI(SetConfigActionType, action)
+	return err
+}
+
+func (c *FakePDClient) GetRegionStats() (*RegionStats, error) {
+	action := &Action{}
+	result, err := c.fakeAPI(GetRegionStatsActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RegionStats), nil
+}
+
+func (c *FakePDClient) GetHotStores() (*HotStoresStats, error) {
+	action := &Action{}
+	result, err := c.fakeAPI(GetHotStoresActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.(*HotStoresStats), nil
+}
+
+func (c *FakePDClient) GetMSMembers(service string) ([]string, error) {
+	action := &Action{Name: service}
+	result, err := c.fakeAPI(GetMSMembersActionType, action)
+	if err != nil {
+		return nil, err
+	}
+	return result.([]string), nil
+}
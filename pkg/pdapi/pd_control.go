@@ -19,6 +19,7 @@ import (
 	"net/http"
 	"sync"
 
+	"github.com/pingcap/tidb-operator/pkg/tracing"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	"k8s.io/client-go/kubernetes"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
@@ -230,17 +231,26 @@ func (pdc *defaultPDControl) GetPDClient(namespace Namespace, tcName string, tls
 		tlsConfig, err := GetTLSConfig(pdc.secretLister, config.tlsSecretNamespace, config.tlsSecretName)
 		if err != nil {
 			klog.Errorf("Unable to get tls config for tidb cluster %q in %s, pd client may not work: %v", tcName, namespace, err)
-			return &pdClient{url: config.clientURL, httpClient: &http.Client{Timeout: DefaultTimeout}}
+			return withTracing(&pdClient{url: config.clientURL, httpClient: &http.Client{Timeout: DefaultTimeout}}, namespace, tcName)
 		}
 
-		return NewPDClient(config.clientURL, DefaultTimeout, tlsConfig)
+		return withTracing(NewPDClient(config.clientURL, DefaultTimeout, tlsConfig), namespace, tcName)
 	}
 	if _, ok := pdc.pdClients[config.clientKey]; !ok {
-		pdc.pdClients[config.clientKey] = NewPDClient(config.clientURL, DefaultTimeout, nil)
+		pdc.pdClients[config.clientKey] = withTracing(NewPDClient(config.clientURL, DefaultTimeout, nil), namespace, tcName)
 	}
 	return pdc.pdClients[config.clientKey]
 }
 
+// withTracing traces the requests of the client as children of the active span of the TidbCluster
+// while it is being reconciled, see tracing.Reconcile.
+func withTracing(c PDClient, namespace Namespace, tcName string) PDClient {
+	if pc, ok := c.(*pdClient); ok {
+		pc.httpClient.Transport = tracing.NewTransport(pc.httpClient.Transport, tracing.TidbClusterKey(string(namespace), tcName))
+	}
+	return c
+}
+
 func genClientKey(scheme string, namespace Namespace, clusterName string, clusterDomain string) string {
 	if len(clusterDomain) == 0 {
 		return fmt.Sprintf("%s.%s.%s", scheme, clusterName, string(namespace))
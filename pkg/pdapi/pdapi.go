@@ -94,6 +94,29 @@ type PDClient interface {
 	GetAutoscalingPlans(strategy Strategy) ([]Plan, error)
 	// GetRecoveringMark return the pd recovering mark
 	GetRecoveringMark() (bool, error)
+	// GetResourceGroups returns the resource groups with their RU consumption, available since PD v7.1.0
+	GetResourceGroups() ([]*ResourceGroup, error)
+	// GetRegions lists the regions from the start of the key space, all regions are returned if limit is 0
+	GetRegions(limit int) (*RegionsInfo, error)
+	// GetRegion gets a region for a specific region id
+	GetRegion(regionID uint64) (*RegionInfo, error)
+	// GetHotRegions gets the hot regions of the given type, which is "read" or "write"
+	GetHotRegions(typ string) (*StoreHotPeersInfos, error)
+	// GetSchedulers lists the names of all schedulers
+	GetSchedulers() ([]string, error)
+	// AddScheduler adds a scheduler, storeID is only used by the schedulers for a store, e.g. evict-leader-scheduler
+	AddScheduler(name string, storeID uint64) error
+	// RemoveScheduler removes a scheduler by its full name
+	RemoveScheduler(name string) error
+	// SetConfig sets the config items of PD, the key is the full name of the item, e.g. "schedule.leader-schedule-limit"
+	SetConfig(config map[string]interface{}) error
+	// GetRegionStats gets the statistics of all regions, including the leader and peer counts of each store
+	GetRegionStats() (*RegionStats, error)
+	// GetHotStores gets the read and write flow of each store
+	GetHotStores() (*HotStoresStats, error)
+	// GetMSMembers returns the addresses of the members of a PD microservice, e.g. tso or scheduling,
+	// available if PD runs in ms mode
+	GetMSMembers(service string) ([]string, error)
 }
 
 var (
@@ -112,6 +135,14 @@ var (
 	evictLeaderSchedulerConfigPrefix = "pd/api/v1/scheduler-config/evict-leader-scheduler/list"
 	autoscalingPrefix                = "autoscaling"
 	recoveringMarkPrefix             = "pd/api/v1/admin/cluster/markers/snapshot-recovering"
+	resourceGroupsPrefix             = "resource-manager/api/v1/config/groups"
+	regionsPrefix                    = "pd/api/v1/regions"
+	regionsByKeyPrefix               = "pd/api/v1/regions/key"
+	regionByIDPrefix                 = "pd/api/v1/region/id"
+	hotRegionsPrefix                 = "pd/api/v1/hotspot/regions"
+	hotStoresPrefix                  = "pd/api/v1/hotspot/stores"
+	regionStatsPrefix                = "pd/api/v1/stats/region"
+	msMembersPrefix                  = "pd/api/v2/ms/members"
 )
 
 // pdClient is default implementation of PDClient
@@ -252,6 +283,114 @@ type RecoveringMark struct {
 	Mark bool `json:"marked"`
 }
 
+// ResourceGroup is the resource group returned by the resource manager of PD
+type ResourceGroup struct {
+	Name          string                    `json:"name"`
+	RUConsumption *ResourceGroupConsumption `json:"ru_consumption,omitempty"`
+}
+
+// ResourceGroupConsumption is the consumed read and write request units of a resource group
+type ResourceGroupConsumption struct {
+	RRU float64 `json:"r_r_u"`
+	WRU float64 `json:"w_r_u"`
+}
+
+// ServiceRegistryEntry is a member of a PD microservice returned from PD RESTful interface
+type ServiceRegistryEntry struct {
+	Name           string `json:"name"`
+	ServiceAddr    string `json:"service-addr"`
+	Version        string `json:"version"`
+	GitHash        string `json:"git-hash"`
+	DeployPath     string `json:"deploy-path"`
+	StartTimestamp int64  `json:"start-timestamp"`
+}
+
+// PeerInfo is a peer of a region returned from PD RESTful interface
+type PeerInfo struct {
+	ID       uint64 `json:"id"`
+	StoreID  uint64 `json:"store_id"`
+	RoleName string `json:"role_name,omitempty"`
+}
+
+// DownPeerInfo is a down peer of a region returned from PD RESTful interface
+type DownPeerInfo struct {
+	Peer        *PeerInfo `json:"peer"`
+	DownSeconds uint64    `json:"down_seconds"`
+}
+
+// RegionEpoch is the epoch of a region returned from PD RESTful interface
+type RegionEpoch struct {
+	ConfVer uint64 `json:"conf_ver"`
+	Version uint64 `json:"version"`
+}
+
+// RegionInfo is a single region info returned from PD RESTful interface
+type RegionInfo struct {
+	ID              uint64          `json:"id"`
+	StartKey        string          `json:"start_key"`
+	EndKey          string          `json:"end_key"`
+	RegionEpoch     *RegionEpoch    `json:"epoch,omitempty"`
+	Peers           []*PeerInfo     `json:"peers,omitempty"`
+	Leader          *PeerInfo       `json:"leader,omitempty"`
+	DownPeers       []*DownPeerInfo `json:"down_peers,omitempty"`
+	PendingPeers    []*PeerInfo     `json:"pending_peers,omitempty"`
+	WrittenBytes    uint64          `json:"written_bytes"`
+	ReadBytes       uint64          `json:"read_bytes"`
+	WrittenKeys     uint64          `json:"written_keys"`
+	ReadKeys        uint64          `json:"read_keys"`
+	ApproximateSize int64           `json:"approximate_size"`
+	ApproximateKeys int64           `json:"approximate_keys"`
+}
+
+// RegionsInfo is regions info returned from PD RESTful interface
+type RegionsInfo struct {
+	Count   int           `json:"count"`
+	Regions []*RegionInfo `json:"regions"`
+}
+
+// HotPeerStat is the statistics of a hot peer returned from PD RESTful interface
+type HotPeerStat struct {
+	StoreID   uint64  `json:"store_id"`
+	RegionID  uint64  `json:"region_id"`
+	HotDegree int     `json:"hot_degree"`
+	ByteRate  float64 `json:"flow_bytes"`
+	KeyRate   float64 `json:"flow_keys"`
+}
+
+// HotPeersStat is the statistics of the hot peers in a store returned from PD RESTful interface
+type HotPeersStat struct {
+	TotalBytesRate float64       `json:"total_flow_bytes"`
+	TotalKeysRate  float64       `json:"total_flow_keys"`
+	Count          int           `json:"regions_count"`
+	Stats          []HotPeerStat `json:"statistics"`
+}
+
+// StoreHotPeersInfos is the hot regions of all stores returned from PD RESTful interface, the key is the store id
+type StoreHotPeersInfos struct {
+	AsPeer   map[uint64]*HotPeersStat `json:"as_peer"`
+	AsLeader map[uint64]*HotPeersStat `json:"as_leader"`
+}
+
+// RegionStats is the statistics of regions returned from PD RESTful interface, the key of the maps is the store id
+type RegionStats struct {
+	Count            int              `json:"count"`
+	EmptyCount       int              `json:"empty_count"`
+	StorageSize      int64            `json:"storage_size"`
+	StorageKeys      int64            `json:"storage_keys"`
+	StoreLeaderCount map[uint64]int   `json:"store_leader_count"`
+	StorePeerCount   map[uint64]int   `json:"store_peer_count"`
+	StoreLeaderSize  map[uint64]int64 `json:"store_leader_size"`
+	StorePeerSize    map[uint64]int64 `json:"store_peer_size"`
+}
+
+// HotStoresStats is the read and write flow of stores returned from PD RESTful interface, the key of the maps is the store id
+type HotStoresStats struct {
+	BytesWriteStats map[uint64]float64 `json:"bytes-write-rate,omitempty"`
+	BytesReadStats  map[uint64]float64 `json:"bytes-read-rate,omitempty"`
+	KeysWriteStats  map[uint64]float64 `json:"keys-write-rate,omitempty"`
+	KeysReadStats   map[uint64]float64 `json:"keys-read-rate,omitempty"`
+}
+
 func (c *pdClient) GetHealth() (*HealthInfo, error) {
 	apiURL := fmt.Sprintf("%s/%s", c.url, healthPrefix)
 	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
@@ -697,6 +836,20 @@ func (c *pdClient) GetRecoveringMark() (bool, error) {
 	return recoveringMark.Mark, nil
 }
 
+func (c *pdClient) GetResourceGroups() ([]*ResourceGroup, error) {
+	apiURL := fmt.Sprintf("%s/%s?with_stats=true", c.url, resourceGroupsPrefix)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	groups := []*ResourceGroup{}
+	err = json.Unmarshal(body, &groups)
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
 func (c *pdClient) GetPDLeader() (*pdpb.Member, error) {
 	apiURL := fmt.Sprintf("%s/%s", c.url, pdLeaderPrefix)
 	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
@@ -747,6 +900,149 @@ func (c *pdClient) GetAutoscalingPlans(strategy Strategy) ([]Plan, error) {
 	return plans, nil
 }
 
+func (c *pdClient) GetRegions(limit int) (*RegionsInfo, error) {
+	apiURL := fmt.Sprintf("%s/%s", c.url, regionsPrefix)
+	if limit > 0 {
+		apiURL = fmt.Sprintf("%s/%s?key=&limit=%d", c.url, regionsByKeyPrefix, limit)
+	}
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	regions := &RegionsInfo{}
+	err = json.Unmarshal(body, regions)
+	if err != nil {
+		return nil, err
+	}
+	return regions, nil
+}
+
+func (c *pdClient) GetRegion(regionID uint64) (*RegionInfo, error) {
+	apiURL := fmt.Sprintf("%s/%s/%d", c.url, regionByIDPrefix, regionID)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	region := &RegionInfo{}
+	err = json.Unmarshal(body, region)
+	if err != nil {
+		return nil, err
+	}
+	return region, nil
+}
+
+func (c *pdClient) GetHotRegions(typ string) (*StoreHotPeersInfos, error) {
+	if typ != "read" && typ != "write" {
+		return nil, fmt.Errorf("unknown type %q of hot regions, only read and write are supported", typ)
+	}
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, hotRegionsPrefix, typ)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	infos := &StoreHotPeersInfos{}
+	err = json.Unmarshal(body, infos)
+	if err != nil {
+		return nil, err
+	}
+	return infos, nil
+}
+
+func (c *pdClient) GetSchedulers() ([]string, error) {
+	apiURL := fmt.Sprintf("%s/%s", c.url, schedulersPrefix)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	var schedulers []string
+	err = json.Unmarshal(body, &schedulers)
+	if err != nil {
+		return nil, err
+	}
+	return schedulers, nil
+}
+
+func (c *pdClient) AddScheduler(name string, storeID uint64) error {
+	apiURL := fmt.Sprintf("%s/%s", c.url, schedulersPrefix)
+	data, err := json.Marshal(&schedulerInfo{Name: name, StoreID: storeID})
+	if err != nil {
+		return err
+	}
+	_, err = httputil.PostBodyOK(c.httpClient, apiURL, bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("failed to add scheduler %s: %v", name, err)
+	}
+	return nil
+}
+
+func (c *pdClient) RemoveScheduler(name string) error {
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, schedulersPrefix, name)
+	_, err := httputil.DeleteBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return fmt.Errorf("failed to remove scheduler %s: %v", name, err)
+	}
+	return nil
+}
+
+func (c *pdClient) SetConfig(config map[string]interface{}) error {
+	apiURL := fmt.Sprintf("%s/%s", c.url, configPrefix)
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+	_, err = httputil.PostBodyOK(c.httpClient, apiURL, bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("failed to set config %v: %v", config, err)
+	}
+	return nil
+}
+
+func (c *pdClient) GetRegionStats() (*RegionStats, error) {
+	apiURL := fmt.Sprintf("%s/%s", c.url, regionStatsPrefix)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	stats := &RegionStats{}
+	err = json.Unmarshal(body, stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (c *pdClient) GetHotStores() (*HotStoresStats, error) {
+	apiURL := fmt.Sprintf("%s/%s", c.url, hotStoresPrefix)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	stats := &HotStoresStats{}
+	err = json.Unmarshal(body, stats)
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (c *pdClient) GetMSMembers(service string) ([]string, error) {
+	apiURL := fmt.Sprintf("%s/%s/%s", c.url, msMembersPrefix, service)
+	body, err := httputil.GetBodyOK(c.httpClient, apiURL)
+	if err != nil {
+		return nil, err
+	}
+	entries := []ServiceRegistryEntry{}
+	err = json.Unmarshal(body, &entries)
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		addrs = append(addrs, entry.ServiceAddr)
+	}
+	return addrs, nil
+}
+
 func getLeaderEvictSchedulerInfo(storeID uint64) *schedulerInfo {
 	return &schedulerInfo{"evict-leader-scheduler", storeID}
 }
@@ -867,6 +867,33 @@ func TestGeneric(t *testing.T) {
 			wantPath:    fmt.Sprintf("/%s/%s", pdLeaderTransferPrefix, "foo"),
 			checkResult: checkNoError,
 		},
+		{
+			name:   "GetResourceGroups",
+			method: "GetResourceGroups",
+			resp: []byte(`
+[
+	{
+		"name": "rg1",
+		"ru_consumption": {
+			"r_r_u": 100.5,
+			"w_r_u": 20
+		}
+	}
+]
+`),
+			statusCode: http.StatusOK,
+			wantMethod: "GET",
+			wantPath:   fmt.Sprintf("/%s", resourceGroupsPrefix),
+			wantQuery:  "with_stats=true",
+			checkResult: func(t *testing.T, results []reflect.Value) {
+				g := NewGomegaWithT(t)
+				g.Expect(results[1].Interface()).To(BeNil())
+				groups := results[0].Interface().([]*ResourceGroup)
+				g.Expect(groups).To(Equal([]*ResourceGroup{
+					{Name: "rg1", RUConsumption: &ResourceGroupConsumption{RRU: 100.5, WRU: 20}},
+				}))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -897,3 +924,165 @@ func TestGeneric(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRegions(t *testing.T) {
+	g := NewGomegaWithT(t)
+	regions := &RegionsInfo{
+		Count: 1,
+		Regions: []*RegionInfo{{
+			ID:     2,
+			Peers:  []*PeerInfo{{ID: 3, StoreID: 1}},
+			Leader: &PeerInfo{ID: 3, StoreID: 1},
+		}},
+	}
+	regionsBytes, err := json.Marshal(regions)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	tcs := []struct {
+		caseName string
+		limit    int
+		path     string
+		query    string
+	}{
+		{caseName: "all regions", limit: 0, path: fmt.Sprintf("/%s", regionsPrefix)},
+		{caseName: "limited regions", limit: 16, path: fmt.Sprintf("/%s", regionsByKeyPrefix), query: "key=&limit=16"},
+	}
+	for _, tc := range tcs {
+		svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+			g.Expect(request.Method).To(Equal("GET"), "check method")
+			g.Expect(request.URL.Path).To(Equal(tc.path), "check url")
+			g.Expect(request.URL.RawQuery).To(Equal(tc.query), "check query")
+
+			w.Header().Set("Content-Type", ContentTypeJSON)
+			w.Write(regionsBytes)
+		})
+		defer svc.Close()
+
+		pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+		result, err := pdClient.GetRegions(tc.limit)
+		g.Expect(err).NotTo(HaveOccurred(), tc.caseName)
+		g.Expect(result).To(Equal(regions), tc.caseName)
+	}
+}
+
+func TestGetHotRegions(t *testing.T) {
+	g := NewGomegaWithT(t)
+	resp := []byte(`{"as_peer":{"1":{"total_flow_bytes":100,"regions_count":1,"statistics":[{"store_id":1,"region_id":2,"hot_degree":3,"flow_bytes":100}]}},"as_leader":{}}`)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s/write", hotRegionsPrefix)), "check url")
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		w.Write(resp)
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	result, err := pdClient.GetHotRegions("write")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.AsPeer).To(HaveKey(uint64(1)))
+	g.Expect(result.AsPeer[1].Stats).To(Equal([]HotPeerStat{{StoreID: 1, RegionID: 2, HotDegree: 3, ByteRate: 100}}))
+
+	_, err = pdClient.GetHotRegions("unknown")
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestSchedulers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tcs := []struct {
+		caseName string
+		method   string
+		path     string
+		body     string
+		fn       func(PDClient) error
+	}{
+		{
+			caseName: "AddScheduler",
+			method:   "POST",
+			path:     fmt.Sprintf("/%s", schedulersPrefix),
+			body:     `{"name":"evict-leader-scheduler","store_id":1}`,
+			fn:       func(c PDClient) error { return c.AddScheduler("evict-leader-scheduler", 1) },
+		},
+		{
+			caseName: "RemoveScheduler",
+			method:   "DELETE",
+			path:     fmt.Sprintf("/%s/balance-hot-region-scheduler", schedulersPrefix),
+			fn:       func(c PDClient) error { return c.RemoveScheduler("balance-hot-region-scheduler") },
+		},
+		{
+			caseName: "SetConfig",
+			method:   "POST",
+			path:     fmt.Sprintf("/%s", configPrefix),
+			body:     `{"schedule.leader-schedule-limit":8}`,
+			fn: func(c PDClient) error {
+				return c.SetConfig(map[string]interface{}{"schedule.leader-schedule-limit": 8})
+			},
+		},
+	}
+	for _, tc := range tcs {
+		svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+			g.Expect(request.Method).To(Equal(tc.method), "check method")
+			g.Expect(request.URL.Path).To(Equal(tc.path), "check url")
+			body, err := ioutil.ReadAll(request.Body)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(body)).To(Equal(tc.body), "check body")
+			w.WriteHeader(http.StatusOK)
+		})
+		defer svc.Close()
+
+		pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+		g.Expect(tc.fn(pdClient)).To(Succeed(), tc.caseName)
+	}
+}
+
+func TestGetStoreStats(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("GET"), "check method")
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		switch request.URL.Path {
+		case fmt.Sprintf("/%s", regionStatsPrefix):
+			w.Write([]byte(`{"count":3,"empty_count":1,"storage_size":96,"storage_keys":1000,"store_leader_count":{"1":2,"4":1},"store_peer_count":{"1":3,"4":3}}`))
+		case fmt.Sprintf("/%s", hotStoresPrefix):
+			w.Write([]byte(`{"bytes-write-rate":{"1":1024.5},"bytes-read-rate":{"1":2048},"keys-write-rate":{"1":10},"keys-read-rate":{"1":20}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	regionStats, err := pdClient.GetRegionStats()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(regionStats.Count).To(Equal(3))
+	g.Expect(regionStats.StoreLeaderCount).To(Equal(map[uint64]int{1: 2, 4: 1}))
+	g.Expect(regionStats.StorePeerCount).To(Equal(map[uint64]int{1: 3, 4: 3}))
+
+	hotStores, err := pdClient.GetHotStores()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hotStores.BytesWriteStats).To(Equal(map[uint64]float64{1: 1024.5}))
+	g.Expect(hotStores.BytesReadStats).To(Equal(map[uint64]float64{1: 2048}))
+	g.Expect(hotStores.KeysReadStats).To(Equal(map[uint64]float64{1: 20}))
+}
+
+func TestGetMSMembers(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	svc := getClientServer(func(w http.ResponseWriter, request *http.Request) {
+		g.Expect(request.Method).To(Equal("GET"), "check method")
+		g.Expect(request.URL.Path).To(Equal(fmt.Sprintf("/%s/tso", msMembersPrefix)), "check url")
+		w.Header().Set("Content-Type", ContentTypeJSON)
+		w.Write([]byte(`[{"service-addr":"http://basic-tso-0.basic-tso-peer.default.svc:2379","version":"v8.0.0","git-hash":"abc","deploy-path":"/","start-timestamp":1700000000,"name":"basic-tso-0"},` +
+			`{"service-addr":"http://basic-tso-1.basic-tso-peer.default.svc:2379","version":"v8.0.0","git-hash":"abc","deploy-path":"/","start-timestamp":1700000000,"name":"basic-tso-1"}]`))
+	})
+	defer svc.Close()
+
+	pdClient := NewPDClient(svc.URL, DefaultTimeout, &tls.Config{})
+	members, err := pdClient.GetMSMembers("tso")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(members).To(Equal([]string{
+		"http://basic-tso-0.basic-tso-peer.default.svc:2379",
+		"http://basic-tso-1.basic-tso-peer.default.svc:2379",
+	}))
+}
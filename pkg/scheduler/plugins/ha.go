@@ -0,0 +1,330 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	framework "k8s.io/kubernetes/pkg/scheduler/framework/v1alpha1"
+)
+
+var (
+	// supportedComponents holds the components scheduled by the plugin
+	supportedComponents = sets.NewString(
+		label.PDLabelVal,
+		label.TiKVLabelVal,
+		label.TiFlashLabelVal,
+		label.TiCDCLabelVal,
+		label.TiDBLabelVal,
+	)
+)
+
+// podMember identifies the pods of the same StatefulSet by their labels
+type podMember struct {
+	instance    string
+	component   string
+	tikvGroup   string
+	tiflashRole string
+}
+
+func newPodMember(pod *apiv1.Pod) *podMember {
+	instance := pod.Labels[label.InstanceLabelKey]
+	component := pod.Labels[label.ComponentLabelKey]
+	if instance == "" || component == "" {
+		return nil
+	}
+	return &podMember{
+		instance:    instance,
+		component:   component,
+		tikvGroup:   pod.Labels[label.TiKVGroupLabelKey],
+		tiflashRole: pod.Labels[label.TiFlashRoleLabelKey],
+	}
+}
+
+func (m *podMember) matches(pod *apiv1.Pod) bool {
+	other := newPodMember(pod)
+	return other != nil && *other == *m
+}
+
+// view returns the TidbCluster in which the spec and status of the member are in `spec.tikv` or
+// `spec.tiflash`, nil is returned if the member is removed from the TidbCluster.
+func (m *podMember) view(tc *v1alpha1.TidbCluster) *v1alpha1.TidbCluster {
+	switch {
+	case m.component == label.TiKVLabelVal && m.tikvGroup != "":
+		return tc.TiKVGroupView(m.tikvGroup)
+	case m.component == label.TiFlashLabelVal && m.tiflashRole == label.TiFlashComputeRoleVal:
+		return tc.TiFlashComputeView()
+	}
+	return tc
+}
+
+// haState is the pods of the component in each topology
+type haState struct {
+	component   string
+	topologyKey string
+	// maxPodsPerTopology is 0 if HA is impossible and the pods are not restricted
+	maxPodsPerTopology int
+	counts             map[string]int
+}
+
+func (s *haState) clone() *haState {
+	c := *s
+	c.counts = make(map[string]int, len(s.counts))
+	for topology, count := range s.counts {
+		c.counts[topology] = count
+	}
+	return &c
+}
+
+func (p *TiDBScheduling) newHAState(tc *v1alpha1.TidbCluster, member *podMember, pod *apiv1.Pod) (*haState, error) {
+	ns := pod.GetNamespace()
+	pinned, err := p.volumePinned(pod)
+	if err != nil {
+		return nil, err
+	}
+	if pinned {
+		// the pod can only run on the node of its local volume
+		klog.Infof("%s: pod %s/%s has local volume bound, skip HA", Name, ns, pod.Name)
+		return nil, nil
+	}
+	view := member.view(tc)
+	if view == nil {
+		return nil, nil
+	}
+
+	topologyKey := label.DefaultHATopologyKey
+	if key := tc.Annotations[label.AnnHATopologyKey]; key != "" {
+		topologyKey = key
+	}
+	replicas, desiredOrdinals, failurePods := memberReplicas(view, member.component)
+
+	nodeInfos, err := p.nodeInfosFn().List()
+	if err != nil {
+		return nil, err
+	}
+	counts := map[string]int{}
+	for _, nodeInfo := range nodeInfos {
+		node := nodeInfo.Node()
+		if node == nil {
+			continue
+		}
+		topology, ok := node.Labels[topologyKey]
+		if !ok {
+			continue
+		}
+		for _, podInfo := range nodeInfo.Pods {
+			other := podInfo.Pod
+			if other.Namespace != ns || other.Name == pod.Name || !member.matches(other) {
+				continue
+			}
+			ordinal, err := util.GetOrdinalFromPodName(other.Name)
+			if err != nil || !desiredOrdinals.Has(ordinal) {
+				klog.Infof("%s: pod %s is not in desired ordinals, do not count its topology", Name, other.Name)
+				continue
+			}
+			if failurePods.Has(other.Name) {
+				klog.Infof("%s: pod %s is a failure member, do not count its topology", Name, other.Name)
+				continue
+			}
+			counts[topology]++
+		}
+	}
+
+	state := &haState{
+		component:          member.component,
+		topologyKey:        topologyKey,
+		maxPodsPerTopology: maxPodsPerTopology(member.component, replicas, len(counts)),
+		counts:             counts,
+	}
+	klog.Infof("%s: pod %s/%s, replicas %d, topology key %s, pods per topology %v, max pods per topology %d",
+		Name, ns, pod.Name, replicas, topologyKey, counts, state.maxPodsPerTopology)
+	return state, nil
+}
+
+// maxPodsPerTopology returns the max allowed pods of the component in each topology,
+// 0 means HA is impossible and the pods are not restricted.
+func maxPodsPerTopology(component string, replicas int32, topologies int) int {
+	switch component {
+	case label.PDLabelVal:
+		/**
+		 * PD is one raft group, no more than the minority of the members in one topology
+		 *
+		 * replicas     maxPodsPerTopology
+		 * ---------------------------
+		 * 1            1
+		 * 2            1
+		 * 3            1
+		 * 4            1
+		 * 5            2
+		 * ...
+		 */
+		max := int((replicas+1)/2) - 1
+		if max <= 0 {
+			max = 1
+		}
+		return max
+	case label.TiKVLabelVal, label.TiFlashLabelVal:
+		// the data has 3 copies by default, HA is impossible if there are less than 3 stores
+		if replicas < 3 {
+			return 0
+		}
+		// the stores must run on at least 3 topologies
+		if topologies < 3 {
+			return 1
+		}
+		/**
+		 * replicas     maxPodsPerTopology   best HA on three topologies
+		 * ---------------------------------------------------
+		 * 3            1                1, 1, 1
+		 * 4            2                1, 1, 2
+		 * 5            2                1, 2, 2
+		 * 6            2                2, 2, 2
+		 * 7            3                2, 2, 3
+		 * ...
+		 */
+		return int(math.Ceil(float64(replicas) / 3))
+	case label.TiCDCLabelVal:
+		// the captures must run on at least 2 topologies, so at least half of them survive a topology failure
+		if replicas < 2 {
+			return 0
+		}
+		if topologies < 2 {
+			return 1
+		}
+		return int(math.Ceil(float64(replicas) / 2))
+	}
+	return 0
+}
+
+func (s *haState) filter(node *apiv1.Node) *framework.Status {
+	if s.maxPodsPerTopology == 0 {
+		return nil
+	}
+	topology, ok := node.Labels[s.topologyKey]
+	if !ok {
+		return framework.NewStatus(framework.UnschedulableAndUnresolvable, fmt.Sprintf("node(s) didn't have the HA topology label %s", s.topologyKey))
+	}
+	if s.counts[topology] >= s.maxPodsPerTopology {
+		return framework.NewStatus(framework.Unschedulable, fmt.Sprintf("topology %s=%s has %d %s pods, max pods per topology: %d",
+			s.topologyKey, topology, s.counts[topology], s.component, s.maxPodsPerTopology))
+	}
+	return nil
+}
+
+// score prefers the topology which has the least pods of the component
+func (s *haState) score(node *apiv1.Node) int64 {
+	topology, ok := node.Labels[s.topologyKey]
+	if !ok {
+		return 0
+	}
+	max := 0
+	for _, count := range s.counts {
+		if count > max {
+			max = count
+		}
+	}
+	if max == 0 {
+		return framework.MaxNodeScore
+	}
+	return int64(max-s.counts[topology]) * framework.MaxNodeScore / int64(max)
+}
+
+func (s *haState) reserve(node *apiv1.Node) {
+	if topology, ok := node.Labels[s.topologyKey]; ok {
+		s.counts[topology]++
+	}
+}
+
+func (s *haState) unreserve(node *apiv1.Node) {
+	if topology, ok := node.Labels[s.topologyKey]; ok && s.counts[topology] > 0 {
+		s.counts[topology]--
+	}
+}
+
+// volumePinned returns whether the pod has a bound volume which is only accessible from some nodes, e.g. local PV
+func (p *TiDBScheduling) volumePinned(pod *apiv1.Pod) (bool, error) {
+	for _, vol := range pod.Spec.Volumes {
+		if vol.PersistentVolumeClaim == nil {
+			continue
+		}
+		pvc, err := p.pvcGetFn(pod.Namespace, vol.PersistentVolumeClaim.ClaimName)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if pvc.Status.Phase != apiv1.ClaimBound || pvc.Spec.VolumeName == "" {
+			continue
+		}
+		pv, err := p.pvGetFn(pvc.Spec.VolumeName)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return false, err
+		}
+		if pv.Spec.NodeAffinity != nil && pv.Spec.NodeAffinity.Required != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// memberReplicas returns the desired replicas, the desired ordinals and the failure pods of the component
+func memberReplicas(tc *v1alpha1.TidbCluster, component string) (int32, sets.Int32, sets.String) {
+	failurePods := sets.NewString()
+	switch component {
+	case label.PDLabelVal:
+		for _, m := range tc.Status.PD.FailureMembers {
+			failurePods.Insert(m.PodName)
+		}
+		return tc.PDStsDesiredReplicas(), tc.PDStsDesiredOrdinals(false), failurePods
+	case label.TiKVLabelVal:
+		for _, s := range tc.Status.TiKV.FailureStores {
+			failurePods.Insert(s.PodName)
+		}
+		return tc.TiKVStsDesiredReplicas(), tc.TiKVStsDesiredOrdinals(false), failurePods
+	case label.TiFlashLabelVal:
+		for _, s := range tc.Status.TiFlash.FailureStores {
+			failurePods.Insert(s.PodName)
+		}
+		return tc.TiFlashStsDesiredReplicas(), tc.TiFlashStsDesiredOrdinals(false), failurePods
+	case label.TiCDCLabelVal:
+		replicas := tc.TiCDCDeployDesiredReplicas()
+		ordinals := sets.NewInt32()
+		for i := int32(0); i < replicas; i++ {
+			ordinals.Insert(i)
+		}
+		return replicas, ordinals, failurePods
+	}
+	return 0, sets.NewInt32(), failurePods
+}
+
+// previousNode returns the node which the TiDB pod ran on before
+func previousNode(tc *v1alpha1.TidbCluster, pod *apiv1.Pod) string {
+	member, ok := tc.Status.TiDB.Members[pod.Name]
+	if !ok {
+		return ""
+	}
+	return member.NodeName
+}
@@ -0,0 +1,278 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	informers "github.com/pingcap/tidb-operator/pkg/client/informers/externalversions"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+	frameworkruntime "k8s.io/kubernetes/pkg/scheduler/framework/runtime"
+	framework "k8s.io/kubernetes/pkg/scheduler/framework/v1alpha1"
+)
+
+const (
+	// Name is the name of the plugin used in the plugin registry and configurations
+	Name = "TiDBScheduling"
+
+	stateKey = Name + "/state"
+)
+
+// Args are the arguments of the plugin
+type Args struct {
+	// KubeConfigPath is the path of the kubeconfig to access TidbClusters,
+	// the in-cluster config is used if it's empty
+	KubeConfigPath string `json:"kubeConfigPath,omitempty"`
+	// StableScheduling makes TiDB pods prefer their previous nodes, defaults to true
+	StableScheduling *bool `json:"stableScheduling,omitempty"`
+}
+
+// TiDBScheduling is a scheduler framework plugin which replaces the tidb-scheduler extender:
+//   - HA: PD, TiKV, TiFlash and TiCDC pods are spread across the topologies so that losing a
+//     topology does not lose the majority of the replicas
+//   - StableScheduling: TiDB pods prefer the nodes they ran on before
+//
+// The pods are scheduled one at a time by kube-scheduler and the pods reserved in the previous
+// cycles are already in the snapshot, so no lock is needed to serialize the scheduling.
+type TiDBScheduling struct {
+	stableScheduling bool
+
+	nodeInfosFn func() framework.NodeInfoLister
+	tcGetFn     func(ns, tcName string) (*v1alpha1.TidbCluster, error)
+	pvcGetFn    func(ns, pvcName string) (*apiv1.PersistentVolumeClaim, error)
+	pvGetFn     func(pvName string) (*apiv1.PersistentVolume, error)
+}
+
+var _ framework.PreFilterPlugin = &TiDBScheduling{}
+var _ framework.FilterPlugin = &TiDBScheduling{}
+var _ framework.ScorePlugin = &TiDBScheduling{}
+var _ framework.ReservePlugin = &TiDBScheduling{}
+
+// New returns the plugin, it's the PluginFactory registered to kube-scheduler
+func New(obj runtime.Object, handle framework.FrameworkHandle) (framework.Plugin, error) {
+	args := &Args{}
+	if err := frameworkruntime.DecodeInto(obj, args); err != nil {
+		return nil, fmt.Errorf("failed to decode args of plugin %s: %v", Name, err)
+	}
+	cfg, err := clientcmd.BuildConfigFromFlags("", args.KubeConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	cli, err := versioned.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(cli, 30*time.Minute)
+	tcInformer := informerFactory.Pingcap().V1alpha1().TidbClusters()
+	tcLister := tcInformer.Lister()
+	pvcLister := handle.SharedInformerFactory().Core().V1().PersistentVolumeClaims().Lister()
+	pvLister := handle.SharedInformerFactory().Core().V1().PersistentVolumes().Lister()
+
+	// The FrameworkHandle of kube-scheduler v1.19 doesn't expose its lifetime, and the plugin lives as long
+	// as the scheduler process, so the informers are deliberately process-scoped and only stopped on failure.
+	stopCh := make(chan struct{})
+	informerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, tcInformer.Informer().HasSynced) {
+		close(stopCh)
+		return nil, fmt.Errorf("failed to sync the cache of TidbClusters")
+	}
+
+	stableScheduling := true
+	if args.StableScheduling != nil {
+		stableScheduling = *args.StableScheduling
+	}
+	return &TiDBScheduling{
+		stableScheduling: stableScheduling,
+		nodeInfosFn: func() framework.NodeInfoLister {
+			return handle.SnapshotSharedLister().NodeInfos()
+		},
+		tcGetFn: func(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+			return tcLister.TidbClusters(ns).Get(tcName)
+		},
+		pvcGetFn: func(ns, pvcName string) (*apiv1.PersistentVolumeClaim, error) {
+			return pvcLister.PersistentVolumeClaims(ns).Get(pvcName)
+		},
+		pvGetFn: pvLister.Get,
+	}, nil
+}
+
+func (p *TiDBScheduling) Name() string {
+	return Name
+}
+
+// schedulingState is computed in PreFilter and shared by the other extension points in a scheduling cycle
+type schedulingState struct {
+	// skip means the pod is not scheduled by the plugin
+	skip bool
+
+	// previousNode is the node the TiDB pod ran on before
+	previousNode string
+
+	// ha is nil if the pod is not restricted by HA
+	ha *haState
+}
+
+func (s *schedulingState) Clone() framework.StateData {
+	c := *s
+	if s.ha != nil {
+		c.ha = s.ha.clone()
+	}
+	return &c
+}
+
+func (p *TiDBScheduling) PreFilter(ctx context.Context, cycleState *framework.CycleState, pod *apiv1.Pod) *framework.Status {
+	if _, ok := pod.Annotations[label.AnnFailTiDBScheduler]; ok {
+		return framework.NewStatus(framework.UnschedulableAndUnresolvable, fmt.Sprintf("pod %s had an intentional failure injected", pod.Name))
+	}
+
+	state, err := p.newSchedulingState(pod)
+	if err != nil {
+		return framework.NewStatus(framework.Error, err.Error())
+	}
+	cycleState.Write(stateKey, state)
+	return nil
+}
+
+func (p *TiDBScheduling) newSchedulingState(pod *apiv1.Pod) (*schedulingState, error) {
+	ns := pod.GetNamespace()
+	member := newPodMember(pod)
+	if member == nil || !supportedComponents.Has(member.component) {
+		return &schedulingState{skip: true}, nil
+	}
+
+	tc, err := p.tcGetFn(ns, member.instance)
+	if apierrors.IsNotFound(err) {
+		klog.V(4).Infof("%s: tidb cluster %s/%s of pod %s is not found, skip", Name, ns, member.instance, pod.Name)
+		return &schedulingState{skip: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	state := &schedulingState{}
+	if member.component == label.TiDBLabelVal {
+		if p.stableScheduling {
+			state.previousNode = previousNode(tc, pod)
+		}
+		return state, nil
+	}
+	state.ha, err = p.newHAState(tc, member, pod)
+	return state, err
+}
+
+func (p *TiDBScheduling) PreFilterExtensions() framework.PreFilterExtensions {
+	return nil
+}
+
+// Filter rejects the nodes in the topologies which already have the max allowed pods of the component
+func (p *TiDBScheduling) Filter(ctx context.Context, cycleState *framework.CycleState, pod *apiv1.Pod, nodeInfo *framework.NodeInfo) *framework.Status {
+	state, err := readSchedulingState(cycleState)
+	if err != nil {
+		return framework.NewStatus(framework.Error, err.Error())
+	}
+	if state.skip || state.ha == nil {
+		return nil
+	}
+	node := nodeInfo.Node()
+	if node == nil {
+		return framework.NewStatus(framework.Error, "node not found")
+	}
+	return state.ha.filter(node)
+}
+
+// Score prefers the topologies with less pods of the component and the previous nodes of TiDB pods
+func (p *TiDBScheduling) Score(ctx context.Context, cycleState *framework.CycleState, pod *apiv1.Pod, nodeName string) (int64, *framework.Status) {
+	state, err := readSchedulingState(cycleState)
+	if err != nil {
+		return 0, framework.NewStatus(framework.Error, err.Error())
+	}
+	if state.skip {
+		return 0, nil
+	}
+	if state.previousNode != "" {
+		if nodeName == state.previousNode {
+			return framework.MaxNodeScore, nil
+		}
+		return 0, nil
+	}
+	if state.ha == nil {
+		return 0, nil
+	}
+	nodeInfo, err := p.nodeInfosFn().Get(nodeName)
+	if err != nil {
+		return 0, framework.NewStatus(framework.Error, err.Error())
+	}
+	return state.ha.score(nodeInfo.Node()), nil
+}
+
+func (p *TiDBScheduling) ScoreExtensions() framework.ScoreExtensions {
+	return nil
+}
+
+// Reserve counts the pod in the topology of the selected node, the reservation is kept in the cycle state
+func (p *TiDBScheduling) Reserve(ctx context.Context, cycleState *framework.CycleState, pod *apiv1.Pod, nodeName string) *framework.Status {
+	state, err := readSchedulingState(cycleState)
+	if err != nil {
+		return framework.NewStatus(framework.Error, err.Error())
+	}
+	if state.skip || state.ha == nil {
+		return nil
+	}
+	nodeInfo, err := p.nodeInfosFn().Get(nodeName)
+	if err != nil {
+		return framework.NewStatus(framework.Error, err.Error())
+	}
+	if status := state.ha.filter(nodeInfo.Node()); !status.IsSuccess() {
+		return status
+	}
+	state.ha.reserve(nodeInfo.Node())
+	klog.Infof("%s: pod %s/%s is reserved on node %s", Name, pod.Namespace, pod.Name, nodeName)
+	return nil
+}
+
+// Unreserve releases the reservation made by Reserve
+func (p *TiDBScheduling) Unreserve(ctx context.Context, cycleState *framework.CycleState, pod *apiv1.Pod, nodeName string) {
+	state, err := readSchedulingState(cycleState)
+	if err != nil || state.skip || state.ha == nil {
+		return
+	}
+	nodeInfo, err := p.nodeInfosFn().Get(nodeName)
+	if err != nil {
+		return
+	}
+	state.ha.unreserve(nodeInfo.Node())
+}
+
+func readSchedulingState(cycleState *framework.CycleState) (*schedulingState, error) {
+	data, err := cycleState.Read(stateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q from cycle state: %v", stateKey, err)
+	}
+	state, ok := data.(*schedulingState)
+	if !ok {
+		return nil, fmt.Errorf("%+v can not be converted to *schedulingState", data)
+	}
+	return state, nil
+}
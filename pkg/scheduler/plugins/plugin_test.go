@@ -0,0 +1,349 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	framework "k8s.io/kubernetes/pkg/scheduler/framework/v1alpha1"
+	fakeframework "k8s.io/kubernetes/pkg/scheduler/framework/v1alpha1/fake"
+)
+
+const (
+	testNamespace = "default"
+	testCluster   = "demo"
+)
+
+type fakeCluster struct {
+	tc        *v1alpha1.TidbCluster
+	nodeInfos []*framework.NodeInfo
+	pvcs      map[string]*apiv1.PersistentVolumeClaim
+	pvs       map[string]*apiv1.PersistentVolume
+}
+
+func newFakeCluster(tc *v1alpha1.TidbCluster, zones ...string) *fakeCluster {
+	c := &fakeCluster{
+		tc:   tc,
+		pvcs: map[string]*apiv1.PersistentVolumeClaim{},
+		pvs:  map[string]*apiv1.PersistentVolume{},
+	}
+	for i, zone := range zones {
+		node := &apiv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("node-%d", i+1),
+				Labels: map[string]string{
+					label.DefaultHATopologyKey: fmt.Sprintf("node-%d", i+1),
+				},
+			},
+		}
+		if zone != "" {
+			node.Labels["zone"] = zone
+		}
+		nodeInfo := framework.NewNodeInfo()
+		_ = nodeInfo.SetNode(node)
+		c.nodeInfos = append(c.nodeInfos, nodeInfo)
+	}
+	return c
+}
+
+// addPod places the pod on the node, the node name starts from 1
+func (c *fakeCluster) addPod(pod *apiv1.Pod, node int) {
+	pod.Spec.NodeName = c.nodeInfos[node-1].Node().Name
+	c.nodeInfos[node-1].AddPod(pod)
+}
+
+func (c *fakeCluster) plugin() *TiDBScheduling {
+	return &TiDBScheduling{
+		stableScheduling: true,
+		nodeInfosFn: func() framework.NodeInfoLister {
+			return fakeframework.NodeInfoLister(c.nodeInfos)
+		},
+		tcGetFn: func(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+			if c.tc == nil || c.tc.Namespace != ns || c.tc.Name != tcName {
+				return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "tidbclusters"}, tcName)
+			}
+			return c.tc, nil
+		},
+		pvcGetFn: func(ns, pvcName string) (*apiv1.PersistentVolumeClaim, error) {
+			if pvc, ok := c.pvcs[pvcName]; ok {
+				return pvc, nil
+			}
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumeclaims"}, pvcName)
+		},
+		pvGetFn: func(pvName string) (*apiv1.PersistentVolume, error) {
+			if pv, ok := c.pvs[pvName]; ok {
+				return pv, nil
+			}
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumes"}, pvName)
+		},
+	}
+}
+
+// filter runs PreFilter and Filter, returns the names of the feasible nodes
+func (c *fakeCluster) filter(g *GomegaWithT, p *TiDBScheduling, state *framework.CycleState, pod *apiv1.Pod) []string {
+	status := p.PreFilter(context.TODO(), state, pod)
+	g.Expect(status.IsSuccess()).Should(BeTrue(), status.Message())
+	var nodes []string
+	for _, nodeInfo := range c.nodeInfos {
+		if p.Filter(context.TODO(), state, pod, nodeInfo).IsSuccess() {
+			nodes = append(nodes, nodeInfo.Node().Name)
+		}
+	}
+	return nodes
+}
+
+func newTidbCluster() *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: testCluster, Namespace: testNamespace},
+		Spec: v1alpha1.TidbClusterSpec{
+			PD:    &v1alpha1.PDSpec{Replicas: 3},
+			TiKV:  &v1alpha1.TiKVSpec{Replicas: 3},
+			TiDB:  &v1alpha1.TiDBSpec{Replicas: 2},
+			TiCDC: &v1alpha1.TiCDCSpec{Replicas: 3},
+		},
+	}
+}
+
+func newPod(component string, ordinal int) *apiv1.Pod {
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-%d", testCluster, component, ordinal),
+			Namespace: testNamespace,
+			Labels:    label.New().Instance(testCluster).Component(component).Labels(),
+		},
+	}
+}
+
+func TestMaxPodsPerTopology(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		component  string
+		replicas   int32
+		topologies int
+		expected   int
+	}{
+		{label.PDLabelVal, 1, 0, 1},
+		{label.PDLabelVal, 3, 3, 1},
+		{label.PDLabelVal, 4, 3, 1},
+		{label.PDLabelVal, 5, 3, 2},
+		{label.TiKVLabelVal, 2, 1, 0},
+		{label.TiKVLabelVal, 4, 2, 1},
+		{label.TiKVLabelVal, 4, 3, 2},
+		{label.TiKVLabelVal, 7, 3, 3},
+		{label.TiFlashLabelVal, 3, 3, 1},
+		{label.TiCDCLabelVal, 1, 1, 0},
+		{label.TiCDCLabelVal, 3, 1, 1},
+		{label.TiCDCLabelVal, 3, 2, 2},
+		{label.TiDBLabelVal, 3, 3, 0},
+	}
+	for _, tt := range tests {
+		g.Expect(maxPodsPerTopology(tt.component, tt.replicas, tt.topologies)).Should(Equal(tt.expected),
+			"component %s, replicas %d, topologies %d", tt.component, tt.replicas, tt.topologies)
+	}
+}
+
+func TestHA(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := newFakeCluster(newTidbCluster(), "zone1", "zone2", "zone3")
+	c.addPod(newPod(label.PDLabelVal, 0), 1)
+	// pods of other components are not counted
+	c.addPod(newPod(label.TiKVLabelVal, 0), 2)
+	p := c.plugin()
+
+	state := framework.NewCycleState()
+	pod := newPod(label.PDLabelVal, 1)
+	g.Expect(c.filter(g, p, state, pod)).Should(Equal([]string{"node-2", "node-3"}))
+
+	status := p.Filter(context.TODO(), state, pod, c.nodeInfos[0])
+	g.Expect(status.Code()).Should(Equal(framework.Unschedulable))
+	g.Expect(status.Message()).Should(ContainSubstring("has 1 pd pods, max pods per topology: 1"))
+
+	score, status := p.Score(context.TODO(), state, pod, "node-1")
+	g.Expect(status.IsSuccess()).Should(BeTrue())
+	g.Expect(score).Should(Equal(int64(0)))
+	score, _ = p.Score(context.TODO(), state, pod, "node-2")
+	g.Expect(score).Should(Equal(framework.MaxNodeScore))
+
+	// the reservation is counted in the cycle
+	g.Expect(p.Reserve(context.TODO(), state, pod, "node-2").IsSuccess()).Should(BeTrue())
+	g.Expect(p.Filter(context.TODO(), state, pod, c.nodeInfos[1]).IsSuccess()).Should(BeFalse())
+	p.Unreserve(context.TODO(), state, pod, "node-2")
+	g.Expect(p.Filter(context.TODO(), state, pod, c.nodeInfos[1]).IsSuccess()).Should(BeTrue())
+
+	// the reserved pod is in the snapshot in the next cycle
+	c.addPod(pod, 2)
+	g.Expect(c.filter(g, p, framework.NewCycleState(), newPod(label.PDLabelVal, 2))).Should(Equal([]string{"node-3"}))
+
+	// the failure members are not counted
+	c.tc.Status.PD.FailureMembers = map[string]v1alpha1.PDFailureMember{
+		"demo-pd-1": {PodName: "demo-pd-1"},
+	}
+	g.Expect(c.filter(g, p, framework.NewCycleState(), newPod(label.PDLabelVal, 2))).Should(Equal([]string{"node-2", "node-3"}))
+}
+
+func TestHATopologyKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbCluster()
+	tc.Spec.TiKV.Replicas = 4
+	tc.Annotations = map[string]string{label.AnnHATopologyKey: "zone"}
+	// the nodes without the topology label are not feasible
+	c := newFakeCluster(tc, "zone1", "zone1", "zone2", "zone3", "")
+	c.addPod(newPod(label.TiKVLabelVal, 0), 1)
+	c.addPod(newPod(label.TiKVLabelVal, 1), 3)
+	p := c.plugin()
+
+	// the stores must run on at least 3 zones
+	g.Expect(c.filter(g, p, framework.NewCycleState(), newPod(label.TiKVLabelVal, 2))).Should(Equal([]string{"node-4"}))
+
+	c.addPod(newPod(label.TiKVLabelVal, 2), 4)
+	g.Expect(c.filter(g, p, framework.NewCycleState(), newPod(label.TiKVLabelVal, 3))).Should(Equal([]string{"node-1", "node-2", "node-3", "node-4"}))
+}
+
+func TestHAWithoutTopologyLabel(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbCluster()
+	tc.Spec.TiKV.Replicas = 2
+	tc.Annotations = map[string]string{label.AnnHATopologyKey: "zone"}
+	// HA is impossible for 2 stores, the nodes without the topology label are feasible
+	c := newFakeCluster(tc, "zone1", "")
+	c.addPod(newPod(label.TiKVLabelVal, 0), 1)
+	p := c.plugin()
+
+	g.Expect(c.filter(g, p, framework.NewCycleState(), newPod(label.TiKVLabelVal, 1))).Should(Equal([]string{"node-1", "node-2"}))
+}
+
+func TestHAComponents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbCluster()
+	tc.Spec.TiFlash = &v1alpha1.TiFlashSpec{
+		Replicas: 3,
+		Disaggregated: &v1alpha1.TiFlashDisaggregatedSpec{
+			Compute: v1alpha1.TiFlashComputeSpec{Replicas: 3},
+		},
+	}
+	tc.Spec.TiKVGroups = []v1alpha1.TiKVGroupSpec{{Name: "hot", TiKVSpec: v1alpha1.TiKVSpec{Replicas: 3}}}
+	c := newFakeCluster(tc, "zone1", "zone2", "zone3")
+	c.addPod(newPod(label.TiFlashLabelVal, 0), 1)
+	c.addPod(newPod(label.TiCDCLabelVal, 0), 1)
+	c.addPod(newPod(label.TiCDCLabelVal, 1), 2)
+	c.addPod(newPod(label.TiKVLabelVal, 0), 3)
+	p := c.plugin()
+
+	g.Expect(c.filter(g, p, framework.NewCycleState(), newPod(label.TiFlashLabelVal, 1))).Should(Equal([]string{"node-2", "node-3"}))
+	g.Expect(c.filter(g, p, framework.NewCycleState(), newPod(label.TiCDCLabelVal, 2))).Should(Equal([]string{"node-1", "node-2", "node-3"}))
+
+	// the compute nodes of TiFlash are counted separately from the write nodes
+	compute := newPod(label.TiFlashLabelVal, 0)
+	compute.Name = "demo-tiflash-compute-0"
+	compute.Labels[label.TiFlashRoleLabelKey] = label.TiFlashComputeRoleVal
+	g.Expect(c.filter(g, p, framework.NewCycleState(), compute)).Should(Equal([]string{"node-1", "node-2", "node-3"}))
+
+	// the TiKV groups are counted separately
+	hot := newPod(label.TiKVLabelVal, 0)
+	hot.Name = "demo-tikv-hot-0"
+	hot.Labels[label.TiKVGroupLabelKey] = "hot"
+	g.Expect(c.filter(g, p, framework.NewCycleState(), hot)).Should(Equal([]string{"node-1", "node-2", "node-3"}))
+	c.addPod(hot, 1)
+	hot = hot.DeepCopy()
+	hot.Name = "demo-tikv-hot-1"
+	g.Expect(c.filter(g, p, framework.NewCycleState(), hot)).Should(Equal([]string{"node-2", "node-3"}))
+}
+
+func TestHAWithLocalVolume(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := newFakeCluster(newTidbCluster(), "zone1", "zone2", "zone3")
+	c.addPod(newPod(label.PDLabelVal, 0), 1)
+	pod := newPod(label.PDLabelVal, 1)
+	pod.Spec.Volumes = []apiv1.Volume{{
+		Name: "pd",
+		VolumeSource: apiv1.VolumeSource{
+			PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{ClaimName: "pd-demo-pd-1"},
+		},
+	}}
+	c.pvcs["pd-demo-pd-1"] = &apiv1.PersistentVolumeClaim{
+		Spec:   apiv1.PersistentVolumeClaimSpec{VolumeName: "local-pv"},
+		Status: apiv1.PersistentVolumeClaimStatus{Phase: apiv1.ClaimBound},
+	}
+	p := c.plugin()
+	g.Expect(c.filter(g, p, framework.NewCycleState(), pod)).Should(Equal([]string{"node-2", "node-3"}))
+
+	// the pod can only run on the node of the local volume
+	c.pvs["local-pv"] = &apiv1.PersistentVolume{
+		Spec: apiv1.PersistentVolumeSpec{
+			NodeAffinity: &apiv1.VolumeNodeAffinity{Required: &apiv1.NodeSelector{}},
+		},
+	}
+	g.Expect(c.filter(g, p, framework.NewCycleState(), pod)).Should(Equal([]string{"node-1", "node-2", "node-3"}))
+}
+
+func TestStableScheduling(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := newTidbCluster()
+	tc.Status.TiDB.Members = map[string]v1alpha1.TiDBMember{
+		"demo-tidb-0": {Name: "demo-tidb-0", NodeName: "node-2"},
+	}
+	c := newFakeCluster(tc, "zone1", "zone2", "zone3")
+	p := c.plugin()
+
+	pod := newPod(label.TiDBLabelVal, 0)
+	state := framework.NewCycleState()
+	g.Expect(c.filter(g, p, state, pod)).Should(Equal([]string{"node-1", "node-2", "node-3"}))
+	score, _ := p.Score(context.TODO(), state, pod, "node-2")
+	g.Expect(score).Should(Equal(framework.MaxNodeScore))
+	score, _ = p.Score(context.TODO(), state, pod, "node-1")
+	g.Expect(score).Should(Equal(int64(0)))
+
+	p.stableScheduling = false
+	state = framework.NewCycleState()
+	c.filter(g, p, state, pod)
+	score, _ = p.Score(context.TODO(), state, pod, "node-2")
+	g.Expect(score).Should(Equal(int64(0)))
+}
+
+func TestSkippedPods(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := newFakeCluster(nil, "zone1", "zone2")
+	c.addPod(newPod(label.PDLabelVal, 0), 1)
+	p := c.plugin()
+
+	// the cluster is not found
+	g.Expect(c.filter(g, p, framework.NewCycleState(), newPod(label.PDLabelVal, 1))).Should(Equal([]string{"node-1", "node-2"}))
+
+	// the pod is not managed by tidb-operator
+	pod := &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "nginx", Namespace: testNamespace}}
+	g.Expect(c.filter(g, p, framework.NewCycleState(), pod)).Should(Equal([]string{"node-1", "node-2"}))
+
+	// the failure is injected
+	pod = newPod(label.PDLabelVal, 1)
+	pod.Annotations = map[string]string{label.AnnFailTiDBScheduler: "true"}
+	status := p.PreFilter(context.TODO(), framework.NewCycleState(), pod)
+	g.Expect(status.Code()).Should(Equal(framework.UnschedulableAndUnresolvable))
+}
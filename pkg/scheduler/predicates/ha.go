@@ -129,7 +129,7 @@ func (h *ha) Filter(instanceName string, pod *apiv1.Pod, nodes []apiv1.Node) ([]
 	if tc.Annotations[label.AnnHATopologyKey] != "" {
 		topologyKey = tc.Annotations[label.AnnHATopologyKey]
 	} else {
-		topologyKey = "kubernetes.io/hostname"
+		topologyKey = label.DefaultHATopologyKey
 	}
 	klog.Infof("current topology key: %s", topologyKey)
 
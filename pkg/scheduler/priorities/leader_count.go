@@ -0,0 +1,113 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"strings"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+)
+
+type leaderCount struct {
+	pdControl   pdapi.PDControlInterface
+	podListFn   func(ns, instanceName, component string) (*apiv1.PodList, error)
+	tcGetFn     func(ns, tcName string) (*v1alpha1.TidbCluster, error)
+	getStoresFn func(tc *v1alpha1.TidbCluster) (*pdapi.StoresInfo, error)
+}
+
+// NewLeaderCount returns a Priority which prefers the nodes hosting the fewest
+// TiKV region leaders, the leader counts are fetched from PD.
+func NewLeaderCount(kubeCli kubernetes.Interface, cli versioned.Interface) Priority {
+	p := &leaderCount{
+		pdControl: pdapi.NewDefaultPDControlByCli(kubeCli),
+	}
+	p.podListFn = newPodListFn(kubeCli)
+	p.tcGetFn = newTCGetFn(cli)
+	p.getStoresFn = p.realGetStoresFn
+	return p
+}
+
+func (p *leaderCount) Name() string {
+	return "LeaderCount"
+}
+
+func (p *leaderCount) Score(instanceName string, pod *apiv1.Pod, nodes []apiv1.Node) (map[string]int64, error) {
+	ns := pod.GetNamespace()
+	component := pod.Labels[label.ComponentLabelKey]
+	if component != label.TiKVLabelVal {
+		return nil, nil
+	}
+
+	tc, err := p.tcGetFn(ns, instanceName)
+	if err != nil {
+		return nil, err
+	}
+	if tc.IsTLSClusterEnabled() {
+		// the PD control of tidb-scheduler has no secret lister to load the client certificates
+		klog.V(4).Infof("tidbcluster %s/%s enables TLS, ignored in %s priority", ns, instanceName, p.Name())
+		return nil, nil
+	}
+
+	podList, err := p.podListFn(ns, instanceName, component)
+	if err != nil {
+		return nil, err
+	}
+	podNodes := map[string]string{}
+	for _, member := range podList.Items {
+		podNodes[member.Name] = member.Spec.NodeName
+	}
+
+	storesInfo, err := p.getStoresFn(tc)
+	if err != nil {
+		return nil, err
+	}
+	leaders := map[string]int64{}
+	for _, store := range storesInfo.Stores {
+		if store.Store == nil || store.Status == nil {
+			continue
+		}
+		// the store address is like ${pod}.${tc}-tikv-peer.${ns}.svc:20160
+		podName := strings.Split(store.Store.Address, ".")[0]
+		if podName == pod.Name {
+			// the leaders of the pod being scheduled will be transferred anyway
+			continue
+		}
+		if nodeName := podNodes[podName]; nodeName != "" {
+			leaders[nodeName] += int64(store.Status.LeaderCount)
+		}
+	}
+
+	var max int64
+	for _, node := range nodes {
+		if leaders[node.Name] > max {
+			max = leaders[node.Name]
+		}
+	}
+	scores := map[string]int64{}
+	for _, node := range nodes {
+		scores[node.Name] = normalizeReverse(leaders[node.Name], max)
+	}
+	return scores, nil
+}
+
+func (p *leaderCount) realGetStoresFn(tc *v1alpha1.TidbCluster) (*pdapi.StoresInfo, error) {
+	return controller.GetPDClient(p.pdControl, tc).GetStores()
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+func newStoreInfo(podName string, leaderCount int) *pdapi.StoreInfo {
+	return &pdapi.StoreInfo{
+		Store: &pdapi.MetaStore{
+			Store: &metapb.Store{Address: podName + ".demo-tikv-peer.default.svc:20160"},
+		},
+		Status: &pdapi.StoreStatus{LeaderCount: leaderCount},
+	}
+}
+
+func TestLeaderCountScore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	nodes := []apiv1.Node{
+		newNode("node-1", ""),
+		newNode("node-2", ""),
+		newNode("node-3", ""),
+		newNode("node-4", ""),
+	}
+	pods := []*apiv1.Pod{
+		newPod("demo-tikv-0", label.TiKVLabelVal, "node-4"),
+		newPod("demo-tikv-1", label.TiKVLabelVal, "node-1"),
+		newPod("demo-tikv-2", label.TiKVLabelVal, "node-2"),
+		newPod("demo-tikv-3", label.TiKVLabelVal, "node-2"),
+	}
+	stores := &pdapi.StoresInfo{
+		Stores: []*pdapi.StoreInfo{
+			newStoreInfo("demo-tikv-0", 1000),
+			newStoreInfo("demo-tikv-1", 100),
+			newStoreInfo("demo-tikv-2", 200),
+			newStoreInfo("demo-tikv-3", 200),
+			{Store: &pdapi.MetaStore{Store: &metapb.Store{Address: "demo-tiflash-0.demo-tiflash-peer.default.svc:3930"}}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		pod      *apiv1.Pod
+		tls      bool
+		expected map[string]int64
+	}{
+		{
+			name:     "score by leaders",
+			pod:      pods[0],
+			expected: map[string]int64{"node-1": 7, "node-2": 0, "node-3": 10, "node-4": 10},
+		},
+		{
+			name: "ignore other components",
+			pod:  newPod("demo-pd-0", label.PDLabelVal, ""),
+		},
+		{
+			name: "ignore TLS cluster",
+			pod:  pods[0],
+			tls:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &leaderCount{
+				podListFn: func(ns, instanceName, component string) (*apiv1.PodList, error) {
+					podList := &apiv1.PodList{}
+					for _, pod := range pods {
+						podList.Items = append(podList.Items, *pod)
+					}
+					return podList, nil
+				},
+				tcGetFn: func(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+					tc := newTidbCluster(nil)
+					if tt.tls {
+						tc.Spec.TLSCluster = &v1alpha1.TLSCluster{Enabled: true}
+					}
+					return tc, nil
+				},
+				getStoresFn: func(tc *v1alpha1.TidbCluster) (*pdapi.StoresInfo, error) {
+					return stores, nil
+				},
+			}
+			scores, err := p.Score("demo", tt.pod, nodes)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tt.expected == nil {
+				g.Expect(scores).To(BeEmpty())
+			} else {
+				g.Expect(scores).To(Equal(tt.expected))
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"context"
+
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	schedulerapi "k8s.io/kube-scheduler/extender/v1"
+	v1helper "k8s.io/kubernetes/pkg/apis/core/v1/helper"
+)
+
+type localVolume struct {
+	kubeCli  kubernetes.Interface
+	pvcGetFn func(ns, pvcName string) (*apiv1.PersistentVolumeClaim, error)
+	pvGetFn  func(pvName string) (*apiv1.PersistentVolume, error)
+}
+
+// NewLocalVolume returns a Priority which prefers the nodes where the bound
+// volumes of the pod are accessible, e.g. the node of a bound local PV.
+func NewLocalVolume(kubeCli kubernetes.Interface) Priority {
+	p := &localVolume{
+		kubeCli: kubeCli,
+	}
+	p.pvcGetFn = p.realPVCGetFn
+	p.pvGetFn = p.realPVGetFn
+	return p
+}
+
+func (p *localVolume) Name() string {
+	return "LocalVolume"
+}
+
+func (p *localVolume) Score(_ string, pod *apiv1.Pod, nodes []apiv1.Node) (map[string]int64, error) {
+	ns := pod.GetNamespace()
+
+	var terms [][]apiv1.NodeSelectorTerm
+	for _, vol := range pod.Spec.Volumes {
+		if vol.PersistentVolumeClaim == nil {
+			continue
+		}
+		pvc, err := p.pvcGetFn(ns, vol.PersistentVolumeClaim.ClaimName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if pvc.Status.Phase != apiv1.ClaimBound || pvc.Spec.VolumeName == "" {
+			continue
+		}
+		pv, err := p.pvGetFn(pvc.Spec.VolumeName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if pv.Spec.NodeAffinity != nil && pv.Spec.NodeAffinity.Required != nil {
+			terms = append(terms, pv.Spec.NodeAffinity.Required.NodeSelectorTerms)
+		}
+	}
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	scores := map[string]int64{}
+	for _, node := range nodes {
+		nodeFields := fields.Set{"metadata.name": node.Name}
+		matched := true
+		for _, t := range terms {
+			if !v1helper.MatchNodeSelectorTerms(t, labels.Set(node.Labels), nodeFields) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			scores[node.Name] = schedulerapi.MaxExtenderPriority
+		}
+	}
+	return scores, nil
+}
+
+func (p *localVolume) realPVCGetFn(ns, pvcName string) (*apiv1.PersistentVolumeClaim, error) {
+	return p.kubeCli.CoreV1().PersistentVolumeClaims(ns).Get(context.TODO(), pvcName, metav1.GetOptions{})
+}
+
+func (p *localVolume) realPVGetFn(pvName string) (*apiv1.PersistentVolume, error) {
+	return p.kubeCli.CoreV1().PersistentVolumes().Get(context.TODO(), pvName, metav1.GetOptions{})
+}
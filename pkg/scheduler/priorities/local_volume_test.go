@@ -0,0 +1,139 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	apiv1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestLocalVolumeScore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	nodes := []apiv1.Node{
+		newNode("node-1", ""),
+		newNode("node-2", ""),
+	}
+	pvcs := map[string]*apiv1.PersistentVolumeClaim{
+		"tikv-demo-tikv-0": {
+			Spec:   apiv1.PersistentVolumeClaimSpec{VolumeName: "local-pv"},
+			Status: apiv1.PersistentVolumeClaimStatus{Phase: apiv1.ClaimBound},
+		},
+		"tikv-demo-tikv-1": {
+			Spec:   apiv1.PersistentVolumeClaimSpec{VolumeName: "network-pv"},
+			Status: apiv1.PersistentVolumeClaimStatus{Phase: apiv1.ClaimBound},
+		},
+		"tikv-demo-tikv-2": {
+			Status: apiv1.PersistentVolumeClaimStatus{Phase: apiv1.ClaimPending},
+		},
+		"tikv-demo-tikv-4": {
+			Spec:   apiv1.PersistentVolumeClaimSpec{VolumeName: "deleted-pv"},
+			Status: apiv1.PersistentVolumeClaimStatus{Phase: apiv1.ClaimBound},
+		},
+	}
+	pvs := map[string]*apiv1.PersistentVolume{
+		"local-pv": {
+			ObjectMeta: metav1.ObjectMeta{Name: "local-pv"},
+			Spec: apiv1.PersistentVolumeSpec{
+				NodeAffinity: &apiv1.VolumeNodeAffinity{
+					Required: &apiv1.NodeSelector{
+						NodeSelectorTerms: []apiv1.NodeSelectorTerm{
+							{
+								MatchExpressions: []apiv1.NodeSelectorRequirement{
+									{
+										Key:      label.DefaultHATopologyKey,
+										Operator: apiv1.NodeSelectorOpIn,
+										Values:   []string{"node-2"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"network-pv": {
+			ObjectMeta: metav1.ObjectMeta{Name: "network-pv"},
+		},
+	}
+	p := &localVolume{
+		pvcGetFn: func(ns, pvcName string) (*apiv1.PersistentVolumeClaim, error) {
+			if pvc, ok := pvcs[pvcName]; ok {
+				return pvc, nil
+			}
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumeclaims"}, pvcName)
+		},
+		pvGetFn: func(pvName string) (*apiv1.PersistentVolume, error) {
+			if pv, ok := pvs[pvName]; ok {
+				return pv, nil
+			}
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "persistentvolumes"}, pvName)
+		},
+	}
+
+	tests := []struct {
+		name     string
+		podName  string
+		expected map[string]int64
+	}{
+		{
+			name:     "local pv bound",
+			podName:  "demo-tikv-0",
+			expected: map[string]int64{"node-2": 10},
+		},
+		{
+			name:    "pv without node affinity",
+			podName: "demo-tikv-1",
+		},
+		{
+			name:    "pvc not bound",
+			podName: "demo-tikv-2",
+		},
+		{
+			name:    "pvc not found",
+			podName: "demo-tikv-3",
+		},
+		{
+			name:    "pv not found",
+			podName: "demo-tikv-4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := newPod(tt.podName, label.TiKVLabelVal, "")
+			pod.Spec.Volumes = []apiv1.Volume{
+				{
+					Name: "tikv",
+					VolumeSource: apiv1.VolumeSource{
+						PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{ClaimName: "tikv-" + tt.podName},
+					},
+				},
+			}
+			scores, err := p.Score("demo", pod, nodes)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tt.expected == nil {
+				g.Expect(scores).To(BeEmpty())
+			} else {
+				g.Expect(scores).To(Equal(tt.expected))
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	schedulerapi "k8s.io/kube-scheduler/extender/v1"
+)
+
+// Priority is an interface as extender-implemented priority functions
+type Priority interface {
+	// Name return the priority name
+	Name() string
+
+	// Score returns the score of each node by node name, scores are in the range
+	// of [0, schedulerapi.MaxExtenderPriority] and nodes not in the result are scored 0.
+	Score(string, *apiv1.Pod, []apiv1.Node) (map[string]int64, error)
+}
+
+// normalizeReverse maps a value in [0, max] to a score in [0, MaxExtenderPriority],
+// the smaller the value is, the higher the score is.
+func normalizeReverse(value, max int64) int64 {
+	if max <= 0 {
+		return schedulerapi.MaxExtenderPriority
+	}
+	if value > max {
+		value = max
+	}
+	return (max - value) * schedulerapi.MaxExtenderPriority / max
+}
+
+// newPodListFn returns a function listing the pods of the component in the TidbCluster
+func newPodListFn(kubeCli kubernetes.Interface) func(ns, instanceName, component string) (*apiv1.PodList, error) {
+	return func(ns, instanceName, component string) (*apiv1.PodList, error) {
+		selector := label.New().Instance(instanceName).Component(component).Labels()
+		return kubeCli.CoreV1().Pods(ns).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(selector).String(),
+		})
+	}
+}
+
+// newTCGetFn returns a function getting the TidbCluster
+func newTCGetFn(cli versioned.Interface) func(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+	return func(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+		return cli.PingcapV1alpha1().TidbClusters(ns).Get(context.TODO(), tcName, metav1.GetOptions{})
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type topologySpread struct {
+	kubeCli            kubernetes.Interface
+	podListFn          func(ns, instanceName, component string) (*apiv1.PodList, error)
+	tcGetFn            func(ns, tcName string) (*v1alpha1.TidbCluster, error)
+	scheduledNodeGetFn func(nodeName string) (*apiv1.Node, error)
+}
+
+// NewTopologySpread returns a Priority which prefers the nodes in the topology
+// with the fewest pods of the same component, the topology is defined by the
+// AnnHATopologyKey annotation of the TidbCluster.
+func NewTopologySpread(kubeCli kubernetes.Interface, cli versioned.Interface) Priority {
+	p := &topologySpread{
+		kubeCli: kubeCli,
+	}
+	p.podListFn = newPodListFn(kubeCli)
+	p.tcGetFn = newTCGetFn(cli)
+	p.scheduledNodeGetFn = p.realScheduledNodeGetFn
+	return p
+}
+
+func (p *topologySpread) Name() string {
+	return "TopologySpread"
+}
+
+func (p *topologySpread) Score(instanceName string, pod *apiv1.Pod, nodes []apiv1.Node) (map[string]int64, error) {
+	ns := pod.GetNamespace()
+	component := pod.Labels[label.ComponentLabelKey]
+
+	tc, err := p.tcGetFn(ns, instanceName)
+	if err != nil {
+		return nil, err
+	}
+	topologyKey := tc.Annotations[label.AnnHATopologyKey]
+	if topologyKey == "" {
+		topologyKey = label.DefaultHATopologyKey
+	}
+
+	podList, err := p.podListFn(ns, instanceName, component)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeTopologies := map[string]string{}
+	for _, node := range nodes {
+		nodeTopologies[node.Name] = node.Labels[topologyKey]
+	}
+	counts := map[string]int64{}
+	for i := range podList.Items {
+		member := &podList.Items[i]
+		if member.Name == pod.Name || member.Spec.NodeName == "" || member.DeletionTimestamp != nil {
+			continue
+		}
+		topology, ok := nodeTopologies[member.Spec.NodeName]
+		if !ok {
+			node, err := p.scheduledNodeGetFn(member.Spec.NodeName)
+			if errors.IsNotFound(err) {
+				// the topology of the member is unknown since its node is deleted
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			topology = node.Labels[topologyKey]
+			nodeTopologies[member.Spec.NodeName] = topology
+		}
+		if topology != "" {
+			counts[topology]++
+		}
+	}
+
+	var max int64
+	for _, node := range nodes {
+		if topology := node.Labels[topologyKey]; topology != "" && counts[topology] > max {
+			max = counts[topology]
+		}
+	}
+	scores := map[string]int64{}
+	for _, node := range nodes {
+		topology := node.Labels[topologyKey]
+		if topology == "" {
+			// the pod can't be spread by the topology on this node
+			continue
+		}
+		scores[node.Name] = normalizeReverse(counts[topology], max)
+	}
+	return scores, nil
+}
+
+func (p *topologySpread) realScheduledNodeGetFn(nodeName string) (*apiv1.Node, error) {
+	return p.kubeCli.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+}
@@ -0,0 +1,149 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package priorities
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newNode(name, zone string) apiv1.Node {
+	node := apiv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{label.DefaultHATopologyKey: name},
+		},
+	}
+	if zone != "" {
+		node.Labels["zone"] = zone
+	}
+	return node
+}
+
+func newPod(name, component, nodeName string) *apiv1.Pod {
+	return &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      name,
+			Labels: map[string]string{
+				label.InstanceLabelKey:  "demo",
+				label.ComponentLabelKey: component,
+			},
+		},
+		Spec: apiv1.PodSpec{NodeName: nodeName},
+	}
+}
+
+func newTidbCluster(annotations map[string]string) *v1alpha1.TidbCluster {
+	return &v1alpha1.TidbCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   metav1.NamespaceDefault,
+			Name:        "demo",
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestTopologySpreadScore(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	nodes := []apiv1.Node{
+		newNode("node-1", "zone-a"),
+		newNode("node-2", "zone-a"),
+		newNode("node-3", "zone-b"),
+		newNode("node-4", "zone-c"),
+		newNode("node-5", ""),
+	}
+	scheduledNodes := map[string]apiv1.Node{
+		"node-6": newNode("node-6", "zone-c"),
+	}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		pods        []*apiv1.Pod
+		expected    map[string]int64
+	}{
+		{
+			name: "no pods scheduled",
+			pods: []*apiv1.Pod{
+				newPod("demo-tikv-0", label.TiKVLabelVal, ""),
+			},
+			expected: map[string]int64{"node-1": 10, "node-2": 10, "node-3": 10, "node-4": 10, "node-5": 10},
+		},
+		{
+			name: "spread by hostname",
+			pods: []*apiv1.Pod{
+				newPod("demo-tikv-0", label.TiKVLabelVal, ""),
+				newPod("demo-tikv-1", label.TiKVLabelVal, "node-1"),
+				newPod("demo-tikv-2", label.TiKVLabelVal, "node-1"),
+				newPod("demo-tikv-3", label.TiKVLabelVal, "node-2"),
+			},
+			expected: map[string]int64{"node-1": 0, "node-2": 5, "node-3": 10, "node-4": 10, "node-5": 10},
+		},
+		{
+			name:        "spread by zone",
+			annotations: map[string]string{label.AnnHATopologyKey: "zone"},
+			pods: []*apiv1.Pod{
+				newPod("demo-tikv-0", label.TiKVLabelVal, "node-3"),
+				newPod("demo-tikv-1", label.TiKVLabelVal, "node-1"),
+				newPod("demo-tikv-2", label.TiKVLabelVal, "node-2"),
+				newPod("demo-tikv-3", label.TiKVLabelVal, "node-6"),
+			},
+			expected: map[string]int64{"node-1": 0, "node-2": 0, "node-3": 10, "node-4": 5},
+		},
+		{
+			name: "node of a member is deleted",
+			pods: []*apiv1.Pod{
+				newPod("demo-tikv-0", label.TiKVLabelVal, ""),
+				newPod("demo-tikv-1", label.TiKVLabelVal, "node-1"),
+				newPod("demo-tikv-2", label.TiKVLabelVal, "node-7"),
+			},
+			expected: map[string]int64{"node-1": 0, "node-2": 10, "node-3": 10, "node-4": 10, "node-5": 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &topologySpread{
+				podListFn: func(ns, instanceName, component string) (*apiv1.PodList, error) {
+					podList := &apiv1.PodList{}
+					for _, pod := range tt.pods {
+						podList.Items = append(podList.Items, *pod)
+					}
+					return podList, nil
+				},
+				tcGetFn: func(ns, tcName string) (*v1alpha1.TidbCluster, error) {
+					return newTidbCluster(tt.annotations), nil
+				},
+				scheduledNodeGetFn: func(nodeName string) (*apiv1.Node, error) {
+					node, ok := scheduledNodes[nodeName]
+					if !ok {
+						return nil, errors.NewNotFound(apiv1.Resource("nodes"), nodeName)
+					}
+					return &node, nil
+				},
+			}
+			scores, err := p.Score("demo", tt.pods[0], nodes)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(scores).To(Equal(tt.expected))
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/scheduler/predicates"
+	"github.com/pingcap/tidb-operator/pkg/scheduler/priorities"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -35,6 +36,9 @@ import (
 // Scheduler is an interface for external processes to influence scheduling
 // decisions made by kubernetes. This is typically needed for resources not directly
 // managed by kubernetes.
+//
+// The extender is superseded by the TiDBScheduling plugin in pkg/scheduler/plugins,
+// which is built into tidb-kube-scheduler.
 type Scheduler interface {
 	// Filter based on extender-implemented predicate functions. The filtered list is
 	// expected to be a subset of the supplied list.
@@ -52,6 +56,8 @@ type Scheduler interface {
 type scheduler struct {
 	// component => predicates
 	predicates map[string][]predicates.Predicate
+	// component => priorities
+	priorities map[string][]priorities.Priority
 
 	kubeCli  kubernetes.Interface
 	recorder record.EventRecorder
@@ -77,8 +83,28 @@ func NewScheduler(kubeCli kubernetes.Interface, cli versioned.Interface) Schedul
 			predicates.NewStableScheduling(kubeCli, cli),
 		}
 	}
+	prioritiesByComponent := map[string][]priorities.Priority{
+		label.PDLabelVal: {
+			priorities.NewTopologySpread(kubeCli, cli),
+			priorities.NewLocalVolume(kubeCli),
+		},
+		label.TiKVLabelVal: {
+			priorities.NewTopologySpread(kubeCli, cli),
+			priorities.NewLeaderCount(kubeCli, cli),
+			priorities.NewLocalVolume(kubeCli),
+		},
+		label.TiFlashLabelVal: {
+			priorities.NewTopologySpread(kubeCli, cli),
+			priorities.NewLocalVolume(kubeCli),
+		},
+		label.TiCDCLabelVal: {
+			priorities.NewTopologySpread(kubeCli, cli),
+			priorities.NewLocalVolume(kubeCli),
+		},
+	}
 	return &scheduler{
 		predicates: predicatesByComponent,
+		priorities: prioritiesByComponent,
 		kubeCli:    kubeCli,
 		recorder:   recorder,
 	}
@@ -250,19 +276,53 @@ func (ferr FailureError) Error() string {
 	return fmt.Sprintf("pod %s had an intentional failure injected", ferr.PodName)
 }
 
-// We don't pass `prioritizeVerb` to kubernetes scheduler extender's config file, this method will not be called.
+// Priority scores the nodes of *schedulerapi.ExtenderArgs.Nodes with the priorities of the pod's component,
+// the score of a node is the average of the scores given by the priorities. A failed priority is ignored
+// rather than failing the scheduling, so nodes are still ranked by the other priorities.
 func (s *scheduler) Priority(args *schedulerapi.ExtenderArgs) (schedulerapi.HostPriorityList, error) {
 	result := schedulerapi.HostPriorityList{}
+	if args.Nodes == nil {
+		return result, nil
+	}
+	kubeNodes := args.Nodes.Items
 
-	if args.Nodes != nil {
-		for _, node := range args.Nodes.Items {
-			result = append(result, schedulerapi.HostPriority{
-				Host:  node.Name,
-				Score: 0,
-			})
+	var prioritiesByComponent []priorities.Priority
+	var instanceName string
+	pod := args.Pod
+	if pod != nil {
+		instanceName = pod.Labels[label.InstanceLabelKey]
+		prioritiesByComponent = s.priorities[pod.Labels[label.ComponentLabelKey]]
+	}
+
+	scores := map[string]int64{}
+	if instanceName != "" && len(prioritiesByComponent) > 0 {
+		ns := pod.GetNamespace()
+		podName := pod.GetName()
+		var succeeded int64
+		for _, priority := range prioritiesByComponent {
+			priorityScores, err := priority.Score(instanceName, pod, kubeNodes)
+			if err != nil {
+				klog.Warningf("priority %s failed to score nodes for pod %s/%s, ignored: %v", priority.Name(), ns, podName, err)
+				continue
+			}
+			klog.V(4).Infof("priority %s scores nodes for pod %s/%s: %v", priority.Name(), ns, podName, priorityScores)
+			succeeded++
+			for nodeName, score := range priorityScores {
+				scores[nodeName] += score
+			}
+		}
+		for nodeName := range scores {
+			scores[nodeName] /= succeeded
 		}
 	}
 
+	for _, node := range kubeNodes {
+		result = append(result, schedulerapi.HostPriority{
+			Host:  node.Name,
+			Score: scores[node.Name],
+		})
+	}
+
 	return result, nil
 }
 
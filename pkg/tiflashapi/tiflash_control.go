@@ -16,10 +16,12 @@ package tiflashapi
 import (
 	"crypto/tls"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tracing"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
@@ -59,13 +61,13 @@ func (tc *defaultTiFlashControl) GetTiFlashPodClient(namespace string, tcName st
 		tlsConfig, err = pdapi.GetTLSConfig(tc.secretLister, pdapi.Namespace(namespace), util.ClusterClientTLSSecretName(tcName))
 		if err != nil {
 			klog.Errorf("Unable to get tls config for TiFlash cluster %q, tiflash client may not work: %v", tcName, err)
-			return NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+			return withTracing(NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, tcName)
 		}
 
-		return NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+		return withTracing(NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, tcName)
 	}
 
-	return NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+	return withTracing(NewTiFlashClient(TiFlashPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, tcName)
 }
 
 func tiflashPodClientKey(schema, namespace, clusterName, podName string) string {
@@ -74,7 +76,12 @@ func tiflashPodClientKey(schema, namespace, clusterName, podName string) string
 
 // TiFlashPodClientURL builds the url of tiflash pod client
 func TiFlashPodClientURL(namespace, clusterName, podName, scheme string) string {
-	return fmt.Sprintf("%s://%s.%s-tiflash-peer.%s:20292", scheme, podName, clusterName, namespace)
+	peerName := fmt.Sprintf("%s-tiflash-peer", clusterName)
+	// compute nodes in the disaggregated architecture have their own peer service
+	if strings.HasPrefix(podName, fmt.Sprintf("%s-tiflash-compute-", clusterName)) {
+		peerName = fmt.Sprintf("%s-tiflash-compute-peer", clusterName)
+	}
+	return fmt.Sprintf("%s://%s.%s.%s:20292", scheme, podName, peerName, namespace)
 }
 
 // FakeTiFlashControl implements a fake version of TiFlashControlInterface.
@@ -97,3 +104,11 @@ func (ftc *FakeTiFlashControl) SetTiFlashPodClient(namespace, tcName, podName st
 func (ftc *FakeTiFlashControl) GetTiFlashPodClient(namespace, tcName, podName string, tlsEnabled bool) TiFlashClient {
 	return ftc.tiflashPodClients[tiflashPodClientKey("http", namespace, tcName, podName)]
 }
+
+// withTracing traces the requests of the client as children of the active span of the TidbCluster.
+func withTracing(c TiFlashClient, namespace, name string) TiFlashClient {
+	if cli, ok := c.(*tiflashClient); ok {
+		cli.httpClient.Transport = tracing.NewTransport(cli.httpClient.Transport, tracing.TidbClusterKey(namespace, name))
+	}
+	return c
+}
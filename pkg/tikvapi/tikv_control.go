@@ -19,6 +19,7 @@ import (
 	"sync"
 
 	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tracing"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	corelisterv1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/klog/v2"
@@ -55,13 +56,13 @@ func (tc *defaultTiKVControl) GetTiKVPodClient(namespace string, tcName string,
 		tlsConfig, err = pdapi.GetTLSConfig(tc.secretLister, pdapi.Namespace(namespace), util.ClusterClientTLSSecretName(tcName))
 		if err != nil {
 			klog.Errorf("Unable to get tls config for TiKV cluster %q, tikv client may not work: %v", tcName, err)
-			return NewTiKVClient(TiKVPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+			return withTracing(NewTiKVClient(TiKVPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, tcName)
 		}
 
-		return NewTiKVClient(TiKVPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+		return withTracing(NewTiKVClient(TiKVPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, tcName)
 	}
 
-	return NewTiKVClient(TiKVPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true)
+	return withTracing(NewTiKVClient(TiKVPodClientURL(namespace, tcName, podName, scheme), DefaultTimeout, tlsConfig, true), namespace, tcName)
 }
 
 func tikvPodClientKey(schema, namespace, clusterName, podName string) string {
@@ -93,3 +94,11 @@ func (ftc *FakeTiKVControl) SetTiKVPodClient(namespace, tcName, podName string,
 func (ftc *FakeTiKVControl) GetTiKVPodClient(namespace, tcName, podName string, tlsEnabled bool) TiKVClient {
 	return ftc.tikvPodClients[tikvPodClientKey("http", namespace, tcName, podName)]
 }
+
+// withTracing traces the requests of the client as children of the active span of the TidbCluster.
+func withTracing(c TiKVClient, namespace, name string) TiKVClient {
+	if cli, ok := c.(*tikvClient); ok {
+		cli.httpClient.Transport = tracing.NewTransport(cli.httpClient.Transport, tracing.TidbClusterKey(namespace, name))
+	}
+	return c
+}
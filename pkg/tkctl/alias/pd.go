@@ -0,0 +1,142 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alias
+
+import (
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PDAPIVersion is the apiVersion set on the PD objects so that they can be
+// printed by the json and yaml printers, which require a kind.
+const PDAPIVersion = "pd/v1"
+
+// The PD objects below wrap the responses of PD API into runtime.Object,
+// these responses are never modified after being fetched, so DeepCopyObject
+// only copies the top level.
+
+// PDStoreList is the stores returned from PD
+type PDStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	*pdapi.StoresInfo
+}
+
+// NewPDStoreList creates a PDStoreList from the PD stores info
+func NewPDStoreList(info *pdapi.StoresInfo) *PDStoreList {
+	return &PDStoreList{
+		TypeMeta:   metav1.TypeMeta{APIVersion: PDAPIVersion, Kind: "StoreList"},
+		StoresInfo: info,
+	}
+}
+
+func (l *PDStoreList) DeepCopyObject() runtime.Object {
+	out := *l
+	return &out
+}
+
+// PDMemberList is the members returned from PD
+type PDMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	*pdapi.MembersInfo
+}
+
+// NewPDMemberList creates a PDMemberList from the PD members info
+func NewPDMemberList(info *pdapi.MembersInfo) *PDMemberList {
+	return &PDMemberList{
+		TypeMeta:    metav1.TypeMeta{APIVersion: PDAPIVersion, Kind: "MemberList"},
+		MembersInfo: info,
+	}
+}
+
+func (l *PDMemberList) DeepCopyObject() runtime.Object {
+	out := *l
+	return &out
+}
+
+// PDRegionList is the regions returned from PD
+type PDRegionList struct {
+	metav1.TypeMeta `json:",inline"`
+	*pdapi.RegionsInfo
+}
+
+// NewPDRegionList creates a PDRegionList from the PD regions info
+func NewPDRegionList(info *pdapi.RegionsInfo) *PDRegionList {
+	return &PDRegionList{
+		TypeMeta:    metav1.TypeMeta{APIVersion: PDAPIVersion, Kind: "RegionList"},
+		RegionsInfo: info,
+	}
+}
+
+func (l *PDRegionList) DeepCopyObject() runtime.Object {
+	out := *l
+	return &out
+}
+
+// PDHotRegions is the hot read or write regions returned from PD
+type PDHotRegions struct {
+	metav1.TypeMeta `json:",inline"`
+	*pdapi.StoreHotPeersInfos
+}
+
+// NewPDHotRegions creates a PDHotRegions from the PD hot regions info
+func NewPDHotRegions(info *pdapi.StoreHotPeersInfos) *PDHotRegions {
+	return &PDHotRegions{
+		TypeMeta:           metav1.TypeMeta{APIVersion: PDAPIVersion, Kind: "HotRegions"},
+		StoreHotPeersInfos: info,
+	}
+}
+
+func (h *PDHotRegions) DeepCopyObject() runtime.Object {
+	out := *h
+	return &out
+}
+
+// PDSchedulerList is the names of schedulers returned from PD
+type PDSchedulerList struct {
+	metav1.TypeMeta `json:",inline"`
+	Schedulers      []string `json:"schedulers"`
+}
+
+// NewPDSchedulerList creates a PDSchedulerList from the PD scheduler names
+func NewPDSchedulerList(schedulers []string) *PDSchedulerList {
+	return &PDSchedulerList{
+		TypeMeta:   metav1.TypeMeta{APIVersion: PDAPIVersion, Kind: "SchedulerList"},
+		Schedulers: schedulers,
+	}
+}
+
+func (l *PDSchedulerList) DeepCopyObject() runtime.Object {
+	out := *l
+	return &out
+}
+
+// PDConfig is the config returned from PD
+type PDConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	*pdapi.PDConfigFromAPI
+}
+
+// NewPDConfig creates a PDConfig from the PD config
+func NewPDConfig(config *pdapi.PDConfigFromAPI) *PDConfig {
+	return &PDConfig{
+		TypeMeta:        metav1.TypeMeta{APIVersion: PDAPIVersion, Kind: "Config"},
+		PDConfigFromAPI: config,
+	}
+}
+
+func (c *PDConfig) DeepCopyObject() runtime.Object {
+	out := *c
+	return &out
+}
@@ -25,6 +25,11 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/get"
 	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/info"
 	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/list"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/pdctl"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/portforward"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/sql"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/top"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/upgrade"
 	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/upinfo"
 	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/use"
 	"github.com/pingcap/tidb-operator/pkg/tkctl/cmd/version"
@@ -80,6 +85,7 @@ func NewTkcCommand(streams genericclioptions.IOStreams) *cobra.Command {
 				use.NewCmdUse(tkcContext, streams),
 				version.NewCmdVersion(tkcContext, streams.Out),
 				upinfo.NewCmdUpInfo(tkcContext, streams),
+				upgrade.NewCmdUpgrade(tkcContext, streams),
 				diagnose.NewCmdDiagnoseInfo(tkcContext, streams),
 			},
 		},
@@ -88,6 +94,10 @@ func NewTkcCommand(streams genericclioptions.IOStreams) *cobra.Command {
 			Commands: []*cobra.Command{
 				debug.NewCmdDebug(tkcContext, streams),
 				ctop.NewCmdCtop(tkcContext, streams),
+				top.NewCmdTop(tkcContext, streams),
+				pdctl.NewCmdPdctl(tkcContext, streams),
+				sql.NewCmdSQL(tkcContext, streams),
+				portforward.NewCmdPortForward(tkcContext, streams),
 			},
 		},
 		{
@@ -0,0 +1,80 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestArchiveWithManifest(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tmp, err := ioutil.TempDir("", "diagnose")
+	g.Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(tmp)
+
+	dir := filepath.Join(tmp, "demo-default-diagnose")
+	g.Expect(os.MkdirAll(filepath.Join(dir, "components", "pd"), os.ModePerm)).To(Succeed())
+	g.Expect(ioutil.WriteFile(filepath.Join(dir, "resources"), []byte("tc"), 0644)).To(Succeed())
+	g.Expect(ioutil.WriteFile(filepath.Join(dir, "components", "pd", "stores.json"), []byte("{}"), 0644)).To(Succeed())
+
+	m := &manifest{Cluster: "demo", Namespace: "default", Errors: []string{"get /metrics of pod demo-tikv-0: timeout"}}
+	g.Expect(writeManifest(dir, m)).To(Succeed())
+	g.Expect(m.Files).To(Equal([]manifestFile{
+		{Path: "components/pd/stores.json", Size: 2},
+		{Path: "resources", Size: 2},
+	}))
+
+	target := dir + ".tar.gz"
+	g.Expect(archiveDir(dir, target)).To(Succeed())
+
+	f, err := os.Open(target)
+	g.Expect(err).NotTo(HaveOccurred())
+	defer f.Close()
+	gr, err := gzip.NewReader(f)
+	g.Expect(err).NotTo(HaveOccurred())
+	tr := tar.NewReader(gr)
+
+	files := map[string][]byte{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		g.Expect(err).NotTo(HaveOccurred())
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		g.Expect(err).NotTo(HaveOccurred())
+		files[header.Name] = data
+	}
+	g.Expect(files).To(HaveLen(3))
+	g.Expect(string(files["demo-default-diagnose/components/pd/stores.json"])).To(Equal("{}"))
+
+	var got manifest
+	g.Expect(json.Unmarshal(files["demo-default-diagnose/manifest.json"], &got)).To(Succeed())
+	g.Expect(got.Cluster).To(Equal("demo"))
+	g.Expect(got.Files).To(Equal(m.Files))
+	g.Expect(got.Errors).To(Equal(m.Errors))
+}
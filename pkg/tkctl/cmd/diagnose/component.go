@@ -0,0 +1,263 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	tkctlUtil "github.com/pingcap/tidb-operator/pkg/tkctl/util"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	tidbStatusPort  = 10080
+	tikvStatusPort  = 20180
+	ticdcStatusPort = 8301
+
+	snapshotTimeout = 10 * time.Second
+)
+
+// snapshot is an HTTP API of a component to capture.
+type snapshot struct {
+	path string
+	file string
+	// raw snapshots are written as is instead of being redacted as json
+	raw bool
+	// once snapshots describe the whole cluster, so they are only captured
+	// from the first pod which responds
+	once bool
+}
+
+// componentDumper captures snapshots of the APIs of PD, TiDB, TiKV and TiCDC.
+// Failures of capturing are collected instead of being returned, because
+// a partial snapshot is still useful to diagnose an unhealthy cluster.
+type componentDumper struct {
+	kubeCli    *kubernetes.Clientset
+	restConfig *rest.Config
+	tc         *v1alpha1.TidbCluster
+	errOut     io.Writer
+
+	tlsConfig *tls.Config
+	errs      []error
+}
+
+// NewComponentDumper returns a componentDumper.
+func NewComponentDumper(kubeCli *kubernetes.Clientset, restConfig *rest.Config, tc *v1alpha1.TidbCluster, errOut io.Writer) *componentDumper {
+	return &componentDumper{
+		kubeCli:    kubeCli,
+		restConfig: restConfig,
+		tc:         tc,
+		errOut:     errOut,
+	}
+}
+
+// Errors returns the failures of capturing snapshots.
+func (d *componentDumper) Errors() []error {
+	return d.errs
+}
+
+// Dump dumps the snapshots of component APIs from a particular tidb cluster.
+func (d *componentDumper) Dump(logPath string, resourceWriter io.Writer) error {
+	if _, err := resourceWriter.Write([]byte("----------------components---------------\n")); err != nil {
+		return err
+	}
+
+	tlsConfig, err := tkctlUtil.ClusterTLSConfig(d.kubeCli, d.tc)
+	if err != nil {
+		d.errs = append(d.errs, err)
+		return nil
+	}
+	d.tlsConfig = tlsConfig
+
+	if !d.tc.WithoutLocalPD() {
+		d.dumpPD(filepath.Join(logPath, "components", "pd"), resourceWriter)
+	}
+	if d.tc.Spec.TiDB != nil {
+		d.dumpPods(filepath.Join(logPath, "components", "tidb"), resourceWriter,
+			label.New().Instance(d.tc.Name).TiDB().String(), controller.TiDBPeerMemberName(d.tc.Name), tidbStatusPort,
+			[]snapshot{
+				{path: "/info/all", file: "info-all.json", once: true},
+				{path: "/settings", file: "settings.json"},
+			})
+	}
+	if d.tc.Spec.TiKV != nil {
+		d.dumpPods(filepath.Join(logPath, "components", "tikv"), resourceWriter,
+			label.New().Instance(d.tc.Name).TiKV().String(), controller.TiKVPeerMemberName(d.tc.Name), tikvStatusPort,
+			[]snapshot{
+				{path: "/config", file: "config.json"},
+				{path: "/metrics", file: "metrics.txt", raw: true},
+			})
+	}
+	if d.tc.Spec.TiCDC != nil {
+		d.dumpPods(filepath.Join(logPath, "components", "ticdc"), resourceWriter,
+			label.New().Instance(d.tc.Name).TiCDC().String(), controller.TiCDCPeerMemberName(d.tc.Name), ticdcStatusPort,
+			[]snapshot{
+				{path: "/api/v1/captures", file: "captures.json", once: true},
+			})
+	}
+	return nil
+}
+
+// dumpPD dumps the members, stores, schedulers and config of PD.
+func (d *componentDumper) dumpPD(path string, resourceWriter io.Writer) {
+	pdCli, stop, err := tkctlUtil.NewPDClient(d.kubeCli, d.restConfig, d.tc, false, d.errOut)
+	if err != nil {
+		d.errs = append(d.errs, fmt.Errorf("connect to pd: %v", err))
+		return
+	}
+	defer stop()
+
+	for _, s := range []struct {
+		file string
+		get  func() (interface{}, error)
+	}{
+		{"members.json", func() (interface{}, error) { return pdCli.GetMembers() }},
+		{"stores.json", func() (interface{}, error) { return pdCli.GetStores() }},
+		{"schedulers.json", func() (interface{}, error) { return pdCli.GetSchedulers() }},
+		{"config.json", func() (interface{}, error) { return pdCli.GetConfig() }},
+	} {
+		obj, err := s.get()
+		if err != nil {
+			d.errs = append(d.errs, fmt.Errorf("get pd %s: %v", s.file, err))
+			continue
+		}
+		data, err := json.Marshal(obj)
+		if err != nil {
+			d.errs = append(d.errs, err)
+			continue
+		}
+		d.write(filepath.Join(path, s.file), redactJSON(data), resourceWriter)
+	}
+}
+
+// dumpPods captures the snapshots from the running pods matched by the selector.
+func (d *componentDumper) dumpPods(path string, resourceWriter io.Writer, selector, peerSvcName string, port int, snapshots []snapshot) {
+	podList, err := d.kubeCli.CoreV1().Pods(d.tc.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		d.errs = append(d.errs, err)
+		return
+	}
+
+	captured := map[string]bool{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != v1.PodRunning {
+			continue
+		}
+		var pending []snapshot
+		for _, s := range snapshots {
+			if !s.once || !captured[s.file] {
+				pending = append(pending, s)
+			}
+		}
+		if len(pending) == 0 {
+			continue
+		}
+
+		for _, s := range d.dumpPod(path, resourceWriter, pod, peerSvcName, port, pending) {
+			captured[s.file] = true
+		}
+	}
+}
+
+// dumpPod forwards a local port to the pod and captures the snapshots, the
+// captured snapshots are returned.
+func (d *componentDumper) dumpPod(path string, resourceWriter io.Writer, pod *v1.Pod, peerSvcName string, port int, snapshots []snapshot) []snapshot {
+	localPort, stop, err := tkctlUtil.ForwardPodPort(d.kubeCli, d.restConfig, pod, 0, port, d.errOut)
+	if err != nil {
+		d.errs = append(d.errs, fmt.Errorf("forward port %d of pod %s: %v", port, pod.Name, err))
+		return nil
+	}
+	defer stop()
+
+	scheme := "http"
+	transport := &http.Transport{}
+	if d.tlsConfig != nil {
+		scheme = "https"
+		tlsConfig := d.tlsConfig.Clone()
+		// the certificates are issued for the peer service instead of the local address
+		tlsConfig.ServerName = fmt.Sprintf("%s.%s.%s.svc", pod.Name, peerSvcName, pod.Namespace)
+		transport.TLSClientConfig = tlsConfig
+	}
+	httpCli := &http.Client{Timeout: snapshotTimeout, Transport: transport}
+	defer transport.CloseIdleConnections()
+
+	var captured []snapshot
+	for _, s := range snapshots {
+		data, err := httpGet(httpCli, fmt.Sprintf("%s://127.0.0.1:%d%s", scheme, localPort, s.path))
+		if err != nil {
+			d.errs = append(d.errs, fmt.Errorf("get %s of pod %s: %v", s.path, pod.Name, err))
+			continue
+		}
+		if !s.raw {
+			data = redactJSON(data)
+		}
+		file := fmt.Sprintf("%s-%s", pod.Name, s.file)
+		if s.once {
+			file = s.file
+		}
+		if d.write(filepath.Join(path, file), data, resourceWriter) {
+			captured = append(captured, s)
+		}
+	}
+	return captured
+}
+
+// write writes the snapshot and records it in the resources.
+func (d *componentDumper) write(file string, data []byte, resourceWriter io.Writer) bool {
+	if err := createPathIfNotExist(filepath.Dir(file)); err != nil {
+		d.errs = append(d.errs, err)
+		return false
+	}
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		d.errs = append(d.errs, err)
+		return false
+	}
+	if err := writeString(resourceWriter, fmt.Sprintf("%s\n", filepath.Join(filepath.Base(filepath.Dir(file)), filepath.Base(file)))); err != nil {
+		d.errs = append(d.errs, err)
+	}
+	return true
+}
+
+func httpGet(httpCli *http.Client, url string) ([]byte, error) {
+	res, err := httpCli.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", res.Status, string(body))
+	}
+	return body, nil
+}
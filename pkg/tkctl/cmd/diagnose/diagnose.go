@@ -37,6 +37,7 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
 	"github.com/pingcap/tidb-operator/pkg/controller"
 	"github.com/pingcap/tidb-operator/pkg/tkctl/config"
+	"github.com/pingcap/tidb-operator/pkg/version"
 	"github.com/spf13/cobra"
 	appv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
@@ -45,6 +46,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubernetes/pkg/apis/apps"
 	api "k8s.io/kubernetes/pkg/apis/core"
@@ -53,7 +55,11 @@ import (
 const (
 	diagnoseLongDesc = `
 		Export a tidb cluster diagnostic information of a specified cluster.
-		
+
+		The kubernetes objects, pod logs, events and the snapshots of PD, TiDB,
+		TiKV and TiCDC APIs are collected into a single tar.gz bundle with a
+		manifest. Passwords and secrets in configs and env are redacted.
+
 		You may omit --tidbcluster option by running 'tkc use <clusterName>'.
 `
 	diagnoseExample = `
@@ -61,7 +67,7 @@ const (
 		tkctl diagnose
 
 		# diagnose specify tidb cluster information
-		tkctl diagnose -t demo-cluster
+		tkctl diagnose -t demo-cluster --path /tmp/diagnose
 `
 	diagnoseUsage = `expected 'diagnose -t CLUSTER_NAME' for the diagnose command or
 using 'tkctl use to set tidb cluster first.'`
@@ -71,14 +77,16 @@ type diagnoseInfoOptions struct {
 	namespace       string
 	tidbClusterName string
 
-	tcCli   *versioned.Clientset
-	kubeCli *kubernetes.Clientset
+	tcCli      *versioned.Clientset
+	kubeCli    *kubernetes.Clientset
+	restConfig *rest.Config
 
 	listOptions metav1.ListOptions
 
 	logPath       string
 	since         time.Duration
 	byteReadLimit int64
+	keepDir       bool
 	printer       printers.ResourcePrinter
 	tidbPrinter   printers.ResourcePrinter
 
@@ -110,6 +118,7 @@ func NewCmdDiagnoseInfo(tkcContext *config.TkcContext, streams genericclioptions
 	cmd.Flags().StringVar(&o.logPath, "path", "", "The log path to dump.")
 	cmd.Flags().DurationVar(&o.since, "since", time.Duration(1)*time.Hour, "Return logs newer than a relative duration like 1m, or 3h.")
 	cmd.Flags().Int64Var(&o.byteReadLimit, "byteReadLimit", 500000, "The maximum number of bytes dump log.")
+	cmd.Flags().BoolVar(&o.keepDir, "keep-dir", false, "Keep the dumped directory besides the tar.gz bundle.")
 	cmdutil.CheckErr(cmd.MarkFlagRequired("path"))
 	return cmd
 }
@@ -136,6 +145,7 @@ func (o *diagnoseInfoOptions) Complete(tkcContext *config.TkcContext, cmd *cobra
 	if err != nil {
 		return err
 	}
+	o.restConfig = restConfig
 
 	tcCli, err := versioned.NewForConfig(restConfig)
 	if err != nil {
@@ -159,21 +169,58 @@ func (o *diagnoseInfoOptions) Complete(tkcContext *config.TkcContext, cmd *cobra
 
 	o.listOptions = metav1.ListOptions{
 		LabelSelector: fmt.Sprintf("%s=%s,%s in (%s)", label.InstanceLabelKey, o.tidbClusterName, label.ComponentLabelKey,
-			strings.Join([]string{label.TiDBLabelVal, label.TiKVLabelVal, label.PDLabelVal}, ",")),
+			strings.Join([]string{label.TiDBLabelVal, label.TiKVLabelVal, label.PDLabelVal, label.TiFlashLabelVal, label.TiCDCLabelVal}, ",")),
 	}
 
 	return nil
 }
 
 func (o *diagnoseInfoOptions) Run() error {
-	if err := createPathIfNotExist(o.logPath); err != nil {
+	createdAt := time.Now()
+	bundleDir := filepath.Join(o.logPath, fmt.Sprintf("%s-%s-diagnose-%s", o.tidbClusterName, o.namespace, createdAt.Format("20060102150405")))
+	if err := createPathIfNotExist(bundleDir); err != nil {
 		return err
 	}
 
-	resourceFile, err := os.Create(filepath.Join(o.logPath, "resources"))
+	errs, err := o.dump(bundleDir)
 	if err != nil {
 		return err
 	}
+
+	m := &manifest{
+		Cluster:   o.tidbClusterName,
+		Namespace: o.namespace,
+		CreatedAt: createdAt,
+		Version:   version.Get().GitVersion,
+	}
+	for _, e := range errs {
+		fmt.Fprintf(o.ErrOut, "Warning: %v\n", e)
+		m.Errors = append(m.Errors, e.Error())
+	}
+	if err := writeManifest(bundleDir, m); err != nil {
+		return err
+	}
+
+	bundle := bundleDir + ".tar.gz"
+	if err := archiveDir(bundleDir, bundle); err != nil {
+		return err
+	}
+	if !o.keepDir {
+		if err := os.RemoveAll(bundleDir); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(o.Out, "Diagnostic bundle is written to %s\n", bundle)
+	return nil
+}
+
+// dump dumps the diagnostic information into logPath, the failures of
+// capturing component snapshots are returned instead of aborting the dump.
+func (o *diagnoseInfoOptions) dump(logPath string) ([]error, error) {
+	resourceFile, err := os.Create(filepath.Join(logPath, "resources"))
+	if err != nil {
+		return nil, err
+	}
 	defer func() {
 		cmdutil.CheckErr(resourceFile.Close())
 	}()
@@ -187,61 +234,75 @@ func (o *diagnoseInfoOptions) Run() error {
 		TidbClusters(o.namespace).
 		Get(context.TODO(), o.tidbClusterName, metav1.GetOptions{})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	tc.SetGroupVersionKind(controller.ControllerKind)
 
 	// dump tidb cluster object information.
-	if err := NewTiDBClusterDumper(tc, o.tidbPrinter).Dump(o.logPath, rWriter); err != nil {
-		return err
+	if err := NewTiDBClusterDumper(tc, o.tidbPrinter).Dump(logPath, rWriter); err != nil {
+		return nil, err
 	}
 
 	// dump stateful information by a particular tidb cluster.
-	if err := NewTiDBClusterStatefulDumper(tc, o.kubeCli, o.printer).Dump(o.logPath, rWriter); err != nil {
-		return err
+	if err := NewTiDBClusterStatefulDumper(tc, o.kubeCli, o.printer).Dump(logPath, rWriter); err != nil {
+		return nil, err
 	}
 
 	// dump pvc information by a particular tidb cluster.
-	if err := NewPvcDumper(o.kubeCli, tc, o.listOptions, o.printer).Dump(o.logPath, rWriter); err != nil {
-		return err
+	if err := NewPvcDumper(o.kubeCli, tc, o.listOptions, o.printer).Dump(logPath, rWriter); err != nil {
+		return nil, err
 	}
 
 	// dump services information by a particular tidb cluster.
-	if err := NewSvcDumper(o.kubeCli, tc, o.listOptions, o.printer).Dump(o.logPath, rWriter); err != nil {
-		return err
+	if err := NewSvcDumper(o.kubeCli, tc, o.listOptions, o.printer).Dump(logPath, rWriter); err != nil {
+		return nil, err
 	}
 
 	// dump configmaps information by a particular tidb cluster.
-	if err := NewConfigMapDumper(o.kubeCli, tc, o.listOptions, o.printer).Dump(o.logPath, rWriter); err != nil {
-		return err
+	if err := NewConfigMapDumper(o.kubeCli, tc, o.listOptions, o.printer).Dump(logPath, rWriter); err != nil {
+		return nil, err
 	}
 
 	podList, err := o.kubeCli.CoreV1().Pods(o.namespace).List(context.TODO(), o.listOptions)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if _, err := rWriter.Write([]byte("----------------pods---------------\n")); err != nil {
-		return err
+		return nil, err
 	}
 
 	// dump detail information and logs of pods.
 	pods := api.PodList{}
 	for i := range podList.Items {
 		pod := podList.Items[i]
-		if err := NewPodDumper(o.kubeCli, pod, int64(o.since.Seconds()), o.byteReadLimit).Dump(o.logPath, rWriter); err != nil {
-			return err
+		if err := NewPodDumper(o.kubeCli, pod, int64(o.since.Seconds()), o.byteReadLimit).Dump(logPath, rWriter); err != nil {
+			return nil, err
 		}
 
 		p, err := convertToInternalObj(&pod, "")
 		if err != nil {
-			return err
+			return nil, err
 		}
 		pods.Items = append(pods.Items, *(p.(*api.Pod)))
 	}
 
-	return o.printer.PrintObj(&pods, rWriter)
+	if err := o.printer.PrintObj(&pods, rWriter); err != nil {
+		return nil, err
+	}
+
+	// dump events by a particular tidb cluster.
+	if err := NewEventDumper(o.kubeCli, tc, o.printer).Dump(logPath, rWriter); err != nil {
+		return nil, err
+	}
+
+	// dump snapshots of component APIs by a particular tidb cluster.
+	componentDumper := NewComponentDumper(o.kubeCli, o.restConfig, tc, o.ErrOut)
+	if err := componentDumper.Dump(logPath, rWriter); err != nil {
+		return nil, err
+	}
+	return componentDumper.Errors(), nil
 }
 
 // tidbClusterDumper generates information about a tidbclusters object.
@@ -281,7 +342,7 @@ func (d *tidbClusterDumper) Dump(logPath string, resourceWriter io.Writer) error
 		return err
 	}
 
-	return writeString(logFile, string(data))
+	return writeString(logFile, redactText(string(data)))
 }
 
 // tidbClusterStatefulDumper generates information about a statefulset by a specify tidbcluster.
@@ -326,6 +387,7 @@ func (d *tidbClusterStatefulDumper) Dump(logPath string, resourceWriter io.Write
 			return err
 		}
 		ps.SetGroupVersionKind(appv1.SchemeGroupVersion.WithKind("StatefulSet"))
+		redactPodSpec(&ps.Spec.Template.Spec)
 
 		if err = writeString(logFile, "#"+sn+"\n"); err != nil {
 			return err
@@ -336,7 +398,7 @@ func (d *tidbClusterStatefulDumper) Dump(logPath string, resourceWriter io.Write
 			return err
 		}
 
-		if err = writeString(logFile, string(body)); err != nil {
+		if err = writeString(logFile, redactText(string(body))); err != nil {
 			return err
 		}
 
@@ -528,7 +590,7 @@ func (d *configMapDumper) Dump(logPath string, resourceWriter io.Writer) error {
 		if err != nil {
 			return err
 		}
-		if err = writeString(logFile, string(body)); err != nil {
+		if err = writeString(logFile, redactText(string(body))); err != nil {
 			return err
 		}
 
@@ -593,12 +655,14 @@ func (d *podDumper) DumpDetail(logPath string) error {
 		cmdutil.CheckErr(logFile.Close())
 	}()
 
-	body, err := yaml.Marshal(d.pod)
+	pod := d.pod.DeepCopy()
+	redactPodSpec(&pod.Spec)
+	body, err := yaml.Marshal(pod)
 	if err != nil {
 		return err
 	}
 
-	return writeString(logFile, string(body))
+	return writeString(logFile, redactText(string(body)))
 }
 
 // Dump dumps the logs for the last terminated container and current running container. If info about the container is not available then a specific
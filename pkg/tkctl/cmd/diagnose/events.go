@@ -0,0 +1,112 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	api "k8s.io/kubernetes/pkg/apis/core"
+)
+
+// eventDumper generates information about the events of a tidb cluster and
+// the objects the operator creates for it.
+type eventDumper struct {
+	kubeCli *kubernetes.Clientset
+	tc      *v1alpha1.TidbCluster
+	printer printers.ResourcePrinter
+}
+
+// NewEventDumper returns an eventDumper.
+func NewEventDumper(kubeCli *kubernetes.Clientset, tc *v1alpha1.TidbCluster, printer printers.ResourcePrinter) *eventDumper {
+	return &eventDumper{
+		kubeCli: kubeCli,
+		tc:      tc,
+		printer: printer,
+	}
+}
+
+// Dump dumps the events from a particular tidb cluster.
+func (d *eventDumper) Dump(logPath string, resourceWriter io.Writer) error {
+	logFile, err := os.Create(filepath.Join(logPath, fmt.Sprintf("%s-%s-events.yaml", d.tc.Name, d.tc.Namespace)))
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		cmdutil.CheckErr(logFile.Close())
+	}()
+
+	if _, err := resourceWriter.Write([]byte("----------------events---------------\n")); err != nil {
+		return err
+	}
+
+	eventList, err := d.kubeCli.CoreV1().Events(d.tc.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	items := filterClusterEvents(d.tc.Name, eventList.Items)
+	events := api.EventList{}
+	for i := range items {
+		event := items[i]
+
+		event.SetGroupVersionKind(v1.SchemeGroupVersion.WithKind("Event"))
+
+		body, err := yaml.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err = writeString(logFile, "---\n"+string(body)); err != nil {
+			return err
+		}
+
+		e, err := convertToInternalObj(&event, "")
+		if err != nil {
+			return err
+		}
+
+		events.Items = append(events.Items, *(e.(*api.Event)))
+	}
+
+	return d.printer.PrintObj(&events, resourceWriter)
+}
+
+// filterClusterEvents returns the events of the tidb cluster and the objects
+// named after it, ordered by the time they were last seen.
+func filterClusterEvents(tcName string, events []v1.Event) []v1.Event {
+	var filtered []v1.Event
+	for _, event := range events {
+		name := event.InvolvedObject.Name
+		if name == tcName || strings.HasPrefix(name, tcName+"-") {
+			filtered = append(filtered, event)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].LastTimestamp.Before(&filtered[j].LastTimestamp)
+	})
+	return filtered
+}
@@ -0,0 +1,123 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const redactedValue = "<redacted>"
+
+var (
+	sensitiveKeyRegexp = regexp.MustCompile(`(?i)(password|passwd|secret|token|credential|private[-_]?key|access[-_]?key)`)
+	// matches `key = value` of toml and `key: value` of yaml
+	keyValueLineRegexp = regexp.MustCompile(`^(\s*(?:-\s+)?["']?([\w.-]+)["']?\s*[:=][ \t]*)(\S.*)$`)
+	// matches `"key": "value"` of inline json, e.g. in annotations
+	jsonPairRegexp = regexp.MustCompile(`"([\w.-]+)"(\s*:\s*)"((?:[^"\\]|\\.)*)"`)
+)
+
+// isSensitiveKey returns whether the value of the key may be a password or a
+// secret. Keys referring to a secret by name or by path are not sensitive.
+func isSensitiveKey(key string) bool {
+	k := strings.ToLower(key)
+	for _, suffix := range []string{"name", "ref", "path", "file"} {
+		if strings.HasSuffix(k, suffix) {
+			return false
+		}
+	}
+	return sensitiveKeyRegexp.MatchString(key)
+}
+
+// redactText redacts the values of sensitive keys in toml, yaml or inline
+// json text line by line.
+func redactText(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if m := keyValueLineRegexp.FindStringSubmatch(line); m != nil && isSensitiveKey(m[2]) && !isBlockIndicator(m[3]) {
+			line = m[1] + `"` + redactedValue + `"`
+		}
+		lines[i] = jsonPairRegexp.ReplaceAllStringFunc(line, func(pair string) string {
+			m := jsonPairRegexp.FindStringSubmatch(pair)
+			if !isSensitiveKey(m[1]) {
+				return pair
+			}
+			return `"` + m[1] + `"` + m[2] + `"` + redactedValue + `"`
+		})
+	}
+	return strings.Join(lines, "\n")
+}
+
+// isBlockIndicator returns whether the yaml value opens a block or an empty
+// collection, which is left as is.
+func isBlockIndicator(value string) bool {
+	switch strings.TrimSpace(value) {
+	case "|", "|-", ">", ">-", "{}", "[]", `""`, "''":
+		return true
+	}
+	return false
+}
+
+// redactJSON redacts the values of sensitive keys in the json document and
+// returns it indented. The document is redacted as text if it is not valid json.
+func redactJSON(data []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var obj interface{}
+	if err := decoder.Decode(&obj); err != nil {
+		return []byte(redactText(string(data)))
+	}
+	out, err := json.MarshalIndent(redactValue(obj), "", "  ")
+	if err != nil {
+		return []byte(redactText(string(data)))
+	}
+	return out
+}
+
+func redactValue(obj interface{}) interface{} {
+	switch o := obj.(type) {
+	case map[string]interface{}:
+		for k, v := range o {
+			if _, isString := v.(string); isString && isSensitiveKey(k) {
+				o[k] = redactedValue
+				continue
+			}
+			o[k] = redactValue(v)
+		}
+	case []interface{}:
+		for i := range o {
+			o[i] = redactValue(o[i])
+		}
+	}
+	return obj
+}
+
+// redactPodSpec redacts the plain values of sensitive environment variables
+// of the containers in place.
+func redactPodSpec(spec *v1.PodSpec) {
+	for _, containers := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			for j := range containers[i].Env {
+				env := &containers[i].Env[j]
+				if len(env.Value) > 0 && isSensitiveKey(env.Name) {
+					env.Value = redactedValue
+				}
+			}
+		}
+	}
+}
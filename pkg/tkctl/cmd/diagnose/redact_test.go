@@ -0,0 +1,100 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diagnose
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestRedactText(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name   string
+		input  string
+		expect string
+	}{
+		{
+			name:   "toml",
+			input:  "[security]\npassword = \"abc\"\nssl-key = \"/var/lib/tls/key\"\nkey-path = \"/var/lib/tls/tls.key\"",
+			expect: "[security]\npassword = \"<redacted>\"\nssl-key = \"/var/lib/tls/key\"\nkey-path = \"/var/lib/tls/tls.key\"",
+		},
+		{
+			name:   "yaml config map",
+			input:  "data:\n  config-file: |\n    [storage]\n    secret-access-key = \"xyz\"\n",
+			expect: "data:\n  config-file: |\n    [storage]\n    secret-access-key = \"<redacted>\"\n",
+		},
+		{
+			name:   "secret references are kept",
+			input:  "tlsClientSecretName: client-tls\nsecretKeyRef:\n  name: s3",
+			expect: "tlsClientSecretName: client-tls\nsecretKeyRef:\n  name: s3",
+		},
+		{
+			name:   "inline json",
+			input:  `  last-applied: '{"user":"root","password":"abc","secretName":"s"}'`,
+			expect: `  last-applied: '{"user":"root","password":"<redacted>","secretName":"s"}'`,
+		},
+		{
+			name:   "blocks are kept",
+			input:  "token: |\n  abc",
+			expect: "token: |\n  abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g.Expect(redactText(tt.input)).To(Equal(tt.expect))
+		})
+	}
+}
+
+func TestRedactJSON(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	data := redactJSON([]byte(`{"security":{"password":"abc","cert-path":"/tls/tls.crt"},"users":[{"name":"root","auth-token":"t"}],"max-size":18446744073709551615}`))
+	var obj map[string]interface{}
+	g.Expect(json.Unmarshal(data, &obj)).To(Succeed())
+	g.Expect(obj["security"]).To(Equal(map[string]interface{}{"password": redactedValue, "cert-path": "/tls/tls.crt"}))
+	g.Expect(obj["users"]).To(Equal([]interface{}{map[string]interface{}{"name": "root", "auth-token": redactedValue}}))
+	g.Expect(string(data)).To(ContainSubstring("18446744073709551615"))
+
+	g.Expect(string(redactJSON([]byte("password = \"abc\"")))).To(Equal("password = \"<redacted>\""))
+}
+
+func TestRedactPodSpec(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	spec := v1.PodSpec{
+		InitContainers: []v1.Container{{
+			Env: []v1.EnvVar{{Name: "AWS_SECRET_ACCESS_KEY", Value: "xyz"}},
+		}},
+		Containers: []v1.Container{{
+			Env: []v1.EnvVar{
+				{Name: "TZ", Value: "UTC"},
+				{Name: "TIDB_PASSWORD", Value: "abc"},
+				{Name: "PD_TOKEN", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{Key: "token"}}},
+			},
+		}},
+	}
+	redactPodSpec(&spec)
+	g.Expect(spec.InitContainers[0].Env[0].Value).To(Equal(redactedValue))
+	g.Expect(spec.Containers[0].Env[0].Value).To(Equal("UTC"))
+	g.Expect(spec.Containers[0].Env[1].Value).To(Equal(redactedValue))
+	g.Expect(spec.Containers[0].Env[2].Value).To(BeEmpty())
+	g.Expect(spec.Containers[0].Env[2].ValueFrom).NotTo(BeNil())
+}
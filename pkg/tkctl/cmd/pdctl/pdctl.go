@@ -14,22 +14,484 @@
 package pdctl
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"os"
+	"strconv"
 
+	"github.com/pingcap/kvproto/pkg/pdpb"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/alias"
 	"github.com/pingcap/tidb-operator/pkg/tkctl/config"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/readable"
+	tkctlUtil "github.com/pingcap/tidb-operator/pkg/tkctl/util"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 )
 
-// TODO: implementation
+const (
+	pdctlLongDesc = `
+		Run the common pd-ctl operations against the PD of a tidb cluster.
+
+		By default the PD is reached through a port-forward to one of the PD pods,
+		use --in-cluster to connect to the PD service directly when running inside
+		the kubernetes cluster. The client certificate in the cluster client secret
+		is used when TLS is enabled for the tidb cluster.
+
+		You can omit --tidbcluster=<name> option by running 'tkctl use <name>',
+`
+	pdctlExample = `
+		# list the TiKV stores
+		tkctl pdctl store
+
+		# get a region in yaml
+		tkctl pdctl region 2 -o yaml
+
+		# list the hot write regions
+		tkctl pdctl hot write
+
+		# add an evict leader scheduler for store 1
+		tkctl pdctl scheduler add evict-leader-scheduler --store-id=1
+
+		# change a config item of PD
+		tkctl pdctl config set schedule.leader-schedule-limit 8
+
+		# transfer the PD leader to another member
+		tkctl pdctl member leader transfer demo-pd-1
+`
+	pdctlUsage = "expected 'pdctl -t CLUSTER_NAME' for the pdctl command or using 'tkctl use' to set tidb cluster first"
+)
+
+// PdctlOptions contains the input to the pdctl command.
+type PdctlOptions struct {
+	Namespace       string
+	TidbClusterName string
+	InCluster       bool
+
+	RegionLimit int
+	StoreID     uint64
+
+	PrintFlags *readable.PrintFlags
+
+	tcCli      *versioned.Clientset
+	kubeCli    *kubernetes.Clientset
+	restConfig *rest.Config
+
+	genericclioptions.IOStreams
+}
+
+// NewPdctlOptions returns a PdctlOptions
+func NewPdctlOptions(streams genericclioptions.IOStreams) *PdctlOptions {
+	return &PdctlOptions{
+		RegionLimit: 16,
+		PrintFlags:  readable.NewPrintFlags(),
+
+		IOStreams: streams,
+	}
+}
+
 // NewCmdPdctl creates the pdctl subcommand
 func NewCmdPdctl(tkcContext *config.TkcContext, streams genericclioptions.IOStreams) *cobra.Command {
-	return &cobra.Command{
-		Use:   "pdctl",
-		Short: "Not implemented",
-		Run: func(_ *cobra.Command, args []string) {
-			fmt.Fprint(os.Stdout, "not implemented")
+	o := NewPdctlOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:     "pdctl",
+		Short:   "Run pd-ctl operations against the PD of a tidb cluster",
+		Long:    pdctlLongDesc,
+		Example: pdctlExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+	cmd.PersistentFlags().BoolVar(&o.InCluster, "in-cluster", false,
+		"connect to the PD service directly instead of port-forwarding to a PD pod")
+
+	// run completes the options and runs fn, the connection to PD is opened
+	// by fn itself after its arguments are validated
+	run := func(fn func(cmd *cobra.Command, args []string) error) func(*cobra.Command, []string) {
+		return func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(tkcContext, cmd))
+			cmdutil.CheckErr(fn(cmd, args))
+		}
+	}
+
+	store := &cobra.Command{
+		Use:   "store [STORE_ID]",
+		Short: "Show all stores or the store with the given id",
+		Run:   run(o.RunStore),
+	}
+	o.PrintFlags.AddFlags(store)
+
+	region := &cobra.Command{
+		Use:   "region [REGION_ID]",
+		Short: "Show regions from the start of the key space or the region with the given id",
+		Run:   run(o.RunRegion),
+	}
+	region.Flags().IntVar(&o.RegionLimit, "limit", o.RegionLimit, "The max number of regions to show, 0 shows all regions")
+	o.PrintFlags.AddFlags(region)
+
+	hot := &cobra.Command{
+		Use:   "hot read|write",
+		Short: "Show the hot read or write regions",
+		Run:   run(o.RunHotRegions),
+	}
+	o.PrintFlags.AddFlags(hot)
+
+	scheduler := &cobra.Command{
+		Use:   "scheduler",
+		Short: "Show, add or remove schedulers",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+	schedulerShow := &cobra.Command{
+		Use:   "show",
+		Short: "Show all schedulers",
+		Run:   run(o.RunSchedulerShow),
+	}
+	o.PrintFlags.AddFlags(schedulerShow)
+	schedulerAdd := &cobra.Command{
+		Use:   "add SCHEDULER_NAME",
+		Short: "Add a scheduler",
+		Run:   run(o.RunSchedulerAdd),
+	}
+	schedulerAdd.Flags().Uint64Var(&o.StoreID, "store-id", 0, "The store of the scheduler, e.g. for evict-leader-scheduler")
+	schedulerRemove := &cobra.Command{
+		Use:   "remove SCHEDULER_NAME",
+		Short: "Remove a scheduler",
+		Run:   run(o.RunSchedulerRemove),
+	}
+	scheduler.AddCommand(schedulerShow, schedulerAdd, schedulerRemove)
+
+	cfg := &cobra.Command{
+		Use:   "config",
+		Short: "Show or set the config of PD",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
 		},
 	}
+	cfgShow := &cobra.Command{
+		Use:   "show",
+		Short: "Show the config of PD",
+		Run:   run(o.RunConfigShow),
+	}
+	o.PrintFlags.AddFlags(cfgShow)
+	cfgSet := &cobra.Command{
+		Use:   "set KEY VALUE",
+		Short: "Set a config item of PD, e.g. schedule.leader-schedule-limit",
+		Run:   run(o.RunConfigSet),
+	}
+	cfg.AddCommand(cfgShow, cfgSet)
+
+	member := &cobra.Command{
+		Use:   "member",
+		Short: "Show the PD members",
+		Run:   run(o.RunMember),
+	}
+	o.PrintFlags.AddFlags(member)
+	leader := &cobra.Command{
+		Use:   "leader",
+		Short: "Show or transfer the PD leader",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+	leaderShow := &cobra.Command{
+		Use:   "show",
+		Short: "Show the PD leader",
+		Run:   run(o.RunLeaderShow),
+	}
+	o.PrintFlags.AddFlags(leaderShow)
+	leaderTransfer := &cobra.Command{
+		Use:   "transfer MEMBER_NAME",
+		Short: "Transfer the PD leader to the given member",
+		Run:   run(o.RunLeaderTransfer),
+	}
+	leader.AddCommand(leaderShow, leaderTransfer)
+	member.AddCommand(leader)
+
+	cmd.AddCommand(store, region, hot, scheduler, cfg, member)
+	return cmd
+}
+
+func (o *PdctlOptions) Complete(tkcContext *config.TkcContext, cmd *cobra.Command) error {
+	clientConfig, err := tkcContext.ToTkcClientConfig()
+	if err != nil {
+		return err
+	}
+
+	if tidbClusterName, ok := clientConfig.TidbClusterName(); ok {
+		o.TidbClusterName = tidbClusterName
+	} else {
+		return cmdutil.UsageErrorf(cmd, pdctlUsage)
+	}
+
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	restConfig, err := clientConfig.RestConfig()
+	if err != nil {
+		return err
+	}
+	o.restConfig = restConfig
+	tcCli, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.tcCli = tcCli
+	kubeCli, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.kubeCli = kubeCli
+
+	return nil
+}
+
+func (o *PdctlOptions) RunStore(cmd *cobra.Command, args []string) error {
+	var storeID uint64
+	if len(args) > 0 {
+		id, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return cmdutil.UsageErrorf(cmd, "invalid store id %q", args[0])
+		}
+		storeID = id
+	}
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	stores, err := pdCli.GetStores()
+	if err != nil {
+		return err
+	}
+	if storeID > 0 {
+		var found []*pdapi.StoreInfo
+		for _, store := range stores.Stores {
+			if store.Store != nil && store.Store.Store != nil && store.Store.Id == storeID {
+				found = append(found, store)
+			}
+		}
+		if len(found) == 0 {
+			return fmt.Errorf("store %d not found", storeID)
+		}
+		stores = &pdapi.StoresInfo{Count: len(found), Stores: found}
+	}
+	return o.print(alias.NewPDStoreList(stores))
+}
+
+func (o *PdctlOptions) RunRegion(cmd *cobra.Command, args []string) error {
+	var regionID uint64
+	if len(args) > 0 {
+		id, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return cmdutil.UsageErrorf(cmd, "invalid region id %q", args[0])
+		}
+		regionID = id
+	}
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	if regionID > 0 {
+		region, err := pdCli.GetRegion(regionID)
+		if err != nil {
+			return err
+		}
+		return o.print(alias.NewPDRegionList(&pdapi.RegionsInfo{Count: 1, Regions: []*pdapi.RegionInfo{region}}))
+	}
+	regions, err := pdCli.GetRegions(o.RegionLimit)
+	if err != nil {
+		return err
+	}
+	return o.print(alias.NewPDRegionList(regions))
+}
+
+func (o *PdctlOptions) RunHotRegions(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 || (args[0] != "read" && args[0] != "write") {
+		return cmdutil.UsageErrorf(cmd, "expected 'hot read' or 'hot write'")
+	}
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	hot, err := pdCli.GetHotRegions(args[0])
+	if err != nil {
+		return err
+	}
+	return o.print(alias.NewPDHotRegions(hot))
+}
+
+func (o *PdctlOptions) RunSchedulerShow(cmd *cobra.Command, args []string) error {
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	schedulers, err := pdCli.GetSchedulers()
+	if err != nil {
+		return err
+	}
+	return o.print(alias.NewPDSchedulerList(schedulers))
+}
+
+func (o *PdctlOptions) RunSchedulerAdd(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return cmdutil.UsageErrorf(cmd, "expected 'scheduler add SCHEDULER_NAME'")
+	}
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	if err := pdCli.AddScheduler(args[0], o.StoreID); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "scheduler %s added\n", args[0])
+	return nil
+}
+
+func (o *PdctlOptions) RunSchedulerRemove(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return cmdutil.UsageErrorf(cmd, "expected 'scheduler remove SCHEDULER_NAME'")
+	}
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	if err := pdCli.RemoveScheduler(args[0]); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "scheduler %s removed\n", args[0])
+	return nil
+}
+
+func (o *PdctlOptions) RunConfigShow(cmd *cobra.Command, args []string) error {
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	config, err := pdCli.GetConfig()
+	if err != nil {
+		return err
+	}
+	return o.print(alias.NewPDConfig(config))
+}
+
+func (o *PdctlOptions) RunConfigSet(cmd *cobra.Command, args []string) error {
+	if len(args) != 2 {
+		return cmdutil.UsageErrorf(cmd, "expected 'config set KEY VALUE'")
+	}
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	if err := pdCli.SetConfig(map[string]interface{}{args[0]: parseConfigValue(args[1])}); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "config %s set to %s\n", args[0], args[1])
+	return nil
+}
+
+func (o *PdctlOptions) RunMember(cmd *cobra.Command, args []string) error {
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	members, err := pdCli.GetMembers()
+	if err != nil {
+		return err
+	}
+	return o.print(alias.NewPDMemberList(members))
+}
+
+func (o *PdctlOptions) RunLeaderShow(cmd *cobra.Command, args []string) error {
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	leader, err := pdCli.GetPDLeader()
+	if err != nil {
+		return err
+	}
+	return o.print(alias.NewPDMemberList(&pdapi.MembersInfo{Members: []*pdpb.Member{leader}, Leader: leader}))
+}
+
+func (o *PdctlOptions) RunLeaderTransfer(cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return cmdutil.UsageErrorf(cmd, "expected 'member leader transfer MEMBER_NAME'")
+	}
+	pdCli, stop, err := o.connect()
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	if err := pdCli.TransferPDLeader(args[0]); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.Out, "PD leader transferred to %s\n", args[0])
+	return nil
+}
+
+func (o *PdctlOptions) print(obj runtime.Object) error {
+	printer, err := o.PrintFlags.ToPrinter(false, false)
+	if err != nil {
+		return err
+	}
+	w := printers.GetNewTabWriter(o.Out)
+	if err := printer.PrintObj(obj, w); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// connect creates a PD client of the tidb cluster, the returned stop function
+// closes the port-forward and must be called after the client is no longer used.
+func (o *PdctlOptions) connect() (pdapi.PDClient, func(), error) {
+	tc, err := o.tcCli.PingcapV1alpha1().
+		TidbClusters(o.Namespace).
+		Get(context.TODO(), o.TidbClusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return tkctlUtil.NewPDClient(o.kubeCli, o.restConfig, tc, o.InCluster, o.ErrOut)
+}
+
+// parseConfigValue parses the value in json so that numbers and booleans are
+// sent to PD in their own types, other values are sent as strings.
+func parseConfigValue(value string) interface{} {
+	var v interface{}
+	if err := json.Unmarshal([]byte(value), &v); err == nil {
+		return v
+	}
+	return value
 }
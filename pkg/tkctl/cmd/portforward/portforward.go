@@ -0,0 +1,241 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/config"
+	tkctlUtil "github.com/pingcap/tidb-operator/pkg/tkctl/util"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+const (
+	portForwardLongDesc = `
+		Forward a local port to a component of a tidb cluster.
+
+		Available components include: pd, tidb, dashboard, grafana
+		The dashboard is served by PD, and grafana is looked up from the TidbMonitor
+		which monitors the tidb cluster.
+
+		You can omit --tidbcluster=<name> option by running 'tkctl use <name>',
+`
+	portForwardExample = `
+		# forward a random local port to PD
+		tkctl port-forward pd
+
+		# open the dashboard at http://127.0.0.1:12333/dashboard
+		tkctl port-forward dashboard --local-port=12333
+
+		# forward a local port to grafana
+		tkctl port-forward grafana
+`
+	portForwardUsage = "expected 'port-forward -t CLUSTER_NAME pd|tidb|dashboard|grafana' for the port-forward command or using 'tkctl use' to set tidb cluster first"
+
+	componentPD        = "pd"
+	componentTiDB      = "tidb"
+	componentDashboard = "dashboard"
+	componentGrafana   = "grafana"
+
+	pdClientPort = 2379
+	tidbPort     = 4000
+	grafanaPort  = 3000
+)
+
+// PortForwardOptions contains the input to the port-forward command.
+type PortForwardOptions struct {
+	Namespace       string
+	TidbClusterName string
+	Component       string
+	LocalPort       int
+
+	tcCli      *versioned.Clientset
+	kubeCli    *kubernetes.Clientset
+	restConfig *rest.Config
+
+	genericclioptions.IOStreams
+}
+
+// NewPortForwardOptions returns a PortForwardOptions
+func NewPortForwardOptions(streams genericclioptions.IOStreams) *PortForwardOptions {
+	return &PortForwardOptions{
+		IOStreams: streams,
+	}
+}
+
+// NewCmdPortForward creates the port-forward command which forwards a local port to a component
+func NewCmdPortForward(tkcContext *config.TkcContext, streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewPortForwardOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:     "port-forward pd|tidb|dashboard|grafana",
+		Short:   "Forward a local port to a component of a tidb cluster",
+		Long:    portForwardLongDesc,
+		Example: portForwardExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(tkcContext, cmd, args))
+			cmdutil.CheckErr(o.Run())
+		},
+	}
+
+	cmd.Flags().IntVar(&o.LocalPort, "local-port", 0, "The local port to listen on, a random port is chosen if not set")
+	return cmd
+}
+
+func (o *PortForwardOptions) Complete(tkcContext *config.TkcContext, cmd *cobra.Command, args []string) error {
+	if len(args) != 1 {
+		return cmdutil.UsageErrorf(cmd, portForwardUsage)
+	}
+	switch args[0] {
+	case componentPD, componentTiDB, componentDashboard, componentGrafana:
+		o.Component = args[0]
+	default:
+		return cmdutil.UsageErrorf(cmd, portForwardUsage)
+	}
+
+	clientConfig, err := tkcContext.ToTkcClientConfig()
+	if err != nil {
+		return err
+	}
+
+	if tidbClusterName, ok := clientConfig.TidbClusterName(); ok {
+		o.TidbClusterName = tidbClusterName
+	} else {
+		return cmdutil.UsageErrorf(cmd, portForwardUsage)
+	}
+
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	restConfig, err := clientConfig.RestConfig()
+	if err != nil {
+		return err
+	}
+	o.restConfig = restConfig
+	tcCli, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.tcCli = tcCli
+	kubeCli, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.kubeCli = kubeCli
+
+	return nil
+}
+
+func (o *PortForwardOptions) Run() error {
+	tc, err := o.tcCli.PingcapV1alpha1().
+		TidbClusters(o.Namespace).
+		Get(context.TODO(), o.TidbClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	namespace := tc.Namespace
+	var selector, urlFormat string
+	var podPort int
+	switch o.Component {
+	case componentPD, componentDashboard:
+		if tc.WithoutLocalPD() {
+			return fmt.Errorf("tidb cluster %s/%s has no PD of its own", tc.Namespace, tc.Name)
+		}
+		selector = label.New().Instance(tc.Name).PD().String()
+		podPort = pdClientPort
+		scheme := "http"
+		if tc.IsTLSClusterEnabled() {
+			scheme = "https"
+		}
+		urlFormat = scheme + "://127.0.0.1:%d"
+		if o.Component == componentDashboard {
+			urlFormat += "/dashboard"
+		}
+	case componentTiDB:
+		if tc.Spec.TiDB == nil {
+			return fmt.Errorf("spec.tidb of cluster %s/%s is not set", tc.Namespace, tc.Name)
+		}
+		selector = label.New().Instance(tc.Name).TiDB().String()
+		podPort = tidbPort
+		urlFormat = "127.0.0.1:%d"
+	case componentGrafana:
+		tm, err := o.getTidbMonitor(tc)
+		if err != nil {
+			return err
+		}
+		if tm.Spec.Grafana == nil {
+			return fmt.Errorf("grafana is not enabled in tidb monitor %s/%s", tm.Namespace, tm.Name)
+		}
+		namespace = tm.Namespace
+		selector = label.NewMonitor().Instance(tm.Name).Monitor().String()
+		podPort = grafanaPort
+		urlFormat = "http://127.0.0.1:%d"
+	}
+
+	pod, err := tkctlUtil.GetRunningPod(o.kubeCli, namespace, selector)
+	if err != nil {
+		return err
+	}
+	localPort, stop, err := tkctlUtil.ForwardPodPort(o.kubeCli, o.restConfig, pod, o.LocalPort, podPort, o.ErrOut)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	fmt.Fprintf(o.Out, "Forwarding from 127.0.0.1:%d to %s/%s:%d\n", localPort, pod.Namespace, pod.Name, podPort)
+	fmt.Fprintf(o.Out, "The %s is available at %s, press Ctrl+C to stop\n", o.Component, fmt.Sprintf(urlFormat, localPort))
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	<-signals
+	return nil
+}
+
+// getTidbMonitor returns the TidbMonitor which monitors the tidb cluster
+func (o *PortForwardOptions) getTidbMonitor(tc *v1alpha1.TidbCluster) (*v1alpha1.TidbMonitor, error) {
+	tms, err := o.tcCli.PingcapV1alpha1().TidbMonitors(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range tms.Items {
+		tm := &tms.Items[i]
+		for _, ref := range tm.Spec.Clusters {
+			ns := ref.Namespace
+			if len(ns) == 0 {
+				ns = tm.Namespace
+			}
+			if ref.Name == tc.Name && ns == tc.Namespace {
+				return tm, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no tidb monitor found for tidb cluster %s/%s", tc.Namespace, tc.Name)
+}
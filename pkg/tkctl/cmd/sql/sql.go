@@ -0,0 +1,447 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	gosql "database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/backup/constants"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/config"
+	tkctlUtil "github.com/pingcap/tidb-operator/pkg/tkctl/util"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/spf13/cobra"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+const (
+	sqlLongDesc = `
+		Open an interactive SQL session or execute statements against the TiDB of a tidb cluster.
+
+		The TiDB is reached through a port-forward to one of the TiDB pods by default,
+		use --in-cluster to connect to the TiDB service directly when running inside
+		the kubernetes cluster. The password of the user is discovered from the
+		password secret of the TidbInitializer of the cluster, or from the secret in
+		spec.sqlAccess of the cluster, unless --password is given. The TiDB client
+		certificate is used when TLS is enabled for the MySQL clients of TiDB.
+
+		You can omit --tidbcluster=<name> option by running 'tkctl use <name>',
+`
+	sqlExample = `
+		# open an interactive sql session as root
+		tkctl sql
+
+		# execute statements
+		tkctl sql -e "show databases; select tidb_version()"
+
+		# login as another user to a database
+		tkctl sql -u app -D test
+`
+	sqlUsage = "expected 'sql -t CLUSTER_NAME' for the sql command or using 'tkctl use' to set tidb cluster first"
+
+	defaultUser  = "root"
+	tlsConfigKey = "tkctl"
+)
+
+// SQLOptions contains the input to the sql command.
+type SQLOptions struct {
+	Namespace       string
+	TidbClusterName string
+	InCluster       bool
+
+	User     string
+	Password string
+	Database string
+	Execute  string
+
+	passwordSet bool
+
+	tcCli      *versioned.Clientset
+	kubeCli    *kubernetes.Clientset
+	restConfig *rest.Config
+
+	genericclioptions.IOStreams
+}
+
+// NewSQLOptions returns a SQLOptions
+func NewSQLOptions(streams genericclioptions.IOStreams) *SQLOptions {
+	return &SQLOptions{
+		User: defaultUser,
+
+		IOStreams: streams,
+	}
+}
+
+// NewCmdSQL creates the sql command which connects to the TiDB of a tidb cluster
+func NewCmdSQL(tkcContext *config.TkcContext, streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewSQLOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:     "sql",
+		Short:   "Open a SQL session to the TiDB of a tidb cluster",
+		Long:    sqlLongDesc,
+		Example: sqlExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(tkcContext, cmd, args))
+			cmdutil.CheckErr(o.Run())
+		},
+		SuggestFor: []string{"mysql"},
+	}
+
+	cmd.Flags().StringVarP(&o.User, "user", "u", o.User, "The user to login TiDB")
+	cmd.Flags().StringVarP(&o.Password, "password", "p", "", "The password of the user, discovered from the cluster if not set")
+	cmd.Flags().StringVarP(&o.Database, "database", "D", "", "The database to use")
+	cmd.Flags().StringVarP(&o.Execute, "execute", "e", "", "Execute the statements and quit")
+	cmd.Flags().BoolVar(&o.InCluster, "in-cluster", false,
+		"connect to the TiDB service directly instead of port-forwarding to a TiDB pod")
+	return cmd
+}
+
+func (o *SQLOptions) Complete(tkcContext *config.TkcContext, cmd *cobra.Command, args []string) error {
+	clientConfig, err := tkcContext.ToTkcClientConfig()
+	if err != nil {
+		return err
+	}
+
+	if tidbClusterName, ok := clientConfig.TidbClusterName(); ok {
+		o.TidbClusterName = tidbClusterName
+	} else {
+		return cmdutil.UsageErrorf(cmd, sqlUsage)
+	}
+
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+	o.passwordSet = cmd.Flags().Changed("password")
+
+	restConfig, err := clientConfig.RestConfig()
+	if err != nil {
+		return err
+	}
+	o.restConfig = restConfig
+	tcCli, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.tcCli = tcCli
+	kubeCli, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.kubeCli = kubeCli
+
+	return nil
+}
+
+func (o *SQLOptions) Run() error {
+	tc, err := o.tcCli.PingcapV1alpha1().
+		TidbClusters(o.Namespace).
+		Get(context.TODO(), o.TidbClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if tc.Spec.TiDB == nil {
+		return fmt.Errorf("spec.tidb of cluster %s/%s is not set", tc.Namespace, tc.Name)
+	}
+
+	svcName := tkctlUtil.GetTidbServiceName(tc.Name)
+	svc, err := o.kubeCli.CoreV1().Services(tc.Namespace).Get(context.TODO(), svcName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	port, ok := tkctlUtil.GetTiDBServerPort(svc)
+	if !ok {
+		return fmt.Errorf("no mysql client port found in service %s/%s", tc.Namespace, svcName)
+	}
+
+	password := o.Password
+	if !o.passwordSet {
+		password, err = o.discoverPassword(tc)
+		if err != nil {
+			return err
+		}
+	}
+
+	cfg := mysql.NewConfig()
+	cfg.User = o.User
+	cfg.Passwd = password
+	cfg.DBName = o.Database
+	cfg.Net = "tcp"
+	cfg.Params = map[string]string{"charset": "utf8mb4"}
+	host := fmt.Sprintf("%s.%s.svc", svcName, tc.Namespace)
+	if o.InCluster {
+		cfg.Addr = fmt.Sprintf("%s:%d", host, port.Port)
+	} else {
+		pod, err := tkctlUtil.GetRunningPod(o.kubeCli, tc.Namespace, label.New().Instance(tc.Name).TiDB().String())
+		if err != nil {
+			return err
+		}
+		podPort := port.TargetPort.IntValue()
+		if podPort == 0 {
+			podPort = int(port.Port)
+		}
+		localPort, stop, err := tkctlUtil.ForwardPodPort(o.kubeCli, o.restConfig, pod, 0, podPort, o.ErrOut)
+		if err != nil {
+			return err
+		}
+		defer stop()
+		cfg.Addr = fmt.Sprintf("127.0.0.1:%d", localPort)
+	}
+	if tc.Spec.TiDB.IsTLSClientEnabled() {
+		// the certificate of TiDB is issued for its service instead of the local address
+		if err := o.registerTLSConfig(tc, host); err != nil {
+			return err
+		}
+		cfg.TLSConfig = tlsConfigKey
+	}
+
+	db, err := gosql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	// a single connection is used so that the session variables and the
+	// current database are kept between statements
+	conn, err := db.Conn(context.TODO())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if len(o.Execute) > 0 {
+		stmts, rest := splitStatements(o.Execute)
+		if len(strings.TrimSpace(rest)) > 0 {
+			stmts = append(stmts, strings.TrimSpace(rest))
+		}
+		for _, stmt := range stmts {
+			if err := execute(conn, stmt, o.Out); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return o.interact(conn)
+}
+
+// interact reads statements terminated by ';' from the input and executes
+// them until the input is closed or 'exit' is read.
+func (o *SQLOptions) interact(conn *gosql.Conn) error {
+	scanner := bufio.NewScanner(o.In)
+	var buf string
+	fmt.Fprint(o.Out, "mysql> ")
+	for scanner.Scan() {
+		line := scanner.Text()
+		if len(strings.TrimSpace(buf)) == 0 {
+			switch strings.ToLower(strings.TrimRight(strings.TrimSpace(line), ";")) {
+			case "exit", "quit":
+				return nil
+			}
+		}
+		buf += line + "\n"
+		stmts, rest := splitStatements(buf)
+		buf = rest
+		for _, stmt := range stmts {
+			if err := execute(conn, stmt, o.Out); err != nil {
+				fmt.Fprintf(o.ErrOut, "ERROR: %v\n", err)
+			}
+		}
+		if len(strings.TrimSpace(buf)) == 0 {
+			buf = ""
+			fmt.Fprint(o.Out, "mysql> ")
+		} else {
+			fmt.Fprint(o.Out, "    -> ")
+		}
+	}
+	fmt.Fprintln(o.Out)
+	return scanner.Err()
+}
+
+// discoverPassword finds the password of the user from the password secret of
+// the TidbInitializer, in which each key is a user, or from the secret of
+// spec.sqlAccess if the user is the one used by the operator.
+func (o *SQLOptions) discoverPassword(tc *v1alpha1.TidbCluster) (string, error) {
+	tis, err := o.tcCli.PingcapV1alpha1().TidbInitializers(tc.Namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return "", err
+	}
+	for _, ti := range tis.Items {
+		if ti.Spec.Clusters.Name != tc.Name || ti.Spec.PasswordSecret == nil {
+			continue
+		}
+		if ns := ti.Spec.Clusters.Namespace; len(ns) > 0 && ns != tc.Namespace {
+			continue
+		}
+		secret, err := o.getSecret(tc.Namespace, *ti.Spec.PasswordSecret)
+		if err != nil {
+			return "", err
+		}
+		if password, ok := secret.Data[o.User]; ok {
+			return string(password), nil
+		}
+	}
+
+	if access := tc.Spec.SQLAccess; access != nil {
+		user := access.User
+		if len(user) == 0 {
+			user = defaultUser
+		}
+		if user == o.User {
+			secret, err := o.getSecret(tc.Namespace, access.SecretName)
+			if err != nil {
+				return "", err
+			}
+			if password, ok := secret.Data[constants.TidbPasswordKey]; ok {
+				return string(password), nil
+			}
+		}
+	}
+
+	fmt.Fprintf(o.ErrOut, "no password found for user %s, login without password\n", o.User)
+	return "", nil
+}
+
+// registerTLSConfig registers the TLS config with the TiDB client certificate to the mysql driver
+func (o *SQLOptions) registerTLSConfig(tc *v1alpha1.TidbCluster, host string) error {
+	var secretName string
+	if tc.Spec.SQLAccess != nil {
+		secretName = util.TiDBClientTLSSecretName(tc.Name, tc.Spec.SQLAccess.TLSClientSecretName)
+	} else {
+		secretName = util.TiDBClientTLSSecretName(tc.Name, nil)
+	}
+	secret, err := o.getSecret(tc.Namespace, secretName)
+	if err != nil {
+		return err
+	}
+	cert, err := tls.X509KeyPair(secret.Data[v1.TLSCertKey], secret.Data[v1.TLSPrivateKeyKey])
+	if err != nil {
+		return fmt.Errorf("unable to load certificates from secret %s/%s: %v", tc.Namespace, secretName, err)
+	}
+
+	skipCA := tc.Spec.TiDB.TLSClient.SkipInternalClientCA
+	rootCAs := x509.NewCertPool()
+	if !skipCA {
+		rootCAs.AppendCertsFromPEM(secret.Data[v1.ServiceAccountRootCAKey])
+	}
+	return mysql.RegisterTLSConfig(tlsConfigKey, &tls.Config{
+		RootCAs:            rootCAs,
+		Certificates:       []tls.Certificate{cert},
+		ServerName:         host,
+		InsecureSkipVerify: skipCA,
+	})
+}
+
+func (o *SQLOptions) getSecret(namespace, name string) (*v1.Secret, error) {
+	secret, err := o.kubeCli.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("get secret %s/%s failed, err: %v", namespace, name, err)
+	}
+	return secret, nil
+}
+
+// execute runs a statement and prints its result set in a table
+func execute(conn *gosql.Conn, stmt string, out io.Writer) error {
+	rows, err := conn.QueryContext(context.TODO(), stmt)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	if len(columns) == 0 {
+		fmt.Fprintln(out, "Query OK")
+		return rows.Err()
+	}
+
+	w := printers.GetNewTabWriter(out)
+	fmt.Fprintln(w, strings.Join(columns, "\t"))
+	values := make([]gosql.RawBytes, len(columns))
+	dest := make([]interface{}, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	count := 0
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		cells := make([]string, len(values))
+		for i, v := range values {
+			if v == nil {
+				cells[i] = "NULL"
+			} else {
+				cells[i] = string(v)
+			}
+		}
+		fmt.Fprintln(w, strings.Join(cells, "\t"))
+		count++
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%d rows in set\n", count)
+	return nil
+}
+
+// splitStatements splits the complete statements terminated by ';' out of s,
+// the semicolons in quotes are not treated as terminators. The text after the
+// last terminator is returned as rest.
+func splitStatements(s string) (stmts []string, rest string) {
+	var quote rune
+	escaped := false
+	start := 0
+	for i, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			if c == '\\' && quote != '`' {
+				escaped = true
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"' || c == '`':
+			quote = c
+		case c == ';':
+			if stmt := strings.TrimSpace(s[start:i]); len(stmt) > 0 {
+				stmts = append(stmts, stmt)
+			}
+			start = i + 1
+		}
+	}
+	return stmts, s[start:]
+}
@@ -0,0 +1,57 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSplitStatements(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		input string
+		stmts []string
+		rest  string
+	}{
+		{
+			input: "select 1",
+			rest:  "select 1",
+		},
+		{
+			input: "select 1; select 2;",
+			stmts: []string{"select 1", "select 2"},
+		},
+		{
+			input: "select ';'; select \"a;b\"; select `c;d`;",
+			stmts: []string{"select ';'", "select \"a;b\"", "select `c;d`"},
+		},
+		{
+			input: "select 'it\\'s;'; insert into t values ('",
+			stmts: []string{"select 'it\\'s;'"},
+			rest:  " insert into t values ('",
+		},
+		{
+			input: ";;\n",
+			rest:  "\n",
+		},
+	}
+	for _, tt := range tests {
+		stmts, rest := splitStatements(tt.input)
+		g.Expect(stmts).To(Equal(tt.stmts), tt.input)
+		g.Expect(rest).To(Equal(tt.rest), tt.input)
+	}
+}
@@ -0,0 +1,360 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package top
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/config"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/readable"
+	tkctlUtil "github.com/pingcap/tidb-operator/pkg/tkctl/util"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+const (
+	topLongDesc = `
+		Show live-refreshing views of the TiKV stores and regions of a tidb cluster
+		built on the statistics of PD.
+
+		The stores are mapped back to their pods through the status of the tidb cluster.
+		You can omit --tidbcluster=<name> option by running 'tkctl use <name>',
+`
+	topExample = `
+		# show the stores, refreshed every 3 seconds
+		tkctl top stores
+
+		# show the top 10 hot regions once
+		tkctl top regions --limit=10 --iterations=1
+`
+	topUsage = "expected 'top -t CLUSTER_NAME stores|regions' for the top command or using 'tkctl use' to set tidb cluster first"
+
+	unset = "<none>"
+
+	// clearScreen moves the cursor to the top left and clears the terminal
+	clearScreen = "\033[H\033[2J"
+)
+
+// TopOptions contains the input to the top command.
+type TopOptions struct {
+	Namespace       string
+	TidbClusterName string
+	InCluster       bool
+
+	Interval   time.Duration
+	Iterations int
+	Limit      int
+
+	tcCli      *versioned.Clientset
+	kubeCli    *kubernetes.Clientset
+	restConfig *rest.Config
+
+	genericclioptions.IOStreams
+}
+
+// NewTopOptions returns a TopOptions
+func NewTopOptions(streams genericclioptions.IOStreams) *TopOptions {
+	return &TopOptions{
+		Interval: 3 * time.Second,
+		Limit:    20,
+
+		IOStreams: streams,
+	}
+}
+
+// NewCmdTop creates the top command which shows the stats of TiKV stores and regions
+func NewCmdTop(tkcContext *config.TkcContext, streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewTopOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:     "top",
+		Short:   "Show live stats of TiKV stores and regions",
+		Long:    topLongDesc,
+		Example: topExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Help()
+		},
+	}
+	cmd.PersistentFlags().BoolVar(&o.InCluster, "in-cluster", false,
+		"connect to the PD service directly instead of port-forwarding to a PD pod")
+	cmd.PersistentFlags().DurationVar(&o.Interval, "interval", o.Interval, "The interval to refresh the view")
+	cmd.PersistentFlags().IntVarP(&o.Iterations, "iterations", "n", 0, "The number of refreshes before quit, 0 refreshes until interrupted")
+
+	stores := &cobra.Command{
+		Use:   "stores",
+		Short: "Show the leaders, regions, space and flow of the TiKV stores",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(tkcContext, cmd))
+			cmdutil.CheckErr(o.Run(o.renderStores))
+		},
+	}
+	regions := &cobra.Command{
+		Use:   "regions",
+		Short: "Show the region statistics and the hot regions",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(tkcContext, cmd))
+			cmdutil.CheckErr(o.Run(o.renderRegions))
+		},
+	}
+	regions.Flags().IntVar(&o.Limit, "limit", o.Limit, "The max number of hot regions to show for read and write each")
+
+	cmd.AddCommand(stores, regions)
+	return cmd
+}
+
+func (o *TopOptions) Complete(tkcContext *config.TkcContext, cmd *cobra.Command) error {
+	clientConfig, err := tkcContext.ToTkcClientConfig()
+	if err != nil {
+		return err
+	}
+
+	if tidbClusterName, ok := clientConfig.TidbClusterName(); ok {
+		o.TidbClusterName = tidbClusterName
+	} else {
+		return cmdutil.UsageErrorf(cmd, topUsage)
+	}
+
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	restConfig, err := clientConfig.RestConfig()
+	if err != nil {
+		return err
+	}
+	o.restConfig = restConfig
+	tcCli, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.tcCli = tcCli
+	kubeCli, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.kubeCli = kubeCli
+
+	return nil
+}
+
+// Run renders the view every interval until the iterations are done or it's interrupted
+func (o *TopOptions) Run(render func(tc *v1alpha1.TidbCluster, pdCli pdapi.PDClient) (string, error)) error {
+	tc, err := o.getTidbCluster()
+	if err != nil {
+		return err
+	}
+	pdCli, stop, err := tkctlUtil.NewPDClient(o.kubeCli, o.restConfig, tc, o.InCluster, o.ErrOut)
+	if err != nil {
+		return err
+	}
+	defer stop()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	for i := 0; o.Iterations <= 0 || i < o.Iterations; i++ {
+		if i > 0 {
+			select {
+			case <-signals:
+				return nil
+			case <-time.After(o.Interval):
+			}
+			// the stores of the tidb cluster may change between refreshes
+			if tc, err = o.getTidbCluster(); err != nil {
+				return err
+			}
+		}
+		msg, err := render(tc, pdCli)
+		if err != nil {
+			return err
+		}
+		if o.Iterations != 1 {
+			fmt.Fprint(o.Out, clearScreen)
+			fmt.Fprintf(o.Out, "%s\n\n", time.Now().Format(time.RFC3339))
+		}
+		fmt.Fprint(o.Out, msg)
+	}
+	return nil
+}
+
+func (o *TopOptions) getTidbCluster() (*v1alpha1.TidbCluster, error) {
+	return o.tcCli.PingcapV1alpha1().
+		TidbClusters(o.Namespace).
+		Get(context.TODO(), o.TidbClusterName, metav1.GetOptions{})
+}
+
+func (o *TopOptions) renderStores(tc *v1alpha1.TidbCluster, pdCli pdapi.PDClient) (string, error) {
+	stores, err := pdCli.GetStores()
+	if err != nil {
+		return "", err
+	}
+	hotStores, err := pdCli.GetHotStores()
+	if err != nil {
+		return "", err
+	}
+	hotRead, err := pdCli.GetHotRegions("read")
+	if err != nil {
+		return "", err
+	}
+	hotWrite, err := pdCli.GetHotRegions("write")
+	if err != nil {
+		return "", err
+	}
+	return renderStores(tc, stores, hotStores, hotRead, hotWrite)
+}
+
+func (o *TopOptions) renderRegions(tc *v1alpha1.TidbCluster, pdCli pdapi.PDClient) (string, error) {
+	stats, err := pdCli.GetRegionStats()
+	if err != nil {
+		return "", err
+	}
+	hotRead, err := pdCli.GetHotRegions("read")
+	if err != nil {
+		return "", err
+	}
+	hotWrite, err := pdCli.GetHotRegions("write")
+	if err != nil {
+		return "", err
+	}
+	return renderRegions(tc, stats, hotRead, hotWrite, o.Limit)
+}
+
+func renderStores(tc *v1alpha1.TidbCluster, stores *pdapi.StoresInfo, hotStores *pdapi.HotStoresStats,
+	hotRead, hotWrite *pdapi.StoreHotPeersInfos) (string, error) {
+	infos := make([]*pdapi.StoreInfo, 0, len(stores.Stores))
+	for _, store := range stores.Stores {
+		if store != nil && store.Store != nil && store.Store.Store != nil {
+			infos = append(infos, store)
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Store.Id < infos[j].Store.Id })
+
+	return readable.TabbedString(func(out io.Writer) error {
+		w := readable.NewPrefixWriter(out)
+		w.WriteLine(readable.LEVEL_0, "Store\tPod\tState\tLeaders\tRegions\tCapacity\tAvailable\tWrite\tRead\tHot Write\tHot Read")
+		w.WriteLine(readable.LEVEL_0, "-----\t---\t-----\t-------\t-------\t--------\t---------\t-----\t----\t---------\t--------")
+		for _, store := range infos {
+			id := store.Store.Id
+			var leaders, regions int
+			capacity, available := unset, unset
+			if store.Status != nil {
+				leaders = store.Status.LeaderCount
+				regions = store.Status.RegionCount
+				capacity = humanize.IBytes(uint64(store.Status.Capacity))
+				available = humanize.IBytes(uint64(store.Status.Available))
+			}
+			w.WriteLine(readable.LEVEL_0, "%d\t%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t%d\t%d",
+				id, podName(tc, id), store.Store.StateName, leaders, regions, capacity, available,
+				byteRate(hotStores.BytesWriteStats[id]), byteRate(hotStores.BytesReadStats[id]),
+				hotRegionCount(hotWrite.AsPeer, id), hotRegionCount(hotRead.AsLeader, id))
+		}
+		return nil
+	})
+}
+
+// hotRegion is a hot peer with its read or write type
+type hotRegion struct {
+	typ  string
+	stat pdapi.HotPeerStat
+}
+
+func renderRegions(tc *v1alpha1.TidbCluster, stats *pdapi.RegionStats, hotRead, hotWrite *pdapi.StoreHotPeersInfos, limit int) (string, error) {
+	// the read flow is served by leaders while the write flow goes to all peers
+	hot := append(topHotRegions("read", hotRead.AsLeader, limit), topHotRegions("write", hotWrite.AsPeer, limit)...)
+
+	return readable.TabbedString(func(out io.Writer) error {
+		w := readable.NewPrefixWriter(out)
+		w.WriteLine(readable.LEVEL_0, "Regions:\t%d", stats.Count)
+		w.WriteLine(readable.LEVEL_0, "Empty Regions:\t%d", stats.EmptyCount)
+		w.WriteLine(readable.LEVEL_0, "Storage Size:\t%s", humanize.IBytes(uint64(stats.StorageSize)*humanize.MiByte))
+		w.WriteLine(readable.LEVEL_0, "Storage Keys:\t%d", stats.StorageKeys)
+		w.WriteLine(readable.LEVEL_0, "Hot Regions:")
+		w.WriteLine(readable.LEVEL_1, "Region\tType\tStore\tPod\tHot Degree\tBytes\tKeys")
+		w.WriteLine(readable.LEVEL_1, "------\t----\t-----\t---\t----------\t-----\t----")
+		if len(hot) == 0 {
+			w.WriteLine(readable.LEVEL_1, "no hot region found")
+		}
+		for _, r := range hot {
+			w.WriteLine(readable.LEVEL_1, "%d\t%s\t%d\t%s\t%d\t%s\t%.0f/s",
+				r.stat.RegionID, r.typ, r.stat.StoreID, podName(tc, r.stat.StoreID), r.stat.HotDegree,
+				byteRate(r.stat.ByteRate), r.stat.KeyRate)
+		}
+		return nil
+	})
+}
+
+// topHotRegions returns at most limit hot regions of all stores with the highest byte rate
+func topHotRegions(typ string, stats map[uint64]*pdapi.HotPeersStat, limit int) []hotRegion {
+	var regions []hotRegion
+	for _, s := range stats {
+		if s == nil {
+			continue
+		}
+		for _, stat := range s.Stats {
+			regions = append(regions, hotRegion{typ: typ, stat: stat})
+		}
+	}
+	sort.Slice(regions, func(i, j int) bool {
+		if regions[i].stat.ByteRate != regions[j].stat.ByteRate {
+			return regions[i].stat.ByteRate > regions[j].stat.ByteRate
+		}
+		return regions[i].stat.RegionID < regions[j].stat.RegionID
+	})
+	if limit > 0 && len(regions) > limit {
+		regions = regions[:limit]
+	}
+	return regions
+}
+
+func hotRegionCount(stats map[uint64]*pdapi.HotPeersStat, storeID uint64) int {
+	if s, ok := stats[storeID]; ok && s != nil {
+		return len(s.Stats)
+	}
+	return 0
+}
+
+// podName maps the store back to its TiKV or TiFlash pod
+func podName(tc *v1alpha1.TidbCluster, storeID uint64) string {
+	id := strconv.FormatUint(storeID, 10)
+	if store, ok := tc.Status.TiKV.Stores[id]; ok {
+		return store.PodName
+	}
+	if store, ok := tc.Status.TiFlash.Stores[id]; ok {
+		return store.PodName
+	}
+	return unset
+}
+
+func byteRate(rate float64) string {
+	return humanize.IBytes(uint64(rate)) + "/s"
+}
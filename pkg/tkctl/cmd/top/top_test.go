@@ -0,0 +1,115 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package top
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/tikv/pd/pkg/typeutil"
+)
+
+func newTidbCluster() *v1alpha1.TidbCluster {
+	tc := &v1alpha1.TidbCluster{}
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+		"1": {ID: "1", PodName: "demo-tikv-0"},
+		"4": {ID: "4", PodName: "demo-tikv-1"},
+	}
+	return tc
+}
+
+func TestRenderStores(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	stores := &pdapi.StoresInfo{
+		Count: 2,
+		Stores: []*pdapi.StoreInfo{
+			{
+				Store: &pdapi.MetaStore{Store: &metapb.Store{Id: 4}, StateName: "Up"},
+				Status: &pdapi.StoreStatus{
+					Capacity:    typeutil.ByteSize(10 * 1024 * 1024 * 1024),
+					Available:   typeutil.ByteSize(5 * 1024 * 1024 * 1024),
+					LeaderCount: 1,
+					RegionCount: 3,
+				},
+			},
+			{
+				Store: &pdapi.MetaStore{Store: &metapb.Store{Id: 1}, StateName: "Up"},
+				Status: &pdapi.StoreStatus{
+					Capacity:    typeutil.ByteSize(10 * 1024 * 1024 * 1024),
+					Available:   typeutil.ByteSize(8 * 1024 * 1024 * 1024),
+					LeaderCount: 2,
+					RegionCount: 3,
+				},
+			},
+			{
+				Store: &pdapi.MetaStore{Store: &metapb.Store{Id: 7}, StateName: "Offline"},
+			},
+		},
+	}
+	hotStores := &pdapi.HotStoresStats{
+		BytesWriteStats: map[uint64]float64{1: 2048},
+		BytesReadStats:  map[uint64]float64{4: 1024},
+	}
+	hotRead := &pdapi.StoreHotPeersInfos{
+		AsLeader: map[uint64]*pdapi.HotPeersStat{4: {Stats: []pdapi.HotPeerStat{{StoreID: 4, RegionID: 2}}}},
+	}
+	hotWrite := &pdapi.StoreHotPeersInfos{
+		AsPeer: map[uint64]*pdapi.HotPeersStat{1: {Stats: []pdapi.HotPeerStat{{StoreID: 1, RegionID: 2}, {StoreID: 1, RegionID: 3}}}},
+	}
+
+	out, err := renderStores(newTidbCluster(), stores, hotStores, hotRead, hotWrite)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(Equal(`Store  Pod          State    Leaders  Regions  Capacity  Available  Write      Read       Hot Write  Hot Read
+-----  ---          -----    -------  -------  --------  ---------  -----      ----       ---------  --------
+1      demo-tikv-0  Up       2        3        10 GiB    8.0 GiB    2.0 KiB/s  0 B/s      2          0
+4      demo-tikv-1  Up       1        3        10 GiB    5.0 GiB    0 B/s      1.0 KiB/s  0          1
+7      <none>       Offline  0        0        <none>    <none>     0 B/s      0 B/s      0          0
+`))
+}
+
+func TestRenderRegions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	stats := &pdapi.RegionStats{Count: 10, EmptyCount: 2, StorageSize: 96, StorageKeys: 1000}
+	hotRead := &pdapi.StoreHotPeersInfos{
+		AsLeader: map[uint64]*pdapi.HotPeersStat{
+			4: {Stats: []pdapi.HotPeerStat{{StoreID: 4, RegionID: 2, HotDegree: 5, ByteRate: 1024, KeyRate: 10}}},
+		},
+	}
+	hotWrite := &pdapi.StoreHotPeersInfos{
+		AsPeer: map[uint64]*pdapi.HotPeersStat{
+			1: {Stats: []pdapi.HotPeerStat{
+				{StoreID: 1, RegionID: 3, HotDegree: 1, ByteRate: 1024},
+				{StoreID: 1, RegionID: 5, HotDegree: 2, ByteRate: 4096},
+			}},
+		},
+	}
+
+	out, err := renderRegions(newTidbCluster(), stats, hotRead, hotWrite, 1)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out).To(Equal(`Regions:        10
+Empty Regions:  2
+Storage Size:   96 MiB
+Storage Keys:   1000
+Hot Regions:
+  Region  Type   Store  Pod          Hot Degree  Bytes      Keys
+  ------  ----   -----  ---          ----------  -----      ----
+  2       read   4      demo-tikv-1  5           1.0 KiB/s  10/s
+  5       write  1      demo-tikv-0  2           4.0 KiB/s  0/s
+`))
+}
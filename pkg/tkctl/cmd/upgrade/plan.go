@@ -0,0 +1,387 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Masterminds/semver"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pingcap/advanced-statefulset/client/apis/apps/v1/helper"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/manager/member"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/readable"
+	"github.com/pingcap/tidb-operator/pkg/util/cmpver"
+	apps "k8s.io/api/apps/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// ticdcCrossUpgradeVersion is the first version TiCDC captures are drained
+	// gracefully across versions, keep consistent with the TiCDC scaler
+	ticdcCrossUpgradeVersion = "v6.3.0"
+	// tiflashConfigChangeVersion is the first version TiFlash changes its default config
+	tiflashConfigChangeVersion = "v5.4.0"
+)
+
+// upgradeOrder is the order the operator syncs the components in
+var upgradeOrder = []v1alpha1.MemberType{
+	v1alpha1.PDMemberType,
+	v1alpha1.PDMSTSOMemberType,
+	v1alpha1.PDMSSchedulingMemberType,
+	v1alpha1.TiProxyMemberType,
+	v1alpha1.TiFlashMemberType,
+	v1alpha1.TiKVMemberType,
+	v1alpha1.PumpMemberType,
+	v1alpha1.TiDBMemberType,
+	v1alpha1.TiCDCMemberType,
+}
+
+// upgradePlan is what the operator will do when the cluster is upgraded
+type upgradePlan struct {
+	Namespace  string
+	Name       string
+	From       string
+	To         string
+	Components []componentPlan
+	Warnings   []string
+}
+
+// componentPlan is the change of a component
+type componentPlan struct {
+	MemberType  v1alpha1.MemberType
+	StatefulSet string
+	OldImage    string
+	NewImage    string
+	// Missing is true if the StatefulSet does not exist, it will be created
+	// by the operator instead of being upgraded
+	Missing bool
+	// Changed is true if the pod template changes, the pods are restarted then
+	Changed  bool
+	Restarts []podRestart
+	Diff     string
+}
+
+// podRestart is a pod to restart with the actions taken before the restart
+type podRestart struct {
+	Pod    string
+	Action string
+}
+
+// buildPlan renders the StatefulSets of the tidb cluster at the target version
+// and compares them against the live StatefulSets in sets.
+func buildPlan(tc *v1alpha1.TidbCluster, to string, sets map[v1alpha1.MemberType]*apps.StatefulSet, cmLister corelisters.ConfigMapLister) (*upgradePlan, error) {
+	newTC := tc.DeepCopy()
+	newTC.Spec.Version = to
+
+	plan := &upgradePlan{
+		Namespace: tc.Namespace,
+		Name:      tc.Name,
+		From:      tc.Spec.Version,
+		To:        to,
+		Warnings:  checkVersions(tc, newTC),
+	}
+
+	for _, mt := range upgradeOrder {
+		if !hasComponent(tc, mt) {
+			continue
+		}
+		cp := componentPlan{
+			MemberType:  mt,
+			StatefulSet: statefulSetName(tc, mt),
+			OldImage:    componentImage(tc, mt),
+			NewImage:    componentImage(newTC, mt),
+		}
+		oldSet, ok := sets[mt]
+		if !ok {
+			cp.Missing = true
+			plan.Components = append(plan.Components, cp)
+			continue
+		}
+
+		newSet, err := member.RenderStatefulSet(newTC, mt, oldSet, cmLister)
+		if err != nil {
+			return nil, fmt.Errorf("render statefulset of %s failed: %v", mt, err)
+		}
+		if !member.TemplateEqual(newSet, oldSet) {
+			cp.Changed = true
+			cp.Restarts = podRestarts(tc, mt, oldSet)
+			cp.Diff = cmp.Diff(lastAppliedSpec(oldSet).Template.Spec, newSet.Spec.Template.Spec)
+		}
+		if phase := componentPhase(tc, mt); phase != "" && phase != v1alpha1.NormalPhase {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("%s is in %s phase, the plan may change after it finishes", mt, phase))
+		}
+		plan.Components = append(plan.Components, cp)
+	}
+
+	if tc.Spec.Paused {
+		plan.Warnings = append(plan.Warnings, "the cluster is paused, nothing will be applied until it is resumed")
+	}
+	return plan, nil
+}
+
+// podRestarts returns the pods in the order the upgraders restart them, which is
+// from the largest ordinal to the smallest.
+func podRestarts(tc *v1alpha1.TidbCluster, mt v1alpha1.MemberType, set *apps.StatefulSet) []podRestart {
+	var replicas int32
+	if set.Spec.Replicas != nil {
+		replicas = *set.Spec.Replicas
+	}
+	ordinals := helper.GetPodOrdinals(replicas, set).List()
+
+	restarts := make([]podRestart, 0, len(ordinals))
+	for i := len(ordinals) - 1; i >= 0; i-- {
+		ordinal := ordinals[i]
+		podName := fmt.Sprintf("%s-%d", set.Name, ordinal)
+		restart := podRestart{Pod: podName}
+		switch mt {
+		case v1alpha1.PDMemberType:
+			leader := tc.Status.PD.Leader.Name
+			if leader != "" && (leader == podName || leader == member.PdName(tc.Name, ordinal, tc.Namespace, tc.Spec.ClusterDomain, tc.Spec.AcrossK8s)) {
+				restart.Action = "transfer PD leader"
+			}
+		case v1alpha1.TiKVMemberType:
+			restart.Action = "evict leaders"
+			for _, store := range tc.Status.TiKV.Stores {
+				if store.PodName == podName {
+					restart.Action = fmt.Sprintf("evict %d leaders of store %s", store.LeaderCount, store.ID)
+					break
+				}
+			}
+		}
+		restarts = append(restarts, restart)
+	}
+	return restarts
+}
+
+// checkVersions returns the warnings of the upgrade from the version compatibility
+func checkVersions(tc, newTC *v1alpha1.TidbCluster) []string {
+	var warnings []string
+	from, to := tc.Spec.Version, newTC.Spec.Version
+	if from == to {
+		warnings = append(warnings, fmt.Sprintf("the cluster is already at version %s", from))
+	} else if less, err := cmpver.Compare(to, cmpver.Less, from); err != nil {
+		warnings = append(warnings, fmt.Sprintf("unable to compare version %s with %s: %v", to, from, err))
+	} else if less {
+		warnings = append(warnings, fmt.Sprintf("downgrading from %s to %s is not supported", from, to))
+	}
+
+	for _, mt := range upgradeOrder {
+		if hasComponent(tc, mt) && from != to && componentImage(tc, mt) == componentImage(newTC, mt) {
+			warnings = append(warnings, fmt.Sprintf("%s pins image %s, it is not upgraded", mt, componentImage(tc, mt)))
+		}
+	}
+
+	if tc.Spec.TiFlash != nil && tc.TiFlashVersion() != newTC.TiFlashVersion() {
+		before, err1 := cmpver.Compare(tc.TiFlashVersion(), cmpver.Less, tiflashConfigChangeVersion)
+		after, err2 := cmpver.Compare(newTC.TiFlashVersion(), cmpver.GreaterOrEqual, tiflashConfigChangeVersion)
+		if err1 == nil && err2 == nil && before && after {
+			warnings = append(warnings, fmt.Sprintf("the default config of TiFlash changes since %s, review spec.tiflash.config before upgrading", tiflashConfigChangeVersion))
+		}
+	}
+
+	if tc.Spec.TiCDC != nil && tc.TiCDCVersion() != newTC.TiCDCVersion() {
+		warnings = append(warnings, checkTiCDCVersions(tc.TiCDCVersion(), newTC.TiCDCVersion())...)
+	}
+	return warnings
+}
+
+// checkTiCDCVersions returns the warnings if the TiCDC captures can not be
+// drained gracefully, keep consistent with the TiCDC scaler.
+func checkTiCDCVersions(from, to string) []string {
+	less, err := cmpver.Compare(from, cmpver.Less, ticdcCrossUpgradeVersion)
+	if err != nil {
+		return nil
+	}
+	if less {
+		return []string{fmt.Sprintf("TiCDC captures are not drained gracefully when upgrading from %s, which is before %s", from, ticdcCrossUpgradeVersion)}
+	}
+	fromVer, err := semver.NewVersion(from)
+	if err != nil {
+		return nil
+	}
+	plus2 := fromVer.IncMajor().IncMajor()
+	within, err := cmpver.Compare(to, cmpver.Less, plus2.String())
+	if err == nil && !within {
+		return []string{fmt.Sprintf("TiCDC captures are not drained gracefully when upgrading across two major versions from %s to %s", from, to)}
+	}
+	return nil
+}
+
+func hasComponent(tc *v1alpha1.TidbCluster, mt v1alpha1.MemberType) bool {
+	switch mt {
+	case v1alpha1.PDMemberType:
+		return tc.Spec.PD != nil
+	case v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType:
+		return tc.GetPDMSSpec(mt.String()) != nil
+	case v1alpha1.TiProxyMemberType:
+		return tc.Spec.TiProxy != nil
+	case v1alpha1.TiFlashMemberType:
+		return tc.Spec.TiFlash != nil
+	case v1alpha1.TiKVMemberType:
+		return tc.Spec.TiKV != nil
+	case v1alpha1.PumpMemberType:
+		return tc.Spec.Pump != nil
+	case v1alpha1.TiDBMemberType:
+		return tc.Spec.TiDB != nil
+	case v1alpha1.TiCDCMemberType:
+		return tc.Spec.TiCDC != nil
+	}
+	return false
+}
+
+func statefulSetName(tc *v1alpha1.TidbCluster, mt v1alpha1.MemberType) string {
+	switch mt {
+	case v1alpha1.PDMemberType:
+		return controller.PDMemberName(tc.Name)
+	case v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType:
+		return controller.PDMSMemberName(tc.Name, mt.String())
+	case v1alpha1.TiProxyMemberType:
+		return controller.TiProxyMemberName(tc.Name)
+	case v1alpha1.TiFlashMemberType:
+		return controller.TiFlashMemberName(tc.Name)
+	case v1alpha1.TiKVMemberType:
+		return controller.TiKVMemberName(tc.Name)
+	case v1alpha1.PumpMemberType:
+		return controller.PumpMemberName(tc.Name)
+	case v1alpha1.TiDBMemberType:
+		return controller.TiDBMemberName(tc.Name)
+	case v1alpha1.TiCDCMemberType:
+		return controller.TiCDCMemberName(tc.Name)
+	}
+	return ""
+}
+
+func componentImage(tc *v1alpha1.TidbCluster, mt v1alpha1.MemberType) string {
+	switch mt {
+	case v1alpha1.PDMemberType:
+		return tc.PDImage()
+	case v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType:
+		return tc.PDMSImage(mt.String())
+	case v1alpha1.TiProxyMemberType:
+		return tc.TiProxyImage()
+	case v1alpha1.TiFlashMemberType:
+		return tc.TiFlashImage()
+	case v1alpha1.TiKVMemberType:
+		return tc.TiKVImage()
+	case v1alpha1.PumpMemberType:
+		if image := tc.PumpImage(); image != nil {
+			return *image
+		}
+	case v1alpha1.TiDBMemberType:
+		return tc.TiDBImage()
+	case v1alpha1.TiCDCMemberType:
+		return tc.TiCDCImage()
+	}
+	return ""
+}
+
+func componentPhase(tc *v1alpha1.TidbCluster, mt v1alpha1.MemberType) v1alpha1.MemberPhase {
+	switch mt {
+	case v1alpha1.PDMemberType:
+		return tc.Status.PD.Phase
+	case v1alpha1.PDMSTSOMemberType, v1alpha1.PDMSSchedulingMemberType:
+		if status, ok := tc.Status.PDMS[mt.String()]; ok && status != nil {
+			return status.Phase
+		}
+	case v1alpha1.TiProxyMemberType:
+		return tc.Status.TiProxy.Phase
+	case v1alpha1.TiFlashMemberType:
+		return tc.Status.TiFlash.Phase
+	case v1alpha1.TiKVMemberType:
+		return tc.Status.TiKV.Phase
+	case v1alpha1.PumpMemberType:
+		return tc.Status.Pump.Phase
+	case v1alpha1.TiDBMemberType:
+		return tc.Status.TiDB.Phase
+	case v1alpha1.TiCDCMemberType:
+		return tc.Status.TiCDC.Phase
+	}
+	return ""
+}
+
+// lastAppliedSpec returns the spec the operator applied to the StatefulSet last
+// time, which is what the rendered StatefulSet is compared against.
+func lastAppliedSpec(set *apps.StatefulSet) apps.StatefulSetSpec {
+	spec := apps.StatefulSetSpec{}
+	if applied, ok := set.Annotations[member.LastAppliedConfigAnnotation]; ok {
+		if err := json.Unmarshal([]byte(applied), &spec); err == nil {
+			return spec
+		}
+	}
+	return set.Spec
+}
+
+// renderPlan renders the plan, the diff of pod templates is included if showDiff is true
+func renderPlan(plan *upgradePlan, showDiff bool) (string, error) {
+	output, err := readable.TabbedString(func(out io.Writer) error {
+		w := readable.NewPrefixWriter(out)
+		w.WriteLine(readable.LEVEL_0, "Upgrade Plan:\t%s/%s", plan.Namespace, plan.Name)
+		w.WriteLine(readable.LEVEL_0, "Version:\t%s -> %s", plan.From, plan.To)
+		if len(plan.Warnings) > 0 {
+			w.WriteLine(readable.LEVEL_0, "Warnings:")
+			for _, warning := range plan.Warnings {
+				w.WriteLine(readable.LEVEL_1, "- %s", warning)
+			}
+		}
+
+		w.WriteLine(readable.LEVEL_0, "Components:")
+		w.WriteLine(readable.LEVEL_1, "Component\tStatefulSet\tImage\tRestart")
+		w.WriteLine(readable.LEVEL_1, "---------\t-----------\t-----\t-------")
+		for _, cp := range plan.Components {
+			image := cp.OldImage
+			if cp.NewImage != cp.OldImage {
+				image = fmt.Sprintf("%s -> %s", cp.OldImage, cp.NewImage)
+			}
+			restart := "no"
+			if cp.Missing {
+				restart = "statefulset not found, it will be created"
+			} else if cp.Changed {
+				restart = fmt.Sprintf("%d pods", len(cp.Restarts))
+			}
+			w.WriteLine(readable.LEVEL_1, "%s\t%s\t%s\t%s", cp.MemberType, cp.StatefulSet, image, restart)
+		}
+
+		w.WriteLine(readable.LEVEL_0, "Restart Order:")
+		step := 0
+		for _, cp := range plan.Components {
+			for _, r := range cp.Restarts {
+				step++
+				w.WriteLine(readable.LEVEL_1, "%d.\t%s\t%s\t%s", step, cp.MemberType, r.Pod, r.Action)
+			}
+		}
+		if step == 0 {
+			w.WriteLine(readable.LEVEL_1, "no pod will be restarted")
+		}
+
+		return nil
+	})
+	if err != nil || !showDiff {
+		return output, err
+	}
+
+	// the diffs are indented by tabs, so they are not written by the tab writer
+	var sb strings.Builder
+	sb.WriteString(output)
+	for _, cp := range plan.Components {
+		if cp.Diff != "" {
+			fmt.Fprintf(&sb, "Diff of %s (-live +planned):\n%s", cp.StatefulSet, cp.Diff)
+		}
+	}
+	return sb.String(), nil
+}
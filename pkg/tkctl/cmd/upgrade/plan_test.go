@@ -0,0 +1,240 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/manager/member"
+	mngerutils "github.com/pingcap/tidb-operator/pkg/manager/utils"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+)
+
+func newTidbCluster() *v1alpha1.TidbCluster {
+	tc := &v1alpha1.TidbCluster{
+		TypeMeta: metav1.TypeMeta{Kind: "TidbCluster", APIVersion: "pingcap.com/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo",
+			Namespace: "default",
+			UID:       "uid",
+		},
+		Spec: v1alpha1.TidbClusterSpec{
+			Version: "v7.1.0",
+			PD: &v1alpha1.PDSpec{
+				BaseImage: "pingcap/pd",
+				Replicas:  3,
+			},
+			TiKV: &v1alpha1.TiKVSpec{
+				BaseImage: "pingcap/tikv",
+				Replicas:  3,
+			},
+			TiDB: &v1alpha1.TiDBSpec{
+				BaseImage: "pingcap/tidb",
+				Replicas:  2,
+			},
+		},
+	}
+	tc.Status.PD.Leader.Name = "demo-pd-1"
+	tc.Status.TiKV.Stores = map[string]v1alpha1.TiKVStore{
+		"1": {ID: "1", PodName: "demo-tikv-0", LeaderCount: 10},
+		"4": {ID: "4", PodName: "demo-tikv-2", LeaderCount: 12},
+	}
+	return tc
+}
+
+// liveSets renders the StatefulSets applied by the operator for the tidb cluster
+func liveSets(g *GomegaWithT, tc *v1alpha1.TidbCluster, cmLister corelisters.ConfigMapLister) map[v1alpha1.MemberType]*apps.StatefulSet {
+	sets := map[v1alpha1.MemberType]*apps.StatefulSet{}
+	for _, mt := range []v1alpha1.MemberType{v1alpha1.PDMemberType, v1alpha1.TiKVMemberType, v1alpha1.TiDBMemberType} {
+		set, err := member.RenderStatefulSet(tc, mt, nil, cmLister)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(set)).To(Succeed())
+		sets[mt] = set
+	}
+	return sets
+}
+
+func TestBuildPlan(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cmLister := corelisters.NewConfigMapLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+	tc := newTidbCluster()
+	sets := liveSets(g, tc, cmLister)
+
+	plan, err := buildPlan(tc, "v7.1.0", sets, cmLister)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(plan.Warnings).To(Equal([]string{"the cluster is already at version v7.1.0"}))
+	for _, cp := range plan.Components {
+		g.Expect(cp.Changed).To(BeFalse(), string(cp.MemberType))
+	}
+
+	tc.Spec.TiDB.Version = pointer.StringPtr("v7.1.0")
+	plan, err = buildPlan(tc, "v7.5.1", sets, cmLister)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(plan.Warnings).To(Equal([]string{"tidb pins image pingcap/tidb:v7.1.0, it is not upgraded"}))
+	g.Expect(plan.Components).To(HaveLen(3))
+
+	pd := plan.Components[0]
+	g.Expect(pd.MemberType).To(Equal(v1alpha1.PDMemberType))
+	g.Expect(pd.OldImage).To(Equal("pingcap/pd:v7.1.0"))
+	g.Expect(pd.NewImage).To(Equal("pingcap/pd:v7.5.1"))
+	g.Expect(pd.Changed).To(BeTrue())
+	g.Expect(pd.Restarts).To(Equal([]podRestart{
+		{Pod: "demo-pd-2"},
+		{Pod: "demo-pd-1", Action: "transfer PD leader"},
+		{Pod: "demo-pd-0"},
+	}))
+	g.Expect(pd.Diff).To(ContainSubstring("pingcap/pd:v7.5.1"))
+
+	tikv := plan.Components[1]
+	g.Expect(tikv.MemberType).To(Equal(v1alpha1.TiKVMemberType))
+	g.Expect(tikv.Restarts).To(Equal([]podRestart{
+		{Pod: "demo-tikv-2", Action: "evict 12 leaders of store 4"},
+		{Pod: "demo-tikv-1", Action: "evict leaders"},
+		{Pod: "demo-tikv-0", Action: "evict 10 leaders of store 1"},
+	}))
+
+	tidb := plan.Components[2]
+	g.Expect(tidb.MemberType).To(Equal(v1alpha1.TiDBMemberType))
+	g.Expect(tidb.Changed).To(BeFalse())
+	g.Expect(tidb.Restarts).To(BeEmpty())
+
+	output, err := renderPlan(plan, false)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(output).To(ContainSubstring("Version:       v7.1.0 -> v7.5.1"))
+	g.Expect(output).To(ContainSubstring("2.  pd    demo-pd-1    transfer PD leader"))
+	g.Expect(output).To(ContainSubstring("4.  tikv  demo-tikv-2  evict 12 leaders of store 4"))
+	g.Expect(output).NotTo(ContainSubstring("Diff of"))
+
+	output, err = renderPlan(plan, true)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(output).To(ContainSubstring("Diff of demo-pd (-live +planned):"))
+	g.Expect(output).NotTo(ContainSubstring("Diff of demo-tidb"))
+}
+
+func TestBuildPlanMissingStatefulSet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cmLister := corelisters.NewConfigMapLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+	tc := newTidbCluster()
+	tc.Spec.TiCDC = &v1alpha1.TiCDCSpec{BaseImage: "pingcap/ticdc", Replicas: 1}
+	tc.Status.TiKV.Phase = v1alpha1.ScalePhase
+
+	plan, err := buildPlan(tc, "v7.5.1", liveSets(g, tc, cmLister), cmLister)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(plan.Warnings).To(ContainElement("tikv is in Scale phase, the plan may change after it finishes"))
+	g.Expect(plan.Components).To(HaveLen(4))
+	g.Expect(plan.Components[3].MemberType).To(Equal(v1alpha1.TiCDCMemberType))
+	g.Expect(plan.Components[3].Missing).To(BeTrue())
+}
+
+func TestCheckVersions(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		name     string
+		update   func(tc *v1alpha1.TidbCluster)
+		to       string
+		warnings []string
+	}{
+		{
+			name:     "downgrade",
+			to:       "v6.5.0",
+			warnings: []string{"downgrading from v7.1.0 to v6.5.0 is not supported"},
+		},
+		{
+			name:     "invalid version",
+			to:       "v7.x",
+			warnings: []string{"unable to compare version v7.x with v7.1.0: Invalid Semantic Version"},
+		},
+		{
+			name: "ticdc before v6.3.0",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.Version = "v6.1.0"
+				tc.Spec.TiCDC = &v1alpha1.TiCDCSpec{BaseImage: "pingcap/ticdc"}
+			},
+			to:       "v6.5.0",
+			warnings: []string{"TiCDC captures are not drained gracefully when upgrading from v6.1.0, which is before v6.3.0"},
+		},
+		{
+			name: "ticdc across two major versions",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.Version = "v6.5.0"
+				tc.Spec.TiCDC = &v1alpha1.TiCDCSpec{BaseImage: "pingcap/ticdc"}
+			},
+			to:       "v8.1.0",
+			warnings: []string{"TiCDC captures are not drained gracefully when upgrading across two major versions from v6.5.0 to v8.1.0"},
+		},
+		{
+			name: "tiflash config change",
+			update: func(tc *v1alpha1.TidbCluster) {
+				tc.Spec.Version = "v5.3.0"
+				tc.Spec.TiFlash = &v1alpha1.TiFlashSpec{BaseImage: "pingcap/tiflash"}
+			},
+			to:       "v5.4.0",
+			warnings: []string{"the default config of TiFlash changes since v5.4.0, review spec.tiflash.config before upgrading"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := newTidbCluster()
+			if tt.update != nil {
+				tt.update(tc)
+			}
+			newTC := tc.DeepCopy()
+			newTC.Spec.Version = tt.to
+			g.Expect(checkVersions(tc, newTC)).To(Equal(tt.warnings))
+		})
+	}
+}
+
+func TestBuildPlanPDMS(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cmLister := corelisters.NewConfigMapLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+	tc := newTidbCluster()
+	tc.Spec.PD.Mode = v1alpha1.PDModeMS
+	tc.Spec.PDMS = []v1alpha1.PDMSSpec{{
+		Name:          "tso",
+		ComponentSpec: v1alpha1.ComponentSpec{Image: "pingcap/pd:v7.1.0"},
+		Replicas:      2,
+	}}
+	sets := liveSets(g, tc, cmLister)
+	set, err := member.RenderStatefulSet(tc, v1alpha1.PDMSTSOMemberType, nil, cmLister)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(mngerutils.SetStatefulSetLastAppliedConfigAnnotation(set)).To(Succeed())
+	sets[v1alpha1.PDMSTSOMemberType] = set
+
+	tc.Spec.PDMS[0].Image = "pingcap/pd:v7.5.1"
+	plan, err := buildPlan(tc, "v7.5.1", sets, cmLister)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(plan.Components).To(HaveLen(4))
+
+	tso := plan.Components[1]
+	g.Expect(tso.MemberType).To(Equal(v1alpha1.PDMSTSOMemberType))
+	g.Expect(tso.StatefulSet).To(Equal("demo-tso"))
+	g.Expect(tso.NewImage).To(Equal("pingcap/pd:v7.5.1"))
+	g.Expect(tso.Changed).To(BeTrue())
+	g.Expect(tso.Restarts).To(Equal([]podRestart{
+		{Pod: "demo-tso-1"},
+		{Pod: "demo-tso-0"},
+	}))
+}
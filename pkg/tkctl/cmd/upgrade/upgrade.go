@@ -0,0 +1,200 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/config"
+	"github.com/spf13/cobra"
+	apps "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+)
+
+const (
+	upgradeLongDesc = `
+		Preview the upgrade of a tidb cluster before changing spec.version.
+
+		The StatefulSets are rendered with the same builders as the operator and
+		compared against the live ones. Nothing is changed in the cluster.
+		You can omit --tidbcluster=<name> option by running 'tkctl use <name>',
+`
+	upgradePlanExample = `
+		# show what the operator will do when the cluster is upgraded to v7.5.1
+		tkctl upgrade plan --to v7.5.1
+
+		# also show the changes of the pod templates
+		tkctl upgrade plan --to v7.5.1 --diff
+`
+	upgradeUsage = "expected 'upgrade plan -t CLUSTER_NAME --to VERSION' for the upgrade command or using 'tkctl use' to set tidb cluster first"
+)
+
+// UpgradeOptions contains the input to the upgrade command.
+type UpgradeOptions struct {
+	Namespace       string
+	TidbClusterName string
+	To              string
+	Diff            bool
+
+	tcCli   *versioned.Clientset
+	kubeCli *kubernetes.Clientset
+
+	genericclioptions.IOStreams
+}
+
+// NewUpgradeOptions returns a UpgradeOptions
+func NewUpgradeOptions(streams genericclioptions.IOStreams) *UpgradeOptions {
+	return &UpgradeOptions{
+		IOStreams: streams,
+	}
+}
+
+// NewCmdUpgrade creates the upgrade command which previews the upgrade of a tidb cluster
+func NewCmdUpgrade(tkcContext *config.TkcContext, streams genericclioptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Preview the upgrade of a tidb cluster",
+		Long:  upgradeLongDesc,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(cmdutil.UsageErrorf(cmd, upgradeUsage))
+		},
+	}
+	cmd.AddCommand(newCmdPlan(tkcContext, streams))
+	return cmd
+}
+
+func newCmdPlan(tkcContext *config.TkcContext, streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewUpgradeOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:     "plan --to VERSION",
+		Short:   "Show what the operator will do when the cluster is upgraded, without changing anything",
+		Long:    upgradeLongDesc,
+		Example: upgradePlanExample,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Complete(tkcContext, cmd, args))
+			cmdutil.CheckErr(o.Run())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.To, "to", "", "The version to upgrade the tidb cluster to")
+	cmd.Flags().BoolVar(&o.Diff, "diff", false, "Show the changes of the pod templates")
+	cmdutil.CheckErr(cmd.MarkFlagRequired("to"))
+	return cmd
+}
+
+func (o *UpgradeOptions) Complete(tkcContext *config.TkcContext, cmd *cobra.Command, args []string) error {
+	if len(args) != 0 || o.To == "" {
+		return cmdutil.UsageErrorf(cmd, upgradeUsage)
+	}
+
+	clientConfig, err := tkcContext.ToTkcClientConfig()
+	if err != nil {
+		return err
+	}
+
+	if tidbClusterName, ok := clientConfig.TidbClusterName(); ok {
+		o.TidbClusterName = tidbClusterName
+	} else {
+		return cmdutil.UsageErrorf(cmd, upgradeUsage)
+	}
+
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	restConfig, err := clientConfig.RestConfig()
+	if err != nil {
+		return err
+	}
+	tcCli, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.tcCli = tcCli
+	kubeCli, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	o.kubeCli = kubeCli
+
+	return nil
+}
+
+func (o *UpgradeOptions) Run() error {
+	tc, err := o.tcCli.PingcapV1alpha1().
+		TidbClusters(o.Namespace).
+		Get(context.TODO(), o.TidbClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	sets := map[v1alpha1.MemberType]*apps.StatefulSet{}
+	for _, mt := range upgradeOrder {
+		if !hasComponent(tc, mt) {
+			continue
+		}
+		set, err := o.kubeCli.AppsV1().StatefulSets(tc.Namespace).Get(context.TODO(), statefulSetName(tc, mt), metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		sets[mt] = set
+	}
+
+	cmLister, err := o.configMapLister(tc.Namespace)
+	if err != nil {
+		return err
+	}
+
+	plan, err := buildPlan(tc, o.To, sets, cmLister)
+	if err != nil {
+		return err
+	}
+	output, err := renderPlan(plan, o.Diff)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(o.Out, output)
+	return nil
+}
+
+// configMapLister returns a lister of the config maps in the namespace, which
+// is used by the builders to find the config maps in use.
+func (o *UpgradeOptions) configMapLister(namespace string) (corelisters.ConfigMapLister, error) {
+	cms, err := o.kubeCli.CoreV1().ConfigMaps(namespace).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for i := range cms.Items {
+		if err := indexer.Add(&cms.Items[i]); err != nil {
+			return nil, err
+		}
+	}
+	return corelisters.NewConfigMapLister(indexer), nil
+}
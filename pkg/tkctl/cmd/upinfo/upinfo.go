@@ -186,15 +186,14 @@ func getState(updateReplicas int32, ordinal int32, tc *v1alpha1.TidbCluster, pod
 }
 
 func getTiDBServerPort(svc *v1.Service) string {
-	for _, port := range svc.Spec.Ports {
-		if port.Name == "mysql-client" {
-			if port.NodePort != 0 {
-				return fmt.Sprintf("%d:%d/%s", port.Port, port.NodePort, port.Protocol)
-			}
-			return fmt.Sprintf("%d/%s", port.Port, port.Protocol)
-		}
+	port, ok := tkctlUtil.GetTiDBServerPort(svc)
+	if !ok {
+		return "<none>"
+	}
+	if port.NodePort != 0 {
+		return fmt.Sprintf("%d:%d/%s", port.Port, port.NodePort, port.Protocol)
 	}
-	return "<none>"
+	return fmt.Sprintf("%d/%s", port.Port, port.Protocol)
 }
 
 func renderTCUpgradeInfo(tc *v1alpha1.TidbCluster, set *apps.StatefulSet, podList *v1.PodList, svc *v1.Service) (string, error) {
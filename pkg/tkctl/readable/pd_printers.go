@@ -0,0 +1,277 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package readable
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/tkctl/alias"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/printers"
+)
+
+func addPDHandlers(h printers.PrintHandler) {
+	storeColumns := []metav1beta1.TableColumnDefinition{
+		{Name: "ID", Type: "integer", Description: "The store id"},
+		{Name: "Address", Type: "string", Description: "The address of the store"},
+		{Name: "State", Type: "string", Description: "The state of the store"},
+		{Name: "Leaders", Type: "integer", Description: "The number of leaders in the store"},
+		{Name: "Regions", Type: "integer", Description: "The number of regions in the store"},
+		{Name: "Capacity", Type: "string", Description: "The capacity of the store"},
+		{Name: "Available", Type: "string", Description: "The available space of the store"},
+		{Name: "Version", Type: "string", Priority: 1, Description: "The version of the store"},
+		{Name: "Labels", Type: "string", Priority: 1, Description: "The labels of the store"},
+	}
+	h.TableHandler(storeColumns, printPDStoreList)
+	memberColumns := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: "The name of the member"},
+		{Name: "ID", Type: "string", Description: "The member id"},
+		{Name: "Client URLs", Type: "string", Description: "The client urls of the member"},
+		{Name: "Leader", Type: "string", Description: "Whether the member is the leader"},
+		{Name: "Peer URLs", Type: "string", Priority: 1, Description: "The peer urls of the member"},
+	}
+	h.TableHandler(memberColumns, printPDMemberList)
+	regionColumns := []metav1beta1.TableColumnDefinition{
+		{Name: "ID", Type: "integer", Description: "The region id"},
+		{Name: "Leader Store", Type: "string", Description: "The store of the leader peer"},
+		{Name: "Peer Stores", Type: "string", Description: "The stores of all peers"},
+		{Name: "Size", Type: "string", Description: "The approximate size of the region"},
+		{Name: "Keys", Type: "integer", Description: "The approximate number of keys in the region"},
+		{Name: "Start Key", Type: "string", Priority: 1, Description: "The start key of the region"},
+		{Name: "End Key", Type: "string", Priority: 1, Description: "The end key of the region"},
+		{Name: "Written", Type: "string", Priority: 1, Description: "The written bytes of the region"},
+		{Name: "Read", Type: "string", Priority: 1, Description: "The read bytes of the region"},
+	}
+	h.TableHandler(regionColumns, printPDRegionList)
+	hotRegionColumns := []metav1beta1.TableColumnDefinition{
+		{Name: "Store", Type: "integer", Description: "The store of the hot peer"},
+		{Name: "Region", Type: "integer", Description: "The region of the hot peer"},
+		{Name: "Role", Type: "string", Description: "Whether the hot peer is counted as leader or peer"},
+		{Name: "Hot Degree", Type: "integer", Description: "How long the peer has been hot"},
+		{Name: "Byte Rate", Type: "string", Description: "The flow of bytes per second"},
+		{Name: "Key Rate", Type: "string", Description: "The flow of keys per second"},
+	}
+	h.TableHandler(hotRegionColumns, printPDHotRegions)
+	schedulerColumns := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: "The name of the scheduler"},
+	}
+	h.TableHandler(schedulerColumns, printPDSchedulerList)
+	configColumns := []metav1beta1.TableColumnDefinition{
+		{Name: "Key", Type: "string", Description: "The full name of the config item"},
+		{Name: "Value", Type: "string", Description: "The value of the config item"},
+	}
+	h.TableHandler(configColumns, printPDConfig)
+}
+
+func printPDStoreList(stores *alias.PDStoreList, options printers.GenerateOptions) ([]metav1beta1.TableRow, error) {
+	if stores.StoresInfo == nil {
+		return nil, nil
+	}
+	rows := make([]metav1beta1.TableRow, 0, len(stores.Stores))
+	for _, store := range stores.Stores {
+		if store == nil || store.Store == nil || store.Store.Store == nil {
+			continue
+		}
+		row := metav1beta1.TableRow{
+			Object: runtime.RawExtension{Object: stores},
+		}
+		var leaders, regions int
+		capacity, available := unset, unset
+		if store.Status != nil {
+			leaders = store.Status.LeaderCount
+			regions = store.Status.RegionCount
+			capacity = humanize.IBytes(uint64(store.Status.Capacity))
+			available = humanize.IBytes(uint64(store.Status.Available))
+		}
+		row.Cells = append(row.Cells, store.Store.Id, store.Store.Address, store.Store.StateName,
+			leaders, regions, capacity, available)
+		if options.Wide {
+			labels := make([]string, 0, len(store.Store.Labels))
+			for _, l := range store.Store.Labels {
+				labels = append(labels, fmt.Sprintf("%s=%s", l.Key, l.Value))
+			}
+			row.Cells = append(row.Cells, orUnset(store.Store.Version), orUnset(strings.Join(labels, ",")))
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func printPDMemberList(members *alias.PDMemberList, options printers.GenerateOptions) ([]metav1beta1.TableRow, error) {
+	if members.MembersInfo == nil {
+		return nil, nil
+	}
+	rows := make([]metav1beta1.TableRow, 0, len(members.Members))
+	for _, member := range members.Members {
+		if member == nil {
+			continue
+		}
+		row := metav1beta1.TableRow{
+			Object: runtime.RawExtension{Object: members},
+		}
+		leader := members.Leader != nil && members.Leader.MemberId == member.MemberId
+		row.Cells = append(row.Cells, member.Name, fmt.Sprintf("%d", member.MemberId),
+			orUnset(strings.Join(member.ClientUrls, ",")), fmt.Sprintf("%t", leader))
+		if options.Wide {
+			row.Cells = append(row.Cells, orUnset(strings.Join(member.PeerUrls, ",")))
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func printPDRegionList(regions *alias.PDRegionList, options printers.GenerateOptions) ([]metav1beta1.TableRow, error) {
+	if regions.RegionsInfo == nil {
+		return nil, nil
+	}
+	rows := make([]metav1beta1.TableRow, 0, len(regions.Regions))
+	for _, region := range regions.Regions {
+		if region == nil {
+			continue
+		}
+		row := metav1beta1.TableRow{
+			Object: runtime.RawExtension{Object: regions},
+		}
+		leader := unset
+		if region.Leader != nil {
+			leader = fmt.Sprintf("%d", region.Leader.StoreID)
+		}
+		peers := make([]string, 0, len(region.Peers))
+		for _, peer := range region.Peers {
+			peers = append(peers, fmt.Sprintf("%d", peer.StoreID))
+		}
+		// the approximate size reported by PD is in MiB
+		size := humanize.IBytes(uint64(region.ApproximateSize) * humanize.MiByte)
+		row.Cells = append(row.Cells, region.ID, leader, orUnset(strings.Join(peers, ",")), size, region.ApproximateKeys)
+		if options.Wide {
+			row.Cells = append(row.Cells, orUnset(region.StartKey), orUnset(region.EndKey),
+				humanize.IBytes(region.WrittenBytes), humanize.IBytes(region.ReadBytes))
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func printPDHotRegions(hot *alias.PDHotRegions, options printers.GenerateOptions) ([]metav1beta1.TableRow, error) {
+	if hot.StoreHotPeersInfos == nil {
+		return nil, nil
+	}
+	var rows []metav1beta1.TableRow
+	appendRows := func(role string, stats map[uint64]*pdapi.HotPeersStat) {
+		storeIDs := make([]uint64, 0, len(stats))
+		for id := range stats {
+			storeIDs = append(storeIDs, id)
+		}
+		sort.Slice(storeIDs, func(i, j int) bool { return storeIDs[i] < storeIDs[j] })
+		for _, id := range storeIDs {
+			if stats[id] == nil {
+				continue
+			}
+			for _, stat := range stats[id].Stats {
+				row := metav1beta1.TableRow{
+					Object: runtime.RawExtension{Object: hot},
+				}
+				row.Cells = append(row.Cells, id, stat.RegionID, role, stat.HotDegree,
+					humanize.IBytes(uint64(stat.ByteRate))+"/s", fmt.Sprintf("%.0f/s", stat.KeyRate))
+				rows = append(rows, row)
+			}
+		}
+	}
+	appendRows("leader", hot.AsLeader)
+	appendRows("peer", hot.AsPeer)
+	return rows, nil
+}
+
+func printPDSchedulerList(schedulers *alias.PDSchedulerList, options printers.GenerateOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(schedulers.Schedulers))
+	for _, name := range schedulers.Schedulers {
+		row := metav1beta1.TableRow{
+			Object: runtime.RawExtension{Object: schedulers},
+		}
+		row.Cells = append(row.Cells, name)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// printPDConfig prints one row for each config item, the key is the full
+// name of the item which is accepted by `pdctl config set`
+func printPDConfig(config *alias.PDConfig, options printers.GenerateOptions) ([]metav1beta1.TableRow, error) {
+	if config.PDConfigFromAPI == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(config.PDConfigFromAPI)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	items := map[string]string{}
+	if err := flattenConfig("", m, items); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(items))
+	for k := range items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	rows := make([]metav1beta1.TableRow, 0, len(keys))
+	for _, k := range keys {
+		row := metav1beta1.TableRow{
+			Object: runtime.RawExtension{Object: config},
+		}
+		row.Cells = append(row.Cells, k, items[k])
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func flattenConfig(prefix string, m map[string]interface{}, items map[string]string) error {
+	for k, v := range m {
+		key := k
+		if len(prefix) > 0 {
+			key = prefix + "." + k
+		}
+		switch val := v.(type) {
+		case map[string]interface{}:
+			if err := flattenConfig(key, val, items); err != nil {
+				return err
+			}
+		case string:
+			items[key] = val
+		default:
+			data, err := json.Marshal(val)
+			if err != nil {
+				return err
+			}
+			items[key] = string(data)
+		}
+	}
+	return nil
+}
+
+func orUnset(s string) string {
+	if len(s) == 0 {
+		return unset
+	}
+	return s
+}
@@ -20,9 +20,12 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
 	"github.com/pingcap/tidb-operator/pkg/tkctl/alias"
+	"github.com/tikv/pd/pkg/typeutil"
 	apps "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -199,6 +202,80 @@ foo    0/0              <none>/<none>   <none>/<none>   0          <unknown>   1
 			outputFormat: "wide",
 			expectedOutput: `VOLUME   CLAIM    STATUS   CAPACITY   STORAGECLASS   NODE   LOCAL
 foo      <none>            10Gi                      node   /mnt/disks/vol1
+`,
+		},
+		{
+			name: "pd store list",
+			testObject: alias.NewPDStoreList(&pdapi.StoresInfo{
+				Count: 1,
+				Stores: []*pdapi.StoreInfo{
+					{
+						Store: &pdapi.MetaStore{
+							Store:     &metapb.Store{Id: 1, Address: "tikv-0:20160", Version: "v5.0.0"},
+							StateName: "Up",
+						},
+						Status: &pdapi.StoreStatus{
+							Capacity:    typeutil.ByteSize(10 * 1024 * 1024 * 1024),
+							Available:   typeutil.ByteSize(5 * 1024 * 1024 * 1024),
+							LeaderCount: 2,
+							RegionCount: 3,
+						},
+					},
+				},
+			}),
+			outputFormat: "wide",
+			expectedOutput: `ID    ADDRESS        STATE   LEADERS   REGIONS   CAPACITY   AVAILABLE   VERSION   LABELS
+1     tikv-0:20160   Up      2         3         10 GiB     5.0 GiB     v5.0.0    <none>
+`,
+		},
+		{
+			name: "pd region list",
+			testObject: alias.NewPDRegionList(&pdapi.RegionsInfo{
+				Count: 1,
+				Regions: []*pdapi.RegionInfo{
+					{
+						ID:              2,
+						Peers:           []*pdapi.PeerInfo{{ID: 3, StoreID: 1}, {ID: 4, StoreID: 5}},
+						Leader:          &pdapi.PeerInfo{ID: 3, StoreID: 1},
+						ApproximateSize: 96,
+						ApproximateKeys: 1000,
+					},
+				},
+			}),
+			expectedOutput: `ID    LEADER STORE   PEER STORES   SIZE     KEYS
+2     1              1,5           96 MiB   1000
+`,
+		},
+		{
+			name: "pd hot regions",
+			testObject: alias.NewPDHotRegions(&pdapi.StoreHotPeersInfos{
+				AsLeader: map[uint64]*pdapi.HotPeersStat{
+					1: {Stats: []pdapi.HotPeerStat{{StoreID: 1, RegionID: 2, HotDegree: 3, ByteRate: 2048, KeyRate: 10}}},
+				},
+			}),
+			expectedOutput: `STORE   REGION   ROLE     HOT DEGREE   BYTE RATE   KEY RATE
+1       2        leader   3            2.0 KiB/s   10/s
+`,
+		},
+		{
+			name:       "pd scheduler list",
+			testObject: alias.NewPDSchedulerList([]string{"balance-leader-scheduler", "balance-region-scheduler"}),
+			expectedOutput: `NAME
+balance-leader-scheduler
+balance-region-scheduler
+`,
+		},
+		{
+			name: "pd config",
+			testObject: alias.NewPDConfig(&pdapi.PDConfigFromAPI{
+				Replication: &pdapi.PDReplicationConfig{
+					LocationLabels: []string{"zone", "host"},
+					MaxReplicas:    func() *uint64 { r := uint64(3); return &r }(),
+				},
+			}),
+			expectedOutput: `KEY                           VALUE
+replication.location-labels   zone,host
+replication.max-replicas      3
 `,
 		},
 	}
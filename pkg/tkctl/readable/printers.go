@@ -104,6 +104,7 @@ func AddHandlers(h printers.PrintHandler) {
 	}
 	h.TableHandler(volumeColumns, printVolume)
 	h.TableHandler(volumeColumns, printVolumeList)
+	addPDHandlers(h)
 }
 
 func printTidbClusterList(tcs *v1alpha1.TidbClusterList, options printers.GenerateOptions) ([]metav1beta1.TableRow, error) {
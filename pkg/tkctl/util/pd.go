@@ -0,0 +1,86 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/controller"
+	"github.com/pingcap/tidb-operator/pkg/pdapi"
+	"github.com/pingcap/tidb-operator/pkg/util"
+	"github.com/pingcap/tidb-operator/pkg/util/crypto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const pdClientPort = 2379
+
+// ClusterTLSConfig loads the client TLS config of the tidb cluster from the
+// cluster client secret, nil is returned if TLS is not enabled for the cluster.
+func ClusterTLSConfig(kubeCli kubernetes.Interface, tc *v1alpha1.TidbCluster) (*tls.Config, error) {
+	if !tc.IsTLSClusterEnabled() {
+		return nil, nil
+	}
+	secretName := util.ClusterClientTLSSecretName(tc.Name)
+	secret, err := kubeCli.CoreV1().Secrets(tc.Namespace).Get(context.TODO(), secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to load certificates from secret %s/%s: %v", tc.Namespace, secretName, err)
+	}
+	return crypto.LoadTlsConfigFromSecret(secret)
+}
+
+// NewPDClient creates a PD client of the tidb cluster. PD is reached through a
+// port-forward to one of the PD pods, or through the PD service if inCluster is
+// true. The returned stop function must be called after the client is no longer used.
+func NewPDClient(kubeCli kubernetes.Interface, restConfig *rest.Config, tc *v1alpha1.TidbCluster, inCluster bool, errOut io.Writer) (pdapi.PDClient, func(), error) {
+	if tc.WithoutLocalPD() {
+		return nil, nil, fmt.Errorf("tidb cluster %s/%s has no PD of its own", tc.Namespace, tc.Name)
+	}
+
+	scheme := "http"
+	tlsConfig, err := ClusterTLSConfig(kubeCli, tc)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tlsConfig != nil {
+		scheme = "https"
+	}
+
+	svcName := controller.PDMemberName(tc.Name)
+	if inCluster {
+		url := fmt.Sprintf("%s://%s.%s:%d", scheme, svcName, tc.Namespace, pdClientPort)
+		return pdapi.NewPDClient(url, pdapi.DefaultTimeout, tlsConfig), func() {}, nil
+	}
+
+	pod, err := GetRunningPod(kubeCli, tc.Namespace, label.New().Instance(tc.Name).PD().String())
+	if err != nil {
+		return nil, nil, err
+	}
+	localPort, stop, err := ForwardPodPort(kubeCli, restConfig, pod, 0, pdClientPort, errOut)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tlsConfig != nil {
+		// the certificate of PD is issued for its service instead of the local address
+		tlsConfig.ServerName = fmt.Sprintf("%s.%s.svc", svcName, tc.Namespace)
+	}
+	url := fmt.Sprintf("%s://127.0.0.1:%d", scheme, localPort)
+	return pdapi.NewPDClient(url, pdapi.DefaultTimeout, tlsConfig), stop, nil
+}
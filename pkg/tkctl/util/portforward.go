@@ -0,0 +1,93 @@
+// Copyright 2019 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// GetRunningPod returns the first running pod matched by the label selector
+func GetRunningPod(kubeCli kubernetes.Interface, namespace string, selector string) (*v1.Pod, error) {
+	podList, err := kubeCli.CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range podList.Items {
+		if podList.Items[i].Status.Phase == v1.PodRunning {
+			return &podList.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no running pod found in namespace %s with selector %s", namespace, selector)
+}
+
+// ForwardPodPort forwards the local port to the given port of the pod, a
+// random local port is chosen if localPort is 0. The returned stop function
+// must be called to close the forwarding.
+func ForwardPodPort(kubeCli kubernetes.Interface, restConfig *rest.Config, pod *v1.Pod, localPort, podPort int, errOut io.Writer) (uint16, func(), error) {
+	if pod.Status.Phase != v1.PodRunning {
+		return 0, nil, fmt.Errorf("unable to forward port because pod %s/%s is not running, current phase: %s", pod.Namespace, pod.Name, pod.Status.Phase)
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(restConfig)
+	if err != nil {
+		return 0, nil, err
+	}
+	req := kubeCli.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward")
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", req.URL())
+
+	stopChan := make(chan struct{})
+	readyChan := make(chan struct{})
+	ports := []string{fmt.Sprintf("%d:%d", localPort, podPort)}
+	fw, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, ports, stopChan, readyChan, ioutil.Discard, errOut)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- fw.ForwardPorts()
+	}()
+	select {
+	case <-readyChan:
+	case err := <-errChan:
+		return 0, nil, err
+	}
+
+	forwarded, err := fw.GetPorts()
+	if err != nil {
+		close(stopChan)
+		return 0, nil, err
+	}
+	if len(forwarded) == 0 {
+		close(stopChan)
+		return 0, nil, fmt.Errorf("no port is forwarded to pod %s/%s", pod.Namespace, pod.Name)
+	}
+	return forwarded[0].Local, func() { close(stopChan) }, nil
+}
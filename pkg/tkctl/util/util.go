@@ -42,3 +42,14 @@ func MakeDockerSocketMount(hostDockerSocket string, readOnly bool) (volume v1.Vo
 func GetTidbServiceName(tc string) string {
 	return tc + "-tidb"
 }
+
+// GetTiDBServerPort returns the mysql client port of the tidb service
+func GetTiDBServerPort(svc *v1.Service) (v1.ServicePort, bool) {
+	for _, port := range svc.Spec.Ports {
+		// FIXME: magic name
+		if port.Name == "mysql-client" {
+			return port, true
+		}
+	}
+	return v1.ServicePort{}, false
+}
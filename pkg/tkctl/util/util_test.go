@@ -17,6 +17,8 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestMakeDockerSocketMount(t *testing.T) {
@@ -55,3 +57,22 @@ func TestGetTidbServiceName(t *testing.T) {
 	g := NewGomegaWithT(t)
 	g.Expect(GetTidbServiceName("demo")).To(Equal("demo-tidb"))
 }
+
+func TestGetTiDBServerPort(t *testing.T) {
+	g := NewGomegaWithT(t)
+	svc := &v1.Service{
+		Spec: v1.ServiceSpec{
+			Ports: []v1.ServicePort{
+				{Name: "status", Port: 10080},
+				{Name: "mysql-client", Port: 4000, TargetPort: intstr.FromInt(4000)},
+			},
+		},
+	}
+	port, ok := GetTiDBServerPort(svc)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(port.Port).To(Equal(int32(4000)))
+
+	svc.Spec.Ports = svc.Spec.Ports[:1]
+	_, ok = GetTiDBServerPort(svc)
+	g.Expect(ok).To(BeFalse())
+}
@@ -0,0 +1,305 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const otlpTracesPath = "/v1/traces"
+
+// OTLPExporter exports spans to an OTLP/HTTP endpoint with the JSON encoding.
+//
+// The gRPC and protobuf exporters are not used because they require a newer gRPC than the one
+// the etcd client depends on.
+type OTLPExporter struct {
+	url    string
+	client *http.Client
+}
+
+var _ sdktrace.SpanExporter = &OTLPExporter{}
+
+// NewOTLPExporter returns an exporter sending spans to the endpoint, "/v1/traces" is appended
+// if the endpoint has no path.
+func NewOTLPExporter(endpoint string) (*OTLPExporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported scheme of endpoint %q", endpoint)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = otlpTracesPath
+	}
+	return &OTLPExporter{
+		url:    u.String(),
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// ExportSpans sends the spans to the endpoint.
+func (e *OTLPExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(encodeSpans(spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("failed to export spans to %s: %s, %s", e.url, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// Shutdown does nothing because the exporter holds no resources.
+func (e *OTLPExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// following types are the JSON mapping of the OTLP trace protobuf messages, see
+// https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto
+
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+	SchemaURL  string       `json:"schemaUrl,omitempty"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes,omitempty"`
+}
+
+type scopeSpans struct {
+	Scope scope  `json:"scope"`
+	Spans []span `json:"spans"`
+}
+
+type scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type span struct {
+	TraceID           string      `json:"traceId"`
+	SpanID            string      `json:"spanId"`
+	ParentSpanID      string      `json:"parentSpanId,omitempty"`
+	Name              string      `json:"name"`
+	Kind              int         `json:"kind"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string      `json:"endTimeUnixNano"`
+	Attributes        []keyValue  `json:"attributes,omitempty"`
+	Events            []spanEvent `json:"events,omitempty"`
+	Status            spanStatus  `json:"status"`
+}
+
+type spanEvent struct {
+	TimeUnixNano string     `json:"timeUnixNano"`
+	Name         string     `json:"name"`
+	Attributes   []keyValue `json:"attributes,omitempty"`
+}
+
+type spanStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue *string     `json:"stringValue,omitempty"`
+	BoolValue   *bool       `json:"boolValue,omitempty"`
+	IntValue    *string     `json:"intValue,omitempty"`
+	DoubleValue *float64    `json:"doubleValue,omitempty"`
+	ArrayValue  *arrayValue `json:"arrayValue,omitempty"`
+}
+
+type arrayValue struct {
+	Values []anyValue `json:"values"`
+}
+
+// status codes of OTLP, which are different from codes.Code
+const (
+	statusCodeUnset = 0
+	statusCodeOk    = 1
+	statusCodeError = 2
+)
+
+func encodeSpans(spans []sdktrace.ReadOnlySpan) *exportRequest {
+	req := &exportRequest{}
+	// group the spans by resource and instrumentation library
+	resourceIndex := map[interface{}]int{}
+	scopeIndex := map[interface{}]map[string]int{}
+	for _, s := range spans {
+		var rkey interface{}
+		var rattrs []keyValue
+		var schemaURL string
+		if r := s.Resource(); r != nil {
+			rkey = r.Equivalent()
+			rattrs = encodeAttributes(r.Attributes())
+			schemaURL = r.SchemaURL()
+		}
+		ri, ok := resourceIndex[rkey]
+		if !ok {
+			ri = len(req.ResourceSpans)
+			resourceIndex[rkey] = ri
+			scopeIndex[rkey] = map[string]int{}
+			req.ResourceSpans = append(req.ResourceSpans, resourceSpans{
+				Resource:  resource{Attributes: rattrs},
+				SchemaURL: schemaURL,
+			})
+		}
+		rs := &req.ResourceSpans[ri]
+
+		lib := s.InstrumentationLibrary()
+		skey := lib.Name + "@" + lib.Version
+		si, ok := scopeIndex[rkey][skey]
+		if !ok {
+			si = len(rs.ScopeSpans)
+			scopeIndex[rkey][skey] = si
+			rs.ScopeSpans = append(rs.ScopeSpans, scopeSpans{Scope: scope{Name: lib.Name, Version: lib.Version}})
+		}
+		rs.ScopeSpans[si].Spans = append(rs.ScopeSpans[si].Spans, encodeSpan(s))
+	}
+	return req
+}
+
+func encodeSpan(s sdktrace.ReadOnlySpan) span {
+	sc := s.SpanContext()
+	traceID := sc.TraceID()
+	spanID := sc.SpanID()
+	out := span{
+		TraceID:           hex.EncodeToString(traceID[:]),
+		SpanID:            hex.EncodeToString(spanID[:]),
+		Name:              s.Name(),
+		Kind:              int(s.SpanKind()),
+		StartTimeUnixNano: unixNano(s.StartTime()),
+		EndTimeUnixNano:   unixNano(s.EndTime()),
+		Attributes:        encodeAttributes(s.Attributes()),
+	}
+	if parent := s.Parent(); parent.IsValid() {
+		parentID := parent.SpanID()
+		out.ParentSpanID = hex.EncodeToString(parentID[:])
+	}
+	for _, e := range s.Events() {
+		out.Events = append(out.Events, spanEvent{
+			TimeUnixNano: unixNano(e.Time),
+			Name:         e.Name,
+			Attributes:   encodeAttributes(e.Attributes),
+		})
+	}
+	switch s.Status().Code {
+	case codes.Ok:
+		out.Status.Code = statusCodeOk
+	case codes.Error:
+		out.Status.Code = statusCodeError
+		out.Status.Message = s.Status().Description
+	default:
+		out.Status.Code = statusCodeUnset
+	}
+	return out
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+func encodeAttributes(attrs []attribute.KeyValue) []keyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make([]keyValue, 0, len(attrs))
+	for _, kv := range attrs {
+		out = append(out, keyValue{Key: string(kv.Key), Value: encodeValue(kv.Value)})
+	}
+	return out
+}
+
+func encodeValue(v attribute.Value) anyValue {
+	switch v.Type() {
+	case attribute.BOOL:
+		b := v.AsBool()
+		return anyValue{BoolValue: &b}
+	case attribute.INT64:
+		i := strconv.FormatInt(v.AsInt64(), 10)
+		return anyValue{IntValue: &i}
+	case attribute.FLOAT64:
+		f := v.AsFloat64()
+		return anyValue{DoubleValue: &f}
+	case attribute.STRING:
+		s := v.AsString()
+		return anyValue{StringValue: &s}
+	case attribute.BOOLSLICE:
+		arr := &arrayValue{}
+		for _, b := range v.AsBoolSlice() {
+			arr.Values = append(arr.Values, encodeValue(attribute.BoolValue(b)))
+		}
+		return anyValue{ArrayValue: arr}
+	case attribute.INT64SLICE:
+		arr := &arrayValue{}
+		for _, i := range v.AsInt64Slice() {
+			arr.Values = append(arr.Values, encodeValue(attribute.Int64Value(i)))
+		}
+		return anyValue{ArrayValue: arr}
+	case attribute.FLOAT64SLICE:
+		arr := &arrayValue{}
+		for _, f := range v.AsFloat64Slice() {
+			arr.Values = append(arr.Values, encodeValue(attribute.Float64Value(f)))
+		}
+		return anyValue{ArrayValue: arr}
+	case attribute.STRINGSLICE:
+		arr := &arrayValue{}
+		for _, s := range v.AsStringSlice() {
+			arr.Values = append(arr.Values, encodeValue(attribute.StringValue(s)))
+		}
+		return anyValue{ArrayValue: arr}
+	default:
+		s := v.Emit()
+		return anyValue{StringValue: &s}
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing traces the reconciliation of the operator with OpenTelemetry.
+//
+// The reconcile loop doesn't pass a context.Context to the member managers and the component
+// API clients, so the span which is currently active for an object is kept in a registry keyed
+// by the object. An object is registered only during its reconciliation, see Reconcile. It's safe
+// because an object is never synced by two workers at the same time.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/pingcap/tidb-operator"
+
+// Config is the config of tracing.
+type Config struct {
+	// Endpoint is the OTLP/HTTP endpoint to export spans to, e.g. http://otel-collector:4318.
+	// Tracing is disabled if it's empty.
+	Endpoint string
+	// SampleRatio is the ratio of the reconciliations to be traced.
+	SampleRatio float64
+	// ServiceName is the name of the service reported with the spans.
+	ServiceName string
+}
+
+// Setup sets the global tracer provider exporting spans to the OTLP endpoint,
+// the returned function flushes the spans and shuts down the provider.
+func Setup(cfg Config) (func(context.Context) error, error) {
+	if cfg.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	exporter, err := NewOTLPExporter(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %v", err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(sdkresource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(cfg.ServiceName))),
+	)
+	SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+var enabled int32
+
+// SetTracerProvider sets the global tracer provider and enables tracing.
+func SetTracerProvider(tp trace.TracerProvider) {
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	atomic.StoreInt32(&enabled, 1)
+}
+
+// Enabled returns whether tracing is enabled.
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// Tracer returns the tracer of the operator.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+var objectContexts = struct {
+	sync.RWMutex
+	m map[string]context.Context
+}{m: map[string]context.Context{}}
+
+// ObjectKey returns the key of an object in the registry.
+func ObjectKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// TidbClusterKey returns the key of a TidbCluster in the registry.
+func TidbClusterKey(namespace, name string) string {
+	return ObjectKey(v1alpha1.TiDBClusterKind, namespace, name)
+}
+
+// DMClusterKey returns the key of a DMCluster in the registry.
+func DMClusterKey(namespace, name string) string {
+	return ObjectKey(v1alpha1.DMClusterKind, namespace, name)
+}
+
+// ObjectContext returns the context carrying the active span of the object.
+func ObjectContext(key string) context.Context {
+	objectContexts.RLock()
+	defer objectContexts.RUnlock()
+	if ctx, ok := objectContexts.m[key]; ok {
+		return ctx
+	}
+	return context.Background()
+}
+
+// updateObjectContext sets the active span of the object if it's being reconciled,
+// it returns false if the object is not registered.
+func updateObjectContext(key string, ctx context.Context) bool {
+	objectContexts.Lock()
+	defer objectContexts.Unlock()
+	if _, ok := objectContexts.m[key]; !ok {
+		return false
+	}
+	objectContexts.m[key] = ctx
+	return true
+}
+
+// Reconcile runs fn in a root span of the reconciliation of the object, the object
+// is registered with the span as its active span until fn returns.
+func Reconcile(key, name string, fn func() error, attrs ...attribute.KeyValue) error {
+	ctx, span := Tracer().Start(context.Background(), name, trace.WithAttributes(attrs...))
+	defer span.End()
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		objectContexts.Lock()
+		objectContexts.m[key] = ctx
+		objectContexts.Unlock()
+		defer func() {
+			objectContexts.Lock()
+			delete(objectContexts.m, key)
+			objectContexts.Unlock()
+		}()
+	}
+	return recordError(span, fn())
+}
+
+// Trace runs fn in a span which is a child of the active span of the object, the span
+// is the active span of the object until fn returns. fn is not traced if the object is
+// not being reconciled, see Reconcile.
+func Trace(key, name string, fn func() error, attrs ...attribute.KeyValue) error {
+	parent := ObjectContext(key)
+	if !trace.SpanContextFromContext(parent).IsValid() {
+		return fn()
+	}
+	ctx, span := Tracer().Start(parent, name, trace.WithAttributes(attrs...))
+	defer span.End()
+	if updateObjectContext(key, ctx) {
+		defer updateObjectContext(key, parent)
+	}
+	return recordError(span, fn())
+}
+
+func recordError(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,169 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupInMemory(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	oldTP, oldPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	SetTracerProvider(tp)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(oldTP)
+		otel.SetTextMapPropagator(oldPropagator)
+		atomic.StoreInt32(&enabled, 0)
+	})
+	return exporter
+}
+
+func TestTrace(t *testing.T) {
+	g := NewGomegaWithT(t)
+	exporter := setupInMemory(t)
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	key := ObjectKey("TidbCluster", "ns", "tc")
+	client := &http.Client{Transport: NewTransport(nil, key)}
+
+	err := Reconcile(key, "reconcile", func() error {
+		return Trace(key, "pd.Sync", func() error {
+			resp, err := client.Get(server.URL + "/pd/api/v1/stores")
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			return errors.New("failed")
+		})
+	})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(ObjectContext(key)).To(Equal(context.Background()))
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(3))
+	req, sync, reconcile := spans[0], spans[1], spans[2]
+	g.Expect(req.Name).To(Equal("HTTP GET /pd/api/v1/stores"))
+	g.Expect(req.SpanKind).To(Equal(trace.SpanKindClient))
+	g.Expect(req.Parent.SpanID()).To(Equal(sync.SpanContext.SpanID()))
+	g.Expect(sync.Name).To(Equal("pd.Sync"))
+	g.Expect(sync.Status.Code).To(Equal(codes.Error))
+	g.Expect(sync.Parent.SpanID()).To(Equal(reconcile.SpanContext.SpanID()))
+	g.Expect(reconcile.Parent.IsValid()).To(BeFalse())
+
+	// the span of the request is propagated to the server
+	g.Expect(traceparent).To(ContainSubstring(req.SpanContext.TraceID().String()))
+	g.Expect(traceparent).To(ContainSubstring(req.SpanContext.SpanID().String()))
+}
+
+func TestTraceWithoutReconcile(t *testing.T) {
+	g := NewGomegaWithT(t)
+	exporter := setupInMemory(t)
+
+	key := ObjectKey("TidbCluster", "ns", "tc")
+	called := false
+	err := Trace(key, "pd.Sync", func() error {
+		called = true
+		// the object is not registered outside its reconciliation
+		g.Expect(ObjectContext(key)).To(Equal(context.Background()))
+		return nil
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(called).To(BeTrue())
+	g.Expect(exporter.GetSpans()).To(BeEmpty())
+}
+
+func TestTransportWithoutActiveSpan(t *testing.T) {
+	g := NewGomegaWithT(t)
+	exporter := setupInMemory(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(nil, ObjectKey("DMCluster", "ns", "dc"))}
+	resp, err := client.Get(server.URL + "/apis/v1alpha1/members")
+	g.Expect(err).NotTo(HaveOccurred())
+	resp.Body.Close()
+
+	spans := exporter.GetSpans()
+	g.Expect(spans).To(HaveLen(1))
+	g.Expect(spans[0].Parent.IsValid()).To(BeFalse())
+	g.Expect(spans[0].Status.Code).To(Equal(codes.Error))
+}
+
+func TestTransportDisabled(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(NewTransport(nil, ObjectKey("TidbCluster", "ns", "tc"))).To(BeNil())
+	base := &http.Transport{}
+	g.Expect(NewTransport(base, ObjectKey("TidbCluster", "ns", "tc"))).To(BeIdenticalTo(base))
+}
+
+func TestOTLPExporter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var path, contentType string
+	var body exportRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, contentType = r.URL.Path, r.Header.Get("Content-Type")
+		data, _ := ioutil.ReadAll(r.Body)
+		body = exportRequest{}
+		g.Expect(json.Unmarshal(data, &body)).To(Succeed())
+	}))
+	defer server.Close()
+
+	exporter, err := NewOTLPExporter(server.URL)
+	g.Expect(err).NotTo(HaveOccurred())
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, child := tp.Tracer("test").Start(ctx, "child")
+	child.End()
+	parent.End()
+
+	g.Expect(path).To(Equal("/v1/traces"))
+	g.Expect(contentType).To(Equal("application/json"))
+	g.Expect(body.ResourceSpans).To(HaveLen(1))
+	g.Expect(body.ResourceSpans[0].ScopeSpans).To(HaveLen(1))
+	g.Expect(body.ResourceSpans[0].ScopeSpans[0].Scope.Name).To(Equal("test"))
+	spans := body.ResourceSpans[0].ScopeSpans[0].Spans
+	g.Expect(spans).To(HaveLen(1))
+	g.Expect(spans[0].Name).To(Equal("parent"))
+	g.Expect(spans[0].ParentSpanID).To(BeEmpty())
+	g.Expect(spans[0].TraceID).To(Equal(parent.SpanContext().TraceID().String()))
+
+	_, err = NewOTLPExporter("otel-collector:4318")
+	g.Expect(err).To(HaveOccurred())
+}
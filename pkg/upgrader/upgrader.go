@@ -22,13 +22,17 @@ import (
 	asclientset "github.com/pingcap/advanced-statefulset/client/client/clientset/versioned"
 	"github.com/pingcap/tidb-operator/pkg/apis/label"
 	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1beta1"
 	"github.com/pingcap/tidb-operator/pkg/client/clientset/versioned"
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	utildiscovery "github.com/pingcap/tidb-operator/pkg/util/discovery"
 	appsv1 "k8s.io/api/apps/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 )
@@ -52,12 +56,21 @@ type upgrader struct {
 	kubeCli kubernetes.Interface
 	cli     versioned.Interface
 	asCli   asclientset.Interface
+	extCli  apiextensionsclientset.Interface
+	dynCli  dynamic.Interface
 	ns      string
 }
 
 var _ Interface = &upgrader{}
 
 func (u *upgrader) Upgrade() error {
+	if err := u.upgradeStatefulSets(); err != nil {
+		return err
+	}
+	return u.migrateStorageVersion()
+}
+
+func (u *upgrader) upgradeStatefulSets() error {
 	if features.DefaultFeatureGate.Enabled(features.AdvancedStatefulSet) {
 		klog.Infof("Upgrader: migrating Kubernetes StatefulSets to Advanced StatefulSets")
 		stsList, err := u.kubeCli.AppsV1().StatefulSets(u.ns).List(context.Background(), metav1.ListOptions{})
@@ -146,6 +159,71 @@ func deleteSlotAnns(tc *v1alpha1.TidbCluster) map[string]string {
 	return anns
 }
 
-func NewUpgrader(kubeCli kubernetes.Interface, cli versioned.Interface, asCli asclientset.Interface, ns string) Interface {
-	return &upgrader{kubeCli, cli, asCli, ns}
+// migrateStorageVersion rewrites the objects stored in versions other than the storage version,
+// then removes these versions from the stored versions of the CRD, so that they can be dropped
+// from the CRD in the future.
+func (u *upgrader) migrateStorageVersion() error {
+	if u.ns != metav1.NamespaceAll {
+		klog.Infof("Upgrader: the operator is not cluster scoped, skip migrating the storage version of CRDs")
+		return nil
+	}
+	// only the resources served in multiple versions may be stored in versions other than the storage version
+	for _, resource := range v1beta1.Resources {
+		if err := u.migrateCRDStorageVersion(v1alpha1.Resource(resource).String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *upgrader) migrateCRDStorageVersion(name string) error {
+	crd, err := u.extCli.ApiextensionsV1().CustomResourceDefinitions().Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.Infof("Upgrader: CRD %s is not found, skip migrating its storage version", name)
+			return nil
+		}
+		return err
+	}
+	storageVersion := ""
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			storageVersion = version.Name
+			break
+		}
+	}
+	storedVersions := crd.Status.StoredVersions
+	if storageVersion == "" || (len(storedVersions) == 1 && storedVersions[0] == storageVersion) {
+		return nil
+	}
+
+	klog.Infof("Upgrader: migrating the objects of CRD %s from stored versions %v to storage version %s", name, storedVersions, storageVersion)
+	gvr := schema.GroupVersionResource{Group: crd.Spec.Group, Version: storageVersion, Resource: crd.Spec.Names.Plural}
+	list, err := u.dynCli.Resource(gvr).Namespace(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range list.Items {
+		obj := &list.Items[i]
+		// an update without any change makes kube-apiserver rewrite the object in the storage version
+		_, err := u.dynCli.Resource(gvr).Namespace(obj.GetNamespace()).Update(context.Background(), obj, metav1.UpdateOptions{})
+		if err != nil {
+			// the object is deleted or rewritten by another update since it's listed
+			if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+				continue
+			}
+			return fmt.Errorf("Upgrader: failed to migrate %s %s/%s to storage version %s: %v", name, obj.GetNamespace(), obj.GetName(), storageVersion, err)
+		}
+	}
+
+	crd.Status.StoredVersions = []string{storageVersion}
+	if _, err := u.extCli.ApiextensionsV1().CustomResourceDefinitions().UpdateStatus(context.Background(), crd, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	klog.Infof("Upgrader: successfully migrated %d objects of CRD %s to storage version %s", len(list.Items), name, storageVersion)
+	return nil
+}
+
+func NewUpgrader(kubeCli kubernetes.Interface, cli versioned.Interface, asCli asclientset.Interface, extCli apiextensionsclientset.Interface, dynCli dynamic.Interface, ns string) Interface {
+	return &upgrader{kubeCli, cli, asCli, extCli, dynCli, ns}
 }
@@ -28,8 +28,15 @@ import (
 	"github.com/pingcap/tidb-operator/pkg/features"
 	"github.com/pingcap/tidb-operator/pkg/util"
 	appsv1 "k8s.io/api/apps/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/utils/pointer"
 )
 
@@ -662,7 +669,7 @@ func TestUpgrade(t *testing.T) {
 			}
 		}
 
-		operatorUpgrader := NewUpgrader(kubeCli, cli, asCli, tt.ns)
+		operatorUpgrader := NewUpgrader(kubeCli, cli, asCli, apiextensionsfake.NewSimpleClientset(), dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()), tt.ns)
 		err = operatorUpgrader.Upgrade()
 		if tt.wantErr {
 			if err == nil {
@@ -696,6 +703,108 @@ func TestUpgrade(t *testing.T) {
 	}
 }
 
+func TestMigrateStorageVersion(t *testing.T) {
+	newCRD := func(name string, storedVersions ...string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name + ".pingcap.com"},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: "pingcap.com",
+				Names: apiextensionsv1.CustomResourceDefinitionNames{Plural: name},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1alpha1", Served: true, Storage: true},
+					{Name: "v1beta1", Served: true, Storage: false},
+				},
+			},
+			Status: apiextensionsv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+		}
+	}
+	newObj := func(kind, ns, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("pingcap.com/v1alpha1")
+		obj.SetKind(kind)
+		obj.SetNamespace(ns)
+		obj.SetName(name)
+		return obj
+	}
+
+	tests := []struct {
+		name               string
+		ns                 string
+		crds               []*apiextensionsv1.CustomResourceDefinition
+		wantUpdatedObjs    []string
+		wantStoredVersions map[string][]string
+	}{
+		{
+			name: "migrate the objects stored in v1beta1",
+			crds: []*apiextensionsv1.CustomResourceDefinition{
+				newCRD("tidbclusters", "v1alpha1", "v1beta1"),
+				newCRD("backups", "v1alpha1"),
+			},
+			wantUpdatedObjs: []string{"ns1/tc1", "ns2/tc2"},
+			wantStoredVersions: map[string][]string{
+				"tidbclusters.pingcap.com": {"v1alpha1"},
+				"backups.pingcap.com":      {"v1alpha1"},
+			},
+		},
+		{
+			name: "namespace scoped operator",
+			ns:   "ns1",
+			crds: []*apiextensionsv1.CustomResourceDefinition{
+				newCRD("tidbclusters", "v1alpha1", "v1beta1"),
+			},
+			wantStoredVersions: map[string][]string{
+				"tidbclusters.pingcap.com": {"v1alpha1", "v1beta1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extCli := apiextensionsfake.NewSimpleClientset()
+			for _, crd := range tt.crds {
+				if _, err := extCli.ApiextensionsV1().CustomResourceDefinitions().Create(context.TODO(), crd, metav1.CreateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			scheme := runtime.NewScheme()
+			for _, kind := range []string{"TidbCluster", "Backup"} {
+				scheme.AddKnownTypeWithName(schema.GroupVersionKind{Group: "pingcap.com", Version: "v1alpha1", Kind: kind + "List"}, &unstructured.UnstructuredList{})
+			}
+			dynCli := dynamicfake.NewSimpleDynamicClient(scheme,
+				newObj("TidbCluster", "ns1", "tc1"),
+				newObj("TidbCluster", "ns2", "tc2"),
+				newObj("Backup", "ns1", "backup1"),
+			)
+
+			u := &upgrader{extCli: extCli, dynCli: dynCli, ns: tt.ns}
+			if err := u.migrateStorageVersion(); err != nil {
+				t.Fatal(err)
+			}
+
+			var updatedObjs []string
+			for _, action := range dynCli.Actions() {
+				if update, ok := action.(k8stesting.UpdateAction); ok {
+					obj := update.GetObject().(*unstructured.Unstructured)
+					updatedObjs = append(updatedObjs, obj.GetNamespace()+"/"+obj.GetName())
+				}
+			}
+			sort.Strings(updatedObjs)
+			if diff := cmp.Diff(tt.wantUpdatedObjs, updatedObjs); diff != "" {
+				t.Errorf("unexpected updated objects (-want, +got): %s", diff)
+			}
+			for name, want := range tt.wantStoredVersions {
+				crd, err := extCli.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), name, metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if diff := cmp.Diff(want, crd.Status.StoredVersions); diff != "" {
+					t.Errorf("unexpected stored versions of %s (-want, +got): %s", name, diff)
+				}
+			}
+		})
+	}
+}
+
 type sortAdvancedStatefulsetByNamespaceName []asappsv1.StatefulSet
 
 func (o sortAdvancedStatefulsetByNamespaceName) Len() int      { return len(o) }
@@ -0,0 +1,176 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+)
+
+const (
+	certificateBlockType = "CERTIFICATE"
+	rsaKeyBlockType      = "RSA PRIVATE KEY"
+
+	// tolerate the clock skew between the operator and the components
+	clockSkew = 5 * time.Minute
+)
+
+// CertificateAuthority is a CA which signs the certificates
+type CertificateAuthority struct {
+	Cert *x509.Certificate
+	Key  *rsa.PrivateKey
+}
+
+// NewCA returns a self-signed CA which is valid for the duration
+func NewCA(commonName string, duration time.Duration) (*CertificateAuthority, error) {
+	key, err := newPrivateKey(rsaKeySize)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization:       []string{"PingCAP"},
+			OrganizationalUnit: []string{"TiDB Operator"},
+			CommonName:         commonName,
+		},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              now.Add(duration),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return &CertificateAuthority{Cert: cert, Key: key}, nil
+}
+
+// Issue signs a certificate for both server and client authentication, the certificate and the private key are returned in PEM format
+func (ca *CertificateAuthority) Issue(commonName string, hostList []string, IPList []string, duration time.Duration) ([]byte, []byte, error) {
+	key, err := newPrivateKey(rsaKeySize)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	var ipAddrList []net.IP
+	for _, ip := range IPList {
+		ipAddrList = append(ipAddrList, net.ParseIP(ip))
+	}
+	now := time.Now()
+	notAfter := now.Add(duration)
+	if notAfter.After(ca.Cert.NotAfter) {
+		// a certificate can not outlive its CA
+		notAfter = ca.Cert.NotAfter
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			Organization:       []string{"PingCAP"},
+			OrganizationalUnit: []string{"TiDB Operator"},
+			CommonName:         commonName,
+		},
+		DNSNames:    hostList,
+		IPAddresses: ipAddrList,
+		NotBefore:   now.Add(-clockSkew),
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Cert, &key.PublicKey, ca.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: certificateBlockType, Bytes: der}), convertKeyToPEM(rsaKeyBlockType, key), nil
+}
+
+// EncodeCertificateAuthorities encodes the certificates and the private keys of the CAs in PEM format, in the same order
+func EncodeCertificateAuthorities(cas []*CertificateAuthority) ([]byte, []byte) {
+	var certs, keys []byte
+	for _, ca := range cas {
+		certs = append(certs, pem.EncodeToMemory(&pem.Block{Type: certificateBlockType, Bytes: ca.Cert.Raw})...)
+		keys = append(keys, convertKeyToPEM(rsaKeyBlockType, ca.Key)...)
+	}
+	return certs, keys
+}
+
+// ParseCertificateAuthorities parses the CAs encoded by EncodeCertificateAuthorities
+func ParseCertificateAuthorities(certsPEM, keysPEM []byte) ([]*CertificateAuthority, error) {
+	certs, err := ParseCertificates(certsPEM)
+	if err != nil {
+		return nil, err
+	}
+	var keys []*rsa.PrivateKey
+	for block, rest := pem.Decode(keysPEM); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != rsaKeyBlockType {
+			continue
+		}
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if len(certs) != len(keys) {
+		return nil, fmt.Errorf("the number of certificates %d and private keys %d mismatch", len(certs), len(keys))
+	}
+	cas := make([]*CertificateAuthority, 0, len(certs))
+	for i := range certs {
+		cas = append(cas, &CertificateAuthority{Cert: certs[i], Key: keys[i]})
+	}
+	return cas, nil
+}
+
+// ParseCertificates parses all the certificates in PEM format
+func ParseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != certificateBlockType {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate is found")
+	}
+	return certs, nil
+}
+
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestCertificateAuthority(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ca, err := NewCA("test-ca", 24*time.Hour)
+	g.Expect(err).Should(BeNil())
+	g.Expect(ca.Cert.IsCA).Should(BeTrue())
+
+	certPEM, keyPEM, err := ca.Issue("pd", []string{"basic-pd", "*.basic-pd-peer"}, []string{"127.0.0.1"}, time.Hour)
+	g.Expect(err).Should(BeNil())
+	_, err = tls.X509KeyPair(certPEM, keyPEM)
+	g.Expect(err).Should(BeNil())
+
+	certs, err := ParseCertificates(certPEM)
+	g.Expect(err).Should(BeNil())
+	g.Expect(certs).Should(HaveLen(1))
+	cert := certs[0]
+	g.Expect(cert.Subject.CommonName).Should(Equal("pd"))
+	g.Expect(cert.DNSNames).Should(Equal([]string{"basic-pd", "*.basic-pd-peer"}))
+	g.Expect(cert.IPAddresses[0].String()).Should(Equal("127.0.0.1"))
+	g.Expect(cert.ExtKeyUsage).Should(ConsistOf(x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth))
+	g.Expect(cert.NotAfter.Sub(time.Now())).Should(BeNumerically("~", time.Hour, time.Minute))
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Cert)
+	_, err = cert.Verify(x509.VerifyOptions{
+		DNSName:   "pd-0.basic-pd-peer",
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	g.Expect(err).Should(BeNil())
+
+	// the certificate can not outlive the CA
+	certPEM, _, err = ca.Issue("tikv", nil, nil, 48*time.Hour)
+	g.Expect(err).Should(BeNil())
+	certs, err = ParseCertificates(certPEM)
+	g.Expect(err).Should(BeNil())
+	g.Expect(certs[0].NotAfter).Should(Equal(ca.Cert.NotAfter))
+}
+
+func TestEncodeCertificateAuthorities(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ca1, err := NewCA("ca1", time.Hour)
+	g.Expect(err).Should(BeNil())
+	ca2, err := NewCA("ca2", time.Hour)
+	g.Expect(err).Should(BeNil())
+
+	certs, keys := EncodeCertificateAuthorities([]*CertificateAuthority{ca1, ca2})
+	cas, err := ParseCertificateAuthorities(certs, keys)
+	g.Expect(err).Should(BeNil())
+	g.Expect(cas).Should(HaveLen(2))
+	g.Expect(cas[0].Cert.Equal(ca1.Cert)).Should(BeTrue())
+	g.Expect(cas[0].Key.Equal(ca1.Key)).Should(BeTrue())
+	g.Expect(cas[1].Cert.Equal(ca2.Cert)).Should(BeTrue())
+	g.Expect(cas[1].Key.Equal(ca2.Key)).Should(BeTrue())
+
+	_, err = ParseCertificateAuthorities(certs, nil)
+	g.Expect(err).Should(HaveOccurred())
+	_, err = ParseCertificates(nil)
+	g.Expect(err).Should(HaveOccurred())
+}
@@ -195,6 +195,11 @@ func ClusterClientTLSSecretName(tcName string) string {
 	return fmt.Sprintf("%s-cluster-client-secret", tcName)
 }
 
+// ClusterCASecretName returns the name of the Secret which stores the CA managed by the operator
+func ClusterCASecretName(tcName string) string {
+	return fmt.Sprintf("%s-ca-secret", tcName)
+}
+
 func ClusterTLSSecretName(tcName, component string) string {
 	return fmt.Sprintf("%s-%s-cluster-secret", tcName, component)
 }
@@ -0,0 +1,151 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1beta1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/klog/v2"
+)
+
+// Path is the URL path the conversion webhook is served at
+const Path = "/convert"
+
+// scheme is the runtime.Scheme to which all versions of the converted resources and their conversions are registered.
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(v1beta1.AddToScheme(scheme))
+}
+
+// Webhook converts the custom resources between the versions served by their CRDs,
+// it handles the ConversionReview requests of both apiextensions.k8s.io/v1 and v1beta1.
+type Webhook struct{}
+
+var _ http.Handler = &Webhook{}
+
+func NewWebhook() *Webhook {
+	return &Webhook{}
+}
+
+func (w *Webhook) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(body, &typeMeta); err != nil {
+		http.Error(rw, fmt.Sprintf("cannot unmarshal ConversionReview: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	var review interface{}
+	switch typeMeta.GroupVersionKind() {
+	case apiextensionsv1.SchemeGroupVersion.WithKind("ConversionReview"):
+		r := &apiextensionsv1.ConversionReview{}
+		if err := json.Unmarshal(body, r); err != nil || r.Request == nil {
+			http.Error(rw, fmt.Sprintf("invalid ConversionReview: %v", err), http.StatusBadRequest)
+			return
+		}
+		r.Response = &apiextensionsv1.ConversionResponse{UID: r.Request.UID}
+		r.Response.ConvertedObjects, r.Response.Result = convertObjects(r.Request.Objects, r.Request.DesiredAPIVersion)
+		r.Request = nil
+		review = r
+	case apiextensionsv1beta1.SchemeGroupVersion.WithKind("ConversionReview"):
+		r := &apiextensionsv1beta1.ConversionReview{}
+		if err := json.Unmarshal(body, r); err != nil || r.Request == nil {
+			http.Error(rw, fmt.Sprintf("invalid ConversionReview: %v", err), http.StatusBadRequest)
+			return
+		}
+		r.Response = &apiextensionsv1beta1.ConversionResponse{UID: r.Request.UID}
+		r.Response.ConvertedObjects, r.Response.Result = convertObjects(r.Request.Objects, r.Request.DesiredAPIVersion)
+		r.Request = nil
+		review = r
+	default:
+		http.Error(rw, fmt.Sprintf("unsupported ConversionReview version %q", typeMeta.APIVersion), http.StatusBadRequest)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(rw).Encode(review); err != nil {
+		klog.Errorf("failed to write ConversionReview response: %v", err)
+	}
+}
+
+// convertObjects converts all objects to the desired version, the conversion fails if any of them fails
+func convertObjects(objects []runtime.RawExtension, desiredAPIVersion string) ([]runtime.RawExtension, metav1.Status) {
+	gv, err := schema.ParseGroupVersion(desiredAPIVersion)
+	if err != nil {
+		return nil, statusFailure(err)
+	}
+	converted := make([]runtime.RawExtension, 0, len(objects))
+	for _, obj := range objects {
+		data, err := convertObject(obj.Raw, gv)
+		if err != nil {
+			klog.Errorf("conversion webhook: %v", err)
+			return nil, statusFailure(err)
+		}
+		converted = append(converted, runtime.RawExtension{Raw: data})
+	}
+	return converted, metav1.Status{Status: metav1.StatusSuccess}
+}
+
+func convertObject(data []byte, gv schema.GroupVersion) ([]byte, error) {
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal object: %v", err)
+	}
+	gvk := typeMeta.GroupVersionKind()
+	if gvk.GroupVersion() == gv {
+		return data, nil
+	}
+
+	in, err := scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, in); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal %s: %v", gvk, err)
+	}
+	desiredGVK := gv.WithKind(gvk.Kind)
+	out, err := scheme.New(desiredGVK)
+	if err != nil {
+		return nil, err
+	}
+	if err := scheme.Convert(in, out, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert %s to %s: %v", gvk, desiredGVK, err)
+	}
+	out.GetObjectKind().SetGroupVersionKind(desiredGVK)
+	return json.Marshal(out)
+}
+
+func statusFailure(err error) metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pingcap/tidb-operator/pkg/apis/label"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1alpha1"
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1beta1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+)
+
+func TestWebhook(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tc := &v1alpha1.TidbCluster{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "pingcap.com/v1alpha1", Kind: "TidbCluster"},
+		ObjectMeta: metav1.ObjectMeta{Name: "basic", Namespace: "default"},
+		Spec: v1alpha1.TidbClusterSpec{
+			Version: "v7.5.0",
+			PD: &v1alpha1.PDSpec{
+				ComponentSpec: v1alpha1.ComponentSpec{Image: "pingcap/pd:v7.5.0"},
+				Replicas:      3,
+			},
+		},
+	}
+	raw, err := json.Marshal(tc)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	post := func(review interface{}) *httptest.ResponseRecorder {
+		body, err := json.Marshal(review)
+		g.Expect(err).NotTo(HaveOccurred())
+		rw := httptest.NewRecorder()
+		NewWebhook().ServeHTTP(rw, httptest.NewRequest(http.MethodPost, Path, bytes.NewReader(body)))
+		return rw
+	}
+
+	// v1alpha1 -> v1beta1 with apiextensions.k8s.io/v1 ConversionReview
+	rw := post(&apiextensionsv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+		Request: &apiextensionsv1.ConversionRequest{
+			UID:               types.UID("uid"),
+			DesiredAPIVersion: "pingcap.com/v1beta1",
+			Objects:           []runtime.RawExtension{{Raw: raw}},
+		},
+	})
+	g.Expect(rw.Code).To(Equal(http.StatusOK))
+	review := &apiextensionsv1.ConversionReview{}
+	g.Expect(json.Unmarshal(rw.Body.Bytes(), review)).To(Succeed())
+	g.Expect(review.Response.UID).To(Equal(types.UID("uid")))
+	g.Expect(review.Response.Result.Status).To(Equal(metav1.StatusSuccess))
+	g.Expect(review.Response.ConvertedObjects).To(HaveLen(1))
+	beta := &v1beta1.TidbCluster{}
+	g.Expect(json.Unmarshal(review.Response.ConvertedObjects[0].Raw, beta)).To(Succeed())
+	g.Expect(beta.APIVersion).To(Equal("pingcap.com/v1beta1"))
+	g.Expect(beta.Kind).To(Equal("TidbCluster"))
+	g.Expect(beta.Spec.PD.Replicas).To(Equal(int32(3)))
+	g.Expect(beta.Annotations).To(HaveKeyWithValue(label.AnnV1alpha1Fields, `{"images":{"pd":"pingcap/pd:v7.5.0"}}`))
+
+	// v1beta1 -> v1alpha1 with apiextensions.k8s.io/v1beta1 ConversionReview
+	rw = post(&apiextensionsv1beta1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1beta1", Kind: "ConversionReview"},
+		Request: &apiextensionsv1beta1.ConversionRequest{
+			UID:               types.UID("uid"),
+			DesiredAPIVersion: "pingcap.com/v1alpha1",
+			Objects:           review.Response.ConvertedObjects,
+		},
+	})
+	g.Expect(rw.Code).To(Equal(http.StatusOK))
+	betaReview := &apiextensionsv1beta1.ConversionReview{}
+	g.Expect(json.Unmarshal(rw.Body.Bytes(), betaReview)).To(Succeed())
+	g.Expect(betaReview.Response.Result.Status).To(Equal(metav1.StatusSuccess))
+	alpha := &v1alpha1.TidbCluster{}
+	g.Expect(json.Unmarshal(betaReview.Response.ConvertedObjects[0].Raw, alpha)).To(Succeed())
+	g.Expect(alpha).To(Equal(tc))
+
+	// unknown version
+	rw = post(&apiextensionsv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+		Request: &apiextensionsv1.ConversionRequest{
+			DesiredAPIVersion: "pingcap.com/v2",
+			Objects:           []runtime.RawExtension{{Raw: raw}},
+		},
+	})
+	review = &apiextensionsv1.ConversionReview{}
+	g.Expect(json.Unmarshal(rw.Body.Bytes(), review)).To(Succeed())
+	g.Expect(review.Response.Result.Status).To(Equal(metav1.StatusFailure))
+	g.Expect(review.Response.ConvertedObjects).To(BeEmpty())
+
+	// not a ConversionReview
+	rw = post(&metav1.Status{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Status"}})
+	g.Expect(rw.Code).To(Equal(http.StatusBadRequest))
+}
+
+func TestRegister(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	extCli := apiextensionsfake.NewSimpleClientset(&apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "tidbclusters.pingcap.com"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{Name: "v1alpha1", Served: true, Storage: true},
+				{Name: "v1beta1", Served: false},
+			},
+		},
+	})
+	service := &apiextensionsv1.ServiceReference{
+		Namespace: "tidb-admin",
+		Name:      "tidb-admission-webhook",
+		Path:      pointer.StringPtr(Path),
+		Port:      pointer.Int32Ptr(6444),
+	}
+	g.Expect(Register(extCli, service, []byte("ca"))).To(Succeed())
+
+	crd, err := extCli.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), "tidbclusters.pingcap.com", metav1.GetOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(crd.Spec.Conversion.Strategy).To(Equal(apiextensionsv1.WebhookConverter))
+	g.Expect(crd.Spec.Conversion.Webhook.ClientConfig.Service).To(Equal(service))
+	g.Expect(crd.Spec.Conversion.Webhook.ClientConfig.CABundle).To(Equal([]byte("ca")))
+	g.Expect(crd.Spec.Conversion.Webhook.ConversionReviewVersions).To(Equal([]string{"v1", "v1beta1"}))
+	// v1beta1 is served once the webhook is registered
+	g.Expect(crd.Spec.Versions[0].Served).To(BeTrue())
+	g.Expect(crd.Spec.Versions[1].Served).To(BeTrue())
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conversion
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb-operator/pkg/apis/pingcap/v1beta1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+)
+
+// Register configures the CRDs of the resources served in v1beta1 to convert
+// their objects by the webhook behind the given Service. The v1beta1 version is
+// shipped unserved in the CRDs since it can't be served without the webhook, it
+// is served once the webhook is registered.
+func Register(extCli apiextensionsclientset.Interface, service *apiextensionsv1.ServiceReference, caBundle []byte) error {
+	conversion := &apiextensionsv1.CustomResourceConversion{
+		Strategy: apiextensionsv1.WebhookConverter,
+		Webhook: &apiextensionsv1.WebhookConversion{
+			ClientConfig: &apiextensionsv1.WebhookClientConfig{
+				Service:  service,
+				CABundle: caBundle,
+			},
+			ConversionReviewVersions: []string{"v1", "v1beta1"},
+		},
+	}
+	for _, resource := range v1beta1.Resources {
+		name := v1beta1.Resource(resource).String()
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			crd, err := extCli.ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			served := serveVersion(crd, v1beta1.SchemeGroupVersion.Version)
+			if served && apiequality.Semantic.DeepEqual(crd.Spec.Conversion, conversion) {
+				return nil
+			}
+			crd.Spec.Conversion = conversion
+			_, err = extCli.ApiextensionsV1().CustomResourceDefinitions().Update(context.TODO(), crd, metav1.UpdateOptions{})
+			return err
+		})
+		if apierrors.IsNotFound(err) {
+			klog.Infof("CRD %s is not found, skip registering the conversion webhook", name)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		klog.Infof("conversion webhook is registered for CRD %s", name)
+	}
+	return nil
+}
+
+// serveVersion marks the version of the CRD as served, it returns whether the
+// version was served already.
+func serveVersion(crd *apiextensionsv1.CustomResourceDefinition, version string) bool {
+	for i := range crd.Spec.Versions {
+		if crd.Spec.Versions[i].Name != version {
+			continue
+		}
+		served := crd.Spec.Versions[i].Served
+		crd.Spec.Versions[i].Served = true
+		return served
+	}
+	return true
+}